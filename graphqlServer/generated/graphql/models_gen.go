@@ -6,8 +6,47 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
 )
 
+type Activity struct {
+	ID        string         `json:"id"`
+	ListID    string         `json:"listId"`
+	TodoID    *string        `json:"todoId,omitempty"`
+	ActorID   *string        `json:"actorId,omitempty"`
+	Actor     *User          `json:"actor,omitempty"`
+	Action    string         `json:"action"`
+	Changes   []*FieldChange `json:"changes"`
+	CreatedAt string         `json:"createdAt"`
+}
+
+type BoardColumn struct {
+	Status *WorkflowStatus `json:"status"`
+	Todos  []*Todo         `json:"todos"`
+}
+
+type BulkTodoInput struct {
+	Ids        []string       `json:"ids"`
+	Action     BulkTodoAction `json:"action"`
+	Priority   *Priority      `json:"priority,omitempty"`
+	Tags       []string       `json:"tags,omitempty"`
+	AssignedTo *string        `json:"assignedTo,omitempty"`
+	ListID     *string        `json:"listId,omitempty"`
+}
+
+type Comment struct {
+	ID         string   `json:"id"`
+	TodoID     string   `json:"todoId"`
+	AuthorID   string   `json:"authorId"`
+	Author     *User    `json:"author"`
+	Body       string   `json:"body"`
+	MentionIds []string `json:"mentionIds"`
+	Mentions   []*User  `json:"mentions"`
+	CreatedAt  string   `json:"createdAt"`
+	UpdatedAt  string   `json:"updatedAt"`
+}
+
 type CreateListInput struct {
 	Name        string     `json:"name"`
 	Description *string    `json:"description,omitempty"`
@@ -17,15 +56,19 @@ type CreateListInput struct {
 }
 
 type CreateTodoInput struct {
-	ListID      string    `json:"listId"`
-	Title       string    `json:"title"`
-	Description *string   `json:"description,omitempty"`
-	DueDate     *string   `json:"dueDate,omitempty"`
-	StartDate   *string   `json:"startDate,omitempty"`
-	Priority    *Priority `json:"priority,omitempty"`
-	Tags        []string  `json:"tags,omitempty"`
-	Completed   *bool     `json:"completed,omitempty"`
-	AssignedTo  *string   `json:"assignedTo,omitempty"`
+	ListID          string                   `json:"listId"`
+	Title           string                   `json:"title"`
+	Description     *string                  `json:"description,omitempty"`
+	DueDate         *string                  `json:"dueDate,omitempty"`
+	StartDate       *string                  `json:"startDate,omitempty"`
+	Priority        *Priority                `json:"priority,omitempty"`
+	Tags            []string                 `json:"tags,omitempty"`
+	Completed       *bool                    `json:"completed,omitempty"`
+	AssignedTo      *string                  `json:"assignedTo,omitempty"`
+	ParentID        *string                  `json:"parentId,omitempty"`
+	Recurrence      *RecurrenceInput         `json:"recurrence,omitempty"`
+	EstimateMinutes *int                     `json:"estimateMinutes,omitempty"`
+	CustomFields    []*CustomFieldValueInput `json:"customFields,omitempty"`
 }
 
 type CreateUserInput struct {
@@ -34,6 +77,39 @@ type CreateUserInput struct {
 	Role     UserRole `json:"role"`
 }
 
+type CustomField struct {
+	ID        string          `json:"id"`
+	ListID    string          `json:"listId"`
+	Name      string          `json:"name"`
+	Type      CustomFieldType `json:"type"`
+	Options   []string        `json:"options"`
+	CreatedAt string          `json:"createdAt"`
+}
+
+type CustomFieldInput struct {
+	Name    string          `json:"name"`
+	Type    CustomFieldType `json:"type"`
+	Options []string        `json:"options,omitempty"`
+}
+
+type CustomFieldValue struct {
+	FieldID string          `json:"fieldId"`
+	Name    string          `json:"name"`
+	Type    CustomFieldType `json:"type"`
+	Value   *string         `json:"value,omitempty"`
+}
+
+type CustomFieldValueInput struct {
+	FieldID string  `json:"fieldId"`
+	Value   *string `json:"value,omitempty"`
+}
+
+type FieldChange struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before,omitempty"`
+	After  *string `json:"after,omitempty"`
+}
+
 type GrantListAccessInput struct {
 	ListID      string      `json:"listId"`
 	UserID      string      `json:"userId"`
@@ -42,16 +118,21 @@ type GrantListAccessInput struct {
 }
 
 type List struct {
-	ID            string        `json:"id"`
-	Name          string        `json:"name"`
-	Description   *string       `json:"description,omitempty"`
-	Owner         *User         `json:"owner"`
-	Visibility    Visibility    `json:"visibility"`
-	Tags          []string      `json:"tags,omitempty"`
-	CreatedAt     string        `json:"createdAt"`
-	UpdatedAt     string        `json:"updatedAt"`
-	Todos         []*Todo       `json:"todos"`
-	Collaborators []*ListAccess `json:"collaborators"`
+	ID            string            `json:"id"`
+	Name          string            `json:"name"`
+	Description   *string           `json:"description,omitempty"`
+	Owner         *User             `json:"owner"`
+	Visibility    Visibility        `json:"visibility"`
+	Tags          []string          `json:"tags,omitempty"`
+	CreatedAt     string            `json:"createdAt"`
+	UpdatedAt     string            `json:"updatedAt"`
+	Version       int               `json:"version"`
+	Todos         []*Todo           `json:"todos"`
+	Collaborators []*ListAccess     `json:"collaborators"`
+	Activity      []*Activity       `json:"activity"`
+	TimeTotal     *TimeTotal        `json:"timeTotal"`
+	CustomFields  []*CustomField    `json:"customFields"`
+	Statuses      []*WorkflowStatus `json:"statuses"`
 }
 
 type ListAccess struct {
@@ -61,43 +142,212 @@ type ListAccess struct {
 	Status      *string     `json:"status,omitempty"`
 }
 
+type ListTemplate struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Tags        []string        `json:"tags,omitempty"`
+	Todos       []*TemplateTodo `json:"todos"`
+	CreatedAt   string          `json:"createdAt"`
+	UpdatedAt   string          `json:"updatedAt"`
+}
+
+type ListTemplateInput struct {
+	Name        string               `json:"name"`
+	Description *string              `json:"description,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Todos       []*TemplateTodoInput `json:"todos"`
+}
+
 type Mutation struct {
 }
 
 type Query struct {
 }
 
+type Recurrence struct {
+	Frequency  RecurrenceFrequency `json:"frequency"`
+	Interval   int                 `json:"interval"`
+	Weekdays   []int               `json:"weekdays,omitempty"`
+	DayOfMonth *int                `json:"dayOfMonth,omitempty"`
+}
+
+type RecurrenceInput struct {
+	Frequency  RecurrenceFrequency `json:"frequency"`
+	Interval   *int                `json:"interval,omitempty"`
+	Weekdays   []int               `json:"weekdays,omitempty"`
+	DayOfMonth *int                `json:"dayOfMonth,omitempty"`
+}
+
+type SavedFilter struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+	CreatedAt  string `json:"createdAt"`
+	UpdatedAt  string `json:"updatedAt"`
+}
+
+type SavedFilterInput struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+}
+
+type SearchResult struct {
+	Type    SearchResultType `json:"type"`
+	ID      string           `json:"id"`
+	ListID  string           `json:"listId"`
+	Title   string           `json:"title"`
+	Snippet string           `json:"snippet"`
+	Rank    float64          `json:"rank"`
+}
+
+type Tag struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Color       string  `json:"color"`
+	Description string  `json:"description"`
+	UserID      *string `json:"userId,omitempty"`
+	ListID      *string `json:"listId,omitempty"`
+	UsageCount  int     `json:"usageCount"`
+	CreatedAt   string  `json:"createdAt"`
+}
+
+type TagInput struct {
+	Name        string  `json:"name"`
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+type TemplateTodo struct {
+	Title           string          `json:"title"`
+	Description     string          `json:"description"`
+	Priority        Priority        `json:"priority"`
+	Tags            []string        `json:"tags,omitempty"`
+	StartOffsetDays *int            `json:"startOffsetDays,omitempty"`
+	DueOffsetDays   *int            `json:"dueOffsetDays,omitempty"`
+	Subtasks        []*TemplateTodo `json:"subtasks"`
+}
+
+type TemplateTodoInput struct {
+	Title           string               `json:"title"`
+	Description     *string              `json:"description,omitempty"`
+	Priority        *Priority            `json:"priority,omitempty"`
+	Tags            []string             `json:"tags,omitempty"`
+	StartOffsetDays *int                 `json:"startOffsetDays,omitempty"`
+	DueOffsetDays   *int                 `json:"dueOffsetDays,omitempty"`
+	Subtasks        []*TemplateTodoInput `json:"subtasks,omitempty"`
+}
+
+type TimeEntry struct {
+	ID        string  `json:"id"`
+	TodoID    string  `json:"todoId"`
+	UserID    string  `json:"userId"`
+	User      *User   `json:"user"`
+	StartedAt string  `json:"startedAt"`
+	EndedAt   *string `json:"endedAt,omitempty"`
+	Note      string  `json:"note"`
+	CreatedAt string  `json:"createdAt"`
+}
+
+type TimeEntryInput struct {
+	StartedAt string  `json:"startedAt"`
+	EndedAt   string  `json:"endedAt"`
+	Note      *string `json:"note,omitempty"`
+}
+
+type TimeTotal struct {
+	Seconds int `json:"seconds"`
+	Entries int `json:"entries"`
+}
+
 type Todo struct {
-	ID          string    `json:"id"`
-	List        *List     `json:"list"`
-	Title       string    `json:"title"`
-	Description *string   `json:"description,omitempty"`
-	Completed   bool      `json:"completed"`
-	DueDate     *string   `json:"dueDate,omitempty"`
-	StartDate   *string   `json:"startDate,omitempty"`
-	Priority    *Priority `json:"priority,omitempty"`
-	Tags        []string  `json:"tags,omitempty"`
-	CreatedAt   string    `json:"createdAt"`
-	UpdatedAt   string    `json:"updatedAt"`
-	AssignedTo  *User     `json:"assignedTo,omitempty"`
+	ID                string              `json:"id"`
+	List              *List               `json:"list"`
+	Title             string              `json:"title"`
+	Description       *string             `json:"description,omitempty"`
+	Completed         bool                `json:"completed"`
+	CompletedAt       *string             `json:"completedAt,omitempty"`
+	DueDate           *string             `json:"dueDate,omitempty"`
+	StartDate         *string             `json:"startDate,omitempty"`
+	Priority          *Priority           `json:"priority,omitempty"`
+	Tags              []string            `json:"tags,omitempty"`
+	CreatedAt         string              `json:"createdAt"`
+	UpdatedAt         string              `json:"updatedAt"`
+	Version           int                 `json:"version"`
+	AssignedTo        *User               `json:"assignedTo,omitempty"`
+	Parent            *Todo               `json:"parent,omitempty"`
+	Subtasks          []*Todo             `json:"subtasks"`
+	SubtasksTotal     int                 `json:"subtasksTotal"`
+	SubtasksCompleted int                 `json:"subtasksCompleted"`
+	Recurrence        *Recurrence         `json:"recurrence,omitempty"`
+	BlockedBy         []*Todo             `json:"blockedBy"`
+	Blocking          []*Todo             `json:"blocking"`
+	Position          string              `json:"position"`
+	Comments          []*Comment          `json:"comments"`
+	Activity          []*Activity         `json:"activity"`
+	EstimateMinutes   *int                `json:"estimateMinutes,omitempty"`
+	TimeEntries       []*TimeEntry        `json:"timeEntries"`
+	TimeTotal         *TimeTotal          `json:"timeTotal"`
+	CustomFields      []*CustomFieldValue `json:"customFields"`
+	StatusID          *string             `json:"statusId,omitempty"`
+	Status            *WorkflowStatus     `json:"status,omitempty"`
+}
+
+type TodoDateGroup struct {
+	Date  *string `json:"date,omitempty"`
+	Todos []*Todo `json:"todos"`
+}
+
+type TodoFilterInput struct {
+	Completed    *bool                    `json:"completed,omitempty"`
+	Priority     *Priority                `json:"priority,omitempty"`
+	Tag          *string                  `json:"tag,omitempty"`
+	TagID        *string                  `json:"tagId,omitempty"`
+	StatusID     *string                  `json:"statusId,omitempty"`
+	AssignedTo   *string                  `json:"assignedTo,omitempty"`
+	DueBefore    *string                  `json:"dueBefore,omitempty"`
+	DueAfter     *string                  `json:"dueAfter,omitempty"`
+	CustomFields []*CustomFieldValueInput `json:"customFields,omitempty"`
+}
+
+type TodoOrderInput struct {
+	Field      TodoSortField `json:"field"`
+	Descending *bool         `json:"descending,omitempty"`
+}
+
+type TodoView struct {
+	View     SmartView        `json:"view"`
+	TimeZone string           `json:"timeZone"`
+	Groups   []*TodoDateGroup `json:"groups"`
+}
+
+type TrashItem struct {
+	Type      TrashItemType `json:"type"`
+	ID        string        `json:"id"`
+	ListID    string        `json:"listId"`
+	Title     string        `json:"title"`
+	DeletedAt string        `json:"deletedAt"`
 }
 
 type UpdateListInput struct {
-	Name        *string     `json:"name,omitempty"`
-	Description *string     `json:"description,omitempty"`
-	Visibility  *Visibility `json:"visibility,omitempty"`
-	Tags        []string    `json:"tags,omitempty"`
+	Name        graphql.Omittable[*string]     `json:"name,omitempty"`
+	Description graphql.Omittable[*string]     `json:"description,omitempty"`
+	Visibility  graphql.Omittable[*Visibility] `json:"visibility,omitempty"`
+	Tags        graphql.Omittable[[]string]    `json:"tags,omitempty"`
 }
 
 type UpdateTodoInput struct {
-	Title       *string   `json:"title,omitempty"`
-	Description *string   `json:"description,omitempty"`
-	Completed   *bool     `json:"completed,omitempty"`
-	DueDate     *string   `json:"dueDate,omitempty"`
-	StartDate   *string   `json:"startDate,omitempty"`
-	Priority    *Priority `json:"priority,omitempty"`
-	Tags        []string  `json:"tags,omitempty"`
-	AssignedTo  *string   `json:"assignedTo,omitempty"`
+	Title           graphql.Omittable[*string]                  `json:"title,omitempty"`
+	Description     graphql.Omittable[*string]                  `json:"description,omitempty"`
+	Completed       graphql.Omittable[*bool]                    `json:"completed,omitempty"`
+	DueDate         graphql.Omittable[*string]                  `json:"dueDate,omitempty"`
+	StartDate       graphql.Omittable[*string]                  `json:"startDate,omitempty"`
+	Priority        graphql.Omittable[*Priority]                `json:"priority,omitempty"`
+	Tags            graphql.Omittable[[]string]                 `json:"tags,omitempty"`
+	AssignedTo      graphql.Omittable[*string]                  `json:"assignedTo,omitempty"`
+	Recurrence      graphql.Omittable[*RecurrenceInput]         `json:"recurrence,omitempty"`
+	EstimateMinutes graphql.Omittable[*int]                     `json:"estimateMinutes,omitempty"`
+	CustomFields    graphql.Omittable[[]*CustomFieldValueInput] `json:"customFields,omitempty"`
 }
 
 type UpdateUserInput struct {
@@ -115,6 +365,15 @@ type User struct {
 	UpdatedAt string   `json:"updatedAt"`
 }
 
+type WorkflowStatus struct {
+	ID        string         `json:"id"`
+	ListID    string         `json:"listId"`
+	Name      string         `json:"name"`
+	Category  StatusCategory `json:"category"`
+	Position  int            `json:"position"`
+	CreatedAt string         `json:"createdAt"`
+}
+
 type AccessLevel string
 
 const (
@@ -158,6 +417,106 @@ func (e AccessLevel) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type BulkTodoAction string
+
+const (
+	BulkTodoActionComplete    BulkTodoAction = "COMPLETE"
+	BulkTodoActionReopen      BulkTodoAction = "REOPEN"
+	BulkTodoActionDelete      BulkTodoAction = "DELETE"
+	BulkTodoActionSetPriority BulkTodoAction = "SET_PRIORITY"
+	BulkTodoActionAddTags     BulkTodoAction = "ADD_TAGS"
+	BulkTodoActionRemoveTags  BulkTodoAction = "REMOVE_TAGS"
+	BulkTodoActionAssign      BulkTodoAction = "ASSIGN"
+	BulkTodoActionMove        BulkTodoAction = "MOVE"
+)
+
+var AllBulkTodoAction = []BulkTodoAction{
+	BulkTodoActionComplete,
+	BulkTodoActionReopen,
+	BulkTodoActionDelete,
+	BulkTodoActionSetPriority,
+	BulkTodoActionAddTags,
+	BulkTodoActionRemoveTags,
+	BulkTodoActionAssign,
+	BulkTodoActionMove,
+}
+
+func (e BulkTodoAction) IsValid() bool {
+	switch e {
+	case BulkTodoActionComplete, BulkTodoActionReopen, BulkTodoActionDelete, BulkTodoActionSetPriority, BulkTodoActionAddTags, BulkTodoActionRemoveTags, BulkTodoActionAssign, BulkTodoActionMove:
+		return true
+	}
+	return false
+}
+
+func (e BulkTodoAction) String() string {
+	return string(e)
+}
+
+func (e *BulkTodoAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BulkTodoAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BulkTodoAction", str)
+	}
+	return nil
+}
+
+func (e BulkTodoAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText         CustomFieldType = "TEXT"
+	CustomFieldTypeNumber       CustomFieldType = "NUMBER"
+	CustomFieldTypeDate         CustomFieldType = "DATE"
+	CustomFieldTypeSingleSelect CustomFieldType = "SINGLE_SELECT"
+	CustomFieldTypeCheckbox     CustomFieldType = "CHECKBOX"
+)
+
+var AllCustomFieldType = []CustomFieldType{
+	CustomFieldTypeText,
+	CustomFieldTypeNumber,
+	CustomFieldTypeDate,
+	CustomFieldTypeSingleSelect,
+	CustomFieldTypeCheckbox,
+}
+
+func (e CustomFieldType) IsValid() bool {
+	switch e {
+	case CustomFieldTypeText, CustomFieldTypeNumber, CustomFieldTypeDate, CustomFieldTypeSingleSelect, CustomFieldTypeCheckbox:
+		return true
+	}
+	return false
+}
+
+func (e CustomFieldType) String() string {
+	return string(e)
+}
+
+func (e *CustomFieldType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CustomFieldType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CustomFieldType", str)
+	}
+	return nil
+}
+
+func (e CustomFieldType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Priority string
 
 const (
@@ -201,6 +560,266 @@ func (e Priority) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyDaily           RecurrenceFrequency = "DAILY"
+	RecurrenceFrequencyWeekly          RecurrenceFrequency = "WEEKLY"
+	RecurrenceFrequencyMonthly         RecurrenceFrequency = "MONTHLY"
+	RecurrenceFrequencyAfterCompletion RecurrenceFrequency = "AFTER_COMPLETION"
+)
+
+var AllRecurrenceFrequency = []RecurrenceFrequency{
+	RecurrenceFrequencyDaily,
+	RecurrenceFrequencyWeekly,
+	RecurrenceFrequencyMonthly,
+	RecurrenceFrequencyAfterCompletion,
+}
+
+func (e RecurrenceFrequency) IsValid() bool {
+	switch e {
+	case RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly, RecurrenceFrequencyMonthly, RecurrenceFrequencyAfterCompletion:
+		return true
+	}
+	return false
+}
+
+func (e RecurrenceFrequency) String() string {
+	return string(e)
+}
+
+func (e *RecurrenceFrequency) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RecurrenceFrequency(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RecurrenceFrequency", str)
+	}
+	return nil
+}
+
+func (e RecurrenceFrequency) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SearchResultType string
+
+const (
+	SearchResultTypeTodo SearchResultType = "TODO"
+	SearchResultTypeList SearchResultType = "LIST"
+)
+
+var AllSearchResultType = []SearchResultType{
+	SearchResultTypeTodo,
+	SearchResultTypeList,
+}
+
+func (e SearchResultType) IsValid() bool {
+	switch e {
+	case SearchResultTypeTodo, SearchResultTypeList:
+		return true
+	}
+	return false
+}
+
+func (e SearchResultType) String() string {
+	return string(e)
+}
+
+func (e *SearchResultType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchResultType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchResultType", str)
+	}
+	return nil
+}
+
+func (e SearchResultType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SmartView string
+
+const (
+	SmartViewToday    SmartView = "TODAY"
+	SmartViewUpcoming SmartView = "UPCOMING"
+	SmartViewOverdue  SmartView = "OVERDUE"
+	SmartViewInbox    SmartView = "INBOX"
+)
+
+var AllSmartView = []SmartView{
+	SmartViewToday,
+	SmartViewUpcoming,
+	SmartViewOverdue,
+	SmartViewInbox,
+}
+
+func (e SmartView) IsValid() bool {
+	switch e {
+	case SmartViewToday, SmartViewUpcoming, SmartViewOverdue, SmartViewInbox:
+		return true
+	}
+	return false
+}
+
+func (e SmartView) String() string {
+	return string(e)
+}
+
+func (e *SmartView) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SmartView(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SmartView", str)
+	}
+	return nil
+}
+
+func (e SmartView) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type StatusCategory string
+
+const (
+	StatusCategoryOpen StatusCategory = "OPEN"
+	StatusCategoryDone StatusCategory = "DONE"
+)
+
+var AllStatusCategory = []StatusCategory{
+	StatusCategoryOpen,
+	StatusCategoryDone,
+}
+
+func (e StatusCategory) IsValid() bool {
+	switch e {
+	case StatusCategoryOpen, StatusCategoryDone:
+		return true
+	}
+	return false
+}
+
+func (e StatusCategory) String() string {
+	return string(e)
+}
+
+func (e *StatusCategory) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatusCategory(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatusCategory", str)
+	}
+	return nil
+}
+
+func (e StatusCategory) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TodoSortField string
+
+const (
+	TodoSortFieldPosition  TodoSortField = "POSITION"
+	TodoSortFieldCreatedAt TodoSortField = "CREATED_AT"
+	TodoSortFieldDueDate   TodoSortField = "DUE_DATE"
+	TodoSortFieldPriority  TodoSortField = "PRIORITY"
+	TodoSortFieldTitle     TodoSortField = "TITLE"
+)
+
+var AllTodoSortField = []TodoSortField{
+	TodoSortFieldPosition,
+	TodoSortFieldCreatedAt,
+	TodoSortFieldDueDate,
+	TodoSortFieldPriority,
+	TodoSortFieldTitle,
+}
+
+func (e TodoSortField) IsValid() bool {
+	switch e {
+	case TodoSortFieldPosition, TodoSortFieldCreatedAt, TodoSortFieldDueDate, TodoSortFieldPriority, TodoSortFieldTitle:
+		return true
+	}
+	return false
+}
+
+func (e TodoSortField) String() string {
+	return string(e)
+}
+
+func (e *TodoSortField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoSortField", str)
+	}
+	return nil
+}
+
+func (e TodoSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TrashItemType string
+
+const (
+	TrashItemTypeTodo TrashItemType = "TODO"
+	TrashItemTypeList TrashItemType = "LIST"
+)
+
+var AllTrashItemType = []TrashItemType{
+	TrashItemTypeTodo,
+	TrashItemTypeList,
+}
+
+func (e TrashItemType) IsValid() bool {
+	switch e {
+	case TrashItemTypeTodo, TrashItemTypeList:
+		return true
+	}
+	return false
+}
+
+func (e TrashItemType) String() string {
+	return string(e)
+}
+
+func (e *TrashItemType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TrashItemType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TrashItemType", str)
+	}
+	return nil
+}
+
+func (e TrashItemType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type UserRole string
 
 const (
@@ -30,3 +30,5 @@ require (
 	golang.org/x/sys v0.21.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/Victor-Uzunov/devops-project/todoservice => ../todoservice
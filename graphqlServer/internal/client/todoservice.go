@@ -3,14 +3,32 @@ package client
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/etag"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
 	"io"
 	"net/http"
 	"time"
 )
 
+// ErrVersionMismatch is returned when the todo service refuses a request
+// made with WithExpectedVersion because the version has changed.
+var ErrVersionMismatch = errors.New("the version has changed since it was read")
+
+type expectedVersionKey struct{}
+
+// WithExpectedVersion makes requests made with the context send the version in
+// the If-Match header. A nil version leaves the context as it is.
+func WithExpectedVersion(ctx context.Context, version *int) context.Context {
+	if version == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, expectedVersionKey{}, *version)
+}
+
 type Client interface {
 	Do(ctx context.Context, method, url string, body []byte) ([]byte, error)
 }
@@ -57,6 +75,9 @@ func (c *client) Do(ctx context.Context, method, url string, body []byte) ([]byt
 	}
 	req.Header.Set("Content-Type", constants.ContentTypeJSON)
 	req.Header.Set(constants.AuthorizationHeader, fmt.Sprintf("Bearer %s", token))
+	if version, ok := ctx.Value(expectedVersionKey{}).(int); ok {
+		req.Header.Set("If-Match", etag.Format(version))
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -72,13 +93,21 @@ func (c *client) Do(ctx context.Context, method, url string, body []byte) ([]byt
 			return
 		}
 	}()
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
-		return nil, fmt.Errorf("status code %d", resp.StatusCode)
-	}
 	body, err = io.ReadAll(resp.Body)
 	if err != nil {
 		log.C(ctx).Errorf("error reading response body for url %s and method %s", endpoint, method)
 		return nil, fmt.Errorf("error reading response body: %w", err)
 	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		if resp.StatusCode == http.StatusBadRequest {
+			if validationErr := validation.Decode(body); validationErr != nil {
+				return nil, fmt.Errorf("status code %d: %w", resp.StatusCode, validationErr)
+			}
+		}
+		if resp.StatusCode == http.StatusPreconditionFailed {
+			return nil, fmt.Errorf("status code %d: %w", resp.StatusCode, ErrVersionMismatch)
+		}
+		return nil, fmt.Errorf("status code %d", resp.StatusCode)
+	}
 	return body, nil
 }
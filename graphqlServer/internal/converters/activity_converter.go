@@ -0,0 +1,61 @@
+package converters
+
+import (
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type ConverterActivityGraphQL struct{}
+
+//go:generate mockery --name=ActivityConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type ActivityConverter interface {
+	ConvertActivityToGraphQL(activity models.Activity) (*graphql.Activity, error)
+	ConvertMultipleActivitiesToGraphQL(activities []models.Activity) ([]*graphql.Activity, error)
+}
+
+func NewConverterActivityGraphQL() ActivityConverter {
+	return &ConverterActivityGraphQL{}
+}
+
+func (c *ConverterActivityGraphQL) ConvertActivityToGraphQL(activity models.Activity) (*graphql.Activity, error) {
+	changes := make([]*graphql.FieldChange, 0, len(activity.Changes))
+	for _, change := range activity.Changes {
+		changes = append(changes, &graphql.FieldChange{
+			Field:  change.Field,
+			Before: rawJSONToString(change.Before),
+			After:  rawJSONToString(change.After),
+		})
+	}
+	return &graphql.Activity{
+		ID:        activity.ID,
+		ListID:    activity.ListID,
+		TodoID:    activity.TodoID,
+		ActorID:   activity.ActorID,
+		Action:    string(activity.Action),
+		Changes:   changes,
+		CreatedAt: activity.CreatedAt.Format(constants.DateFormat),
+	}, nil
+}
+
+func (c *ConverterActivityGraphQL) ConvertMultipleActivitiesToGraphQL(activities []models.Activity) ([]*graphql.Activity, error) {
+	graphqlActivities := make([]*graphql.Activity, 0, len(activities))
+	for _, activity := range activities {
+		graphqlActivity, err := c.ConvertActivityToGraphQL(activity)
+		if err != nil {
+			return nil, err
+		}
+		graphqlActivities = append(graphqlActivities, graphqlActivity)
+	}
+	return graphqlActivities, nil
+}
+
+// rawJSONToString keeps a field value JSON encoded and maps a missing value to nil.
+func rawJSONToString(value json.RawMessage) *string {
+	if len(value) == 0 || string(value) == "null" {
+		return nil
+	}
+	encoded := string(value)
+	return &encoded
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// ActivityConverter is an autogenerated mock type for the ActivityConverter type
+type ActivityConverter struct {
+	mock.Mock
+}
+
+type ActivityConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ActivityConverter) EXPECT() *ActivityConverter_Expecter {
+	return &ActivityConverter_Expecter{mock: &_m.Mock}
+}
+
+// ConvertActivityToGraphQL provides a mock function with given fields: activity
+func (_m *ActivityConverter) ConvertActivityToGraphQL(activity models.Activity) (*graphql.Activity, error) {
+	ret := _m.Called(activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertActivityToGraphQL")
+	}
+
+	var r0 *graphql.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Activity) (*graphql.Activity, error)); ok {
+		return rf(activity)
+	}
+	if rf, ok := ret.Get(0).(func(models.Activity) *graphql.Activity); ok {
+		r0 = rf(activity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Activity) error); ok {
+		r1 = rf(activity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivityConverter_ConvertActivityToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertActivityToGraphQL'
+type ActivityConverter_ConvertActivityToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertActivityToGraphQL is a helper method to define mock.On call
+//   - activity models.Activity
+func (_e *ActivityConverter_Expecter) ConvertActivityToGraphQL(activity interface{}) *ActivityConverter_ConvertActivityToGraphQL_Call {
+	return &ActivityConverter_ConvertActivityToGraphQL_Call{Call: _e.mock.On("ConvertActivityToGraphQL", activity)}
+}
+
+func (_c *ActivityConverter_ConvertActivityToGraphQL_Call) Run(run func(activity models.Activity)) *ActivityConverter_ConvertActivityToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Activity))
+	})
+	return _c
+}
+
+func (_c *ActivityConverter_ConvertActivityToGraphQL_Call) Return(_a0 *graphql.Activity, _a1 error) *ActivityConverter_ConvertActivityToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ActivityConverter_ConvertActivityToGraphQL_Call) RunAndReturn(run func(models.Activity) (*graphql.Activity, error)) *ActivityConverter_ConvertActivityToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertMultipleActivitiesToGraphQL provides a mock function with given fields: activities
+func (_m *ActivityConverter) ConvertMultipleActivitiesToGraphQL(activities []models.Activity) ([]*graphql.Activity, error) {
+	ret := _m.Called(activities)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertMultipleActivitiesToGraphQL")
+	}
+
+	var r0 []*graphql.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.Activity) ([]*graphql.Activity, error)); ok {
+		return rf(activities)
+	}
+	if rf, ok := ret.Get(0).(func([]models.Activity) []*graphql.Activity); ok {
+		r0 = rf(activities)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.Activity) error); ok {
+		r1 = rf(activities)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivityConverter_ConvertMultipleActivitiesToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertMultipleActivitiesToGraphQL'
+type ActivityConverter_ConvertMultipleActivitiesToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertMultipleActivitiesToGraphQL is a helper method to define mock.On call
+//   - activities []models.Activity
+func (_e *ActivityConverter_Expecter) ConvertMultipleActivitiesToGraphQL(activities interface{}) *ActivityConverter_ConvertMultipleActivitiesToGraphQL_Call {
+	return &ActivityConverter_ConvertMultipleActivitiesToGraphQL_Call{Call: _e.mock.On("ConvertMultipleActivitiesToGraphQL", activities)}
+}
+
+func (_c *ActivityConverter_ConvertMultipleActivitiesToGraphQL_Call) Run(run func(activities []models.Activity)) *ActivityConverter_ConvertMultipleActivitiesToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.Activity))
+	})
+	return _c
+}
+
+func (_c *ActivityConverter_ConvertMultipleActivitiesToGraphQL_Call) Return(_a0 []*graphql.Activity, _a1 error) *ActivityConverter_ConvertMultipleActivitiesToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ActivityConverter_ConvertMultipleActivitiesToGraphQL_Call) RunAndReturn(run func([]models.Activity) ([]*graphql.Activity, error)) *ActivityConverter_ConvertMultipleActivitiesToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewActivityConverter creates a new instance of ActivityConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewActivityConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ActivityConverter {
+	mock := &ActivityConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
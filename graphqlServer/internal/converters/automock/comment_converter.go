@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// CommentConverter is an autogenerated mock type for the CommentConverter type
+type CommentConverter struct {
+	mock.Mock
+}
+
+type CommentConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CommentConverter) EXPECT() *CommentConverter_Expecter {
+	return &CommentConverter_Expecter{mock: &_m.Mock}
+}
+
+// ConvertCommentToGraphQL provides a mock function with given fields: comment
+func (_m *CommentConverter) ConvertCommentToGraphQL(comment models.Comment) (*graphql.Comment, error) {
+	ret := _m.Called(comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertCommentToGraphQL")
+	}
+
+	var r0 *graphql.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Comment) (*graphql.Comment, error)); ok {
+		return rf(comment)
+	}
+	if rf, ok := ret.Get(0).(func(models.Comment) *graphql.Comment); ok {
+		r0 = rf(comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Comment) error); ok {
+		r1 = rf(comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentConverter_ConvertCommentToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertCommentToGraphQL'
+type CommentConverter_ConvertCommentToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertCommentToGraphQL is a helper method to define mock.On call
+//   - comment models.Comment
+func (_e *CommentConverter_Expecter) ConvertCommentToGraphQL(comment interface{}) *CommentConverter_ConvertCommentToGraphQL_Call {
+	return &CommentConverter_ConvertCommentToGraphQL_Call{Call: _e.mock.On("ConvertCommentToGraphQL", comment)}
+}
+
+func (_c *CommentConverter_ConvertCommentToGraphQL_Call) Run(run func(comment models.Comment)) *CommentConverter_ConvertCommentToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Comment))
+	})
+	return _c
+}
+
+func (_c *CommentConverter_ConvertCommentToGraphQL_Call) Return(_a0 *graphql.Comment, _a1 error) *CommentConverter_ConvertCommentToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentConverter_ConvertCommentToGraphQL_Call) RunAndReturn(run func(models.Comment) (*graphql.Comment, error)) *CommentConverter_ConvertCommentToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertMultipleCommentsToGraphQL provides a mock function with given fields: comments
+func (_m *CommentConverter) ConvertMultipleCommentsToGraphQL(comments []models.Comment) ([]*graphql.Comment, error) {
+	ret := _m.Called(comments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertMultipleCommentsToGraphQL")
+	}
+
+	var r0 []*graphql.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.Comment) ([]*graphql.Comment, error)); ok {
+		return rf(comments)
+	}
+	if rf, ok := ret.Get(0).(func([]models.Comment) []*graphql.Comment); ok {
+		r0 = rf(comments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.Comment) error); ok {
+		r1 = rf(comments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentConverter_ConvertMultipleCommentsToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertMultipleCommentsToGraphQL'
+type CommentConverter_ConvertMultipleCommentsToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertMultipleCommentsToGraphQL is a helper method to define mock.On call
+//   - comments []models.Comment
+func (_e *CommentConverter_Expecter) ConvertMultipleCommentsToGraphQL(comments interface{}) *CommentConverter_ConvertMultipleCommentsToGraphQL_Call {
+	return &CommentConverter_ConvertMultipleCommentsToGraphQL_Call{Call: _e.mock.On("ConvertMultipleCommentsToGraphQL", comments)}
+}
+
+func (_c *CommentConverter_ConvertMultipleCommentsToGraphQL_Call) Run(run func(comments []models.Comment)) *CommentConverter_ConvertMultipleCommentsToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.Comment))
+	})
+	return _c
+}
+
+func (_c *CommentConverter_ConvertMultipleCommentsToGraphQL_Call) Return(_a0 []*graphql.Comment, _a1 error) *CommentConverter_ConvertMultipleCommentsToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentConverter_ConvertMultipleCommentsToGraphQL_Call) RunAndReturn(run func([]models.Comment) ([]*graphql.Comment, error)) *CommentConverter_ConvertMultipleCommentsToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCommentConverter creates a new instance of CommentConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommentConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommentConverter {
+	mock := &CommentConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,267 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// CustomFieldConverter is an autogenerated mock type for the CustomFieldConverter type
+type CustomFieldConverter struct {
+	mock.Mock
+}
+
+type CustomFieldConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CustomFieldConverter) EXPECT() *CustomFieldConverter_Expecter {
+	return &CustomFieldConverter_Expecter{mock: &_m.Mock}
+}
+
+// ConvertCustomFieldInput provides a mock function with given fields: input
+func (_m *CustomFieldConverter) ConvertCustomFieldInput(input graphql.CustomFieldInput) (models.CustomField, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertCustomFieldInput")
+	}
+
+	var r0 models.CustomField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(graphql.CustomFieldInput) (models.CustomField, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(graphql.CustomFieldInput) models.CustomField); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(models.CustomField)
+	}
+
+	if rf, ok := ret.Get(1).(func(graphql.CustomFieldInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldConverter_ConvertCustomFieldInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertCustomFieldInput'
+type CustomFieldConverter_ConvertCustomFieldInput_Call struct {
+	*mock.Call
+}
+
+// ConvertCustomFieldInput is a helper method to define mock.On call
+//   - input graphql.CustomFieldInput
+func (_e *CustomFieldConverter_Expecter) ConvertCustomFieldInput(input interface{}) *CustomFieldConverter_ConvertCustomFieldInput_Call {
+	return &CustomFieldConverter_ConvertCustomFieldInput_Call{Call: _e.mock.On("ConvertCustomFieldInput", input)}
+}
+
+func (_c *CustomFieldConverter_ConvertCustomFieldInput_Call) Run(run func(input graphql.CustomFieldInput)) *CustomFieldConverter_ConvertCustomFieldInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(graphql.CustomFieldInput))
+	})
+	return _c
+}
+
+func (_c *CustomFieldConverter_ConvertCustomFieldInput_Call) Return(_a0 models.CustomField, _a1 error) *CustomFieldConverter_ConvertCustomFieldInput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldConverter_ConvertCustomFieldInput_Call) RunAndReturn(run func(graphql.CustomFieldInput) (models.CustomField, error)) *CustomFieldConverter_ConvertCustomFieldInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertCustomFieldToGraphQL provides a mock function with given fields: field
+func (_m *CustomFieldConverter) ConvertCustomFieldToGraphQL(field models.CustomField) (*graphql.CustomField, error) {
+	ret := _m.Called(field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertCustomFieldToGraphQL")
+	}
+
+	var r0 *graphql.CustomField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.CustomField) (*graphql.CustomField, error)); ok {
+		return rf(field)
+	}
+	if rf, ok := ret.Get(0).(func(models.CustomField) *graphql.CustomField); ok {
+		r0 = rf(field)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.CustomField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.CustomField) error); ok {
+		r1 = rf(field)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldConverter_ConvertCustomFieldToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertCustomFieldToGraphQL'
+type CustomFieldConverter_ConvertCustomFieldToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertCustomFieldToGraphQL is a helper method to define mock.On call
+//   - field models.CustomField
+func (_e *CustomFieldConverter_Expecter) ConvertCustomFieldToGraphQL(field interface{}) *CustomFieldConverter_ConvertCustomFieldToGraphQL_Call {
+	return &CustomFieldConverter_ConvertCustomFieldToGraphQL_Call{Call: _e.mock.On("ConvertCustomFieldToGraphQL", field)}
+}
+
+func (_c *CustomFieldConverter_ConvertCustomFieldToGraphQL_Call) Run(run func(field models.CustomField)) *CustomFieldConverter_ConvertCustomFieldToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.CustomField))
+	})
+	return _c
+}
+
+func (_c *CustomFieldConverter_ConvertCustomFieldToGraphQL_Call) Return(_a0 *graphql.CustomField, _a1 error) *CustomFieldConverter_ConvertCustomFieldToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldConverter_ConvertCustomFieldToGraphQL_Call) RunAndReturn(run func(models.CustomField) (*graphql.CustomField, error)) *CustomFieldConverter_ConvertCustomFieldToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertCustomFieldValuesToGraphQL provides a mock function with given fields: values
+func (_m *CustomFieldConverter) ConvertCustomFieldValuesToGraphQL(values []models.CustomFieldValue) ([]*graphql.CustomFieldValue, error) {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertCustomFieldValuesToGraphQL")
+	}
+
+	var r0 []*graphql.CustomFieldValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.CustomFieldValue) ([]*graphql.CustomFieldValue, error)); ok {
+		return rf(values)
+	}
+	if rf, ok := ret.Get(0).(func([]models.CustomFieldValue) []*graphql.CustomFieldValue); ok {
+		r0 = rf(values)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.CustomFieldValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.CustomFieldValue) error); ok {
+		r1 = rf(values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldConverter_ConvertCustomFieldValuesToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertCustomFieldValuesToGraphQL'
+type CustomFieldConverter_ConvertCustomFieldValuesToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertCustomFieldValuesToGraphQL is a helper method to define mock.On call
+//   - values []models.CustomFieldValue
+func (_e *CustomFieldConverter_Expecter) ConvertCustomFieldValuesToGraphQL(values interface{}) *CustomFieldConverter_ConvertCustomFieldValuesToGraphQL_Call {
+	return &CustomFieldConverter_ConvertCustomFieldValuesToGraphQL_Call{Call: _e.mock.On("ConvertCustomFieldValuesToGraphQL", values)}
+}
+
+func (_c *CustomFieldConverter_ConvertCustomFieldValuesToGraphQL_Call) Run(run func(values []models.CustomFieldValue)) *CustomFieldConverter_ConvertCustomFieldValuesToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.CustomFieldValue))
+	})
+	return _c
+}
+
+func (_c *CustomFieldConverter_ConvertCustomFieldValuesToGraphQL_Call) Return(_a0 []*graphql.CustomFieldValue, _a1 error) *CustomFieldConverter_ConvertCustomFieldValuesToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldConverter_ConvertCustomFieldValuesToGraphQL_Call) RunAndReturn(run func([]models.CustomFieldValue) ([]*graphql.CustomFieldValue, error)) *CustomFieldConverter_ConvertCustomFieldValuesToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertMultipleCustomFieldsToGraphQL provides a mock function with given fields: fields
+func (_m *CustomFieldConverter) ConvertMultipleCustomFieldsToGraphQL(fields []models.CustomField) ([]*graphql.CustomField, error) {
+	ret := _m.Called(fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertMultipleCustomFieldsToGraphQL")
+	}
+
+	var r0 []*graphql.CustomField
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.CustomField) ([]*graphql.CustomField, error)); ok {
+		return rf(fields)
+	}
+	if rf, ok := ret.Get(0).(func([]models.CustomField) []*graphql.CustomField); ok {
+		r0 = rf(fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.CustomField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.CustomField) error); ok {
+		r1 = rf(fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldConverter_ConvertMultipleCustomFieldsToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertMultipleCustomFieldsToGraphQL'
+type CustomFieldConverter_ConvertMultipleCustomFieldsToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertMultipleCustomFieldsToGraphQL is a helper method to define mock.On call
+//   - fields []models.CustomField
+func (_e *CustomFieldConverter_Expecter) ConvertMultipleCustomFieldsToGraphQL(fields interface{}) *CustomFieldConverter_ConvertMultipleCustomFieldsToGraphQL_Call {
+	return &CustomFieldConverter_ConvertMultipleCustomFieldsToGraphQL_Call{Call: _e.mock.On("ConvertMultipleCustomFieldsToGraphQL", fields)}
+}
+
+func (_c *CustomFieldConverter_ConvertMultipleCustomFieldsToGraphQL_Call) Run(run func(fields []models.CustomField)) *CustomFieldConverter_ConvertMultipleCustomFieldsToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.CustomField))
+	})
+	return _c
+}
+
+func (_c *CustomFieldConverter_ConvertMultipleCustomFieldsToGraphQL_Call) Return(_a0 []*graphql.CustomField, _a1 error) *CustomFieldConverter_ConvertMultipleCustomFieldsToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldConverter_ConvertMultipleCustomFieldsToGraphQL_Call) RunAndReturn(run func([]models.CustomField) ([]*graphql.CustomField, error)) *CustomFieldConverter_ConvertMultipleCustomFieldsToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCustomFieldConverter creates a new instance of CustomFieldConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomFieldConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomFieldConverter {
+	mock := &CustomFieldConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
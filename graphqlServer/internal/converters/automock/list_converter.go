@@ -367,22 +367,24 @@ func (_c *ListConverter_ConvertMultipleListsToGraphQL_Call) RunAndReturn(run fun
 }
 
 // ConvertUpdateListInput provides a mock function with given fields: input
-func (_m *ListConverter) ConvertUpdateListInput(input graphql.UpdateListInput) (models.List, error) {
+func (_m *ListConverter) ConvertUpdateListInput(input graphql.UpdateListInput) (map[string]interface{}, error) {
 	ret := _m.Called(input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ConvertUpdateListInput")
 	}
 
-	var r0 models.List
+	var r0 map[string]interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(graphql.UpdateListInput) (models.List, error)); ok {
+	if rf, ok := ret.Get(0).(func(graphql.UpdateListInput) (map[string]interface{}, error)); ok {
 		return rf(input)
 	}
-	if rf, ok := ret.Get(0).(func(graphql.UpdateListInput) models.List); ok {
+	if rf, ok := ret.Get(0).(func(graphql.UpdateListInput) map[string]interface{}); ok {
 		r0 = rf(input)
 	} else {
-		r0 = ret.Get(0).(models.List)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(graphql.UpdateListInput) error); ok {
@@ -412,12 +414,12 @@ func (_c *ListConverter_ConvertUpdateListInput_Call) Run(run func(input graphql.
 	return _c
 }
 
-func (_c *ListConverter_ConvertUpdateListInput_Call) Return(_a0 models.List, _a1 error) *ListConverter_ConvertUpdateListInput_Call {
+func (_c *ListConverter_ConvertUpdateListInput_Call) Return(_a0 map[string]interface{}, _a1 error) *ListConverter_ConvertUpdateListInput_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ListConverter_ConvertUpdateListInput_Call) RunAndReturn(run func(graphql.UpdateListInput) (models.List, error)) *ListConverter_ConvertUpdateListInput_Call {
+func (_c *ListConverter_ConvertUpdateListInput_Call) RunAndReturn(run func(graphql.UpdateListInput) (map[string]interface{}, error)) *ListConverter_ConvertUpdateListInput_Call {
 	_c.Call.Return(run)
 	return _c
 }
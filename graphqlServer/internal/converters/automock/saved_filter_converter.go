@@ -0,0 +1,209 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// SavedFilterConverter is an autogenerated mock type for the SavedFilterConverter type
+type SavedFilterConverter struct {
+	mock.Mock
+}
+
+type SavedFilterConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SavedFilterConverter) EXPECT() *SavedFilterConverter_Expecter {
+	return &SavedFilterConverter_Expecter{mock: &_m.Mock}
+}
+
+// ConvertMultipleSavedFiltersToGraphQL provides a mock function with given fields: filters
+func (_m *SavedFilterConverter) ConvertMultipleSavedFiltersToGraphQL(filters []models.SavedFilter) ([]*graphql.SavedFilter, error) {
+	ret := _m.Called(filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertMultipleSavedFiltersToGraphQL")
+	}
+
+	var r0 []*graphql.SavedFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.SavedFilter) ([]*graphql.SavedFilter, error)); ok {
+		return rf(filters)
+	}
+	if rf, ok := ret.Get(0).(func([]models.SavedFilter) []*graphql.SavedFilter); ok {
+		r0 = rf(filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.SavedFilter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.SavedFilter) error); ok {
+		r1 = rf(filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterConverter_ConvertMultipleSavedFiltersToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertMultipleSavedFiltersToGraphQL'
+type SavedFilterConverter_ConvertMultipleSavedFiltersToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertMultipleSavedFiltersToGraphQL is a helper method to define mock.On call
+//   - filters []models.SavedFilter
+func (_e *SavedFilterConverter_Expecter) ConvertMultipleSavedFiltersToGraphQL(filters interface{}) *SavedFilterConverter_ConvertMultipleSavedFiltersToGraphQL_Call {
+	return &SavedFilterConverter_ConvertMultipleSavedFiltersToGraphQL_Call{Call: _e.mock.On("ConvertMultipleSavedFiltersToGraphQL", filters)}
+}
+
+func (_c *SavedFilterConverter_ConvertMultipleSavedFiltersToGraphQL_Call) Run(run func(filters []models.SavedFilter)) *SavedFilterConverter_ConvertMultipleSavedFiltersToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.SavedFilter))
+	})
+	return _c
+}
+
+func (_c *SavedFilterConverter_ConvertMultipleSavedFiltersToGraphQL_Call) Return(_a0 []*graphql.SavedFilter, _a1 error) *SavedFilterConverter_ConvertMultipleSavedFiltersToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterConverter_ConvertMultipleSavedFiltersToGraphQL_Call) RunAndReturn(run func([]models.SavedFilter) ([]*graphql.SavedFilter, error)) *SavedFilterConverter_ConvertMultipleSavedFiltersToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertSavedFilterInput provides a mock function with given fields: input
+func (_m *SavedFilterConverter) ConvertSavedFilterInput(input graphql.SavedFilterInput) (models.SavedFilter, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertSavedFilterInput")
+	}
+
+	var r0 models.SavedFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(graphql.SavedFilterInput) (models.SavedFilter, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(graphql.SavedFilterInput) models.SavedFilter); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(models.SavedFilter)
+	}
+
+	if rf, ok := ret.Get(1).(func(graphql.SavedFilterInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterConverter_ConvertSavedFilterInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertSavedFilterInput'
+type SavedFilterConverter_ConvertSavedFilterInput_Call struct {
+	*mock.Call
+}
+
+// ConvertSavedFilterInput is a helper method to define mock.On call
+//   - input graphql.SavedFilterInput
+func (_e *SavedFilterConverter_Expecter) ConvertSavedFilterInput(input interface{}) *SavedFilterConverter_ConvertSavedFilterInput_Call {
+	return &SavedFilterConverter_ConvertSavedFilterInput_Call{Call: _e.mock.On("ConvertSavedFilterInput", input)}
+}
+
+func (_c *SavedFilterConverter_ConvertSavedFilterInput_Call) Run(run func(input graphql.SavedFilterInput)) *SavedFilterConverter_ConvertSavedFilterInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(graphql.SavedFilterInput))
+	})
+	return _c
+}
+
+func (_c *SavedFilterConverter_ConvertSavedFilterInput_Call) Return(_a0 models.SavedFilter, _a1 error) *SavedFilterConverter_ConvertSavedFilterInput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterConverter_ConvertSavedFilterInput_Call) RunAndReturn(run func(graphql.SavedFilterInput) (models.SavedFilter, error)) *SavedFilterConverter_ConvertSavedFilterInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertSavedFilterToGraphQL provides a mock function with given fields: filter
+func (_m *SavedFilterConverter) ConvertSavedFilterToGraphQL(filter models.SavedFilter) (*graphql.SavedFilter, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertSavedFilterToGraphQL")
+	}
+
+	var r0 *graphql.SavedFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.SavedFilter) (*graphql.SavedFilter, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(models.SavedFilter) *graphql.SavedFilter); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.SavedFilter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.SavedFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterConverter_ConvertSavedFilterToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertSavedFilterToGraphQL'
+type SavedFilterConverter_ConvertSavedFilterToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertSavedFilterToGraphQL is a helper method to define mock.On call
+//   - filter models.SavedFilter
+func (_e *SavedFilterConverter_Expecter) ConvertSavedFilterToGraphQL(filter interface{}) *SavedFilterConverter_ConvertSavedFilterToGraphQL_Call {
+	return &SavedFilterConverter_ConvertSavedFilterToGraphQL_Call{Call: _e.mock.On("ConvertSavedFilterToGraphQL", filter)}
+}
+
+func (_c *SavedFilterConverter_ConvertSavedFilterToGraphQL_Call) Run(run func(filter models.SavedFilter)) *SavedFilterConverter_ConvertSavedFilterToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.SavedFilter))
+	})
+	return _c
+}
+
+func (_c *SavedFilterConverter_ConvertSavedFilterToGraphQL_Call) Return(_a0 *graphql.SavedFilter, _a1 error) *SavedFilterConverter_ConvertSavedFilterToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterConverter_ConvertSavedFilterToGraphQL_Call) RunAndReturn(run func(models.SavedFilter) (*graphql.SavedFilter, error)) *SavedFilterConverter_ConvertSavedFilterToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSavedFilterConverter creates a new instance of SavedFilterConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSavedFilterConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SavedFilterConverter {
+	mock := &SavedFilterConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
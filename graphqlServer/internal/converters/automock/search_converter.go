@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// SearchConverter is an autogenerated mock type for the SearchConverter type
+type SearchConverter struct {
+	mock.Mock
+}
+
+type SearchConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SearchConverter) EXPECT() *SearchConverter_Expecter {
+	return &SearchConverter_Expecter{mock: &_m.Mock}
+}
+
+// ConvertMultipleSearchResultsToGraphQL provides a mock function with given fields: results
+func (_m *SearchConverter) ConvertMultipleSearchResultsToGraphQL(results []models.SearchResult) ([]*graphql.SearchResult, error) {
+	ret := _m.Called(results)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertMultipleSearchResultsToGraphQL")
+	}
+
+	var r0 []*graphql.SearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.SearchResult) ([]*graphql.SearchResult, error)); ok {
+		return rf(results)
+	}
+	if rf, ok := ret.Get(0).(func([]models.SearchResult) []*graphql.SearchResult); ok {
+		r0 = rf(results)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.SearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.SearchResult) error); ok {
+		r1 = rf(results)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchConverter_ConvertMultipleSearchResultsToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertMultipleSearchResultsToGraphQL'
+type SearchConverter_ConvertMultipleSearchResultsToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertMultipleSearchResultsToGraphQL is a helper method to define mock.On call
+//   - results []models.SearchResult
+func (_e *SearchConverter_Expecter) ConvertMultipleSearchResultsToGraphQL(results interface{}) *SearchConverter_ConvertMultipleSearchResultsToGraphQL_Call {
+	return &SearchConverter_ConvertMultipleSearchResultsToGraphQL_Call{Call: _e.mock.On("ConvertMultipleSearchResultsToGraphQL", results)}
+}
+
+func (_c *SearchConverter_ConvertMultipleSearchResultsToGraphQL_Call) Run(run func(results []models.SearchResult)) *SearchConverter_ConvertMultipleSearchResultsToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.SearchResult))
+	})
+	return _c
+}
+
+func (_c *SearchConverter_ConvertMultipleSearchResultsToGraphQL_Call) Return(_a0 []*graphql.SearchResult, _a1 error) *SearchConverter_ConvertMultipleSearchResultsToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchConverter_ConvertMultipleSearchResultsToGraphQL_Call) RunAndReturn(run func([]models.SearchResult) ([]*graphql.SearchResult, error)) *SearchConverter_ConvertMultipleSearchResultsToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertSearchResultToGraphQL provides a mock function with given fields: result
+func (_m *SearchConverter) ConvertSearchResultToGraphQL(result models.SearchResult) (*graphql.SearchResult, error) {
+	ret := _m.Called(result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertSearchResultToGraphQL")
+	}
+
+	var r0 *graphql.SearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.SearchResult) (*graphql.SearchResult, error)); ok {
+		return rf(result)
+	}
+	if rf, ok := ret.Get(0).(func(models.SearchResult) *graphql.SearchResult); ok {
+		r0 = rf(result)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.SearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.SearchResult) error); ok {
+		r1 = rf(result)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchConverter_ConvertSearchResultToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertSearchResultToGraphQL'
+type SearchConverter_ConvertSearchResultToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertSearchResultToGraphQL is a helper method to define mock.On call
+//   - result models.SearchResult
+func (_e *SearchConverter_Expecter) ConvertSearchResultToGraphQL(result interface{}) *SearchConverter_ConvertSearchResultToGraphQL_Call {
+	return &SearchConverter_ConvertSearchResultToGraphQL_Call{Call: _e.mock.On("ConvertSearchResultToGraphQL", result)}
+}
+
+func (_c *SearchConverter_ConvertSearchResultToGraphQL_Call) Run(run func(result models.SearchResult)) *SearchConverter_ConvertSearchResultToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.SearchResult))
+	})
+	return _c
+}
+
+func (_c *SearchConverter_ConvertSearchResultToGraphQL_Call) Return(_a0 *graphql.SearchResult, _a1 error) *SearchConverter_ConvertSearchResultToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchConverter_ConvertSearchResultToGraphQL_Call) RunAndReturn(run func(models.SearchResult) (*graphql.SearchResult, error)) *SearchConverter_ConvertSearchResultToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSearchConverter creates a new instance of SearchConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearchConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SearchConverter {
+	mock := &SearchConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
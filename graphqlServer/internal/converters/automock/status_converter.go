@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// StatusConverter is an autogenerated mock type for the StatusConverter type
+type StatusConverter struct {
+	mock.Mock
+}
+
+type StatusConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatusConverter) EXPECT() *StatusConverter_Expecter {
+	return &StatusConverter_Expecter{mock: &_m.Mock}
+}
+
+// ConvertMultipleStatusesToGraphQL provides a mock function with given fields: statuses
+func (_m *StatusConverter) ConvertMultipleStatusesToGraphQL(statuses []models.WorkflowStatus) ([]*graphql.WorkflowStatus, error) {
+	ret := _m.Called(statuses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertMultipleStatusesToGraphQL")
+	}
+
+	var r0 []*graphql.WorkflowStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.WorkflowStatus) ([]*graphql.WorkflowStatus, error)); ok {
+		return rf(statuses)
+	}
+	if rf, ok := ret.Get(0).(func([]models.WorkflowStatus) []*graphql.WorkflowStatus); ok {
+		r0 = rf(statuses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.WorkflowStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.WorkflowStatus) error); ok {
+		r1 = rf(statuses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusConverter_ConvertMultipleStatusesToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertMultipleStatusesToGraphQL'
+type StatusConverter_ConvertMultipleStatusesToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertMultipleStatusesToGraphQL is a helper method to define mock.On call
+//   - statuses []models.WorkflowStatus
+func (_e *StatusConverter_Expecter) ConvertMultipleStatusesToGraphQL(statuses interface{}) *StatusConverter_ConvertMultipleStatusesToGraphQL_Call {
+	return &StatusConverter_ConvertMultipleStatusesToGraphQL_Call{Call: _e.mock.On("ConvertMultipleStatusesToGraphQL", statuses)}
+}
+
+func (_c *StatusConverter_ConvertMultipleStatusesToGraphQL_Call) Run(run func(statuses []models.WorkflowStatus)) *StatusConverter_ConvertMultipleStatusesToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.WorkflowStatus))
+	})
+	return _c
+}
+
+func (_c *StatusConverter_ConvertMultipleStatusesToGraphQL_Call) Return(_a0 []*graphql.WorkflowStatus, _a1 error) *StatusConverter_ConvertMultipleStatusesToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusConverter_ConvertMultipleStatusesToGraphQL_Call) RunAndReturn(run func([]models.WorkflowStatus) ([]*graphql.WorkflowStatus, error)) *StatusConverter_ConvertMultipleStatusesToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertStatusToGraphQL provides a mock function with given fields: status
+func (_m *StatusConverter) ConvertStatusToGraphQL(status models.WorkflowStatus) (*graphql.WorkflowStatus, error) {
+	ret := _m.Called(status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertStatusToGraphQL")
+	}
+
+	var r0 *graphql.WorkflowStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.WorkflowStatus) (*graphql.WorkflowStatus, error)); ok {
+		return rf(status)
+	}
+	if rf, ok := ret.Get(0).(func(models.WorkflowStatus) *graphql.WorkflowStatus); ok {
+		r0 = rf(status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.WorkflowStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.WorkflowStatus) error); ok {
+		r1 = rf(status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusConverter_ConvertStatusToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertStatusToGraphQL'
+type StatusConverter_ConvertStatusToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertStatusToGraphQL is a helper method to define mock.On call
+//   - status models.WorkflowStatus
+func (_e *StatusConverter_Expecter) ConvertStatusToGraphQL(status interface{}) *StatusConverter_ConvertStatusToGraphQL_Call {
+	return &StatusConverter_ConvertStatusToGraphQL_Call{Call: _e.mock.On("ConvertStatusToGraphQL", status)}
+}
+
+func (_c *StatusConverter_ConvertStatusToGraphQL_Call) Run(run func(status models.WorkflowStatus)) *StatusConverter_ConvertStatusToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.WorkflowStatus))
+	})
+	return _c
+}
+
+func (_c *StatusConverter_ConvertStatusToGraphQL_Call) Return(_a0 *graphql.WorkflowStatus, _a1 error) *StatusConverter_ConvertStatusToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusConverter_ConvertStatusToGraphQL_Call) RunAndReturn(run func(models.WorkflowStatus) (*graphql.WorkflowStatus, error)) *StatusConverter_ConvertStatusToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatusConverter creates a new instance of StatusConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatusConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatusConverter {
+	mock := &StatusConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
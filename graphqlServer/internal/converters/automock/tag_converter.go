@@ -0,0 +1,209 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// TagConverter is an autogenerated mock type for the TagConverter type
+type TagConverter struct {
+	mock.Mock
+}
+
+type TagConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagConverter) EXPECT() *TagConverter_Expecter {
+	return &TagConverter_Expecter{mock: &_m.Mock}
+}
+
+// ConvertMultipleTagsToGraphQL provides a mock function with given fields: tags
+func (_m *TagConverter) ConvertMultipleTagsToGraphQL(tags []models.Tag) ([]*graphql.Tag, error) {
+	ret := _m.Called(tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertMultipleTagsToGraphQL")
+	}
+
+	var r0 []*graphql.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.Tag) ([]*graphql.Tag, error)); ok {
+		return rf(tags)
+	}
+	if rf, ok := ret.Get(0).(func([]models.Tag) []*graphql.Tag); ok {
+		r0 = rf(tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.Tag) error); ok {
+		r1 = rf(tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagConverter_ConvertMultipleTagsToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertMultipleTagsToGraphQL'
+type TagConverter_ConvertMultipleTagsToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertMultipleTagsToGraphQL is a helper method to define mock.On call
+//   - tags []models.Tag
+func (_e *TagConverter_Expecter) ConvertMultipleTagsToGraphQL(tags interface{}) *TagConverter_ConvertMultipleTagsToGraphQL_Call {
+	return &TagConverter_ConvertMultipleTagsToGraphQL_Call{Call: _e.mock.On("ConvertMultipleTagsToGraphQL", tags)}
+}
+
+func (_c *TagConverter_ConvertMultipleTagsToGraphQL_Call) Run(run func(tags []models.Tag)) *TagConverter_ConvertMultipleTagsToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.Tag))
+	})
+	return _c
+}
+
+func (_c *TagConverter_ConvertMultipleTagsToGraphQL_Call) Return(_a0 []*graphql.Tag, _a1 error) *TagConverter_ConvertMultipleTagsToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagConverter_ConvertMultipleTagsToGraphQL_Call) RunAndReturn(run func([]models.Tag) ([]*graphql.Tag, error)) *TagConverter_ConvertMultipleTagsToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertTagInput provides a mock function with given fields: input
+func (_m *TagConverter) ConvertTagInput(input graphql.TagInput) (models.Tag, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertTagInput")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(graphql.TagInput) (models.Tag, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(graphql.TagInput) models.Tag); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(graphql.TagInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagConverter_ConvertTagInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertTagInput'
+type TagConverter_ConvertTagInput_Call struct {
+	*mock.Call
+}
+
+// ConvertTagInput is a helper method to define mock.On call
+//   - input graphql.TagInput
+func (_e *TagConverter_Expecter) ConvertTagInput(input interface{}) *TagConverter_ConvertTagInput_Call {
+	return &TagConverter_ConvertTagInput_Call{Call: _e.mock.On("ConvertTagInput", input)}
+}
+
+func (_c *TagConverter_ConvertTagInput_Call) Run(run func(input graphql.TagInput)) *TagConverter_ConvertTagInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(graphql.TagInput))
+	})
+	return _c
+}
+
+func (_c *TagConverter_ConvertTagInput_Call) Return(_a0 models.Tag, _a1 error) *TagConverter_ConvertTagInput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagConverter_ConvertTagInput_Call) RunAndReturn(run func(graphql.TagInput) (models.Tag, error)) *TagConverter_ConvertTagInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertTagToGraphQL provides a mock function with given fields: tag
+func (_m *TagConverter) ConvertTagToGraphQL(tag models.Tag) (*graphql.Tag, error) {
+	ret := _m.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertTagToGraphQL")
+	}
+
+	var r0 *graphql.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Tag) (*graphql.Tag, error)); ok {
+		return rf(tag)
+	}
+	if rf, ok := ret.Get(0).(func(models.Tag) *graphql.Tag); ok {
+		r0 = rf(tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Tag) error); ok {
+		r1 = rf(tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagConverter_ConvertTagToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertTagToGraphQL'
+type TagConverter_ConvertTagToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertTagToGraphQL is a helper method to define mock.On call
+//   - tag models.Tag
+func (_e *TagConverter_Expecter) ConvertTagToGraphQL(tag interface{}) *TagConverter_ConvertTagToGraphQL_Call {
+	return &TagConverter_ConvertTagToGraphQL_Call{Call: _e.mock.On("ConvertTagToGraphQL", tag)}
+}
+
+func (_c *TagConverter_ConvertTagToGraphQL_Call) Run(run func(tag models.Tag)) *TagConverter_ConvertTagToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Tag))
+	})
+	return _c
+}
+
+func (_c *TagConverter_ConvertTagToGraphQL_Call) Return(_a0 *graphql.Tag, _a1 error) *TagConverter_ConvertTagToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagConverter_ConvertTagToGraphQL_Call) RunAndReturn(run func(models.Tag) (*graphql.Tag, error)) *TagConverter_ConvertTagToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagConverter creates a new instance of TagConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagConverter {
+	mock := &TagConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,266 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// TemplateConverter is an autogenerated mock type for the TemplateConverter type
+type TemplateConverter struct {
+	mock.Mock
+}
+
+type TemplateConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TemplateConverter) EXPECT() *TemplateConverter_Expecter {
+	return &TemplateConverter_Expecter{mock: &_m.Mock}
+}
+
+// ConvertMultipleTemplatesToGraphQL provides a mock function with given fields: templates
+func (_m *TemplateConverter) ConvertMultipleTemplatesToGraphQL(templates []models.ListTemplate) ([]*graphql.ListTemplate, error) {
+	ret := _m.Called(templates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertMultipleTemplatesToGraphQL")
+	}
+
+	var r0 []*graphql.ListTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.ListTemplate) ([]*graphql.ListTemplate, error)); ok {
+		return rf(templates)
+	}
+	if rf, ok := ret.Get(0).(func([]models.ListTemplate) []*graphql.ListTemplate); ok {
+		r0 = rf(templates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.ListTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.ListTemplate) error); ok {
+		r1 = rf(templates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateConverter_ConvertMultipleTemplatesToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertMultipleTemplatesToGraphQL'
+type TemplateConverter_ConvertMultipleTemplatesToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertMultipleTemplatesToGraphQL is a helper method to define mock.On call
+//   - templates []models.ListTemplate
+func (_e *TemplateConverter_Expecter) ConvertMultipleTemplatesToGraphQL(templates interface{}) *TemplateConverter_ConvertMultipleTemplatesToGraphQL_Call {
+	return &TemplateConverter_ConvertMultipleTemplatesToGraphQL_Call{Call: _e.mock.On("ConvertMultipleTemplatesToGraphQL", templates)}
+}
+
+func (_c *TemplateConverter_ConvertMultipleTemplatesToGraphQL_Call) Run(run func(templates []models.ListTemplate)) *TemplateConverter_ConvertMultipleTemplatesToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.ListTemplate))
+	})
+	return _c
+}
+
+func (_c *TemplateConverter_ConvertMultipleTemplatesToGraphQL_Call) Return(_a0 []*graphql.ListTemplate, _a1 error) *TemplateConverter_ConvertMultipleTemplatesToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateConverter_ConvertMultipleTemplatesToGraphQL_Call) RunAndReturn(run func([]models.ListTemplate) ([]*graphql.ListTemplate, error)) *TemplateConverter_ConvertMultipleTemplatesToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertTemplateAnchor provides a mock function with given fields: name, anchor
+func (_m *TemplateConverter) ConvertTemplateAnchor(name *string, anchor *string) (models.TemplateAnchor, error) {
+	ret := _m.Called(name, anchor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertTemplateAnchor")
+	}
+
+	var r0 models.TemplateAnchor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*string, *string) (models.TemplateAnchor, error)); ok {
+		return rf(name, anchor)
+	}
+	if rf, ok := ret.Get(0).(func(*string, *string) models.TemplateAnchor); ok {
+		r0 = rf(name, anchor)
+	} else {
+		r0 = ret.Get(0).(models.TemplateAnchor)
+	}
+
+	if rf, ok := ret.Get(1).(func(*string, *string) error); ok {
+		r1 = rf(name, anchor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateConverter_ConvertTemplateAnchor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertTemplateAnchor'
+type TemplateConverter_ConvertTemplateAnchor_Call struct {
+	*mock.Call
+}
+
+// ConvertTemplateAnchor is a helper method to define mock.On call
+//   - name *string
+//   - anchor *string
+func (_e *TemplateConverter_Expecter) ConvertTemplateAnchor(name interface{}, anchor interface{}) *TemplateConverter_ConvertTemplateAnchor_Call {
+	return &TemplateConverter_ConvertTemplateAnchor_Call{Call: _e.mock.On("ConvertTemplateAnchor", name, anchor)}
+}
+
+func (_c *TemplateConverter_ConvertTemplateAnchor_Call) Run(run func(name *string, anchor *string)) *TemplateConverter_ConvertTemplateAnchor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*string), args[1].(*string))
+	})
+	return _c
+}
+
+func (_c *TemplateConverter_ConvertTemplateAnchor_Call) Return(_a0 models.TemplateAnchor, _a1 error) *TemplateConverter_ConvertTemplateAnchor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateConverter_ConvertTemplateAnchor_Call) RunAndReturn(run func(*string, *string) (models.TemplateAnchor, error)) *TemplateConverter_ConvertTemplateAnchor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertTemplateInput provides a mock function with given fields: input
+func (_m *TemplateConverter) ConvertTemplateInput(input graphql.ListTemplateInput) (models.ListTemplate, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertTemplateInput")
+	}
+
+	var r0 models.ListTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(graphql.ListTemplateInput) (models.ListTemplate, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(graphql.ListTemplateInput) models.ListTemplate); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(models.ListTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(graphql.ListTemplateInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateConverter_ConvertTemplateInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertTemplateInput'
+type TemplateConverter_ConvertTemplateInput_Call struct {
+	*mock.Call
+}
+
+// ConvertTemplateInput is a helper method to define mock.On call
+//   - input graphql.ListTemplateInput
+func (_e *TemplateConverter_Expecter) ConvertTemplateInput(input interface{}) *TemplateConverter_ConvertTemplateInput_Call {
+	return &TemplateConverter_ConvertTemplateInput_Call{Call: _e.mock.On("ConvertTemplateInput", input)}
+}
+
+func (_c *TemplateConverter_ConvertTemplateInput_Call) Run(run func(input graphql.ListTemplateInput)) *TemplateConverter_ConvertTemplateInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(graphql.ListTemplateInput))
+	})
+	return _c
+}
+
+func (_c *TemplateConverter_ConvertTemplateInput_Call) Return(_a0 models.ListTemplate, _a1 error) *TemplateConverter_ConvertTemplateInput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateConverter_ConvertTemplateInput_Call) RunAndReturn(run func(graphql.ListTemplateInput) (models.ListTemplate, error)) *TemplateConverter_ConvertTemplateInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertTemplateToGraphQL provides a mock function with given fields: template
+func (_m *TemplateConverter) ConvertTemplateToGraphQL(template models.ListTemplate) (*graphql.ListTemplate, error) {
+	ret := _m.Called(template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertTemplateToGraphQL")
+	}
+
+	var r0 *graphql.ListTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ListTemplate) (*graphql.ListTemplate, error)); ok {
+		return rf(template)
+	}
+	if rf, ok := ret.Get(0).(func(models.ListTemplate) *graphql.ListTemplate); ok {
+		r0 = rf(template)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.ListTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ListTemplate) error); ok {
+		r1 = rf(template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateConverter_ConvertTemplateToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertTemplateToGraphQL'
+type TemplateConverter_ConvertTemplateToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertTemplateToGraphQL is a helper method to define mock.On call
+//   - template models.ListTemplate
+func (_e *TemplateConverter_Expecter) ConvertTemplateToGraphQL(template interface{}) *TemplateConverter_ConvertTemplateToGraphQL_Call {
+	return &TemplateConverter_ConvertTemplateToGraphQL_Call{Call: _e.mock.On("ConvertTemplateToGraphQL", template)}
+}
+
+func (_c *TemplateConverter_ConvertTemplateToGraphQL_Call) Run(run func(template models.ListTemplate)) *TemplateConverter_ConvertTemplateToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.ListTemplate))
+	})
+	return _c
+}
+
+func (_c *TemplateConverter_ConvertTemplateToGraphQL_Call) Return(_a0 *graphql.ListTemplate, _a1 error) *TemplateConverter_ConvertTemplateToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateConverter_ConvertTemplateToGraphQL_Call) RunAndReturn(run func(models.ListTemplate) (*graphql.ListTemplate, error)) *TemplateConverter_ConvertTemplateToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTemplateConverter creates a new instance of TemplateConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTemplateConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TemplateConverter {
+	mock := &TemplateConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
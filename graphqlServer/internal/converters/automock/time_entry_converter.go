@@ -0,0 +1,267 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// TimeEntryConverter is an autogenerated mock type for the TimeEntryConverter type
+type TimeEntryConverter struct {
+	mock.Mock
+}
+
+type TimeEntryConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TimeEntryConverter) EXPECT() *TimeEntryConverter_Expecter {
+	return &TimeEntryConverter_Expecter{mock: &_m.Mock}
+}
+
+// ConvertMultipleTimeEntriesToGraphQL provides a mock function with given fields: entries
+func (_m *TimeEntryConverter) ConvertMultipleTimeEntriesToGraphQL(entries []models.TimeEntry) ([]*graphql.TimeEntry, error) {
+	ret := _m.Called(entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertMultipleTimeEntriesToGraphQL")
+	}
+
+	var r0 []*graphql.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.TimeEntry) ([]*graphql.TimeEntry, error)); ok {
+		return rf(entries)
+	}
+	if rf, ok := ret.Get(0).(func([]models.TimeEntry) []*graphql.TimeEntry); ok {
+		r0 = rf(entries)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.TimeEntry) error); ok {
+		r1 = rf(entries)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryConverter_ConvertMultipleTimeEntriesToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertMultipleTimeEntriesToGraphQL'
+type TimeEntryConverter_ConvertMultipleTimeEntriesToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertMultipleTimeEntriesToGraphQL is a helper method to define mock.On call
+//   - entries []models.TimeEntry
+func (_e *TimeEntryConverter_Expecter) ConvertMultipleTimeEntriesToGraphQL(entries interface{}) *TimeEntryConverter_ConvertMultipleTimeEntriesToGraphQL_Call {
+	return &TimeEntryConverter_ConvertMultipleTimeEntriesToGraphQL_Call{Call: _e.mock.On("ConvertMultipleTimeEntriesToGraphQL", entries)}
+}
+
+func (_c *TimeEntryConverter_ConvertMultipleTimeEntriesToGraphQL_Call) Run(run func(entries []models.TimeEntry)) *TimeEntryConverter_ConvertMultipleTimeEntriesToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.TimeEntry))
+	})
+	return _c
+}
+
+func (_c *TimeEntryConverter_ConvertMultipleTimeEntriesToGraphQL_Call) Return(_a0 []*graphql.TimeEntry, _a1 error) *TimeEntryConverter_ConvertMultipleTimeEntriesToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryConverter_ConvertMultipleTimeEntriesToGraphQL_Call) RunAndReturn(run func([]models.TimeEntry) ([]*graphql.TimeEntry, error)) *TimeEntryConverter_ConvertMultipleTimeEntriesToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertTimeEntryInput provides a mock function with given fields: input
+func (_m *TimeEntryConverter) ConvertTimeEntryInput(input graphql.TimeEntryInput) (models.TimeEntry, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertTimeEntryInput")
+	}
+
+	var r0 models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(graphql.TimeEntryInput) (models.TimeEntry, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(graphql.TimeEntryInput) models.TimeEntry); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(models.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(graphql.TimeEntryInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryConverter_ConvertTimeEntryInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertTimeEntryInput'
+type TimeEntryConverter_ConvertTimeEntryInput_Call struct {
+	*mock.Call
+}
+
+// ConvertTimeEntryInput is a helper method to define mock.On call
+//   - input graphql.TimeEntryInput
+func (_e *TimeEntryConverter_Expecter) ConvertTimeEntryInput(input interface{}) *TimeEntryConverter_ConvertTimeEntryInput_Call {
+	return &TimeEntryConverter_ConvertTimeEntryInput_Call{Call: _e.mock.On("ConvertTimeEntryInput", input)}
+}
+
+func (_c *TimeEntryConverter_ConvertTimeEntryInput_Call) Run(run func(input graphql.TimeEntryInput)) *TimeEntryConverter_ConvertTimeEntryInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(graphql.TimeEntryInput))
+	})
+	return _c
+}
+
+func (_c *TimeEntryConverter_ConvertTimeEntryInput_Call) Return(_a0 models.TimeEntry, _a1 error) *TimeEntryConverter_ConvertTimeEntryInput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryConverter_ConvertTimeEntryInput_Call) RunAndReturn(run func(graphql.TimeEntryInput) (models.TimeEntry, error)) *TimeEntryConverter_ConvertTimeEntryInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertTimeEntryToGraphQL provides a mock function with given fields: entry
+func (_m *TimeEntryConverter) ConvertTimeEntryToGraphQL(entry models.TimeEntry) (*graphql.TimeEntry, error) {
+	ret := _m.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertTimeEntryToGraphQL")
+	}
+
+	var r0 *graphql.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.TimeEntry) (*graphql.TimeEntry, error)); ok {
+		return rf(entry)
+	}
+	if rf, ok := ret.Get(0).(func(models.TimeEntry) *graphql.TimeEntry); ok {
+		r0 = rf(entry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.TimeEntry) error); ok {
+		r1 = rf(entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryConverter_ConvertTimeEntryToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertTimeEntryToGraphQL'
+type TimeEntryConverter_ConvertTimeEntryToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertTimeEntryToGraphQL is a helper method to define mock.On call
+//   - entry models.TimeEntry
+func (_e *TimeEntryConverter_Expecter) ConvertTimeEntryToGraphQL(entry interface{}) *TimeEntryConverter_ConvertTimeEntryToGraphQL_Call {
+	return &TimeEntryConverter_ConvertTimeEntryToGraphQL_Call{Call: _e.mock.On("ConvertTimeEntryToGraphQL", entry)}
+}
+
+func (_c *TimeEntryConverter_ConvertTimeEntryToGraphQL_Call) Run(run func(entry models.TimeEntry)) *TimeEntryConverter_ConvertTimeEntryToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.TimeEntry))
+	})
+	return _c
+}
+
+func (_c *TimeEntryConverter_ConvertTimeEntryToGraphQL_Call) Return(_a0 *graphql.TimeEntry, _a1 error) *TimeEntryConverter_ConvertTimeEntryToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryConverter_ConvertTimeEntryToGraphQL_Call) RunAndReturn(run func(models.TimeEntry) (*graphql.TimeEntry, error)) *TimeEntryConverter_ConvertTimeEntryToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertTimeTotalToGraphQL provides a mock function with given fields: total
+func (_m *TimeEntryConverter) ConvertTimeTotalToGraphQL(total models.TimeTotal) (*graphql.TimeTotal, error) {
+	ret := _m.Called(total)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertTimeTotalToGraphQL")
+	}
+
+	var r0 *graphql.TimeTotal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.TimeTotal) (*graphql.TimeTotal, error)); ok {
+		return rf(total)
+	}
+	if rf, ok := ret.Get(0).(func(models.TimeTotal) *graphql.TimeTotal); ok {
+		r0 = rf(total)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.TimeTotal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.TimeTotal) error); ok {
+		r1 = rf(total)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryConverter_ConvertTimeTotalToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertTimeTotalToGraphQL'
+type TimeEntryConverter_ConvertTimeTotalToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertTimeTotalToGraphQL is a helper method to define mock.On call
+//   - total models.TimeTotal
+func (_e *TimeEntryConverter_Expecter) ConvertTimeTotalToGraphQL(total interface{}) *TimeEntryConverter_ConvertTimeTotalToGraphQL_Call {
+	return &TimeEntryConverter_ConvertTimeTotalToGraphQL_Call{Call: _e.mock.On("ConvertTimeTotalToGraphQL", total)}
+}
+
+func (_c *TimeEntryConverter_ConvertTimeTotalToGraphQL_Call) Run(run func(total models.TimeTotal)) *TimeEntryConverter_ConvertTimeTotalToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.TimeTotal))
+	})
+	return _c
+}
+
+func (_c *TimeEntryConverter_ConvertTimeTotalToGraphQL_Call) Return(_a0 *graphql.TimeTotal, _a1 error) *TimeEntryConverter_ConvertTimeTotalToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryConverter_ConvertTimeTotalToGraphQL_Call) RunAndReturn(run func(models.TimeTotal) (*graphql.TimeTotal, error)) *TimeEntryConverter_ConvertTimeTotalToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTimeEntryConverter creates a new instance of TimeEntryConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTimeEntryConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TimeEntryConverter {
+	mock := &TimeEntryConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
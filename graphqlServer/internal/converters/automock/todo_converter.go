@@ -22,6 +22,62 @@ func (_m *TodoConverter) EXPECT() *TodoConverter_Expecter {
 	return &TodoConverter_Expecter{mock: &_m.Mock}
 }
 
+// ConvertBulkTodoInput provides a mock function with given fields: input
+func (_m *TodoConverter) ConvertBulkTodoInput(input graphql.BulkTodoInput) (models.BulkOperation, error) {
+	ret := _m.Called(input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertBulkTodoInput")
+	}
+
+	var r0 models.BulkOperation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(graphql.BulkTodoInput) (models.BulkOperation, error)); ok {
+		return rf(input)
+	}
+	if rf, ok := ret.Get(0).(func(graphql.BulkTodoInput) models.BulkOperation); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(models.BulkOperation)
+	}
+
+	if rf, ok := ret.Get(1).(func(graphql.BulkTodoInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoConverter_ConvertBulkTodoInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertBulkTodoInput'
+type TodoConverter_ConvertBulkTodoInput_Call struct {
+	*mock.Call
+}
+
+// ConvertBulkTodoInput is a helper method to define mock.On call
+//   - input graphql.BulkTodoInput
+func (_e *TodoConverter_Expecter) ConvertBulkTodoInput(input interface{}) *TodoConverter_ConvertBulkTodoInput_Call {
+	return &TodoConverter_ConvertBulkTodoInput_Call{Call: _e.mock.On("ConvertBulkTodoInput", input)}
+}
+
+func (_c *TodoConverter_ConvertBulkTodoInput_Call) Run(run func(input graphql.BulkTodoInput)) *TodoConverter_ConvertBulkTodoInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(graphql.BulkTodoInput))
+	})
+	return _c
+}
+
+func (_c *TodoConverter_ConvertBulkTodoInput_Call) Return(_a0 models.BulkOperation, _a1 error) *TodoConverter_ConvertBulkTodoInput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoConverter_ConvertBulkTodoInput_Call) RunAndReturn(run func(graphql.BulkTodoInput) (models.BulkOperation, error)) *TodoConverter_ConvertBulkTodoInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConvertCreateTodoInput provides a mock function with given fields: input
 func (_m *TodoConverter) ConvertCreateTodoInput(input graphql.CreateTodoInput) (models.Todo, error) {
 	ret := _m.Called(input)
@@ -194,23 +250,83 @@ func (_c *TodoConverter_ConvertTodoToGraphQL_Call) RunAndReturn(run func(models.
 	return _c
 }
 
+// ConvertTodoViewToGraphQL provides a mock function with given fields: view
+func (_m *TodoConverter) ConvertTodoViewToGraphQL(view models.SmartView) (*graphql.TodoView, error) {
+	ret := _m.Called(view)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertTodoViewToGraphQL")
+	}
+
+	var r0 *graphql.TodoView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.SmartView) (*graphql.TodoView, error)); ok {
+		return rf(view)
+	}
+	if rf, ok := ret.Get(0).(func(models.SmartView) *graphql.TodoView); ok {
+		r0 = rf(view)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.TodoView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.SmartView) error); ok {
+		r1 = rf(view)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoConverter_ConvertTodoViewToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertTodoViewToGraphQL'
+type TodoConverter_ConvertTodoViewToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertTodoViewToGraphQL is a helper method to define mock.On call
+//   - view models.SmartView
+func (_e *TodoConverter_Expecter) ConvertTodoViewToGraphQL(view interface{}) *TodoConverter_ConvertTodoViewToGraphQL_Call {
+	return &TodoConverter_ConvertTodoViewToGraphQL_Call{Call: _e.mock.On("ConvertTodoViewToGraphQL", view)}
+}
+
+func (_c *TodoConverter_ConvertTodoViewToGraphQL_Call) Run(run func(view models.SmartView)) *TodoConverter_ConvertTodoViewToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.SmartView))
+	})
+	return _c
+}
+
+func (_c *TodoConverter_ConvertTodoViewToGraphQL_Call) Return(_a0 *graphql.TodoView, _a1 error) *TodoConverter_ConvertTodoViewToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoConverter_ConvertTodoViewToGraphQL_Call) RunAndReturn(run func(models.SmartView) (*graphql.TodoView, error)) *TodoConverter_ConvertTodoViewToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConvertUpdateTodoInput provides a mock function with given fields: input
-func (_m *TodoConverter) ConvertUpdateTodoInput(input graphql.UpdateTodoInput) (models.Todo, error) {
+func (_m *TodoConverter) ConvertUpdateTodoInput(input graphql.UpdateTodoInput) (map[string]interface{}, error) {
 	ret := _m.Called(input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ConvertUpdateTodoInput")
 	}
 
-	var r0 models.Todo
+	var r0 map[string]interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(graphql.UpdateTodoInput) (models.Todo, error)); ok {
+	if rf, ok := ret.Get(0).(func(graphql.UpdateTodoInput) (map[string]interface{}, error)); ok {
 		return rf(input)
 	}
-	if rf, ok := ret.Get(0).(func(graphql.UpdateTodoInput) models.Todo); ok {
+	if rf, ok := ret.Get(0).(func(graphql.UpdateTodoInput) map[string]interface{}); ok {
 		r0 = rf(input)
 	} else {
-		r0 = ret.Get(0).(models.Todo)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(graphql.UpdateTodoInput) error); ok {
@@ -240,12 +356,12 @@ func (_c *TodoConverter_ConvertUpdateTodoInput_Call) Run(run func(input graphql.
 	return _c
 }
 
-func (_c *TodoConverter_ConvertUpdateTodoInput_Call) Return(_a0 models.Todo, _a1 error) *TodoConverter_ConvertUpdateTodoInput_Call {
+func (_c *TodoConverter_ConvertUpdateTodoInput_Call) Return(_a0 map[string]interface{}, _a1 error) *TodoConverter_ConvertUpdateTodoInput_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoConverter_ConvertUpdateTodoInput_Call) RunAndReturn(run func(graphql.UpdateTodoInput) (models.Todo, error)) *TodoConverter_ConvertUpdateTodoInput_Call {
+func (_c *TodoConverter_ConvertUpdateTodoInput_Call) RunAndReturn(run func(graphql.UpdateTodoInput) (map[string]interface{}, error)) *TodoConverter_ConvertUpdateTodoInput_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// TrashConverter is an autogenerated mock type for the TrashConverter type
+type TrashConverter struct {
+	mock.Mock
+}
+
+type TrashConverter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TrashConverter) EXPECT() *TrashConverter_Expecter {
+	return &TrashConverter_Expecter{mock: &_m.Mock}
+}
+
+// ConvertMultipleTrashItemsToGraphQL provides a mock function with given fields: items
+func (_m *TrashConverter) ConvertMultipleTrashItemsToGraphQL(items []models.TrashItem) ([]*graphql.TrashItem, error) {
+	ret := _m.Called(items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertMultipleTrashItemsToGraphQL")
+	}
+
+	var r0 []*graphql.TrashItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]models.TrashItem) ([]*graphql.TrashItem, error)); ok {
+		return rf(items)
+	}
+	if rf, ok := ret.Get(0).(func([]models.TrashItem) []*graphql.TrashItem); ok {
+		r0 = rf(items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.TrashItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.TrashItem) error); ok {
+		r1 = rf(items)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrashConverter_ConvertMultipleTrashItemsToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertMultipleTrashItemsToGraphQL'
+type TrashConverter_ConvertMultipleTrashItemsToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertMultipleTrashItemsToGraphQL is a helper method to define mock.On call
+//   - items []models.TrashItem
+func (_e *TrashConverter_Expecter) ConvertMultipleTrashItemsToGraphQL(items interface{}) *TrashConverter_ConvertMultipleTrashItemsToGraphQL_Call {
+	return &TrashConverter_ConvertMultipleTrashItemsToGraphQL_Call{Call: _e.mock.On("ConvertMultipleTrashItemsToGraphQL", items)}
+}
+
+func (_c *TrashConverter_ConvertMultipleTrashItemsToGraphQL_Call) Run(run func(items []models.TrashItem)) *TrashConverter_ConvertMultipleTrashItemsToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]models.TrashItem))
+	})
+	return _c
+}
+
+func (_c *TrashConverter_ConvertMultipleTrashItemsToGraphQL_Call) Return(_a0 []*graphql.TrashItem, _a1 error) *TrashConverter_ConvertMultipleTrashItemsToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TrashConverter_ConvertMultipleTrashItemsToGraphQL_Call) RunAndReturn(run func([]models.TrashItem) ([]*graphql.TrashItem, error)) *TrashConverter_ConvertMultipleTrashItemsToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConvertTrashItemToGraphQL provides a mock function with given fields: item
+func (_m *TrashConverter) ConvertTrashItemToGraphQL(item models.TrashItem) (*graphql.TrashItem, error) {
+	ret := _m.Called(item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertTrashItemToGraphQL")
+	}
+
+	var r0 *graphql.TrashItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.TrashItem) (*graphql.TrashItem, error)); ok {
+		return rf(item)
+	}
+	if rf, ok := ret.Get(0).(func(models.TrashItem) *graphql.TrashItem); ok {
+		r0 = rf(item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.TrashItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.TrashItem) error); ok {
+		r1 = rf(item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrashConverter_ConvertTrashItemToGraphQL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConvertTrashItemToGraphQL'
+type TrashConverter_ConvertTrashItemToGraphQL_Call struct {
+	*mock.Call
+}
+
+// ConvertTrashItemToGraphQL is a helper method to define mock.On call
+//   - item models.TrashItem
+func (_e *TrashConverter_Expecter) ConvertTrashItemToGraphQL(item interface{}) *TrashConverter_ConvertTrashItemToGraphQL_Call {
+	return &TrashConverter_ConvertTrashItemToGraphQL_Call{Call: _e.mock.On("ConvertTrashItemToGraphQL", item)}
+}
+
+func (_c *TrashConverter_ConvertTrashItemToGraphQL_Call) Run(run func(item models.TrashItem)) *TrashConverter_ConvertTrashItemToGraphQL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.TrashItem))
+	})
+	return _c
+}
+
+func (_c *TrashConverter_ConvertTrashItemToGraphQL_Call) Return(_a0 *graphql.TrashItem, _a1 error) *TrashConverter_ConvertTrashItemToGraphQL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TrashConverter_ConvertTrashItemToGraphQL_Call) RunAndReturn(run func(models.TrashItem) (*graphql.TrashItem, error)) *TrashConverter_ConvertTrashItemToGraphQL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTrashConverter creates a new instance of TrashConverter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTrashConverter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TrashConverter {
+	mock := &TrashConverter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,47 @@
+package converters
+
+import (
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type ConverterCommentGraphQL struct{}
+
+//go:generate mockery --name=CommentConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type CommentConverter interface {
+	ConvertCommentToGraphQL(comment models.Comment) (*graphql.Comment, error)
+	ConvertMultipleCommentsToGraphQL(comments []models.Comment) ([]*graphql.Comment, error)
+}
+
+func NewConverterCommentGraphQL() CommentConverter {
+	return &ConverterCommentGraphQL{}
+}
+
+func (c *ConverterCommentGraphQL) ConvertCommentToGraphQL(comment models.Comment) (*graphql.Comment, error) {
+	mentionIDs := comment.Mentions
+	if mentionIDs == nil {
+		mentionIDs = []string{}
+	}
+	return &graphql.Comment{
+		ID:         comment.ID,
+		TodoID:     comment.TodoID,
+		AuthorID:   comment.AuthorID,
+		Body:       comment.Body,
+		MentionIds: mentionIDs,
+		CreatedAt:  comment.CreatedAt.Format(constants.DateFormat),
+		UpdatedAt:  comment.UpdatedAt.Format(constants.DateFormat),
+	}, nil
+}
+
+func (c *ConverterCommentGraphQL) ConvertMultipleCommentsToGraphQL(comments []models.Comment) ([]*graphql.Comment, error) {
+	graphqlComments := make([]*graphql.Comment, 0, len(comments))
+	for _, comment := range comments {
+		graphqlComment, err := c.ConvertCommentToGraphQL(comment)
+		if err != nil {
+			return nil, err
+		}
+		graphqlComments = append(graphqlComments, graphqlComment)
+	}
+	return graphqlComments, nil
+}
@@ -83,3 +83,166 @@ func ConvertRoleToGraphQL(role constants.Role) (graphql.UserRole, error) {
 		return graphql.UserRoleReader, fmt.Errorf("invalid role: %v", role)
 	}
 }
+
+func ConvertRecurrenceFrequencyToGraphQL(frequency constants.RecurrenceFrequency) (graphql.RecurrenceFrequency, error) {
+	switch frequency {
+	case constants.RecurrenceDaily:
+		return graphql.RecurrenceFrequencyDaily, nil
+	case constants.RecurrenceWeekly:
+		return graphql.RecurrenceFrequencyWeekly, nil
+	case constants.RecurrenceMonthly:
+		return graphql.RecurrenceFrequencyMonthly, nil
+	case constants.RecurrenceAfterCompletion:
+		return graphql.RecurrenceFrequencyAfterCompletion, nil
+	default:
+		return graphql.RecurrenceFrequencyDaily, fmt.Errorf("invalid recurrence frequency: %v", frequency)
+	}
+}
+
+func ConvertRecurrenceFrequencyFromGraphQL(frequency graphql.RecurrenceFrequency) (constants.RecurrenceFrequency, error) {
+	switch frequency {
+	case graphql.RecurrenceFrequencyDaily:
+		return constants.RecurrenceDaily, nil
+	case graphql.RecurrenceFrequencyWeekly:
+		return constants.RecurrenceWeekly, nil
+	case graphql.RecurrenceFrequencyMonthly:
+		return constants.RecurrenceMonthly, nil
+	case graphql.RecurrenceFrequencyAfterCompletion:
+		return constants.RecurrenceAfterCompletion, nil
+	default:
+		return constants.RecurrenceDaily, fmt.Errorf("invalid recurrence frequency: %v", frequency)
+	}
+}
+
+func ConvertBulkTodoActionFromGraphQL(action graphql.BulkTodoAction) (constants.BulkAction, error) {
+	switch action {
+	case graphql.BulkTodoActionComplete:
+		return constants.BulkComplete, nil
+	case graphql.BulkTodoActionReopen:
+		return constants.BulkReopen, nil
+	case graphql.BulkTodoActionDelete:
+		return constants.BulkDelete, nil
+	case graphql.BulkTodoActionSetPriority:
+		return constants.BulkSetPriority, nil
+	case graphql.BulkTodoActionAddTags:
+		return constants.BulkAddTags, nil
+	case graphql.BulkTodoActionRemoveTags:
+		return constants.BulkRemoveTags, nil
+	case graphql.BulkTodoActionAssign:
+		return constants.BulkAssign, nil
+	case graphql.BulkTodoActionMove:
+		return constants.BulkMove, nil
+	default:
+		return "", fmt.Errorf("invalid bulk todo action: %v", action)
+	}
+}
+
+func ConvertSearchResultTypeToGraphQL(resultType constants.SearchResultType) (graphql.SearchResultType, error) {
+	switch resultType {
+	case constants.SearchResultTodo:
+		return graphql.SearchResultTypeTodo, nil
+	case constants.SearchResultList:
+		return graphql.SearchResultTypeList, nil
+	default:
+		return graphql.SearchResultTypeTodo, fmt.Errorf("invalid search result type: %v", resultType)
+	}
+}
+
+func ConvertTrashItemTypeToGraphQL(itemType constants.TrashItemType) (graphql.TrashItemType, error) {
+	switch itemType {
+	case constants.TrashItemTodo:
+		return graphql.TrashItemTypeTodo, nil
+	case constants.TrashItemList:
+		return graphql.TrashItemTypeList, nil
+	default:
+		return graphql.TrashItemTypeTodo, fmt.Errorf("invalid trash item type: %v", itemType)
+	}
+}
+
+func ConvertTodoSortFieldFromGraphQL(field graphql.TodoSortField) (constants.SortKey, error) {
+	switch field {
+	case graphql.TodoSortFieldPosition:
+		return constants.SortPosition, nil
+	case graphql.TodoSortFieldCreatedAt:
+		return constants.SortCreatedAt, nil
+	case graphql.TodoSortFieldDueDate:
+		return constants.SortDueDate, nil
+	case graphql.TodoSortFieldPriority:
+		return constants.SortPriority, nil
+	case graphql.TodoSortFieldTitle:
+		return constants.SortTitle, nil
+	default:
+		return constants.SortCreatedAt, fmt.Errorf("invalid todo sort field: %v", field)
+	}
+}
+
+func ConvertCustomFieldTypeToGraphQL(fieldType constants.CustomFieldType) (graphql.CustomFieldType, error) {
+	switch fieldType {
+	case constants.CustomFieldText:
+		return graphql.CustomFieldTypeText, nil
+	case constants.CustomFieldNumber:
+		return graphql.CustomFieldTypeNumber, nil
+	case constants.CustomFieldDate:
+		return graphql.CustomFieldTypeDate, nil
+	case constants.CustomFieldSingleSelect:
+		return graphql.CustomFieldTypeSingleSelect, nil
+	case constants.CustomFieldCheckbox:
+		return graphql.CustomFieldTypeCheckbox, nil
+	default:
+		return graphql.CustomFieldTypeText, fmt.Errorf("invalid custom field type: %v", fieldType)
+	}
+}
+
+func ConvertCustomFieldTypeFromGraphQL(fieldType graphql.CustomFieldType) (constants.CustomFieldType, error) {
+	switch fieldType {
+	case graphql.CustomFieldTypeText:
+		return constants.CustomFieldText, nil
+	case graphql.CustomFieldTypeNumber:
+		return constants.CustomFieldNumber, nil
+	case graphql.CustomFieldTypeDate:
+		return constants.CustomFieldDate, nil
+	case graphql.CustomFieldTypeSingleSelect:
+		return constants.CustomFieldSingleSelect, nil
+	case graphql.CustomFieldTypeCheckbox:
+		return constants.CustomFieldCheckbox, nil
+	default:
+		return constants.CustomFieldText, fmt.Errorf("invalid custom field type: %v", fieldType)
+	}
+}
+
+func ConvertStatusCategoryToGraphQL(category constants.StatusCategory) (graphql.StatusCategory, error) {
+	switch category {
+	case constants.StatusCategoryOpen:
+		return graphql.StatusCategoryOpen, nil
+	case constants.StatusCategoryDone:
+		return graphql.StatusCategoryDone, nil
+	default:
+		return graphql.StatusCategoryOpen, fmt.Errorf("invalid status category: %v", category)
+	}
+}
+
+func ConvertStatusCategoryFromGraphQL(category graphql.StatusCategory) (constants.StatusCategory, error) {
+	switch category {
+	case graphql.StatusCategoryOpen:
+		return constants.StatusCategoryOpen, nil
+	case graphql.StatusCategoryDone:
+		return constants.StatusCategoryDone, nil
+	default:
+		return constants.StatusCategoryOpen, fmt.Errorf("invalid status category: %v", category)
+	}
+}
+
+func ConvertSmartViewToGraphQL(view constants.SmartView) (graphql.SmartView, error) {
+	switch view {
+	case constants.SmartViewToday:
+		return graphql.SmartViewToday, nil
+	case constants.SmartViewUpcoming:
+		return graphql.SmartViewUpcoming, nil
+	case constants.SmartViewOverdue:
+		return graphql.SmartViewOverdue, nil
+	case constants.SmartViewInbox:
+		return graphql.SmartViewInbox, nil
+	default:
+		return graphql.SmartViewToday, fmt.Errorf("invalid smart view: %v", view)
+	}
+}
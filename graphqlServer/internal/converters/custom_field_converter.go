@@ -0,0 +1,118 @@
+package converters
+
+import (
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"strconv"
+)
+
+type ConverterCustomFieldGraphQL struct{}
+
+//go:generate mockery --name=CustomFieldConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type CustomFieldConverter interface {
+	ConvertCustomFieldToGraphQL(field models.CustomField) (*graphql.CustomField, error)
+	ConvertMultipleCustomFieldsToGraphQL(fields []models.CustomField) ([]*graphql.CustomField, error)
+	ConvertCustomFieldValuesToGraphQL(values []models.CustomFieldValue) ([]*graphql.CustomFieldValue, error)
+	ConvertCustomFieldInput(input graphql.CustomFieldInput) (models.CustomField, error)
+}
+
+func NewConverterCustomFieldGraphQL() CustomFieldConverter {
+	return &ConverterCustomFieldGraphQL{}
+}
+
+func (c *ConverterCustomFieldGraphQL) ConvertCustomFieldToGraphQL(field models.CustomField) (*graphql.CustomField, error) {
+	fieldType, err := ConvertCustomFieldTypeToGraphQL(field.Type)
+	if err != nil {
+		return nil, fmt.Errorf("convertCustomFieldToGraphQL: %w", err)
+	}
+	options := field.Options
+	if options == nil {
+		options = []string{}
+	}
+	return &graphql.CustomField{
+		ID:        field.ID,
+		ListID:    field.ListID,
+		Name:      field.Name,
+		Type:      fieldType,
+		Options:   options,
+		CreatedAt: field.CreatedAt.Format(constants.DateFormat),
+	}, nil
+}
+
+func (c *ConverterCustomFieldGraphQL) ConvertMultipleCustomFieldsToGraphQL(fields []models.CustomField) ([]*graphql.CustomField, error) {
+	graphqlFields := make([]*graphql.CustomField, 0, len(fields))
+	for _, field := range fields {
+		graphqlField, err := c.ConvertCustomFieldToGraphQL(field)
+		if err != nil {
+			return nil, err
+		}
+		graphqlFields = append(graphqlFields, graphqlField)
+	}
+	return graphqlFields, nil
+}
+
+func (c *ConverterCustomFieldGraphQL) ConvertCustomFieldValuesToGraphQL(values []models.CustomFieldValue) ([]*graphql.CustomFieldValue, error) {
+	graphqlValues := make([]*graphql.CustomFieldValue, 0, len(values))
+	for _, value := range values {
+		fieldType, err := ConvertCustomFieldTypeToGraphQL(value.Type)
+		if err != nil {
+			return nil, fmt.Errorf("convertCustomFieldValuesToGraphQL: %w", err)
+		}
+		graphqlValues = append(graphqlValues, &graphql.CustomFieldValue{
+			FieldID: value.FieldID,
+			Name:    value.Name,
+			Type:    fieldType,
+			Value:   formatCustomFieldValue(value.Value),
+		})
+	}
+	return graphqlValues, nil
+}
+
+func (c *ConverterCustomFieldGraphQL) ConvertCustomFieldInput(input graphql.CustomFieldInput) (models.CustomField, error) {
+	fieldType, err := ConvertCustomFieldTypeFromGraphQL(input.Type)
+	if err != nil {
+		return models.CustomField{}, fmt.Errorf("convertCustomFieldInput: %w", err)
+	}
+	return models.CustomField{
+		Name:    input.Name,
+		Type:    fieldType,
+		Options: input.Options,
+	}, nil
+}
+
+// formatCustomFieldValue turns a stored value into the text the schema exposes.
+func formatCustomFieldValue(value interface{}) *string {
+	var text string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		text = v
+	case float64:
+		text = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		text = strconv.FormatBool(v)
+	default:
+		text = fmt.Sprint(v)
+	}
+	return &text
+}
+
+// convertCustomFieldValuesFromGraphQL keys the values by field ID the way the
+// todo service expects them. A null value clears the field.
+func convertCustomFieldValuesFromGraphQL(input []*graphql.CustomFieldValueInput) map[string]interface{} {
+	if input == nil {
+		return nil
+	}
+	values := make(map[string]interface{}, len(input))
+	for _, value := range input {
+		if value.Value == nil {
+			values[value.FieldID] = nil
+			continue
+		}
+		values[value.FieldID] = *value.Value
+	}
+	return values
+}
@@ -12,7 +12,7 @@ import (
 type ListConverter interface {
 	ConvertListToGraphQL(list models.List) (*graphql.List, error)
 	ConvertCreateListInput(input graphql.CreateListInput, userID string) (models.List, error)
-	ConvertUpdateListInput(input graphql.UpdateListInput) (models.List, error)
+	ConvertUpdateListInput(input graphql.UpdateListInput) (map[string]interface{}, error)
 	ConvertAccessLevelToGraphQL(role constants.Role) (graphql.AccessLevel, error)
 	ConvertAccessLevelFromGraphQL(role graphql.AccessLevel) (constants.Role, error)
 	ConvertGrantListAccessInputToModel(input graphql.GrantListAccessInput) (models.Access, error)
@@ -48,6 +48,7 @@ func (c *ConverterListGraphQL) ConvertListToGraphQL(list models.List) (*graphql.
 		Tags:          tags,
 		CreatedAt:     list.CreatedAt.Format(constants.DateFormat),
 		UpdatedAt:     list.UpdatedAt.Format(constants.DateFormat),
+		Version:       list.Version,
 		Todos:         make([]*graphql.Todo, 0),
 		Collaborators: make([]*graphql.ListAccess, 0),
 	}, nil
@@ -92,23 +93,31 @@ func (c *ConverterListGraphQL) ConvertCreateListInput(input graphql.CreateListIn
 	}, nil
 }
 
-func (c *ConverterListGraphQL) ConvertUpdateListInput(input graphql.UpdateListInput) (models.List, error) {
-	jsonBytes, err := json.Marshal(input.Tags)
-	if err != nil {
-		return models.List{}, fmt.Errorf("convertCreateListInput: %w", err)
+// ConvertUpdateListInput returns the JSON merge patch that applies the input
+// to a list. Fields missing from the input are left out of the patch and
+// fields set to null clear the value.
+func (c *ConverterListGraphQL) ConvertUpdateListInput(input graphql.UpdateListInput) (map[string]interface{}, error) {
+	patch := map[string]interface{}{}
+	if input.Name.IsSet() {
+		patch["name"] = input.Name.Value()
 	}
-	jsonRawMessage := json.RawMessage(jsonBytes)
-
-	visibility, err := ConvertVisibilityFromGraphQL(*input.Visibility)
-	if err != nil {
-		return models.List{}, fmt.Errorf("convertVisibilityFromGraphQL: %w", err)
+	if input.Description.IsSet() {
+		patch["description"] = input.Description.Value()
 	}
-	return models.List{
-		Name:        *input.Name,
-		Description: *input.Description,
-		Visibility:  visibility,
-		Tags:        jsonRawMessage,
-	}, nil
+	if input.Visibility.IsSet() {
+		patch["visibility"] = nil
+		if visibility := input.Visibility.Value(); visibility != nil {
+			converted, err := ConvertVisibilityFromGraphQL(*visibility)
+			if err != nil {
+				return nil, fmt.Errorf("convertVisibilityFromGraphQL: %w", err)
+			}
+			patch["visibility"] = converted
+		}
+	}
+	if input.Tags.IsSet() {
+		patch["tags"] = input.Tags.Value()
+	}
+	return patch, nil
 }
 
 func (c *ConverterListGraphQL) ConvertAccessLevelToGraphQL(role constants.Role) (graphql.AccessLevel, error) {
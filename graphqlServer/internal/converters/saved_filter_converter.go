@@ -0,0 +1,46 @@
+package converters
+
+import (
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type ConverterSavedFilterGraphQL struct{}
+
+//go:generate mockery --name=SavedFilterConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type SavedFilterConverter interface {
+	ConvertSavedFilterToGraphQL(filter models.SavedFilter) (*graphql.SavedFilter, error)
+	ConvertMultipleSavedFiltersToGraphQL(filters []models.SavedFilter) ([]*graphql.SavedFilter, error)
+	ConvertSavedFilterInput(input graphql.SavedFilterInput) (models.SavedFilter, error)
+}
+
+func NewConverterSavedFilterGraphQL() SavedFilterConverter {
+	return &ConverterSavedFilterGraphQL{}
+}
+
+func (c *ConverterSavedFilterGraphQL) ConvertSavedFilterToGraphQL(filter models.SavedFilter) (*graphql.SavedFilter, error) {
+	return &graphql.SavedFilter{
+		ID:         filter.ID,
+		Name:       filter.Name,
+		Expression: filter.Expression,
+		CreatedAt:  filter.CreatedAt.Format(constants.DateFormat),
+		UpdatedAt:  filter.UpdatedAt.Format(constants.DateFormat),
+	}, nil
+}
+
+func (c *ConverterSavedFilterGraphQL) ConvertMultipleSavedFiltersToGraphQL(filters []models.SavedFilter) ([]*graphql.SavedFilter, error) {
+	graphqlFilters := make([]*graphql.SavedFilter, 0, len(filters))
+	for _, filter := range filters {
+		graphqlFilter, err := c.ConvertSavedFilterToGraphQL(filter)
+		if err != nil {
+			return nil, err
+		}
+		graphqlFilters = append(graphqlFilters, graphqlFilter)
+	}
+	return graphqlFilters, nil
+}
+
+func (c *ConverterSavedFilterGraphQL) ConvertSavedFilterInput(input graphql.SavedFilterInput) (models.SavedFilter, error) {
+	return models.SavedFilter{Name: input.Name, Expression: input.Expression}, nil
+}
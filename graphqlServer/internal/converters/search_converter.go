@@ -0,0 +1,46 @@
+package converters
+
+import (
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type ConverterSearchGraphQL struct{}
+
+//go:generate mockery --name=SearchConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type SearchConverter interface {
+	ConvertSearchResultToGraphQL(result models.SearchResult) (*graphql.SearchResult, error)
+	ConvertMultipleSearchResultsToGraphQL(results []models.SearchResult) ([]*graphql.SearchResult, error)
+}
+
+func NewConverterSearchGraphQL() SearchConverter {
+	return &ConverterSearchGraphQL{}
+}
+
+func (c *ConverterSearchGraphQL) ConvertSearchResultToGraphQL(result models.SearchResult) (*graphql.SearchResult, error) {
+	resultType, err := ConvertSearchResultTypeToGraphQL(result.Type)
+	if err != nil {
+		return nil, fmt.Errorf("convert search result type to graphql: %w", err)
+	}
+	return &graphql.SearchResult{
+		Type:    resultType,
+		ID:      result.ID,
+		ListID:  result.ListID,
+		Title:   result.Title,
+		Snippet: result.Snippet,
+		Rank:    result.Rank,
+	}, nil
+}
+
+func (c *ConverterSearchGraphQL) ConvertMultipleSearchResultsToGraphQL(results []models.SearchResult) ([]*graphql.SearchResult, error) {
+	graphqlResults := make([]*graphql.SearchResult, 0, len(results))
+	for _, result := range results {
+		graphqlResult, err := c.ConvertSearchResultToGraphQL(result)
+		if err != nil {
+			return nil, err
+		}
+		graphqlResults = append(graphqlResults, graphqlResult)
+	}
+	return graphqlResults, nil
+}
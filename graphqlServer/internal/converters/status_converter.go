@@ -0,0 +1,47 @@
+package converters
+
+import (
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type ConverterStatusGraphQL struct{}
+
+//go:generate mockery --name=StatusConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type StatusConverter interface {
+	ConvertStatusToGraphQL(status models.WorkflowStatus) (*graphql.WorkflowStatus, error)
+	ConvertMultipleStatusesToGraphQL(statuses []models.WorkflowStatus) ([]*graphql.WorkflowStatus, error)
+}
+
+func NewConverterStatusGraphQL() StatusConverter {
+	return &ConverterStatusGraphQL{}
+}
+
+func (c *ConverterStatusGraphQL) ConvertStatusToGraphQL(status models.WorkflowStatus) (*graphql.WorkflowStatus, error) {
+	category, err := ConvertStatusCategoryToGraphQL(status.Category)
+	if err != nil {
+		return nil, fmt.Errorf("convertStatusToGraphQL: %w", err)
+	}
+	return &graphql.WorkflowStatus{
+		ID:        status.ID,
+		ListID:    status.ListID,
+		Name:      status.Name,
+		Category:  category,
+		Position:  status.Position,
+		CreatedAt: status.CreatedAt.Format(constants.DateFormat),
+	}, nil
+}
+
+func (c *ConverterStatusGraphQL) ConvertMultipleStatusesToGraphQL(statuses []models.WorkflowStatus) ([]*graphql.WorkflowStatus, error) {
+	graphqlStatuses := make([]*graphql.WorkflowStatus, 0, len(statuses))
+	for _, status := range statuses {
+		graphqlStatus, err := c.ConvertStatusToGraphQL(status)
+		if err != nil {
+			return nil, err
+		}
+		graphqlStatuses = append(graphqlStatuses, graphqlStatus)
+	}
+	return graphqlStatuses, nil
+}
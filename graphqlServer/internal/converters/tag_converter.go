@@ -0,0 +1,56 @@
+package converters
+
+import (
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type ConverterTagGraphQL struct{}
+
+//go:generate mockery --name=TagConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TagConverter interface {
+	ConvertTagToGraphQL(tag models.Tag) (*graphql.Tag, error)
+	ConvertMultipleTagsToGraphQL(tags []models.Tag) ([]*graphql.Tag, error)
+	ConvertTagInput(input graphql.TagInput) (models.Tag, error)
+}
+
+func NewConverterTagGraphQL() TagConverter {
+	return &ConverterTagGraphQL{}
+}
+
+func (c *ConverterTagGraphQL) ConvertTagToGraphQL(tag models.Tag) (*graphql.Tag, error) {
+	return &graphql.Tag{
+		ID:          tag.ID,
+		Name:        tag.Name,
+		Color:       tag.Color,
+		Description: tag.Description,
+		UserID:      tag.UserID,
+		ListID:      tag.ListID,
+		UsageCount:  tag.UsageCount,
+		CreatedAt:   tag.CreatedAt.Format(constants.DateFormat),
+	}, nil
+}
+
+func (c *ConverterTagGraphQL) ConvertMultipleTagsToGraphQL(tags []models.Tag) ([]*graphql.Tag, error) {
+	graphqlTags := make([]*graphql.Tag, 0, len(tags))
+	for _, tag := range tags {
+		graphqlTag, err := c.ConvertTagToGraphQL(tag)
+		if err != nil {
+			return nil, err
+		}
+		graphqlTags = append(graphqlTags, graphqlTag)
+	}
+	return graphqlTags, nil
+}
+
+func (c *ConverterTagGraphQL) ConvertTagInput(input graphql.TagInput) (models.Tag, error) {
+	tag := models.Tag{Name: input.Name}
+	if input.Color != nil {
+		tag.Color = *input.Color
+	}
+	if input.Description != nil {
+		tag.Description = *input.Description
+	}
+	return tag, nil
+}
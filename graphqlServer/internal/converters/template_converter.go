@@ -0,0 +1,174 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"time"
+)
+
+type ConverterTemplateGraphQL struct{}
+
+//go:generate mockery --name=TemplateConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TemplateConverter interface {
+	ConvertTemplateToGraphQL(template models.ListTemplate) (*graphql.ListTemplate, error)
+	ConvertMultipleTemplatesToGraphQL(templates []models.ListTemplate) ([]*graphql.ListTemplate, error)
+	ConvertTemplateInput(input graphql.ListTemplateInput) (models.ListTemplate, error)
+	ConvertTemplateAnchor(name *string, anchor *string) (models.TemplateAnchor, error)
+}
+
+func NewConverterTemplateGraphQL() TemplateConverter {
+	return &ConverterTemplateGraphQL{}
+}
+
+func (c *ConverterTemplateGraphQL) ConvertTemplateToGraphQL(template models.ListTemplate) (*graphql.ListTemplate, error) {
+	tags, err := convertTemplateTagsToGraphQL(template.Tags)
+	if err != nil {
+		return nil, err
+	}
+	todos, err := convertTemplateTodosToGraphQL(template.Todos)
+	if err != nil {
+		return nil, err
+	}
+	return &graphql.ListTemplate{
+		ID:          template.ID,
+		Name:        template.Name,
+		Description: template.Description,
+		Tags:        tags,
+		Todos:       todos,
+		CreatedAt:   template.CreatedAt.Format(constants.DateFormat),
+		UpdatedAt:   template.UpdatedAt.Format(constants.DateFormat),
+	}, nil
+}
+
+func (c *ConverterTemplateGraphQL) ConvertMultipleTemplatesToGraphQL(templates []models.ListTemplate) ([]*graphql.ListTemplate, error) {
+	graphqlTemplates := make([]*graphql.ListTemplate, 0, len(templates))
+	for _, template := range templates {
+		graphqlTemplate, err := c.ConvertTemplateToGraphQL(template)
+		if err != nil {
+			return nil, err
+		}
+		graphqlTemplates = append(graphqlTemplates, graphqlTemplate)
+	}
+	return graphqlTemplates, nil
+}
+
+func (c *ConverterTemplateGraphQL) ConvertTemplateInput(input graphql.ListTemplateInput) (models.ListTemplate, error) {
+	tags, err := convertTemplateTagsFromGraphQL(input.Tags)
+	if err != nil {
+		return models.ListTemplate{}, err
+	}
+	todos, err := convertTemplateTodoInputs(input.Todos)
+	if err != nil {
+		return models.ListTemplate{}, err
+	}
+	template := models.ListTemplate{
+		Name:  input.Name,
+		Tags:  tags,
+		Todos: todos,
+	}
+	if input.Description != nil {
+		template.Description = *input.Description
+	}
+	return template, nil
+}
+
+// ConvertTemplateAnchor converts the optional name and RFC 3339 anchor of the
+// saveListAsTemplate and instantiateTemplate mutations.
+func (c *ConverterTemplateGraphQL) ConvertTemplateAnchor(name *string, anchor *string) (models.TemplateAnchor, error) {
+	var request models.TemplateAnchor
+	if name != nil {
+		request.Name = *name
+	}
+	if anchor != nil {
+		date, err := time.Parse(constants.DateFormat, *anchor)
+		if err != nil {
+			return models.TemplateAnchor{}, fmt.Errorf("invalid anchor: %w", err)
+		}
+		request.Anchor = &date
+	}
+	return request, nil
+}
+
+func convertTemplateTodosToGraphQL(todos []models.TemplateTodo) ([]*graphql.TemplateTodo, error) {
+	graphqlTodos := make([]*graphql.TemplateTodo, 0, len(todos))
+	for _, todo := range todos {
+		priority, err := ConvertPriorityToGraphQL(todo.Priority)
+		if err != nil {
+			return nil, err
+		}
+		tags, err := convertTemplateTagsToGraphQL(todo.Tags)
+		if err != nil {
+			return nil, err
+		}
+		subtasks, err := convertTemplateTodosToGraphQL(todo.Subtasks)
+		if err != nil {
+			return nil, err
+		}
+		graphqlTodos = append(graphqlTodos, &graphql.TemplateTodo{
+			Title:           todo.Title,
+			Description:     todo.Description,
+			Priority:        priority,
+			Tags:            tags,
+			StartOffsetDays: todo.StartOffsetDays,
+			DueOffsetDays:   todo.DueOffsetDays,
+			Subtasks:        subtasks,
+		})
+	}
+	return graphqlTodos, nil
+}
+
+func convertTemplateTodoInputs(inputs []*graphql.TemplateTodoInput) ([]models.TemplateTodo, error) {
+	todos := make([]models.TemplateTodo, 0, len(inputs))
+	for _, input := range inputs {
+		tags, err := convertTemplateTagsFromGraphQL(input.Tags)
+		if err != nil {
+			return nil, err
+		}
+		subtasks, err := convertTemplateTodoInputs(input.Subtasks)
+		if err != nil {
+			return nil, err
+		}
+		todo := models.TemplateTodo{
+			Title:           input.Title,
+			Tags:            tags,
+			StartOffsetDays: input.StartOffsetDays,
+			DueOffsetDays:   input.DueOffsetDays,
+			Subtasks:        subtasks,
+		}
+		if input.Description != nil {
+			todo.Description = *input.Description
+		}
+		if input.Priority != nil {
+			if todo.Priority, err = ConvertPriorityFromGraphQL(*input.Priority); err != nil {
+				return nil, err
+			}
+		}
+		todos = append(todos, todo)
+	}
+	return todos, nil
+}
+
+func convertTemplateTagsToGraphQL(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var tags []string
+	if err := json.Unmarshal(raw, &tags); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tags: %w", err)
+	}
+	return tags, nil
+}
+
+func convertTemplateTagsFromGraphQL(tags []string) (json.RawMessage, error) {
+	if tags == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tags: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,92 @@
+package converters
+
+import (
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	format "github.com/Victor-Uzunov/devops-project/todoservice/pkg/time"
+	"net/url"
+	"time"
+)
+
+type ConverterTimeEntryGraphQL struct{}
+
+//go:generate mockery --name=TimeEntryConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeEntryConverter interface {
+	ConvertTimeEntryToGraphQL(entry models.TimeEntry) (*graphql.TimeEntry, error)
+	ConvertMultipleTimeEntriesToGraphQL(entries []models.TimeEntry) ([]*graphql.TimeEntry, error)
+	ConvertTimeTotalToGraphQL(total models.TimeTotal) (*graphql.TimeTotal, error)
+	ConvertTimeEntryInput(input graphql.TimeEntryInput) (models.TimeEntry, error)
+}
+
+func NewConverterTimeEntryGraphQL() TimeEntryConverter {
+	return &ConverterTimeEntryGraphQL{}
+}
+
+func (c *ConverterTimeEntryGraphQL) ConvertTimeEntryToGraphQL(entry models.TimeEntry) (*graphql.TimeEntry, error) {
+	return &graphql.TimeEntry{
+		ID:        entry.ID,
+		TodoID:    entry.TodoID,
+		UserID:    entry.UserID,
+		StartedAt: entry.StartedAt.Format(constants.DateFormat),
+		EndedAt:   format.TimeToString(entry.EndedAt),
+		Note:      entry.Note,
+		CreatedAt: entry.CreatedAt.Format(constants.DateFormat),
+	}, nil
+}
+
+func (c *ConverterTimeEntryGraphQL) ConvertMultipleTimeEntriesToGraphQL(entries []models.TimeEntry) ([]*graphql.TimeEntry, error) {
+	graphqlEntries := make([]*graphql.TimeEntry, 0, len(entries))
+	for _, entry := range entries {
+		graphqlEntry, err := c.ConvertTimeEntryToGraphQL(entry)
+		if err != nil {
+			return nil, err
+		}
+		graphqlEntries = append(graphqlEntries, graphqlEntry)
+	}
+	return graphqlEntries, nil
+}
+
+func (c *ConverterTimeEntryGraphQL) ConvertTimeTotalToGraphQL(total models.TimeTotal) (*graphql.TimeTotal, error) {
+	return &graphql.TimeTotal{
+		Seconds: int(total.Seconds),
+		Entries: total.Entries,
+	}, nil
+}
+
+func (c *ConverterTimeEntryGraphQL) ConvertTimeEntryInput(input graphql.TimeEntryInput) (models.TimeEntry, error) {
+	startedAt, err := time.Parse(constants.DateFormat, input.StartedAt)
+	if err != nil {
+		return models.TimeEntry{}, fmt.Errorf("convertTimeEntryInput: %w", err)
+	}
+	endedAt, err := time.Parse(constants.DateFormat, input.EndedAt)
+	if err != nil {
+		return models.TimeEntry{}, fmt.Errorf("convertTimeEntryInput: %w", err)
+	}
+	entry := models.TimeEntry{
+		StartedAt: startedAt,
+		EndedAt:   &endedAt,
+	}
+	if input.Note != nil {
+		entry.Note = *input.Note
+	}
+	return entry, nil
+}
+
+// ConvertTimeRangeToQuery turns the from and to arguments of the time totals
+// into a query string, including the leading question mark. It returns an
+// empty string when neither is set.
+func ConvertTimeRangeToQuery(from *string, to *string) string {
+	params := url.Values{}
+	if from != nil {
+		params.Set("from", *from)
+	}
+	if to != nil {
+		params.Set("to", *to)
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return "?" + params.Encode()
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	format "github.com/Victor-Uzunov/devops-project/todoservice/pkg/time"
+	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -14,8 +16,10 @@ import (
 type TodoConverter interface {
 	ConvertTodoToGraphQL(todo models.Todo) (*graphql.Todo, error)
 	ConvertCreateTodoInput(input graphql.CreateTodoInput) (models.Todo, error)
-	ConvertUpdateTodoInput(input graphql.UpdateTodoInput) (models.Todo, error)
+	ConvertUpdateTodoInput(input graphql.UpdateTodoInput) (map[string]interface{}, error)
 	ConvertMultipleTodoToGraphQL(todos []*models.Todo) ([]*graphql.Todo, error)
+	ConvertBulkTodoInput(input graphql.BulkTodoInput) (models.BulkOperation, error)
+	ConvertTodoViewToGraphQL(view models.SmartView) (*graphql.TodoView, error)
 }
 
 type ConverterTodoGraphQL struct{}
@@ -29,6 +33,10 @@ func (c *ConverterTodoGraphQL) ConvertTodoToGraphQL(todo models.Todo) (*graphql.
 	if err != nil {
 		return &graphql.Todo{}, fmt.Errorf("convertPriorityToGraphQL: %w", err)
 	}
+	recurrence, err := convertRecurrenceToGraphQL(todo.Recurrence)
+	if err != nil {
+		return &graphql.Todo{}, fmt.Errorf("convertRecurrenceToGraphQL: %w", err)
+	}
 	var tags []string
 
 	if todo.Tags != nil {
@@ -40,18 +48,26 @@ func (c *ConverterTodoGraphQL) ConvertTodoToGraphQL(todo models.Todo) (*graphql.
 	}
 
 	return &graphql.Todo{
-		ID:          todo.ID,
-		List:        nil,
-		Title:       todo.Title,
-		Completed:   todo.Completed,
-		Description: &todo.Description,
-		Tags:        tags,
-		Priority:    &priority,
-		DueDate:     format.TimeToString(todo.DueDate),
-		StartDate:   format.TimeToString(todo.StartDate),
-		CreatedAt:   todo.CreatedAt.Format(constants.DateFormat),
-		UpdatedAt:   todo.UpdatedAt.Format(constants.DateFormat),
-		AssignedTo:  nil,
+		ID:                todo.ID,
+		List:              nil,
+		Title:             todo.Title,
+		Completed:         todo.Completed,
+		CompletedAt:       format.TimeToString(todo.CompletedAt),
+		Description:       &todo.Description,
+		Tags:              tags,
+		Priority:          &priority,
+		DueDate:           format.TimeToString(todo.DueDate),
+		StartDate:         format.TimeToString(todo.StartDate),
+		CreatedAt:         todo.CreatedAt.Format(constants.DateFormat),
+		UpdatedAt:         todo.UpdatedAt.Format(constants.DateFormat),
+		Version:           todo.Version,
+		AssignedTo:        nil,
+		SubtasksTotal:     todo.Subtasks.Total,
+		SubtasksCompleted: todo.Subtasks.Completed,
+		Recurrence:        recurrence,
+		Position:          todo.Position,
+		EstimateMinutes:   todo.EstimateMinutes,
+		StatusID:          todo.StatusID,
 	}, nil
 }
 
@@ -95,55 +111,98 @@ func (c *ConverterTodoGraphQL) ConvertCreateTodoInput(input graphql.CreateTodoIn
 		return models.Todo{}, fmt.Errorf("convertCreateTodoInput: %w", err)
 	}
 	jsonRawMessage := json.RawMessage(jsonBytes)
+	recurrence, err := convertRecurrenceFromGraphQL(input.Recurrence)
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("convertCreateTodoInput: %w", err)
+	}
 	return models.Todo{
-		Title:       input.Title,
-		ListID:      input.ListID,
-		Description: *input.Description,
-		DueDate:     &dueDate,
-		StartDate:   &startDate,
-		Priority:    priority,
-		Tags:        jsonRawMessage,
-		Completed:   *input.Completed,
-		AssignedTo:  input.AssignedTo,
+		Title:           input.Title,
+		ListID:          input.ListID,
+		Description:     *input.Description,
+		DueDate:         &dueDate,
+		StartDate:       &startDate,
+		Priority:        priority,
+		Tags:            jsonRawMessage,
+		Completed:       *input.Completed,
+		AssignedTo:      input.AssignedTo,
+		ParentID:        input.ParentID,
+		Recurrence:      recurrence,
+		EstimateMinutes: input.EstimateMinutes,
+		CustomFields:    convertCustomFieldValuesFromGraphQL(input.CustomFields),
 	}, nil
 }
 
-func (c *ConverterTodoGraphQL) ConvertUpdateTodoInput(input graphql.UpdateTodoInput) (models.Todo, error) {
-	priority, err := ConvertPriorityFromGraphQL(*input.Priority)
-	if err != nil {
-		return models.Todo{}, fmt.Errorf("convertCreateTodoInput: %w", err)
+// ConvertUpdateTodoInput returns the JSON merge patch that applies the input
+// to a todo. Fields missing from the input are left out of the patch and
+// fields set to null clear the value.
+func (c *ConverterTodoGraphQL) ConvertUpdateTodoInput(input graphql.UpdateTodoInput) (map[string]interface{}, error) {
+	patch := map[string]interface{}{}
+	if input.Title.IsSet() {
+		patch["title"] = input.Title.Value()
 	}
-	var dueDate time.Time
-	var startDate time.Time
-
-	if *input.DueDate != "null" {
-		dueDate, err = time.Parse(time.RFC3339, *input.DueDate)
+	if input.Description.IsSet() {
+		patch["description"] = input.Description.Value()
+	}
+	if input.Completed.IsSet() {
+		patch["completed"] = input.Completed.Value()
+	}
+	if input.DueDate.IsSet() {
+		dueDate, err := convertPatchDate(input.DueDate.Value())
 		if err != nil {
-			return models.Todo{}, fmt.Errorf("cannot convert dueDate in graphql converter: %w", err)
+			return nil, fmt.Errorf("cannot convert dueDate in graphql converter: %w", err)
 		}
+		patch["due_date"] = dueDate
 	}
-
-	if *input.StartDate != "null" {
-		startDate, err = time.Parse(time.RFC3339, *input.StartDate)
+	if input.StartDate.IsSet() {
+		startDate, err := convertPatchDate(input.StartDate.Value())
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert startDate in graphql converter: %w", err)
+		}
+		patch["start_date"] = startDate
+	}
+	if input.Priority.IsSet() {
+		patch["priority"] = nil
+		if priority := input.Priority.Value(); priority != nil {
+			converted, err := ConvertPriorityFromGraphQL(*priority)
+			if err != nil {
+				return nil, fmt.Errorf("convertUpdateTodoInput: %w", err)
+			}
+			patch["priority"] = converted
+		}
+	}
+	if input.Tags.IsSet() {
+		patch["tags"] = input.Tags.Value()
+	}
+	if input.AssignedTo.IsSet() {
+		patch["assigned_to"] = input.AssignedTo.Value()
+	}
+	if input.Recurrence.IsSet() {
+		recurrence, err := convertRecurrenceFromGraphQL(input.Recurrence.Value())
 		if err != nil {
-			return models.Todo{}, fmt.Errorf("cannot convert startDate in graphql converter: %w", err)
+			return nil, fmt.Errorf("convertUpdateTodoInput: %w", err)
 		}
+		patch["recurrence"] = recurrence
+	}
+	if input.EstimateMinutes.IsSet() {
+		patch["estimate_minutes"] = input.EstimateMinutes.Value()
+	}
+	if input.CustomFields.IsSet() {
+		patch["custom_fields"] = convertCustomFieldValuesFromGraphQL(input.CustomFields.Value())
 	}
+	return patch, nil
+}
 
-	jsonBytes, err := json.Marshal(input.Tags)
+// convertPatchDate parses a date of a patch. Both null and "null" clear the
+// date.
+func convertPatchDate(value *string) (*time.Time, error) {
+	if value == nil || *value == "null" {
+		return nil, nil
+	}
+	date, err := time.Parse(time.RFC3339, *value)
 	if err != nil {
-		return models.Todo{}, fmt.Errorf("convertCreateTodoInput: %w", err)
+		return nil, err
 	}
-	jsonRawMessage := json.RawMessage(jsonBytes)
-	return models.Todo{
-		Title:       *input.Title,
-		Description: *input.Description,
-		DueDate:     &dueDate,
-		StartDate:   &startDate,
-		Priority:    priority,
-		Tags:        jsonRawMessage,
-		AssignedTo:  input.AssignedTo,
-	}, nil
+	return &date, nil
 }
 
 func (c *ConverterTodoGraphQL) ConvertMultipleTodoToGraphQL(todos []*models.Todo) ([]*graphql.Todo, error) {
@@ -157,3 +216,152 @@ func (c *ConverterTodoGraphQL) ConvertMultipleTodoToGraphQL(todos []*models.Todo
 	}
 	return result, nil
 }
+
+func (c *ConverterTodoGraphQL) ConvertTodoViewToGraphQL(view models.SmartView) (*graphql.TodoView, error) {
+	smartView, err := ConvertSmartViewToGraphQL(view.View)
+	if err != nil {
+		return nil, fmt.Errorf("convertTodoViewToGraphQL: %w", err)
+	}
+	groups := make([]*graphql.TodoDateGroup, 0, len(view.Groups))
+	for _, group := range view.Groups {
+		todos := make([]*graphql.Todo, 0, len(group.Todos))
+		for _, todo := range group.Todos {
+			graphqlTodo, err := c.ConvertTodoToGraphQL(todo)
+			if err != nil {
+				return nil, fmt.Errorf("error converting todo: %w", err)
+			}
+			todos = append(todos, graphqlTodo)
+		}
+		groups = append(groups, &graphql.TodoDateGroup{Date: group.Date, Todos: todos})
+	}
+	return &graphql.TodoView{View: smartView, TimeZone: view.TimeZone, Groups: groups}, nil
+}
+
+func (c *ConverterTodoGraphQL) ConvertBulkTodoInput(input graphql.BulkTodoInput) (models.BulkOperation, error) {
+	action, err := ConvertBulkTodoActionFromGraphQL(input.Action)
+	if err != nil {
+		return models.BulkOperation{}, fmt.Errorf("convertBulkTodoInput: %w", err)
+	}
+	operation := models.BulkOperation{
+		IDs:    input.Ids,
+		Action: action,
+		Tags:   input.Tags,
+	}
+	if input.Priority != nil {
+		operation.Priority, err = ConvertPriorityFromGraphQL(*input.Priority)
+		if err != nil {
+			return models.BulkOperation{}, fmt.Errorf("convertBulkTodoInput: %w", err)
+		}
+	}
+	if input.AssignedTo != nil {
+		operation.AssignedTo = *input.AssignedTo
+	}
+	if input.ListID != nil {
+		operation.ListID = *input.ListID
+	}
+	return operation, nil
+}
+
+// ConvertTodoFilterToQuery turns the filter and order arguments of the todo
+// queries into the query string understood by the todo service, including the
+// leading question mark. It returns an empty string when nothing is set.
+func ConvertTodoFilterToQuery(filter *graphql.TodoFilterInput, orderBy *graphql.TodoOrderInput) (string, error) {
+	params := url.Values{}
+	if filter != nil {
+		if filter.Completed != nil {
+			params.Set("completed", strconv.FormatBool(*filter.Completed))
+		}
+		if filter.Priority != nil {
+			priority, err := ConvertPriorityFromGraphQL(*filter.Priority)
+			if err != nil {
+				return "", fmt.Errorf("convertTodoFilterToQuery: %w", err)
+			}
+			params.Set("priority", string(priority))
+		}
+		if filter.Tag != nil {
+			params.Set("tag", *filter.Tag)
+		}
+		if filter.TagID != nil {
+			params.Set("tag_id", *filter.TagID)
+		}
+		if filter.StatusID != nil {
+			params.Set("status_id", *filter.StatusID)
+		}
+		if filter.AssignedTo != nil {
+			params.Set("assigned_to", *filter.AssignedTo)
+		}
+		if filter.DueBefore != nil {
+			params.Set("due_before", *filter.DueBefore)
+		}
+		if filter.DueAfter != nil {
+			params.Set("due_after", *filter.DueAfter)
+		}
+		for _, value := range filter.CustomFields {
+			if value.Value != nil {
+				params.Set("field["+value.FieldID+"]", *value.Value)
+			}
+		}
+	}
+	if orderBy != nil {
+		sortKey, err := ConvertTodoSortFieldFromGraphQL(orderBy.Field)
+		if err != nil {
+			return "", fmt.Errorf("convertTodoFilterToQuery: %w", err)
+		}
+		params.Set("sort", string(sortKey))
+		if orderBy.Descending != nil && *orderBy.Descending {
+			params.Set("order", "desc")
+		}
+	}
+	if len(params) == 0 {
+		return "", nil
+	}
+	return "?" + params.Encode(), nil
+}
+
+func convertRecurrenceToGraphQL(recurrence *models.Recurrence) (*graphql.Recurrence, error) {
+	if recurrence == nil {
+		return nil, nil
+	}
+	frequency, err := ConvertRecurrenceFrequencyToGraphQL(recurrence.Frequency)
+	if err != nil {
+		return nil, err
+	}
+	weekdays := make([]int, 0, len(recurrence.Weekdays))
+	for _, day := range recurrence.Weekdays {
+		weekdays = append(weekdays, int(day))
+	}
+	var dayOfMonth *int
+	if recurrence.DayOfMonth != 0 {
+		dayOfMonth = &recurrence.DayOfMonth
+	}
+	return &graphql.Recurrence{
+		Frequency:  frequency,
+		Interval:   recurrence.Interval,
+		Weekdays:   weekdays,
+		DayOfMonth: dayOfMonth,
+	}, nil
+}
+
+func convertRecurrenceFromGraphQL(input *graphql.RecurrenceInput) (*models.Recurrence, error) {
+	if input == nil {
+		return nil, nil
+	}
+	frequency, err := ConvertRecurrenceFrequencyFromGraphQL(input.Frequency)
+	if err != nil {
+		return nil, err
+	}
+	recurrence := &models.Recurrence{
+		Frequency: frequency,
+		Interval:  1,
+	}
+	if input.Interval != nil {
+		recurrence.Interval = *input.Interval
+	}
+	if input.DayOfMonth != nil {
+		recurrence.DayOfMonth = *input.DayOfMonth
+	}
+	for _, day := range input.Weekdays {
+		recurrence.Weekdays = append(recurrence.Weekdays, time.Weekday(day))
+	}
+	return recurrence, nil
+}
@@ -0,0 +1,46 @@
+package converters
+
+import (
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type ConverterTrashGraphQL struct{}
+
+//go:generate mockery --name=TrashConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TrashConverter interface {
+	ConvertTrashItemToGraphQL(item models.TrashItem) (*graphql.TrashItem, error)
+	ConvertMultipleTrashItemsToGraphQL(items []models.TrashItem) ([]*graphql.TrashItem, error)
+}
+
+func NewConverterTrashGraphQL() TrashConverter {
+	return &ConverterTrashGraphQL{}
+}
+
+func (c *ConverterTrashGraphQL) ConvertTrashItemToGraphQL(item models.TrashItem) (*graphql.TrashItem, error) {
+	itemType, err := ConvertTrashItemTypeToGraphQL(item.Type)
+	if err != nil {
+		return nil, fmt.Errorf("convert trash item type to graphql: %w", err)
+	}
+	return &graphql.TrashItem{
+		Type:      itemType,
+		ID:        item.ID,
+		ListID:    item.ListID,
+		Title:     item.Title,
+		DeletedAt: item.DeletedAt.Format(constants.DateFormat),
+	}, nil
+}
+
+func (c *ConverterTrashGraphQL) ConvertMultipleTrashItemsToGraphQL(items []models.TrashItem) ([]*graphql.TrashItem, error) {
+	graphqlItems := make([]*graphql.TrashItem, 0, len(items))
+	for _, item := range items {
+		graphqlItem, err := c.ConvertTrashItemToGraphQL(item)
+		if err != nil {
+			return nil, err
+		}
+		graphqlItems = append(graphqlItems, graphqlItem)
+	}
+	return graphqlItems, nil
+}
@@ -0,0 +1,65 @@
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+type Resolver struct {
+	httpClient   client.Client
+	activityConv converters.ActivityConverter
+}
+
+func NewResolver(client client.Client, converter converters.ActivityConverter) *Resolver {
+	return &Resolver{
+		httpClient:   client,
+		activityConv: converter,
+	}
+}
+
+func (r *Resolver) ListActivity(ctx context.Context, obj *graphql.List, limit *int) ([]*graphql.Activity, error) {
+	log.C(ctx).Info("activityResolver called for list activity")
+	if obj == nil {
+		return nil, nil
+	}
+	return r.getActivity(ctx, fmt.Sprintf("/lists/%s/activity", obj.ID), limit)
+}
+
+func (r *Resolver) TodoActivity(ctx context.Context, obj *graphql.Todo, limit *int) ([]*graphql.Activity, error) {
+	log.C(ctx).Info("activityResolver called for todo activity")
+	if obj == nil {
+		return nil, nil
+	}
+	return r.getActivity(ctx, fmt.Sprintf("/todos/%s/activity", obj.ID), limit)
+}
+
+func (r *Resolver) getActivity(ctx context.Context, url string, limit *int) ([]*graphql.Activity, error) {
+	if limit != nil {
+		url = fmt.Sprintf("%s?limit=%d", url, *limit)
+	}
+	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting activity: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var activities []models.Activity
+	if err = json.Unmarshal(response, &activities); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.activityConv.ConvertMultipleActivitiesToGraphQL(activities)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting activity to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting activity to graphql: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package activity_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/activity"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTodoActivity_ActivityResolver(t *testing.T) {
+	createdAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	actorID := "3"
+	todoID := "2"
+	inputActivity := []models.Activity{
+		{
+			ID:        "1",
+			ListID:    "list",
+			TodoID:    &todoID,
+			ActorID:   &actorID,
+			Action:    constants.ActivityTodoUpdated,
+			Changes:   []models.FieldChange{{Field: "title", Before: json.RawMessage(`"Old"`), After: json.RawMessage(`"New"`)}},
+			CreatedAt: createdAt,
+		},
+	}
+	before, after := `"Old"`, `"New"`
+	expectedActivity := []*graphql.Activity{
+		{
+			ID:        "1",
+			ListID:    "list",
+			TodoID:    &todoID,
+			ActorID:   &actorID,
+			Action:    "todo.updated",
+			Changes:   []*graphql.FieldChange{{Field: "title", Before: &before, After: &after}},
+			CreatedAt: "2026-10-18T09:00:00Z",
+		},
+	}
+	limit := 10
+
+	tests := []struct {
+		name              string
+		mockResp          []byte
+		mockErr           error
+		expectError       bool
+		expectActivity    []*graphql.Activity
+		activityConverter func() *automock.ActivityConverter
+	}{
+		{
+			name:           "successful activity",
+			mockResp:       []byte(`[{"id": "1", "list_id": "list", "todo_id": "2", "actor_id": "3", "action": "todo.updated", "changes": [{"field": "title", "before": "Old", "after": "New"}], "created_at": "2026-10-18T09:00:00Z"}]`),
+			expectActivity: expectedActivity,
+			activityConverter: func() *automock.ActivityConverter {
+				activityConverter := &automock.ActivityConverter{}
+				activityConverter.EXPECT().ConvertMultipleActivitiesToGraphQL(inputActivity).Return(expectedActivity, nil)
+				return activityConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("failed to get activity"),
+			expectError: true,
+			activityConverter: func() *automock.ActivityConverter {
+				return &automock.ActivityConverter{}
+			},
+		},
+		{
+			name:        "failed to unmarshal response",
+			mockResp:    []byte(`invalid JSON`),
+			expectError: true,
+			activityConverter: func() *automock.ActivityConverter {
+				return &automock.ActivityConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", "/todos/2/activity?limit=10", mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := activity.NewResolver(mockClient, tt.activityConverter())
+
+			result, err := r.TodoActivity(context.Background(), &graphql.Todo{ID: "2"}, &limit)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectActivity, result)
+			}
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", "/todos/2/activity?limit=10", mock.Anything)
+		})
+	}
+}
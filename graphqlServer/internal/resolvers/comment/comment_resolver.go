@@ -0,0 +1,59 @@
+package comment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+type Resolver struct {
+	httpClient  client.Client
+	commentConv converters.CommentConverter
+}
+
+func NewResolver(client client.Client, converter converters.CommentConverter) *Resolver {
+	return &Resolver{
+		httpClient:  client,
+		commentConv: converter,
+	}
+}
+
+func (r *Resolver) Comments(ctx context.Context, obj *graphql.Todo) ([]*graphql.Comment, error) {
+	log.C(ctx).Info("commentResolver called for todo comments")
+	if obj == nil {
+		return nil, nil
+	}
+	return r.getComments(ctx, fmt.Sprintf("/todos/%s/comments", obj.ID))
+}
+
+func (r *Resolver) CommentsMentioningMe(ctx context.Context) ([]*graphql.Comment, error) {
+	log.C(ctx).Info("commentResolver called comments mentioning me")
+	return r.getComments(ctx, "/comments/mentions")
+}
+
+func (r *Resolver) getComments(ctx context.Context, url string) ([]*graphql.Comment, error) {
+	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting comments: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var comments []models.Comment
+	if err = json.Unmarshal(response, &comments); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.commentConv.ConvertMultipleCommentsToGraphQL(comments)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting comments to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting comments to graphql: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package comment_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/comment"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComments_CommentResolver(t *testing.T) {
+	createdAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	inputComments := []models.Comment{
+		{ID: "1", TodoID: "2", AuthorID: "3", Body: "Hi @alice@example.com", Mentions: []string{"4"}, CreatedAt: createdAt, UpdatedAt: createdAt},
+	}
+	expectedComments := []*graphql.Comment{
+		{ID: "1", TodoID: "2", AuthorID: "3", Body: "Hi @alice@example.com", MentionIds: []string{"4"}, CreatedAt: "2026-10-18T09:00:00Z", UpdatedAt: "2026-10-18T09:00:00Z"},
+	}
+
+	tests := []struct {
+		name             string
+		mockResp         []byte
+		mockErr          error
+		expectError      bool
+		expectComments   []*graphql.Comment
+		commentConverter func() *automock.CommentConverter
+	}{
+		{
+			name:           "successful comments",
+			mockResp:       []byte(`[{"id": "1", "todo_id": "2", "author_id": "3", "body": "Hi @alice@example.com", "mentions": ["4"], "created_at": "2026-10-18T09:00:00Z", "updated_at": "2026-10-18T09:00:00Z"}]`),
+			expectComments: expectedComments,
+			commentConverter: func() *automock.CommentConverter {
+				commentConverter := &automock.CommentConverter{}
+				commentConverter.EXPECT().ConvertMultipleCommentsToGraphQL(inputComments).Return(expectedComments, nil)
+				return commentConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("failed to get comments"),
+			expectError: true,
+			commentConverter: func() *automock.CommentConverter {
+				return &automock.CommentConverter{}
+			},
+		},
+		{
+			name:        "failed to unmarshal response",
+			mockResp:    []byte(`invalid JSON`),
+			expectError: true,
+			commentConverter: func() *automock.CommentConverter {
+				return &automock.CommentConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", "/todos/2/comments", mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := comment.NewResolver(mockClient, tt.commentConverter())
+
+			result, err := r.Comments(context.Background(), &graphql.Todo{ID: "2"})
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectComments, result)
+			}
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", "/todos/2/comments", mock.Anything)
+		})
+	}
+}
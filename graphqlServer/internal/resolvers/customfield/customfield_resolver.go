@@ -0,0 +1,129 @@
+package customfield
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+type Resolver struct {
+	httpClient      client.Client
+	customFieldConv converters.CustomFieldConverter
+}
+
+func NewResolver(client client.Client, converter converters.CustomFieldConverter) *Resolver {
+	return &Resolver{
+		httpClient:      client,
+		customFieldConv: converter,
+	}
+}
+
+func (r *Resolver) ListCustomFields(ctx context.Context, obj *graphql.List) ([]*graphql.CustomField, error) {
+	log.C(ctx).Info("customFieldResolver called for list custom fields")
+	if obj == nil {
+		return nil, nil
+	}
+	response, err := r.httpClient.Do(ctx, http.MethodGet, fmt.Sprintf("/lists/%s/fields", obj.ID), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting custom fields: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var fields []models.CustomField
+	if err = json.Unmarshal(response, &fields); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.customFieldConv.ConvertMultipleCustomFieldsToGraphQL(fields)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting custom fields to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting custom fields to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) TodoCustomFields(ctx context.Context, obj *graphql.Todo) ([]*graphql.CustomFieldValue, error) {
+	log.C(ctx).Info("customFieldResolver called for todo custom fields")
+	if obj == nil {
+		return nil, nil
+	}
+	response, err := r.httpClient.Do(ctx, http.MethodGet, fmt.Sprintf("/todos/%s/custom_fields", obj.ID), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting custom field values: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var values []models.CustomFieldValue
+	if err = json.Unmarshal(response, &values); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.customFieldConv.ConvertCustomFieldValuesToGraphQL(values)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting custom field values to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting custom field values to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) CreateCustomField(ctx context.Context, listID string, input graphql.CustomFieldInput) (*graphql.CustomField, error) {
+	log.C(ctx).Info("customFieldResolver called for create custom field")
+	return r.saveCustomField(ctx, http.MethodPost, fmt.Sprintf("/lists/%s/fields", listID), input)
+}
+
+func (r *Resolver) UpdateCustomField(ctx context.Context, listID string, id string, input graphql.CustomFieldInput) (*graphql.CustomField, error) {
+	log.C(ctx).Info("customFieldResolver called for update custom field")
+	return r.saveCustomField(ctx, http.MethodPut, fmt.Sprintf("/lists/%s/fields/%s", listID, id), input)
+}
+
+func (r *Resolver) DeleteCustomField(ctx context.Context, listID string, id string) (*bool, error) {
+	log.C(ctx).Info("customFieldResolver called for delete custom field")
+	_, err := r.httpClient.Do(ctx, http.MethodDelete, fmt.Sprintf("/lists/%s/fields/%s", listID, id), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error deleting custom field: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	success := true
+	return &success, nil
+}
+
+func (r *Resolver) saveCustomField(ctx context.Context, method string, url string, input graphql.CustomFieldInput) (*graphql.CustomField, error) {
+	field, err := r.customFieldConv.ConvertCustomFieldInput(input)
+	if err != nil {
+		log.C(ctx).Errorf("error converting custom field input: %v", err)
+		return nil, fmt.Errorf("error converting custom field input: %w", err)
+	}
+	requestBody, err := json.Marshal(field)
+	if err != nil {
+		log.C(ctx).Errorf("error marshalling request body: %v", err)
+		return nil, fmt.Errorf("error marshalling request body: %w", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, method, url, requestBody)
+	if err != nil {
+		log.C(ctx).Errorf("error saving custom field: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var saved models.CustomField
+	if err = json.Unmarshal(response, &saved); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.customFieldConv.ConvertCustomFieldToGraphQL(saved)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting custom field to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting custom field to graphql: %w", err)
+	}
+	return result, nil
+}
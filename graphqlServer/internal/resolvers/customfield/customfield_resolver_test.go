@@ -0,0 +1,133 @@
+package customfield_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/customfield"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestCustomField_TodoCustomFieldsResolver(t *testing.T) {
+	inputValues := []models.CustomFieldValue{
+		{FieldID: "1", Name: "Points", Type: constants.CustomFieldNumber, Value: float64(3)},
+		{FieldID: "2", Name: "Stage", Type: constants.CustomFieldSingleSelect},
+	}
+	points := "3"
+	expectedValues := []*graphql.CustomFieldValue{
+		{FieldID: "1", Name: "Points", Type: graphql.CustomFieldTypeNumber, Value: &points},
+		{FieldID: "2", Name: "Stage", Type: graphql.CustomFieldTypeSingleSelect},
+	}
+
+	tests := []struct {
+		name                 string
+		mockResp             []byte
+		mockErr              error
+		expectError          bool
+		expectValues         []*graphql.CustomFieldValue
+		customFieldConverter func() *automock.CustomFieldConverter
+	}{
+		{
+			name:         "values of the todo",
+			mockResp:     []byte(`[{"field_id": "1", "name": "Points", "type": "number", "value": 3}, {"field_id": "2", "name": "Stage", "type": "single_select", "value": null}]`),
+			expectValues: expectedValues,
+			customFieldConverter: func() *automock.CustomFieldConverter {
+				customFieldConverter := &automock.CustomFieldConverter{}
+				customFieldConverter.EXPECT().ConvertCustomFieldValuesToGraphQL(inputValues).Return(expectedValues, nil)
+				return customFieldConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("failed to get values"),
+			expectError: true,
+			customFieldConverter: func() *automock.CustomFieldConverter {
+				return &automock.CustomFieldConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", "/todos/todo/custom_fields", mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := customfield.NewResolver(mockClient, tt.customFieldConverter())
+
+			result, err := r.TodoCustomFields(context.Background(), &graphql.Todo{ID: "todo"})
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectValues, result)
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", "/todos/todo/custom_fields", mock.Anything)
+		})
+	}
+}
+
+func TestCustomField_CreateCustomFieldResolver(t *testing.T) {
+	input := graphql.CustomFieldInput{Name: "Stage", Type: graphql.CustomFieldTypeSingleSelect, Options: []string{"todo", "done"}}
+	field := models.CustomField{Name: "Stage", Type: constants.CustomFieldSingleSelect, Options: []string{"todo", "done"}}
+	created := models.CustomField{ID: "1", ListID: "list", Name: "Stage", Type: constants.CustomFieldSingleSelect, Options: []string{"todo", "done"}}
+	expectedField := &graphql.CustomField{ID: "1", ListID: "list", Name: "Stage", Type: graphql.CustomFieldTypeSingleSelect, Options: []string{"todo", "done"}}
+
+	tests := []struct {
+		name                 string
+		mockResp             []byte
+		mockErr              error
+		expectError          bool
+		expectField          *graphql.CustomField
+		customFieldConverter func() *automock.CustomFieldConverter
+	}{
+		{
+			name:        "created field",
+			mockResp:    []byte(`{"id": "1", "list_id": "list", "name": "Stage", "type": "single_select", "options": ["todo", "done"], "created_at": "0001-01-01T00:00:00Z"}`),
+			expectField: expectedField,
+			customFieldConverter: func() *automock.CustomFieldConverter {
+				customFieldConverter := &automock.CustomFieldConverter{}
+				customFieldConverter.EXPECT().ConvertCustomFieldInput(input).Return(field, nil)
+				customFieldConverter.EXPECT().ConvertCustomFieldToGraphQL(created).Return(expectedField, nil)
+				return customFieldConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("field name taken"),
+			expectError: true,
+			customFieldConverter: func() *automock.CustomFieldConverter {
+				customFieldConverter := &automock.CustomFieldConverter{}
+				customFieldConverter.EXPECT().ConvertCustomFieldInput(input).Return(field, nil)
+				return customFieldConverter
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "POST", "/lists/list/fields", mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := customfield.NewResolver(mockClient, tt.customFieldConverter())
+
+			result, err := r.CreateCustomField(context.Background(), "list", input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectField, result)
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "POST", "/lists/list/fields", mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrorPresenter presents errors like the default presenter. When the todo
+// service rejected the input, the field errors it returned are added to the
+// extensions of the error under the VALIDATION_FAILED code. Updates refused
+// because of an outdated expectedVersion get the VERSION_MISMATCH code.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	presented := graphql.DefaultErrorPresenter(ctx, err)
+	var validationErr *validation.Errors
+	switch {
+	case errors.As(err, &validationErr):
+		setExtension(presented, "code", "VALIDATION_FAILED")
+		setExtension(presented, "fields", validationErr.Fields)
+	case errors.Is(err, client.ErrVersionMismatch):
+		setExtension(presented, "code", "VERSION_MISMATCH")
+	}
+	return presented
+}
+
+func setExtension(err *gqlerror.Error, key string, value interface{}) {
+	if err.Extensions == nil {
+		err.Extensions = map[string]interface{}{}
+	}
+	err.Extensions[key] = value
+}
@@ -163,12 +163,17 @@ func (r *Resolver) Owner(ctx context.Context, obj *graphql.List) (*graphql.User,
 	return r.getUser(ctx, ownerID)
 }
 
-func (r *Resolver) Todos(ctx context.Context, obj *graphql.List) ([]*graphql.Todo, error) {
+func (r *Resolver) Todos(ctx context.Context, obj *graphql.List, filter *graphql.TodoFilterInput, orderBy *graphql.TodoOrderInput) ([]*graphql.Todo, error) {
 	log.C(ctx).Info("list resolver for getting todos")
 	if obj == nil {
 		return nil, nil
 	}
-	url := fmt.Sprintf("/lists/%s/todos", obj.ID)
+	query, err := converters.ConvertTodoFilterToQuery(filter, orderBy)
+	if err != nil {
+		log.C(ctx).Errorf("error converting todo filter: %v", err)
+		return nil, fmt.Errorf("error converting filter: %w", err)
+	}
+	url := fmt.Sprintf("/lists/%s/todos", obj.ID) + query
 	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch todos: %v", err)
@@ -296,29 +301,23 @@ func (r *Resolver) CreateList(ctx context.Context, input graphql.CreateListInput
 	return r.listConv.ConvertListToGraphQL(list)
 }
 
-func (r *Resolver) UpdateList(ctx context.Context, id string, input graphql.UpdateListInput) (*graphql.List, error) {
+func (r *Resolver) UpdateList(ctx context.Context, id string, input graphql.UpdateListInput, expectedVersion *int) (*graphql.List, error) {
 	log.C(ctx).Info("update list resolver")
-	httpInput, err := r.listConv.ConvertUpdateListInput(input)
+	patch, err := r.listConv.ConvertUpdateListInput(input)
 	if err != nil {
 		log.C(ctx).Errorf("failed to convert update list: %v", err)
-		return nil, fmt.Errorf("error converting update list input to struct: %w", err)
+		return nil, fmt.Errorf("error converting update list input to patch: %w", err)
 	}
-	body, err := json.Marshal(httpInput)
+	body, err := json.Marshal(patch)
 	if err != nil {
 		log.C(ctx).Errorf("failed to marshal update list input: %v", err)
-		return nil, fmt.Errorf("error marshalling user: %v", err)
+		return nil, fmt.Errorf("error marshalling patch: %v", err)
 	}
 
 	url := fmt.Sprintf("/lists/%s", id)
-	log.C(ctx).Debugf("update list response: %v", string(body))
-
-	_, err = r.httpClient.Do(ctx, http.MethodPut, url, body)
-	if err != nil {
-		log.C(ctx).Errorf("failed to fetch update list response: %v", err)
-		return nil, fmt.Errorf("error executing request: %w", err)
-	}
+	log.C(ctx).Debugf("update list patch: %v", string(body))
 
-	responseGet, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	response, err := r.httpClient.Do(client.WithExpectedVersion(ctx, expectedVersion), http.MethodPatch, url, body)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch update list response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
@@ -327,7 +326,7 @@ func (r *Resolver) UpdateList(ctx context.Context, id string, input graphql.Upda
 
 	var l models.List
 
-	if err = json.Unmarshal(responseGet, &l); err != nil {
+	if err = json.Unmarshal(response, &l); err != nil {
 		log.C(ctx).Errorf("failed to unmarshal update list response: %v", err)
 		return nil, fmt.Errorf("error unmarshalling response: %w", err)
 	}
@@ -381,6 +380,16 @@ func (r *Resolver) DeleteList(ctx context.Context, id string) (*graphql.List, er
 	return r.listConv.ConvertListToGraphQL(l)
 }
 
+func (r *Resolver) RestoreList(ctx context.Context, id string) (*graphql.List, error) {
+	log.C(ctx).Info("restore list resolver")
+	_, err := r.httpClient.Do(ctx, http.MethodPost, fmt.Sprintf("/trash/lists/%s/restore", id), nil)
+	if err != nil {
+		log.C(ctx).Errorf("failed to fetch restore list response: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.List(ctx, id)
+}
+
 func (r *Resolver) ListsByUser(ctx context.Context, id string) ([]*graphql.List, error) {
 	log.C(ctx).Info("list resolver for ListByUser")
 	url := fmt.Sprintf("/users/%s/lists", id)
@@ -559,7 +568,7 @@ func (r *Resolver) getList(ctx context.Context, id string) (*graphql.List, error
 	return r.listConv.ConvertListToGraphQL(l)
 }
 
-func (r *Resolver) UpdateListName(ctx context.Context, id string, name string) (*graphql.List, error) {
+func (r *Resolver) UpdateListName(ctx context.Context, id string, name string, expectedVersion *int) (*graphql.List, error) {
 	log.C(ctx).Info("update list name resolver")
 	url := fmt.Sprintf("/lists/%s/name", id)
 
@@ -575,7 +584,7 @@ func (r *Resolver) UpdateListName(ctx context.Context, id string, name string) (
 		return nil, fmt.Errorf("error marshalling name: %v", err)
 	}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+	response, err := r.httpClient.Do(client.WithExpectedVersion(ctx, expectedVersion), http.MethodPatch, url, body)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch list response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
@@ -590,7 +599,7 @@ func (r *Resolver) UpdateListName(ctx context.Context, id string, name string) (
 	return r.listConv.ConvertListToGraphQL(l)
 }
 
-func (r *Resolver) UpdateListDescription(ctx context.Context, id string, description string) (*graphql.List, error) {
+func (r *Resolver) UpdateListDescription(ctx context.Context, id string, description string, expectedVersion *int) (*graphql.List, error) {
 	log.C(ctx).Info("update list description resolver")
 	url := fmt.Sprintf("/lists/%s/description", id)
 
@@ -606,7 +615,7 @@ func (r *Resolver) UpdateListDescription(ctx context.Context, id string, descrip
 		return nil, fmt.Errorf("error marshalling description: %v", err)
 	}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+	response, err := r.httpClient.Do(client.WithExpectedVersion(ctx, expectedVersion), http.MethodPatch, url, body)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch list response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
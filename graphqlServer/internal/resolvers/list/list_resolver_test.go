@@ -3,6 +3,7 @@ package list_test
 import (
 	"context"
 	"errors"
+	graphql2 "github.com/99designs/gqlgen/graphql"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/list"
@@ -200,86 +201,59 @@ func TestUpdateList_ListResolver(t *testing.T) {
 	}
 	name := "Updated Test List"
 	input := graphql.UpdateListInput{
-		Name: &name,
+		Name:        graphql2.OmittableOf(&name),
+		Description: graphql2.OmittableOf[*string](nil),
 	}
 
-	httpInput := models.List{
-		Name: "Updated Test List",
-	}
+	patch := map[string]interface{}{"name": &name, "description": nil}
 
 	tests := []struct {
 		name          string
 		id            string
 		input         graphql.UpdateListInput
-		mockMethodPut string
-		mockMethodGet string
-		mockURLPut    string
-		mockURLGet    string
-		mockRespPut   []byte
-		mockRespGet   []byte
-		mockErrPut    error
-		mockErrGet    error
+		mockResp      []byte
+		mockErr       error
 		expectError   bool
 		expectList    *graphql.List
 		listConverter func() *automock.ListConverter
 	}{
 		{
-			name:          "successful list update",
-			id:            "1",
-			input:         input,
-			mockMethodPut: "PUT",
-			mockMethodGet: "GET",
-			mockURLPut:    "/lists/1",
-			mockURLGet:    "/lists/1",
-			mockRespPut:   nil,
-			mockRespGet:   []byte(`{"ID": "1", "Title": "Updated Test List"}`),
-			mockErrPut:    nil,
-			mockErrGet:    nil,
-			expectError:   false,
-			expectList:    &expectedList,
+			name:        "successful list update",
+			id:          "1",
+			input:       input,
+			mockResp:    []byte(`{"id": "1", "name": "Updated Test List"}`),
+			expectError: false,
+			expectList:  &expectedList,
 			listConverter: func() *automock.ListConverter {
 				listConverter := &automock.ListConverter{}
-				listConverter.EXPECT().ConvertUpdateListInput(input).Return(httpInput, nil)
-				listConverter.EXPECT().ConvertListToGraphQL(models.List{ID: "1"}).Return(&expectedList, nil)
+				listConverter.EXPECT().ConvertUpdateListInput(input).Return(patch, nil)
+				listConverter.EXPECT().ConvertListToGraphQL(models.List{ID: "1", Name: "Updated Test List"}).Return(&expectedList, nil)
 				return listConverter
 			},
 		},
 		{
-			name:          "failed GET request after update",
-			id:            "1",
-			input:         input,
-			mockMethodPut: "PUT",
-			mockMethodGet: "GET",
-			mockURLPut:    "/lists/1",
-			mockURLGet:    "/lists/1",
-			mockRespPut:   nil,
-			mockRespGet:   nil,
-			mockErrPut:    nil,
-			mockErrGet:    errors.New("failed GET request"),
-			expectError:   true,
-			expectList:    nil,
+			name:        "failed PATCH request",
+			id:          "1",
+			input:       input,
+			mockErr:     errors.New("failed PATCH request"),
+			expectError: true,
+			expectList:  nil,
 			listConverter: func() *automock.ListConverter {
 				listConverter := &automock.ListConverter{}
-				listConverter.EXPECT().ConvertUpdateListInput(input).Return(httpInput, nil)
+				listConverter.EXPECT().ConvertUpdateListInput(input).Return(patch, nil)
 				return listConverter
 			},
 		},
 		{
-			name:          "failed to unmarshal GET response",
-			id:            "1",
-			input:         input,
-			mockMethodPut: "PUT",
-			mockMethodGet: "GET",
-			mockURLPut:    "/lists/1",
-			mockURLGet:    "/lists/1",
-			mockRespPut:   nil,
-			mockRespGet:   []byte(`invalid JSON`),
-			mockErrPut:    nil,
-			expectError:   true,
-			expectList:    nil,
+			name:        "failed to unmarshal PATCH response",
+			id:          "1",
+			input:       input,
+			mockResp:    []byte(`invalid JSON`),
+			expectError: true,
+			expectList:  nil,
 			listConverter: func() *automock.ListConverter {
 				listConverter := &automock.ListConverter{}
-				listConverter.EXPECT().ConvertUpdateListInput(input).Return(httpInput, nil)
+				listConverter.EXPECT().ConvertUpdateListInput(input).Return(patch, nil)
 				return listConverter
 			},
 		},
@@ -289,15 +263,13 @@ func TestUpdateList_ListResolver(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := new(mock2.ClientMock)
 
-			mockClient.On("Do", mock.Anything, "PUT", tt.mockURLPut, mock.Anything).Return(tt.mockRespPut, tt.mockErrPut)
-
-			mockClient.On("Do", mock.Anything, "GET", tt.mockURLGet, mock.Anything).Return(tt.mockRespGet, tt.mockErrGet)
+			mockClient.On("Do", mock.Anything, "PATCH", "/lists/1", []byte(`{"description":null,"name":"Updated Test List"}`)).Return(tt.mockResp, tt.mockErr)
 
 			listConverter := tt.listConverter()
 
 			r := list.NewResolver(mockClient, listConverter, nil)
 
-			result, err := r.UpdateList(context.Background(), tt.id, tt.input)
+			result, err := r.UpdateList(context.Background(), tt.id, tt.input, nil)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -308,8 +280,7 @@ func TestUpdateList_ListResolver(t *testing.T) {
 				assert.Equal(t, tt.expectList, result)
 			}
 
-			mockClient.AssertCalled(t, "Do", mock.Anything, "PUT", tt.mockURLPut, mock.Anything)
-			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", tt.mockURLGet, mock.Anything)
+			mockClient.AssertExpectations(t)
 		})
 	}
 }
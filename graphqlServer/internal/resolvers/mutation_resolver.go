@@ -30,9 +30,9 @@ func (r *mutationResolver) CreateList(ctx context.Context, input graphql.CreateL
 	return r.list.CreateList(ctx, input)
 }
 
-func (r *mutationResolver) UpdateList(ctx context.Context, id string, input graphql.UpdateListInput) (*graphql.List, error) {
+func (r *mutationResolver) UpdateList(ctx context.Context, id string, input graphql.UpdateListInput, expectedVersion *int) (*graphql.List, error) {
 	log.C(ctx).Info("updating list mutation resolver")
-	return r.list.UpdateList(ctx, id, input)
+	return r.list.UpdateList(ctx, id, input, expectedVersion)
 }
 
 func (r *mutationResolver) DeleteList(ctx context.Context, id string) (*graphql.List, error) {
@@ -45,9 +45,9 @@ func (r *mutationResolver) CreateTodo(ctx context.Context, input graphql.CreateT
 	return r.todo.CreateTodo(ctx, input)
 }
 
-func (r *mutationResolver) UpdateTodo(ctx context.Context, id string, input graphql.UpdateTodoInput) (*graphql.Todo, error) {
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id string, input graphql.UpdateTodoInput, expectedVersion *int) (*graphql.Todo, error) {
 	log.C(ctx).Info("updating todo mutation resolver")
-	return r.todo.UpdateTodo(ctx, id, input)
+	return r.todo.UpdateTodo(ctx, id, input, expectedVersion)
 }
 
 func (r *mutationResolver) DeleteTodo(ctx context.Context, id string) (*graphql.Todo, error) {
@@ -55,6 +55,16 @@ func (r *mutationResolver) DeleteTodo(ctx context.Context, id string) (*graphql.
 	return r.todo.DeleteTodo(ctx, id)
 }
 
+func (r *mutationResolver) AddTodoDependency(ctx context.Context, todoID string, blockedByID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("adding todo dependency mutation resolver")
+	return r.todo.AddTodoDependency(ctx, todoID, blockedByID)
+}
+
+func (r *mutationResolver) RemoveTodoDependency(ctx context.Context, todoID string, blockedByID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("removing todo dependency mutation resolver")
+	return r.todo.RemoveTodoDependency(ctx, todoID, blockedByID)
+}
+
 func (r *mutationResolver) AddListAccess(ctx context.Context, input graphql.GrantListAccessInput) (*graphql.ListAccess, error) {
 	log.C(ctx).Info("adding list access mutation resolver")
 	return r.list.AddListAccess(ctx, input)
@@ -65,39 +75,64 @@ func (r *mutationResolver) RemoveListAccess(ctx context.Context, listID string)
 	return r.list.RemoveListAccess(ctx, listID)
 }
 
-func (r *mutationResolver) UpdateListName(ctx context.Context, id string, name string) (*graphql.List, error) {
+func (r *mutationResolver) UpdateListName(ctx context.Context, id string, name string, expectedVersion *int) (*graphql.List, error) {
 	log.C(ctx).Info("updating list name mutation resolver")
-	return r.list.UpdateListName(ctx, id, name)
+	return r.list.UpdateListName(ctx, id, name, expectedVersion)
 }
 
-func (r *mutationResolver) UpdateListDescription(ctx context.Context, id string, description string) (*graphql.List, error) {
+func (r *mutationResolver) UpdateListDescription(ctx context.Context, id string, description string, expectedVersion *int) (*graphql.List, error) {
 	log.C(ctx).Info("updating list description mutation resolver")
-	return r.list.UpdateListDescription(ctx, id, description)
+	return r.list.UpdateListDescription(ctx, id, description, expectedVersion)
 }
 
-func (r *mutationResolver) UpdateTodoTitle(ctx context.Context, id string, title string) (*graphql.Todo, error) {
+func (r *mutationResolver) UpdateTodoTitle(ctx context.Context, id string, title string, expectedVersion *int) (*graphql.Todo, error) {
 	log.C(ctx).Info("updating todo title mutation resolver")
-	return r.todo.UpdateTodoTitle(ctx, id, title)
+	return r.todo.UpdateTodoTitle(ctx, id, title, expectedVersion)
 }
 
-func (r *mutationResolver) UpdateTodoDescription(ctx context.Context, id string, description string) (*graphql.Todo, error) {
+func (r *mutationResolver) UpdateTodoDescription(ctx context.Context, id string, description string, expectedVersion *int) (*graphql.Todo, error) {
 	log.C(ctx).Info("updating todo description mutation resolver")
-	return r.todo.UpdateTodoDescription(ctx, id, description)
+	return r.todo.UpdateTodoDescription(ctx, id, description, expectedVersion)
 }
 
-func (r *mutationResolver) UpdateTodoPriority(ctx context.Context, id string, priority graphql.Priority) (*graphql.Todo, error) {
+func (r *mutationResolver) UpdateTodoPriority(ctx context.Context, id string, priority graphql.Priority, expectedVersion *int) (*graphql.Todo, error) {
 	log.C(ctx).Info("updating todo priority mutation resolver")
-	return r.todo.UpdateTodoPriority(ctx, id, priority)
+	return r.todo.UpdateTodoPriority(ctx, id, priority, expectedVersion)
 }
 
-func (r *mutationResolver) UpdateTodoAssignTo(ctx context.Context, id string, userID string) (*graphql.Todo, error) {
+func (r *mutationResolver) UpdateTodoAssignTo(ctx context.Context, id string, userID string, expectedVersion *int) (*graphql.Todo, error) {
 	log.C(ctx).Info("updating todo assignment mutation resolver")
-	return r.todo.UpdateTodoAssignTo(ctx, id, userID)
+	return r.todo.UpdateTodoAssignTo(ctx, id, userID, expectedVersion)
 }
 
-func (r *mutationResolver) CompleteTodo(ctx context.Context, id string) (*graphql.Todo, error) {
+func (r *mutationResolver) CompleteTodo(ctx context.Context, id string, withSubtasks *bool) (*graphql.Todo, error) {
 	log.C(ctx).Info("updating todo completion mutation resolver")
-	return r.todo.CompleteTodo(ctx, id)
+	return r.todo.CompleteTodo(ctx, id, withSubtasks)
+}
+
+func (r *mutationResolver) ReopenTodo(ctx context.Context, id string) (*graphql.Todo, error) {
+	log.C(ctx).Info("reopening todo mutation resolver")
+	return r.todo.ReopenTodo(ctx, id)
+}
+
+func (r *mutationResolver) ReorderTodo(ctx context.Context, id string, beforeID *string, afterID *string) (*graphql.Todo, error) {
+	log.C(ctx).Info("reordering todo mutation resolver")
+	return r.todo.ReorderTodo(ctx, id, beforeID, afterID)
+}
+
+func (r *mutationResolver) MoveTodo(ctx context.Context, id string, targetListID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("moving todo mutation resolver")
+	return r.todo.MoveTodo(ctx, id, targetListID)
+}
+
+func (r *mutationResolver) BulkUpdateTodos(ctx context.Context, input graphql.BulkTodoInput) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("bulk updating todos mutation resolver")
+	return r.todo.BulkUpdateTodos(ctx, input)
+}
+
+func (r *mutationResolver) QuickAddTodo(ctx context.Context, listID string, text string) (*graphql.Todo, error) {
+	log.C(ctx).Info("quick adding todo mutation resolver")
+	return r.todo.QuickAddTodo(ctx, listID, text)
 }
 
 func (r *mutationResolver) AcceptList(ctx context.Context, listID string) (*bool, error) {
@@ -109,3 +144,133 @@ func (r *mutationResolver) RemoveCollaborator(ctx context.Context, listID string
 	log.C(ctx).Info("removing collaborator mutation resolver")
 	return r.list.RemoveCollaborator(ctx, listID, userID)
 }
+
+func (r *mutationResolver) RestoreList(ctx context.Context, id string) (*graphql.List, error) {
+	log.C(ctx).Info("restoring list mutation resolver")
+	return r.list.RestoreList(ctx, id)
+}
+
+func (r *mutationResolver) RestoreTodo(ctx context.Context, id string) (*graphql.Todo, error) {
+	log.C(ctx).Info("restoring todo mutation resolver")
+	return r.todo.RestoreTodo(ctx, id)
+}
+
+func (r *mutationResolver) StartTimer(ctx context.Context, todoID string, note *string) (*graphql.TimeEntry, error) {
+	log.C(ctx).Info("starting timer mutation resolver")
+	return r.timeEntry.StartTimer(ctx, todoID, note)
+}
+
+func (r *mutationResolver) StopTimer(ctx context.Context) (*graphql.TimeEntry, error) {
+	log.C(ctx).Info("stopping timer mutation resolver")
+	return r.timeEntry.StopTimer(ctx)
+}
+
+func (r *mutationResolver) AddTimeEntry(ctx context.Context, todoID string, input graphql.TimeEntryInput) (*graphql.TimeEntry, error) {
+	log.C(ctx).Info("adding time entry mutation resolver")
+	return r.timeEntry.AddTimeEntry(ctx, todoID, input)
+}
+
+func (r *mutationResolver) DeleteTimeEntry(ctx context.Context, todoID string, id string) (*bool, error) {
+	log.C(ctx).Info("deleting time entry mutation resolver")
+	return r.timeEntry.DeleteTimeEntry(ctx, todoID, id)
+}
+
+func (r *mutationResolver) CreateCustomField(ctx context.Context, listID string, input graphql.CustomFieldInput) (*graphql.CustomField, error) {
+	log.C(ctx).Info("creating custom field mutation resolver")
+	return r.customField.CreateCustomField(ctx, listID, input)
+}
+
+func (r *mutationResolver) UpdateCustomField(ctx context.Context, listID string, id string, input graphql.CustomFieldInput) (*graphql.CustomField, error) {
+	log.C(ctx).Info("updating custom field mutation resolver")
+	return r.customField.UpdateCustomField(ctx, listID, id, input)
+}
+
+func (r *mutationResolver) DeleteCustomField(ctx context.Context, listID string, id string) (*bool, error) {
+	log.C(ctx).Info("deleting custom field mutation resolver")
+	return r.customField.DeleteCustomField(ctx, listID, id)
+}
+
+func (r *mutationResolver) CreateTag(ctx context.Context, listID *string, input graphql.TagInput) (*graphql.Tag, error) {
+	log.C(ctx).Info("creating tag mutation resolver")
+	return r.tag.CreateTag(ctx, listID, input)
+}
+
+func (r *mutationResolver) UpdateTag(ctx context.Context, id string, input graphql.TagInput) (*graphql.Tag, error) {
+	log.C(ctx).Info("updating tag mutation resolver")
+	return r.tag.UpdateTag(ctx, id, input)
+}
+
+func (r *mutationResolver) DeleteTag(ctx context.Context, id string) (*bool, error) {
+	log.C(ctx).Info("deleting tag mutation resolver")
+	return r.tag.DeleteTag(ctx, id)
+}
+
+func (r *mutationResolver) MergeTags(ctx context.Context, sourceID string, targetID string) (*graphql.Tag, error) {
+	log.C(ctx).Info("merging tags mutation resolver")
+	return r.tag.MergeTags(ctx, sourceID, targetID)
+}
+
+func (r *mutationResolver) CreateStatus(ctx context.Context, listID string, name string, category graphql.StatusCategory) (*graphql.WorkflowStatus, error) {
+	log.C(ctx).Info("creating status mutation resolver")
+	return r.status.CreateStatus(ctx, listID, name, category)
+}
+
+func (r *mutationResolver) UpdateStatus(ctx context.Context, listID string, id string, name string) (*graphql.WorkflowStatus, error) {
+	log.C(ctx).Info("updating status mutation resolver")
+	return r.status.UpdateStatus(ctx, listID, id, name)
+}
+
+func (r *mutationResolver) DeleteStatus(ctx context.Context, listID string, id string, moveTo *string) (*bool, error) {
+	log.C(ctx).Info("deleting status mutation resolver")
+	return r.status.DeleteStatus(ctx, listID, id, moveTo)
+}
+
+func (r *mutationResolver) ReorderStatuses(ctx context.Context, listID string, ids []string) ([]*graphql.WorkflowStatus, error) {
+	log.C(ctx).Info("reordering statuses mutation resolver")
+	return r.status.ReorderStatuses(ctx, listID, ids)
+}
+
+func (r *mutationResolver) TransitionTodo(ctx context.Context, id string, statusID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("transitioning todo mutation resolver")
+	return r.todo.TransitionTodo(ctx, id, statusID)
+}
+
+func (r *mutationResolver) CreateSavedFilter(ctx context.Context, input graphql.SavedFilterInput) (*graphql.SavedFilter, error) {
+	log.C(ctx).Info("creating saved filter mutation resolver")
+	return r.savedFilter.CreateSavedFilter(ctx, input)
+}
+
+func (r *mutationResolver) UpdateSavedFilter(ctx context.Context, id string, input graphql.SavedFilterInput) (*graphql.SavedFilter, error) {
+	log.C(ctx).Info("updating saved filter mutation resolver")
+	return r.savedFilter.UpdateSavedFilter(ctx, id, input)
+}
+
+func (r *mutationResolver) DeleteSavedFilter(ctx context.Context, id string) (*bool, error) {
+	log.C(ctx).Info("deleting saved filter mutation resolver")
+	return r.savedFilter.DeleteSavedFilter(ctx, id)
+}
+
+func (r *mutationResolver) CreateTemplate(ctx context.Context, input graphql.ListTemplateInput) (*graphql.ListTemplate, error) {
+	log.C(ctx).Info("creating template mutation resolver")
+	return r.template.CreateTemplate(ctx, input)
+}
+
+func (r *mutationResolver) UpdateTemplate(ctx context.Context, id string, input graphql.ListTemplateInput) (*graphql.ListTemplate, error) {
+	log.C(ctx).Info("updating template mutation resolver")
+	return r.template.UpdateTemplate(ctx, id, input)
+}
+
+func (r *mutationResolver) DeleteTemplate(ctx context.Context, id string) (*bool, error) {
+	log.C(ctx).Info("deleting template mutation resolver")
+	return r.template.DeleteTemplate(ctx, id)
+}
+
+func (r *mutationResolver) SaveListAsTemplate(ctx context.Context, listID string, name *string, anchor *string) (*graphql.ListTemplate, error) {
+	log.C(ctx).Info("saving list as template mutation resolver")
+	return r.template.SaveListAsTemplate(ctx, listID, name, anchor)
+}
+
+func (r *mutationResolver) InstantiateTemplate(ctx context.Context, id string, name *string, anchor *string) (*graphql.List, error) {
+	log.C(ctx).Info("instantiating template mutation resolver")
+	return r.template.InstantiateTemplate(ctx, id, name, anchor)
+}
@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 )
 
@@ -51,9 +52,9 @@ func (r *queryResolver) List(ctx context.Context, id string) (*graphql.List, err
 	return r.list.List(ctx, id)
 }
 
-func (r *queryResolver) Todos(ctx context.Context) ([]*graphql.Todo, error) {
+func (r *queryResolver) Todos(ctx context.Context, filter *graphql.TodoFilterInput, orderBy *graphql.TodoOrderInput) ([]*graphql.Todo, error) {
 	log.C(ctx).Info("queryResolver todos")
-	return r.todo.Todos(ctx)
+	return r.todo.Todos(ctx, filter, orderBy)
 }
 
 func (r *queryResolver) Todo(ctx context.Context, id string) (*graphql.Todo, error) {
@@ -61,9 +62,9 @@ func (r *queryResolver) Todo(ctx context.Context, id string) (*graphql.Todo, err
 	return r.todo.Todo(ctx, id)
 }
 
-func (r *queryResolver) TodosByList(ctx context.Context, id string) ([]*graphql.Todo, error) {
+func (r *queryResolver) TodosByList(ctx context.Context, id string, filter *graphql.TodoFilterInput, orderBy *graphql.TodoOrderInput) ([]*graphql.Todo, error) {
 	log.C(ctx).Infof("queryResolver todos by list with id %s", id)
-	return r.todo.TodosByList(ctx, id)
+	return r.todo.TodosByList(ctx, id, filter, orderBy)
 }
 
 func (r *queryResolver) ListsPending(ctx context.Context) ([]*graphql.List, error) {
@@ -85,3 +86,83 @@ func (r *queryResolver) ListsAccepted(ctx context.Context) ([]*graphql.List, err
 	log.C(ctx).Info("queryResolve ListsAccepted")
 	return r.list.ListsAccepted(ctx)
 }
+
+func (r *queryResolver) Search(ctx context.Context, query string, limit *int) ([]*graphql.SearchResult, error) {
+	log.C(ctx).Info("queryResolver search")
+	return r.search.Search(ctx, query, limit)
+}
+
+func (r *queryResolver) CommentsMentioningMe(ctx context.Context) ([]*graphql.Comment, error) {
+	log.C(ctx).Info("queryResolver comments mentioning me")
+	return r.comment.CommentsMentioningMe(ctx)
+}
+
+func (r *queryResolver) Trash(ctx context.Context) ([]*graphql.TrashItem, error) {
+	log.C(ctx).Info("queryResolver trash")
+	return r.trash.Trash(ctx)
+}
+
+func (r *queryResolver) RunningTimer(ctx context.Context) (*graphql.TimeEntry, error) {
+	log.C(ctx).Info("queryResolver running timer")
+	return r.timeEntry.RunningTimer(ctx)
+}
+
+func (r *queryResolver) MyTimeTotal(ctx context.Context, from *string, to *string) (*graphql.TimeTotal, error) {
+	log.C(ctx).Info("queryResolver my time total")
+	return r.timeEntry.MyTimeTotal(ctx, from, to)
+}
+
+func (r *queryResolver) Tags(ctx context.Context) ([]*graphql.Tag, error) {
+	log.C(ctx).Info("queryResolver tags")
+	return r.tag.Tags(ctx)
+}
+
+func (r *queryResolver) TodosByTag(ctx context.Context, tagID string) ([]*graphql.Todo, error) {
+	log.C(ctx).Infof("queryResolver todos by tag %s", tagID)
+	return r.todo.Todos(ctx, &graphql.TodoFilterInput{TagID: &tagID}, nil)
+}
+
+func (r *queryResolver) Board(ctx context.Context, listID string) ([]*graphql.BoardColumn, error) {
+	log.C(ctx).Info("queryResolver board")
+	return r.status.Board(ctx, listID)
+}
+
+func (r *queryResolver) Today(ctx context.Context, timeZone *string) (*graphql.TodoView, error) {
+	log.C(ctx).Info("queryResolver today")
+	return r.todo.SmartView(ctx, constants.SmartViewToday, timeZone, nil)
+}
+
+func (r *queryResolver) Overdue(ctx context.Context, timeZone *string) (*graphql.TodoView, error) {
+	log.C(ctx).Info("queryResolver overdue")
+	return r.todo.SmartView(ctx, constants.SmartViewOverdue, timeZone, nil)
+}
+
+func (r *queryResolver) Upcoming(ctx context.Context, timeZone *string, days *int) (*graphql.TodoView, error) {
+	log.C(ctx).Info("queryResolver upcoming")
+	return r.todo.SmartView(ctx, constants.SmartViewUpcoming, timeZone, days)
+}
+
+func (r *queryResolver) Inbox(ctx context.Context) (*graphql.TodoView, error) {
+	log.C(ctx).Info("queryResolver inbox")
+	return r.todo.SmartView(ctx, constants.SmartViewInbox, nil, nil)
+}
+
+func (r *queryResolver) SavedFilters(ctx context.Context) ([]*graphql.SavedFilter, error) {
+	log.C(ctx).Info("queryResolver saved filters")
+	return r.savedFilter.SavedFilters(ctx)
+}
+
+func (r *queryResolver) TodosByFilter(ctx context.Context, id string, timeZone *string, orderBy *graphql.TodoOrderInput) ([]*graphql.Todo, error) {
+	log.C(ctx).Infof("queryResolver todos by filter %s", id)
+	return r.savedFilter.TodosByFilter(ctx, id, timeZone, orderBy)
+}
+
+func (r *queryResolver) Templates(ctx context.Context) ([]*graphql.ListTemplate, error) {
+	log.C(ctx).Info("queryResolver templates")
+	return r.template.Templates(ctx)
+}
+
+func (r *queryResolver) Template(ctx context.Context, id string) (*graphql.ListTemplate, error) {
+	log.C(ctx).Infof("queryResolver template %s", id)
+	return r.template.Template(ctx, id)
+}
@@ -6,8 +6,18 @@ import (
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/activity"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/comment"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/customfield"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/list"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/savedfilter"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/search"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/status"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/tag"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/template"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/timeentry"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/todo"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/trash"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/user"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 )
@@ -15,20 +25,50 @@ import (
 var _ graph.ResolverRoot = &RootResolver{}
 
 type RootResolver struct {
-	list *list.Resolver
-	user *user.Resolver
-	todo *todo.Resolver
+	list        *list.Resolver
+	user        *user.Resolver
+	todo        *todo.Resolver
+	search      *search.Resolver
+	comment     *comment.Resolver
+	activity    *activity.Resolver
+	trash       *trash.Resolver
+	timeEntry   *timeentry.Resolver
+	customField *customfield.Resolver
+	tag         *tag.Resolver
+	status      *status.Resolver
+	savedFilter *savedfilter.Resolver
+	template    *template.Resolver
 }
 
 func NewRootResolver(todoService client.Client) *RootResolver {
 	listConverter := converters.NewConverterListGraphQL()
 	todoConverter := converters.NewConverterTodoGraphQL()
 	userConverter := converters.NewConverterUserGraphQL()
+	searchConverter := converters.NewConverterSearchGraphQL()
+	commentConverter := converters.NewConverterCommentGraphQL()
+	activityConverter := converters.NewConverterActivityGraphQL()
+	trashConverter := converters.NewConverterTrashGraphQL()
+	timeEntryConverter := converters.NewConverterTimeEntryGraphQL()
+	customFieldConverter := converters.NewConverterCustomFieldGraphQL()
+	tagConverter := converters.NewConverterTagGraphQL()
+	statusConverter := converters.NewConverterStatusGraphQL()
+	savedFilterConverter := converters.NewConverterSavedFilterGraphQL()
+	templateConverter := converters.NewConverterTemplateGraphQL()
 
 	return &RootResolver{
-		list: list.NewResolver(todoService, listConverter, userConverter),
-		user: user.NewResolver(todoService, userConverter, listConverter),
-		todo: todo.NewResolver(todoService, todoConverter, listConverter, userConverter),
+		list:        list.NewResolver(todoService, listConverter, userConverter),
+		user:        user.NewResolver(todoService, userConverter, listConverter),
+		todo:        todo.NewResolver(todoService, todoConverter, listConverter, userConverter),
+		search:      search.NewResolver(todoService, searchConverter),
+		comment:     comment.NewResolver(todoService, commentConverter),
+		activity:    activity.NewResolver(todoService, activityConverter),
+		trash:       trash.NewResolver(todoService, trashConverter),
+		timeEntry:   timeentry.NewResolver(todoService, timeEntryConverter),
+		customField: customfield.NewResolver(todoService, customFieldConverter),
+		tag:         tag.NewResolver(todoService, tagConverter),
+		status:      status.NewResolver(todoService, statusConverter, todoConverter),
+		savedFilter: savedfilter.NewResolver(todoService, savedFilterConverter, todoConverter),
+		template:    template.NewResolver(todoService, templateConverter, listConverter),
 	}
 }
 
@@ -48,6 +88,18 @@ func (r *RootResolver) Todo() graph.TodoResolver {
 	return &todoResolver{r}
 }
 
+func (r *RootResolver) Comment() graph.CommentResolver {
+	return &commentResolver{r}
+}
+
+func (r *RootResolver) Activity() graph.ActivityResolver {
+	return &activityResolver{r}
+}
+
+func (r *RootResolver) TimeEntry() graph.TimeEntryResolver {
+	return &timeEntryResolver{r}
+}
+
 type todoResolver struct {
 	*RootResolver
 }
@@ -62,6 +114,99 @@ func (r *todoResolver) List(ctx context.Context, obj *graphql.Todo) (*graphql.Li
 	return r.todo.List(ctx, obj)
 }
 
+func (r *todoResolver) Parent(ctx context.Context, obj *graphql.Todo) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver.Parent")
+	return r.todo.Parent(ctx, obj)
+}
+
+func (r *todoResolver) Subtasks(ctx context.Context, obj *graphql.Todo) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver.Subtasks")
+	return r.todo.Subtasks(ctx, obj)
+}
+
+func (r *todoResolver) BlockedBy(ctx context.Context, obj *graphql.Todo) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver.BlockedBy")
+	return r.todo.BlockedBy(ctx, obj)
+}
+
+func (r *todoResolver) Blocking(ctx context.Context, obj *graphql.Todo) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver.Blocking")
+	return r.todo.Blocking(ctx, obj)
+}
+
+func (r *todoResolver) Comments(ctx context.Context, obj *graphql.Todo) ([]*graphql.Comment, error) {
+	log.C(ctx).Info("todoResolver.Comments")
+	return r.comment.Comments(ctx, obj)
+}
+
+func (r *todoResolver) Activity(ctx context.Context, obj *graphql.Todo, limit *int) ([]*graphql.Activity, error) {
+	log.C(ctx).Info("todoResolver.Activity")
+	return r.activity.TodoActivity(ctx, obj, limit)
+}
+
+func (r *todoResolver) TimeEntries(ctx context.Context, obj *graphql.Todo) ([]*graphql.TimeEntry, error) {
+	log.C(ctx).Info("todoResolver.TimeEntries")
+	return r.timeEntry.TimeEntries(ctx, obj)
+}
+
+func (r *todoResolver) TimeTotal(ctx context.Context, obj *graphql.Todo, from *string, to *string) (*graphql.TimeTotal, error) {
+	log.C(ctx).Info("todoResolver.TimeTotal")
+	return r.timeEntry.TodoTimeTotal(ctx, obj, from, to)
+}
+
+func (r *todoResolver) CustomFields(ctx context.Context, obj *graphql.Todo) ([]*graphql.CustomFieldValue, error) {
+	log.C(ctx).Info("todoResolver.CustomFields")
+	return r.customField.TodoCustomFields(ctx, obj)
+}
+
+func (r *todoResolver) Status(ctx context.Context, obj *graphql.Todo) (*graphql.WorkflowStatus, error) {
+	log.C(ctx).Info("todoResolver.Status")
+	return r.status.TodoStatus(ctx, obj)
+}
+
+type activityResolver struct {
+	*RootResolver
+}
+
+func (r *activityResolver) Actor(ctx context.Context, obj *graphql.Activity) (*graphql.User, error) {
+	log.C(ctx).Info("activityResolver.Actor")
+	if obj.ActorID == nil {
+		return nil, nil
+	}
+	return r.user.User(ctx, *obj.ActorID)
+}
+
+type commentResolver struct {
+	*RootResolver
+}
+
+func (r *commentResolver) Author(ctx context.Context, obj *graphql.Comment) (*graphql.User, error) {
+	log.C(ctx).Info("commentResolver.Author")
+	return r.user.User(ctx, obj.AuthorID)
+}
+
+func (r *commentResolver) Mentions(ctx context.Context, obj *graphql.Comment) ([]*graphql.User, error) {
+	log.C(ctx).Info("commentResolver.Mentions")
+	mentions := make([]*graphql.User, 0, len(obj.MentionIds))
+	for _, id := range obj.MentionIds {
+		user, err := r.user.User(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, user)
+	}
+	return mentions, nil
+}
+
+type timeEntryResolver struct {
+	*RootResolver
+}
+
+func (r *timeEntryResolver) User(ctx context.Context, obj *graphql.TimeEntry) (*graphql.User, error) {
+	log.C(ctx).Info("timeEntryResolver.User")
+	return r.user.User(ctx, obj.UserID)
+}
+
 type listResolver struct {
 	*RootResolver
 }
@@ -71,12 +216,32 @@ func (l *listResolver) Owner(ctx context.Context, obj *graphql.List) (*graphql.U
 	return l.list.Owner(ctx, obj)
 }
 
-func (l *listResolver) Todos(ctx context.Context, obj *graphql.List) ([]*graphql.Todo, error) {
+func (l *listResolver) Todos(ctx context.Context, obj *graphql.List, filter *graphql.TodoFilterInput, orderBy *graphql.TodoOrderInput) ([]*graphql.Todo, error) {
 	log.C(ctx).Info("listResolver.Todos")
-	return l.list.Todos(ctx, obj)
+	return l.list.Todos(ctx, obj, filter, orderBy)
 }
 
 func (l *listResolver) Collaborators(ctx context.Context, obj *graphql.List) ([]*graphql.ListAccess, error) {
 	log.C(ctx).Info("listResolver.Collaborators")
 	return l.list.Collaborators(ctx, obj)
 }
+
+func (l *listResolver) Activity(ctx context.Context, obj *graphql.List, limit *int) ([]*graphql.Activity, error) {
+	log.C(ctx).Info("listResolver.Activity")
+	return l.activity.ListActivity(ctx, obj, limit)
+}
+
+func (l *listResolver) TimeTotal(ctx context.Context, obj *graphql.List, from *string, to *string) (*graphql.TimeTotal, error) {
+	log.C(ctx).Info("listResolver.TimeTotal")
+	return l.timeEntry.ListTimeTotal(ctx, obj, from, to)
+}
+
+func (l *listResolver) CustomFields(ctx context.Context, obj *graphql.List) ([]*graphql.CustomField, error) {
+	log.C(ctx).Info("listResolver.CustomFields")
+	return l.customField.ListCustomFields(ctx, obj)
+}
+
+func (l *listResolver) Statuses(ctx context.Context, obj *graphql.List) ([]*graphql.WorkflowStatus, error) {
+	log.C(ctx).Info("listResolver.Statuses")
+	return l.status.ListStatuses(ctx, obj)
+}
@@ -0,0 +1,138 @@
+package savedfilter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+	"net/url"
+)
+
+type Resolver struct {
+	httpClient      client.Client
+	savedFilterConv converters.SavedFilterConverter
+	todoConv        converters.TodoConverter
+}
+
+func NewResolver(client client.Client, converter converters.SavedFilterConverter, todoConverter converters.TodoConverter) *Resolver {
+	return &Resolver{
+		httpClient:      client,
+		savedFilterConv: converter,
+		todoConv:        todoConverter,
+	}
+}
+
+func (r *Resolver) SavedFilters(ctx context.Context) ([]*graphql.SavedFilter, error) {
+	log.C(ctx).Info("savedFilterResolver called saved filters")
+	response, err := r.httpClient.Do(ctx, http.MethodGet, "/filters", nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting saved filters: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var filters []models.SavedFilter
+	if err = json.Unmarshal(response, &filters); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.savedFilterConv.ConvertMultipleSavedFiltersToGraphQL(filters)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting saved filters to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting saved filters to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) TodosByFilter(ctx context.Context, id string, timeZone *string, orderBy *graphql.TodoOrderInput) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("savedFilterResolver called todos by filter")
+	query, err := converters.ConvertTodoFilterToQuery(nil, orderBy)
+	if err != nil {
+		log.C(ctx).Errorf("error converting todo order: %v", err)
+		return nil, fmt.Errorf("error converting order: %w", err)
+	}
+	if timeZone != nil {
+		separator := "?"
+		if query != "" {
+			separator = "&"
+		}
+		query += separator + "tz=" + url.QueryEscape(*timeZone)
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodGet, fmt.Sprintf("/filters/%s/todos%s", id, query), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting todos of saved filter: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var todos []*models.Todo
+	if err = json.Unmarshal(response, &todos); err != nil {
+		log.C(ctx).Errorf("error unmarshalling todos: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+	result, err := r.todoConv.ConvertMultipleTodoToGraphQL(todos)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting multiple todos to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting multiple todos to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) CreateSavedFilter(ctx context.Context, input graphql.SavedFilterInput) (*graphql.SavedFilter, error) {
+	log.C(ctx).Info("savedFilterResolver called create saved filter")
+	return r.saveFilter(ctx, http.MethodPost, "/filters", input)
+}
+
+func (r *Resolver) UpdateSavedFilter(ctx context.Context, id string, input graphql.SavedFilterInput) (*graphql.SavedFilter, error) {
+	log.C(ctx).Info("savedFilterResolver called update saved filter")
+	return r.saveFilter(ctx, http.MethodPut, fmt.Sprintf("/filters/%s", id), input)
+}
+
+func (r *Resolver) DeleteSavedFilter(ctx context.Context, id string) (*bool, error) {
+	log.C(ctx).Info("savedFilterResolver called delete saved filter")
+	_, err := r.httpClient.Do(ctx, http.MethodDelete, fmt.Sprintf("/filters/%s", id), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error deleting saved filter: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	success := true
+	return &success, nil
+}
+
+func (r *Resolver) saveFilter(ctx context.Context, method string, path string, input graphql.SavedFilterInput) (*graphql.SavedFilter, error) {
+	filter, err := r.savedFilterConv.ConvertSavedFilterInput(input)
+	if err != nil {
+		log.C(ctx).Errorf("error converting saved filter input: %v", err)
+		return nil, fmt.Errorf("error converting saved filter input: %w", err)
+	}
+	requestBody, err := json.Marshal(filter)
+	if err != nil {
+		log.C(ctx).Errorf("error marshalling request body: %v", err)
+		return nil, fmt.Errorf("error marshalling request body: %w", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, method, path, requestBody)
+	if err != nil {
+		log.C(ctx).Errorf("error saving saved filter: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var saved models.SavedFilter
+	if err = json.Unmarshal(response, &saved); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.savedFilterConv.ConvertSavedFilterToGraphQL(saved)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting saved filter to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting saved filter to graphql: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,199 @@
+package savedfilter_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/savedfilter"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestSavedFilter_SavedFiltersResolver(t *testing.T) {
+	inputFilters := []models.SavedFilter{{ID: "1", UserID: "user", Name: "Urgent", Expression: "priority = high"}}
+	expectedFilters := []*graphql.SavedFilter{{ID: "1", Name: "Urgent", Expression: "priority = high"}}
+
+	tests := []struct {
+		name            string
+		mockResp        []byte
+		mockErr         error
+		expectError     bool
+		expectFilters   []*graphql.SavedFilter
+		filterConverter func() *automock.SavedFilterConverter
+	}{
+		{
+			name:          "saved filters of the user",
+			mockResp:      []byte(`[{"id": "1", "user_id": "user", "name": "Urgent", "expression": "priority = high"}]`),
+			expectFilters: expectedFilters,
+			filterConverter: func() *automock.SavedFilterConverter {
+				filterConverter := &automock.SavedFilterConverter{}
+				filterConverter.EXPECT().ConvertMultipleSavedFiltersToGraphQL(inputFilters).Return(expectedFilters, nil)
+				return filterConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("failed to get saved filters"),
+			expectError: true,
+			filterConverter: func() *automock.SavedFilterConverter {
+				return &automock.SavedFilterConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", "/filters", mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := savedfilter.NewResolver(mockClient, tt.filterConverter(), &automock.TodoConverter{})
+
+			result, err := r.SavedFilters(context.Background())
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectFilters, result)
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", "/filters", mock.Anything)
+		})
+	}
+}
+
+func TestSavedFilter_TodosByFilterResolver(t *testing.T) {
+	timeZone := "Europe/Sofia"
+	descending := true
+	inputTodos := []*models.Todo{{ID: "todo", Title: "Ship it"}}
+	expectedTodos := []*graphql.Todo{{ID: "todo", Title: "Ship it"}}
+
+	tests := []struct {
+		name          string
+		timeZone      *string
+		orderBy       *graphql.TodoOrderInput
+		url           string
+		mockResp      []byte
+		mockErr       error
+		expectError   bool
+		expectTodos   []*graphql.Todo
+		todoConverter func() *automock.TodoConverter
+	}{
+		{
+			name:        "todos in a time zone",
+			timeZone:    &timeZone,
+			url:         "/filters/1/todos?tz=Europe%2FSofia",
+			mockResp:    []byte(`[{"id": "todo", "title": "Ship it"}]`),
+			expectTodos: expectedTodos,
+			todoConverter: func() *automock.TodoConverter {
+				todoConverter := &automock.TodoConverter{}
+				todoConverter.EXPECT().ConvertMultipleTodoToGraphQL(inputTodos).Return(expectedTodos, nil)
+				return todoConverter
+			},
+		},
+		{
+			name:        "ordered todos in a time zone",
+			timeZone:    &timeZone,
+			orderBy:     &graphql.TodoOrderInput{Field: graphql.TodoSortFieldDueDate, Descending: &descending},
+			url:         "/filters/1/todos?order=desc&sort=due_date&tz=Europe%2FSofia",
+			mockResp:    []byte(`[{"id": "todo", "title": "Ship it"}]`),
+			expectTodos: expectedTodos,
+			todoConverter: func() *automock.TodoConverter {
+				todoConverter := &automock.TodoConverter{}
+				todoConverter.EXPECT().ConvertMultipleTodoToGraphQL(inputTodos).Return(expectedTodos, nil)
+				return todoConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			url:         "/filters/1/todos",
+			mockErr:     errors.New("saved filter not found"),
+			expectError: true,
+			todoConverter: func() *automock.TodoConverter {
+				return &automock.TodoConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", tt.url, mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := savedfilter.NewResolver(mockClient, &automock.SavedFilterConverter{}, tt.todoConverter())
+
+			result, err := r.TodosByFilter(context.Background(), "1", tt.timeZone, tt.orderBy)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectTodos, result)
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", tt.url, mock.Anything)
+		})
+	}
+}
+
+func TestSavedFilter_CreateSavedFilterResolver(t *testing.T) {
+	input := graphql.SavedFilterInput{Name: "Urgent", Expression: "priority = high"}
+	filter := models.SavedFilter{Name: "Urgent", Expression: "priority = high"}
+	created := models.SavedFilter{ID: "1", UserID: "user", Name: "Urgent", Expression: "priority = high"}
+	expectedFilter := &graphql.SavedFilter{ID: "1", Name: "Urgent", Expression: "priority = high"}
+
+	tests := []struct {
+		name            string
+		mockResp        []byte
+		mockErr         error
+		expectError     bool
+		expectFilter    *graphql.SavedFilter
+		filterConverter func() *automock.SavedFilterConverter
+	}{
+		{
+			name:         "created saved filter",
+			mockResp:     []byte(`{"id": "1", "user_id": "user", "name": "Urgent", "expression": "priority = high"}`),
+			expectFilter: expectedFilter,
+			filterConverter: func() *automock.SavedFilterConverter {
+				filterConverter := &automock.SavedFilterConverter{}
+				filterConverter.EXPECT().ConvertSavedFilterInput(input).Return(filter, nil)
+				filterConverter.EXPECT().ConvertSavedFilterToGraphQL(created).Return(expectedFilter, nil)
+				return filterConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("invalid saved filter"),
+			expectError: true,
+			filterConverter: func() *automock.SavedFilterConverter {
+				filterConverter := &automock.SavedFilterConverter{}
+				filterConverter.EXPECT().ConvertSavedFilterInput(input).Return(filter, nil)
+				return filterConverter
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "POST", "/filters", mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := savedfilter.NewResolver(mockClient, tt.filterConverter(), &automock.TodoConverter{})
+
+			result, err := r.CreateSavedFilter(context.Background(), input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectFilter, result)
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "POST", "/filters", mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type Resolver struct {
+	httpClient client.Client
+	searchConv converters.SearchConverter
+}
+
+func NewResolver(client client.Client, converter converters.SearchConverter) *Resolver {
+	return &Resolver{
+		httpClient: client,
+		searchConv: converter,
+	}
+}
+
+func (r *Resolver) Search(ctx context.Context, query string, limit *int) ([]*graphql.SearchResult, error) {
+	log.C(ctx).Info("searchResolver called search")
+	params := url.Values{}
+	params.Set("q", query)
+	if limit != nil {
+		params.Set("limit", strconv.Itoa(*limit))
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodGet, "/search?"+params.Encode(), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error searching: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var results []models.SearchResult
+	if err = json.Unmarshal(response, &results); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	graphResults, err := r.searchConv.ConvertMultipleSearchResultsToGraphQL(results)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting search results to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting search results to graphql: %w", err)
+	}
+	return graphResults, nil
+}
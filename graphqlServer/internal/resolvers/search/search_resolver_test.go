@@ -0,0 +1,90 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/search"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/mock"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch_SearchResolver(t *testing.T) {
+	limit := 5
+	inputResults := []models.SearchResult{
+		{Type: constants.SearchResultTodo, ID: "1", ListID: "2", Title: "Buy milk", Snippet: "Buy <b>milk</b>", Rank: 0.5},
+	}
+	expectedResults := []*graphql.SearchResult{
+		{Type: graphql.SearchResultTypeTodo, ID: "1", ListID: "2", Title: "Buy milk", Snippet: "Buy <b>milk</b>", Rank: 0.5},
+	}
+
+	tests := []struct {
+		name            string
+		limit           *int
+		mockURL         string
+		mockResp        []byte
+		mockErr         error
+		expectError     bool
+		expectResults   []*graphql.SearchResult
+		searchConverter func() *automock.SearchConverter
+	}{
+		{
+			name:          "successful search",
+			limit:         &limit,
+			mockURL:       "/search?limit=5&q=milk+run",
+			mockResp:      []byte(`[{"type": "todo", "id": "1", "list_id": "2", "title": "Buy milk", "snippet": "Buy <b>milk</b>", "rank": 0.5}]`),
+			expectResults: expectedResults,
+			searchConverter: func() *automock.SearchConverter {
+				searchConverter := &automock.SearchConverter{}
+				searchConverter.EXPECT().ConvertMultipleSearchResultsToGraphQL(inputResults).Return(expectedResults, nil)
+				return searchConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockURL:     "/search?q=milk+run",
+			mockResp:    nil,
+			mockErr:     errors.New("failed to search"),
+			expectError: true,
+			searchConverter: func() *automock.SearchConverter {
+				return &automock.SearchConverter{}
+			},
+		},
+		{
+			name:        "failed to unmarshal response",
+			mockURL:     "/search?q=milk+run",
+			mockResp:    []byte(`invalid JSON`),
+			expectError: true,
+			searchConverter: func() *automock.SearchConverter {
+				return &automock.SearchConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", tt.mockURL, mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := search.NewResolver(mockClient, tt.searchConverter())
+
+			result, err := r.Search(context.Background(), "milk run", tt.limit)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectResults, result)
+			}
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", tt.mockURL, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+type Resolver struct {
+	httpClient client.Client
+	statusConv converters.StatusConverter
+	todoConv   converters.TodoConverter
+}
+
+func NewResolver(client client.Client, statusConverter converters.StatusConverter, todoConverter converters.TodoConverter) *Resolver {
+	return &Resolver{
+		httpClient: client,
+		statusConv: statusConverter,
+		todoConv:   todoConverter,
+	}
+}
+
+func (r *Resolver) ListStatuses(ctx context.Context, obj *graphql.List) ([]*graphql.WorkflowStatus, error) {
+	log.C(ctx).Info("statusResolver called for list statuses")
+	if obj == nil {
+		return nil, nil
+	}
+	statuses, err := r.getStatuses(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.statusConv.ConvertMultipleStatusesToGraphQL(statuses)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting statuses to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting statuses to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) TodoStatus(ctx context.Context, obj *graphql.Todo) (*graphql.WorkflowStatus, error) {
+	log.C(ctx).Info("statusResolver called for todo status")
+	if obj == nil || obj.StatusID == nil {
+		return nil, nil
+	}
+	response, err := r.httpClient.Do(ctx, http.MethodGet, fmt.Sprintf("/todos/%s", obj.ID), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var todo models.Todo
+	if err = json.Unmarshal(response, &todo); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	statuses, err := r.getStatuses(ctx, todo.ListID)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range statuses {
+		if status.ID == *obj.StatusID {
+			return r.convertStatus(ctx, status)
+		}
+	}
+	return nil, nil
+}
+
+// Board returns a column for every status of the list, in order, holding the
+// todos in that status.
+func (r *Resolver) Board(ctx context.Context, listID string) ([]*graphql.BoardColumn, error) {
+	log.C(ctx).Info("statusResolver called for board")
+	statuses, err := r.getStatuses(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodGet, fmt.Sprintf("/lists/%s/todos", listID), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting todos for listID %s: %v", listID, err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	var todos []*models.Todo
+	if err = json.Unmarshal(response, &todos); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	byStatus := make(map[string][]*models.Todo, len(statuses))
+	for _, todo := range todos {
+		if todo.StatusID != nil {
+			byStatus[*todo.StatusID] = append(byStatus[*todo.StatusID], todo)
+		}
+	}
+
+	columns := make([]*graphql.BoardColumn, 0, len(statuses))
+	for _, status := range statuses {
+		graphStatus, err := r.convertStatus(ctx, status)
+		if err != nil {
+			return nil, err
+		}
+		graphTodos, err := r.todoConv.ConvertMultipleTodoToGraphQL(byStatus[status.ID])
+		if err != nil {
+			log.C(ctx).Errorf("failed converting multiple todos to graphql: %v", err)
+			return nil, fmt.Errorf("error while converting multiple todos to graphql: %w", err)
+		}
+		if graphTodos == nil {
+			graphTodos = []*graphql.Todo{}
+		}
+		columns = append(columns, &graphql.BoardColumn{Status: graphStatus, Todos: graphTodos})
+	}
+	return columns, nil
+}
+
+func (r *Resolver) CreateStatus(ctx context.Context, listID string, name string, category graphql.StatusCategory) (*graphql.WorkflowStatus, error) {
+	log.C(ctx).Info("statusResolver called for create status")
+	statusCategory, err := converters.ConvertStatusCategoryFromGraphQL(category)
+	if err != nil {
+		log.C(ctx).Errorf("error converting status category: %v", err)
+		return nil, fmt.Errorf("error converting status category: %w", err)
+	}
+	status := models.WorkflowStatus{Name: name, Category: statusCategory}
+	return r.saveStatus(ctx, http.MethodPost, fmt.Sprintf("/lists/%s/statuses", listID), status)
+}
+
+func (r *Resolver) UpdateStatus(ctx context.Context, listID string, id string, name string) (*graphql.WorkflowStatus, error) {
+	log.C(ctx).Info("statusResolver called for update status")
+	status := models.WorkflowStatus{Name: name}
+	return r.saveStatus(ctx, http.MethodPut, fmt.Sprintf("/lists/%s/statuses/%s", listID, id), status)
+}
+
+func (r *Resolver) DeleteStatus(ctx context.Context, listID string, id string, moveTo *string) (*bool, error) {
+	log.C(ctx).Info("statusResolver called for delete status")
+	url := fmt.Sprintf("/lists/%s/statuses/%s", listID, id)
+	if moveTo != nil {
+		url += "?move_to=" + *moveTo
+	}
+	_, err := r.httpClient.Do(ctx, http.MethodDelete, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("error deleting status: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	success := true
+	return &success, nil
+}
+
+func (r *Resolver) ReorderStatuses(ctx context.Context, listID string, ids []string) ([]*graphql.WorkflowStatus, error) {
+	log.C(ctx).Info("statusResolver called for reorder statuses")
+	requestBody, err := json.Marshal(struct {
+		IDs []string `json:"ids"`
+	}{IDs: ids})
+	if err != nil {
+		log.C(ctx).Errorf("error marshalling request body: %v", err)
+		return nil, fmt.Errorf("error marshalling request body: %w", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodPatch, fmt.Sprintf("/lists/%s/statuses/order", listID), requestBody)
+	if err != nil {
+		log.C(ctx).Errorf("error reordering statuses: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var statuses []models.WorkflowStatus
+	if err = json.Unmarshal(response, &statuses); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.statusConv.ConvertMultipleStatusesToGraphQL(statuses)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting statuses to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting statuses to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) getStatuses(ctx context.Context, listID string) ([]models.WorkflowStatus, error) {
+	response, err := r.httpClient.Do(ctx, http.MethodGet, fmt.Sprintf("/lists/%s/statuses", listID), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting statuses: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var statuses []models.WorkflowStatus
+	if err = json.Unmarshal(response, &statuses); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+	return statuses, nil
+}
+
+func (r *Resolver) saveStatus(ctx context.Context, method string, url string, status models.WorkflowStatus) (*graphql.WorkflowStatus, error) {
+	requestBody, err := json.Marshal(status)
+	if err != nil {
+		log.C(ctx).Errorf("error marshalling request body: %v", err)
+		return nil, fmt.Errorf("error marshalling request body: %w", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, method, url, requestBody)
+	if err != nil {
+		log.C(ctx).Errorf("error saving status: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var saved models.WorkflowStatus
+	if err = json.Unmarshal(response, &saved); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+	return r.convertStatus(ctx, saved)
+}
+
+func (r *Resolver) convertStatus(ctx context.Context, status models.WorkflowStatus) (*graphql.WorkflowStatus, error) {
+	result, err := r.statusConv.ConvertStatusToGraphQL(status)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting status to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting status to graphql: %w", err)
+	}
+	return result, nil
+}
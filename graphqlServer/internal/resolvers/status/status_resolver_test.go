@@ -0,0 +1,143 @@
+package status_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/status"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestStatus_BoardResolver(t *testing.T) {
+	listID := "list"
+	openID := "open"
+	doneID := "done"
+	statuses := []models.WorkflowStatus{
+		{ID: openID, ListID: listID, Name: "To do", Category: constants.StatusCategoryOpen, Position: 0},
+		{ID: doneID, ListID: listID, Name: "Done", Category: constants.StatusCategoryDone, Position: 1},
+	}
+	openStatus := &graphql.WorkflowStatus{ID: openID, ListID: listID, Name: "To do", Category: graphql.StatusCategoryOpen}
+	doneStatus := &graphql.WorkflowStatus{ID: doneID, ListID: listID, Name: "Done", Category: graphql.StatusCategoryDone, Position: 1}
+	openTodos := []*models.Todo{{ID: "1", ListID: listID, Title: "Write", StatusID: &openID}, {ID: "2", ListID: listID, Title: "Test", StatusID: &openID}}
+	graphOpenTodos := []*graphql.Todo{{ID: "1", Title: "Write", StatusID: &openID}, {ID: "2", Title: "Test", StatusID: &openID}}
+
+	tests := []struct {
+		name            string
+		statusesResp    []byte
+		statusesErr     error
+		todosResp       []byte
+		expectError     bool
+		expectColumns   []*graphql.BoardColumn
+		statusConverter func() *automock.StatusConverter
+		todoConverter   func() *automock.TodoConverter
+	}{
+		{
+			name:         "todos grouped by status with empty columns kept",
+			statusesResp: []byte(`[{"id": "open", "list_id": "list", "name": "To do", "category": "open", "position": 0}, {"id": "done", "list_id": "list", "name": "Done", "category": "done", "position": 1}]`),
+			todosResp:    []byte(`[{"id": "1", "list_id": "list", "title": "Write", "status_id": "open"}, {"id": "2", "list_id": "list", "title": "Test", "status_id": "open"}]`),
+			expectColumns: []*graphql.BoardColumn{
+				{Status: openStatus, Todos: graphOpenTodos},
+				{Status: doneStatus, Todos: []*graphql.Todo{}},
+			},
+			statusConverter: func() *automock.StatusConverter {
+				statusConverter := &automock.StatusConverter{}
+				statusConverter.EXPECT().ConvertStatusToGraphQL(statuses[0]).Return(openStatus, nil).Once()
+				statusConverter.EXPECT().ConvertStatusToGraphQL(statuses[1]).Return(doneStatus, nil).Once()
+				return statusConverter
+			},
+			todoConverter: func() *automock.TodoConverter {
+				todoConverter := &automock.TodoConverter{}
+				todoConverter.EXPECT().ConvertMultipleTodoToGraphQL(openTodos).Return(graphOpenTodos, nil).Once()
+				todoConverter.EXPECT().ConvertMultipleTodoToGraphQL([]*models.Todo(nil)).Return([]*graphql.Todo{}, nil).Once()
+				return todoConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			statusesErr: errors.New("failed to get statuses"),
+			expectError: true,
+			statusConverter: func() *automock.StatusConverter {
+				return &automock.StatusConverter{}
+			},
+			todoConverter: func() *automock.TodoConverter {
+				return &automock.TodoConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+			statusConverter := tt.statusConverter()
+			todoConverter := tt.todoConverter()
+
+			mockClient.On("Do", mock.Anything, "GET", "/lists/list/statuses", mock.Anything).Return(tt.statusesResp, tt.statusesErr)
+			mockClient.On("Do", mock.Anything, "GET", "/lists/list/todos", mock.Anything).Return(tt.todosResp, nil)
+			r := status.NewResolver(mockClient, statusConverter, todoConverter)
+
+			result, err := r.Board(context.Background(), listID)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectColumns, result)
+			mock.AssertExpectationsForObjects(t, statusConverter, todoConverter)
+		})
+	}
+}
+
+func TestStatus_DeleteStatusResolver(t *testing.T) {
+	moveTo := "other"
+
+	tests := []struct {
+		name        string
+		moveTo      *string
+		url         string
+		mockErr     error
+		expectError bool
+	}{
+		{
+			name: "delete moving todos to the first status of the category",
+			url:  "/lists/list/statuses/1",
+		},
+		{
+			name:   "delete moving todos to the given status",
+			moveTo: &moveTo,
+			url:    "/lists/list/statuses/1?move_to=other",
+		},
+		{
+			name:        "failed http request",
+			url:         "/lists/list/statuses/1",
+			mockErr:     errors.New("last status"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "DELETE", tt.url, mock.Anything).Return([]byte(nil), tt.mockErr)
+			r := status.NewResolver(mockClient, &automock.StatusConverter{}, &automock.TodoConverter{})
+
+			result, err := r.DeleteStatus(context.Background(), "list", "1", tt.moveTo)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, *result)
+			}
+			mockClient.AssertCalled(t, "Do", mock.Anything, "DELETE", tt.url, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package tag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+type Resolver struct {
+	httpClient client.Client
+	tagConv    converters.TagConverter
+}
+
+func NewResolver(client client.Client, converter converters.TagConverter) *Resolver {
+	return &Resolver{
+		httpClient: client,
+		tagConv:    converter,
+	}
+}
+
+func (r *Resolver) Tags(ctx context.Context) ([]*graphql.Tag, error) {
+	log.C(ctx).Info("tagResolver called tags")
+	response, err := r.httpClient.Do(ctx, http.MethodGet, "/tags", nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting tags: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var tags []models.Tag
+	if err = json.Unmarshal(response, &tags); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.tagConv.ConvertMultipleTagsToGraphQL(tags)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting tags to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting tags to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) CreateTag(ctx context.Context, listID *string, input graphql.TagInput) (*graphql.Tag, error) {
+	log.C(ctx).Info("tagResolver called create tag")
+	url := "/tags"
+	if listID != nil {
+		url = fmt.Sprintf("/lists/%s/tags", *listID)
+	}
+	return r.saveTag(ctx, http.MethodPost, url, input)
+}
+
+func (r *Resolver) UpdateTag(ctx context.Context, id string, input graphql.TagInput) (*graphql.Tag, error) {
+	log.C(ctx).Info("tagResolver called update tag")
+	return r.saveTag(ctx, http.MethodPut, fmt.Sprintf("/tags/%s", id), input)
+}
+
+func (r *Resolver) DeleteTag(ctx context.Context, id string) (*bool, error) {
+	log.C(ctx).Info("tagResolver called delete tag")
+	_, err := r.httpClient.Do(ctx, http.MethodDelete, fmt.Sprintf("/tags/%s", id), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error deleting tag: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	success := true
+	return &success, nil
+}
+
+func (r *Resolver) MergeTags(ctx context.Context, sourceID string, targetID string) (*graphql.Tag, error) {
+	log.C(ctx).Info("tagResolver called merge tags")
+	response, err := r.httpClient.Do(ctx, http.MethodPost, fmt.Sprintf("/tags/%s/merge/%s", sourceID, targetID), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error merging tags: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTag(ctx, response)
+}
+
+func (r *Resolver) saveTag(ctx context.Context, method string, url string, input graphql.TagInput) (*graphql.Tag, error) {
+	tag, err := r.tagConv.ConvertTagInput(input)
+	if err != nil {
+		log.C(ctx).Errorf("error converting tag input: %v", err)
+		return nil, fmt.Errorf("error converting tag input: %w", err)
+	}
+	requestBody, err := json.Marshal(tag)
+	if err != nil {
+		log.C(ctx).Errorf("error marshalling request body: %v", err)
+		return nil, fmt.Errorf("error marshalling request body: %w", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, method, url, requestBody)
+	if err != nil {
+		log.C(ctx).Errorf("error saving tag: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTag(ctx, response)
+}
+
+func (r *Resolver) convertTag(ctx context.Context, response []byte) (*graphql.Tag, error) {
+	var tag models.Tag
+	if err := json.Unmarshal(response, &tag); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.tagConv.ConvertTagToGraphQL(tag)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting tag to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting tag to graphql: %w", err)
+	}
+	return result, nil
+}
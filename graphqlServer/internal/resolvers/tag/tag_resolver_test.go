@@ -0,0 +1,150 @@
+package tag_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/tag"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestTag_TagsResolver(t *testing.T) {
+	userID := "user"
+	inputTags := []models.Tag{{ID: "1", Name: "Work", Color: "#1e90ff", UserID: &userID, UsageCount: 3}}
+	expectedTags := []*graphql.Tag{{ID: "1", Name: "Work", Color: "#1e90ff", UserID: &userID, UsageCount: 3, CreatedAt: "0001-01-01T00:00:00Z"}}
+
+	tests := []struct {
+		name         string
+		mockResp     []byte
+		mockErr      error
+		expectError  bool
+		expectTags   []*graphql.Tag
+		tagConverter func() *automock.TagConverter
+	}{
+		{
+			name:       "tags of the user",
+			mockResp:   []byte(`[{"id": "1", "name": "Work", "color": "#1e90ff", "description": "", "user_id": "user", "list_id": null, "usage_count": 3}]`),
+			expectTags: expectedTags,
+			tagConverter: func() *automock.TagConverter {
+				tagConverter := &automock.TagConverter{}
+				tagConverter.EXPECT().ConvertMultipleTagsToGraphQL(inputTags).Return(expectedTags, nil)
+				return tagConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("failed to get tags"),
+			expectError: true,
+			tagConverter: func() *automock.TagConverter {
+				return &automock.TagConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", "/tags", mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := tag.NewResolver(mockClient, tt.tagConverter())
+
+			result, err := r.Tags(context.Background())
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectTags, result)
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", "/tags", mock.Anything)
+		})
+	}
+}
+
+func TestTag_CreateTagResolver(t *testing.T) {
+	listID := "list"
+	input := graphql.TagInput{Name: "Stage"}
+	created := models.Tag{ID: "1", Name: "Stage", ListID: &listID}
+	expectedTag := &graphql.Tag{ID: "1", Name: "Stage", ListID: &listID}
+
+	tests := []struct {
+		name         string
+		listID       *string
+		url          string
+		mockResp     []byte
+		mockErr      error
+		expectError  bool
+		expectTag    *graphql.Tag
+		tagConverter func() *automock.TagConverter
+	}{
+		{
+			name:      "tag of a list",
+			listID:    &listID,
+			url:       "/lists/list/tags",
+			mockResp:  []byte(`{"id": "1", "name": "Stage", "list_id": "list"}`),
+			expectTag: expectedTag,
+			tagConverter: func() *automock.TagConverter {
+				tagConverter := &automock.TagConverter{}
+				tagConverter.EXPECT().ConvertTagInput(input).Return(models.Tag{Name: "Stage"}, nil)
+				tagConverter.EXPECT().ConvertTagToGraphQL(created).Return(expectedTag, nil)
+				return tagConverter
+			},
+		},
+		{
+			name:        "failed http request for a personal tag",
+			url:         "/tags",
+			mockErr:     errors.New("failed to create tag"),
+			expectError: true,
+			tagConverter: func() *automock.TagConverter {
+				tagConverter := &automock.TagConverter{}
+				tagConverter.EXPECT().ConvertTagInput(input).Return(models.Tag{Name: "Stage"}, nil)
+				return tagConverter
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "POST", tt.url, mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := tag.NewResolver(mockClient, tt.tagConverter())
+
+			result, err := r.CreateTag(context.Background(), tt.listID, input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectTag, result)
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "POST", tt.url, mock.Anything)
+		})
+	}
+}
+
+func TestTag_MergeTagsResolver(t *testing.T) {
+	userID := "user"
+	merged := models.Tag{ID: "2", Name: "Work", UserID: &userID, UsageCount: 5}
+	expectedTag := &graphql.Tag{ID: "2", Name: "Work", UserID: &userID, UsageCount: 5}
+
+	mockClient := new(mock2.ClientMock)
+	mockClient.On("Do", mock.Anything, "POST", "/tags/1/merge/2", mock.Anything).
+		Return([]byte(`{"id": "2", "name": "Work", "user_id": "user", "usage_count": 5}`), nil)
+	tagConverter := &automock.TagConverter{}
+	tagConverter.EXPECT().ConvertTagToGraphQL(merged).Return(expectedTag, nil)
+	r := tag.NewResolver(mockClient, tagConverter)
+
+	result, err := r.MergeTags(context.Background(), "1", "2")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTag, result)
+	mockClient.AssertCalled(t, "Do", mock.Anything, "POST", "/tags/1/merge/2", mock.Anything)
+}
@@ -0,0 +1,172 @@
+package template
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+type Resolver struct {
+	httpClient   client.Client
+	templateConv converters.TemplateConverter
+	listConv     converters.ListConverter
+}
+
+func NewResolver(client client.Client, converter converters.TemplateConverter, listConverter converters.ListConverter) *Resolver {
+	return &Resolver{
+		httpClient:   client,
+		templateConv: converter,
+		listConv:     listConverter,
+	}
+}
+
+func (r *Resolver) Templates(ctx context.Context) ([]*graphql.ListTemplate, error) {
+	log.C(ctx).Info("templateResolver called templates")
+	response, err := r.httpClient.Do(ctx, http.MethodGet, "/templates", nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting templates: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var templates []models.ListTemplate
+	if err = json.Unmarshal(response, &templates); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.templateConv.ConvertMultipleTemplatesToGraphQL(templates)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting templates to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting templates to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) Template(ctx context.Context, id string) (*graphql.ListTemplate, error) {
+	log.C(ctx).Info("templateResolver called template")
+	response, err := r.httpClient.Do(ctx, http.MethodGet, fmt.Sprintf("/templates/%s", id), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting template: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTemplate(ctx, response)
+}
+
+func (r *Resolver) CreateTemplate(ctx context.Context, input graphql.ListTemplateInput) (*graphql.ListTemplate, error) {
+	log.C(ctx).Info("templateResolver called create template")
+	return r.saveTemplate(ctx, http.MethodPost, "/templates", input)
+}
+
+func (r *Resolver) UpdateTemplate(ctx context.Context, id string, input graphql.ListTemplateInput) (*graphql.ListTemplate, error) {
+	log.C(ctx).Info("templateResolver called update template")
+	return r.saveTemplate(ctx, http.MethodPut, fmt.Sprintf("/templates/%s", id), input)
+}
+
+func (r *Resolver) DeleteTemplate(ctx context.Context, id string) (*bool, error) {
+	log.C(ctx).Info("templateResolver called delete template")
+	_, err := r.httpClient.Do(ctx, http.MethodDelete, fmt.Sprintf("/templates/%s", id), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error deleting template: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	success := true
+	return &success, nil
+}
+
+func (r *Resolver) SaveListAsTemplate(ctx context.Context, listID string, name *string, anchor *string) (*graphql.ListTemplate, error) {
+	log.C(ctx).Info("templateResolver called save list as template")
+	requestBody, err := r.anchorRequestBody(ctx, name, anchor)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodPost, fmt.Sprintf("/lists/%s/template", listID), requestBody)
+	if err != nil {
+		log.C(ctx).Errorf("error saving list as template: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTemplate(ctx, response)
+}
+
+func (r *Resolver) InstantiateTemplate(ctx context.Context, id string, name *string, anchor *string) (*graphql.List, error) {
+	log.C(ctx).Info("templateResolver called instantiate template")
+	requestBody, err := r.anchorRequestBody(ctx, name, anchor)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodPost, fmt.Sprintf("/templates/%s/instantiate", id), requestBody)
+	if err != nil {
+		log.C(ctx).Errorf("error instantiating template: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var list models.List
+	if err = json.Unmarshal(response, &list); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.listConv.ConvertListToGraphQL(list)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting list to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting list to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) saveTemplate(ctx context.Context, method string, path string, input graphql.ListTemplateInput) (*graphql.ListTemplate, error) {
+	template, err := r.templateConv.ConvertTemplateInput(input)
+	if err != nil {
+		log.C(ctx).Errorf("error converting template input: %v", err)
+		return nil, fmt.Errorf("error converting template input: %w", err)
+	}
+	requestBody, err := json.Marshal(template)
+	if err != nil {
+		log.C(ctx).Errorf("error marshalling request body: %v", err)
+		return nil, fmt.Errorf("error marshalling request body: %w", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, method, path, requestBody)
+	if err != nil {
+		log.C(ctx).Errorf("error saving template: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTemplate(ctx, response)
+}
+
+func (r *Resolver) anchorRequestBody(ctx context.Context, name *string, anchor *string) ([]byte, error) {
+	request, err := r.templateConv.ConvertTemplateAnchor(name, anchor)
+	if err != nil {
+		log.C(ctx).Errorf("error converting template anchor: %v", err)
+		return nil, fmt.Errorf("error converting template anchor: %w", err)
+	}
+	requestBody, err := json.Marshal(request)
+	if err != nil {
+		log.C(ctx).Errorf("error marshalling request body: %v", err)
+		return nil, fmt.Errorf("error marshalling request body: %w", err)
+	}
+	return requestBody, nil
+}
+
+func (r *Resolver) convertTemplate(ctx context.Context, response []byte) (*graphql.ListTemplate, error) {
+	var template models.ListTemplate
+	if err := json.Unmarshal(response, &template); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.templateConv.ConvertTemplateToGraphQL(template)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting template to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting template to graphql: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,176 @@
+package template_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/template"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestTemplate_TemplatesResolver(t *testing.T) {
+	inputTemplates := []models.ListTemplate{{ID: "1", OwnerID: "user", Name: "Onboarding", Todos: []models.TemplateTodo{{Title: "Laptop", Priority: "high"}}}}
+	expectedTemplates := []*graphql.ListTemplate{{ID: "1", Name: "Onboarding", Todos: []*graphql.TemplateTodo{{Title: "Laptop", Priority: graphql.PriorityHigh}}}}
+
+	tests := []struct {
+		name              string
+		mockResp          []byte
+		mockErr           error
+		expectError       bool
+		expectTemplates   []*graphql.ListTemplate
+		templateConverter func() *automock.TemplateConverter
+	}{
+		{
+			name:            "templates of the user",
+			mockResp:        []byte(`[{"id": "1", "owner_id": "user", "name": "Onboarding", "todos": [{"title": "Laptop", "priority": "high"}]}]`),
+			expectTemplates: expectedTemplates,
+			templateConverter: func() *automock.TemplateConverter {
+				templateConverter := &automock.TemplateConverter{}
+				templateConverter.EXPECT().ConvertMultipleTemplatesToGraphQL(inputTemplates).Return(expectedTemplates, nil)
+				return templateConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("failed to get templates"),
+			expectError: true,
+			templateConverter: func() *automock.TemplateConverter {
+				return &automock.TemplateConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", "/templates", mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := template.NewResolver(mockClient, tt.templateConverter(), &automock.ListConverter{})
+
+			result, err := r.Templates(context.Background())
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectTemplates, result)
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", "/templates", mock.Anything)
+		})
+	}
+}
+
+func TestTemplate_SaveListAsTemplateResolver(t *testing.T) {
+	name := "Release"
+	expectedTemplate := &graphql.ListTemplate{ID: "1", Name: "Release", Todos: []*graphql.TemplateTodo{}}
+
+	templateConverter := &automock.TemplateConverter{}
+	templateConverter.EXPECT().ConvertTemplateAnchor(&name, (*string)(nil)).Return(models.TemplateAnchor{Name: name}, nil)
+	templateConverter.EXPECT().ConvertTemplateToGraphQL(models.ListTemplate{ID: "1", OwnerID: "user", Name: "Release", Todos: []models.TemplateTodo{}}).Return(expectedTemplate, nil)
+
+	mockClient := new(mock2.ClientMock)
+	mockClient.On("Do", mock.Anything, "POST", "/lists/list/template", []byte(`{"name":"Release","anchor":null}`)).
+		Return([]byte(`{"id": "1", "owner_id": "user", "name": "Release", "todos": []}`), nil)
+
+	r := template.NewResolver(mockClient, templateConverter, &automock.ListConverter{})
+	result, err := r.SaveListAsTemplate(context.Background(), "list", &name, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTemplate, result)
+	mockClient.AssertExpectations(t)
+}
+
+func TestTemplate_InstantiateTemplateResolver(t *testing.T) {
+	anchor := "2026-11-02T00:00:00Z"
+	anchorTime := time.Date(2026, time.November, 2, 0, 0, 0, 0, time.UTC)
+	expectedList := &graphql.List{ID: "list", Name: "Onboarding"}
+
+	tests := []struct {
+		name              string
+		anchor            *string
+		mockResp          []byte
+		mockErr           error
+		expectError       bool
+		expectList        *graphql.List
+		templateConverter func() *automock.TemplateConverter
+		listConverter     func() *automock.ListConverter
+		expectRequest     bool
+	}{
+		{
+			name:     "list created from the template",
+			anchor:   &anchor,
+			mockResp: []byte(`{"id": "list", "name": "Onboarding"}`),
+			templateConverter: func() *automock.TemplateConverter {
+				templateConverter := &automock.TemplateConverter{}
+				templateConverter.EXPECT().ConvertTemplateAnchor((*string)(nil), &anchor).Return(models.TemplateAnchor{Anchor: &anchorTime}, nil)
+				return templateConverter
+			},
+			listConverter: func() *automock.ListConverter {
+				listConverter := &automock.ListConverter{}
+				listConverter.EXPECT().ConvertListToGraphQL(models.List{ID: "list", Name: "Onboarding"}).Return(expectedList, nil)
+				return listConverter
+			},
+			expectList:    expectedList,
+			expectRequest: true,
+		},
+		{
+			name:        "failed http request",
+			anchor:      &anchor,
+			mockResp:    []byte(nil),
+			mockErr:     errors.New("template not found"),
+			expectError: true,
+			templateConverter: func() *automock.TemplateConverter {
+				templateConverter := &automock.TemplateConverter{}
+				templateConverter.EXPECT().ConvertTemplateAnchor((*string)(nil), &anchor).Return(models.TemplateAnchor{Anchor: &anchorTime}, nil)
+				return templateConverter
+			},
+			listConverter: func() *automock.ListConverter {
+				return &automock.ListConverter{}
+			},
+			expectRequest: true,
+		},
+		{
+			name:        "invalid anchor",
+			anchor:      &anchor,
+			expectError: true,
+			templateConverter: func() *automock.TemplateConverter {
+				templateConverter := &automock.TemplateConverter{}
+				templateConverter.EXPECT().ConvertTemplateAnchor((*string)(nil), &anchor).Return(models.TemplateAnchor{}, errors.New("invalid anchor"))
+				return templateConverter
+			},
+			listConverter: func() *automock.ListConverter {
+				return &automock.ListConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+			mockClient.On("Do", mock.Anything, "POST", "/templates/1/instantiate", []byte(`{"name":"","anchor":"2026-11-02T00:00:00Z"}`)).Return(tt.mockResp, tt.mockErr)
+
+			r := template.NewResolver(mockClient, tt.templateConverter(), tt.listConverter())
+			result, err := r.InstantiateTemplate(context.Background(), "1", nil, tt.anchor)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectList, result)
+			}
+			if tt.expectRequest {
+				mockClient.AssertExpectations(t)
+			} else {
+				mockClient.AssertNotCalled(t, "Do", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
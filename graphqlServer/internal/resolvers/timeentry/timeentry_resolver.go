@@ -0,0 +1,179 @@
+package timeentry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+type Resolver struct {
+	httpClient    client.Client
+	timeEntryConv converters.TimeEntryConverter
+}
+
+func NewResolver(client client.Client, converter converters.TimeEntryConverter) *Resolver {
+	return &Resolver{
+		httpClient:    client,
+		timeEntryConv: converter,
+	}
+}
+
+func (r *Resolver) TimeEntries(ctx context.Context, obj *graphql.Todo) ([]*graphql.TimeEntry, error) {
+	log.C(ctx).Info("timeEntryResolver called for todo time entries")
+	if obj == nil {
+		return nil, nil
+	}
+	response, err := r.httpClient.Do(ctx, http.MethodGet, fmt.Sprintf("/todos/%s/time_entries", obj.ID), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting time entries: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var entries []models.TimeEntry
+	if err = json.Unmarshal(response, &entries); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.timeEntryConv.ConvertMultipleTimeEntriesToGraphQL(entries)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting time entries to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting time entries to graphql: %w", err)
+	}
+	return result, nil
+}
+
+// RunningTimer returns the running timer of the current user or nil when
+// there is none.
+func (r *Resolver) RunningTimer(ctx context.Context) (*graphql.TimeEntry, error) {
+	log.C(ctx).Info("timeEntryResolver called for running timer")
+	response, err := r.httpClient.Do(ctx, http.MethodGet, "/timer", nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting running timer: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	if len(response) == 0 {
+		return nil, nil
+	}
+	return r.convertTimeEntry(ctx, response)
+}
+
+func (r *Resolver) StartTimer(ctx context.Context, todoID string, note *string) (*graphql.TimeEntry, error) {
+	log.C(ctx).Info("timeEntryResolver called for start timer")
+	body := map[string]string{}
+	if note != nil {
+		body["note"] = *note
+	}
+	requestBody, err := json.Marshal(body)
+	if err != nil {
+		log.C(ctx).Errorf("error marshalling request body: %v", err)
+		return nil, fmt.Errorf("error marshalling request body: %w", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodPost, fmt.Sprintf("/todos/%s/timer/start", todoID), requestBody)
+	if err != nil {
+		log.C(ctx).Errorf("error starting timer: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTimeEntry(ctx, response)
+}
+
+func (r *Resolver) StopTimer(ctx context.Context) (*graphql.TimeEntry, error) {
+	log.C(ctx).Info("timeEntryResolver called for stop timer")
+	response, err := r.httpClient.Do(ctx, http.MethodPost, "/timer/stop", nil)
+	if err != nil {
+		log.C(ctx).Errorf("error stopping timer: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTimeEntry(ctx, response)
+}
+
+func (r *Resolver) AddTimeEntry(ctx context.Context, todoID string, input graphql.TimeEntryInput) (*graphql.TimeEntry, error) {
+	log.C(ctx).Info("timeEntryResolver called for add time entry")
+	entry, err := r.timeEntryConv.ConvertTimeEntryInput(input)
+	if err != nil {
+		log.C(ctx).Errorf("error converting time entry input: %v", err)
+		return nil, fmt.Errorf("error converting time entry input: %w", err)
+	}
+	requestBody, err := json.Marshal(entry)
+	if err != nil {
+		log.C(ctx).Errorf("error marshalling request body: %v", err)
+		return nil, fmt.Errorf("error marshalling request body: %w", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodPost, fmt.Sprintf("/todos/%s/time_entries", todoID), requestBody)
+	if err != nil {
+		log.C(ctx).Errorf("error adding time entry: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTimeEntry(ctx, response)
+}
+
+func (r *Resolver) DeleteTimeEntry(ctx context.Context, todoID string, id string) (*bool, error) {
+	log.C(ctx).Info("timeEntryResolver called for delete time entry")
+	_, err := r.httpClient.Do(ctx, http.MethodDelete, fmt.Sprintf("/todos/%s/time_entries/%s", todoID, id), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error deleting time entry: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	success := true
+	return &success, nil
+}
+
+func (r *Resolver) TodoTimeTotal(ctx context.Context, obj *graphql.Todo, from *string, to *string) (*graphql.TimeTotal, error) {
+	log.C(ctx).Info("timeEntryResolver called for todo time total")
+	return r.getTotal(ctx, fmt.Sprintf("/todos/%s/time_total", obj.ID), from, to)
+}
+
+func (r *Resolver) ListTimeTotal(ctx context.Context, obj *graphql.List, from *string, to *string) (*graphql.TimeTotal, error) {
+	log.C(ctx).Info("timeEntryResolver called for list time total")
+	return r.getTotal(ctx, fmt.Sprintf("/lists/%s/time_total", obj.ID), from, to)
+}
+
+func (r *Resolver) MyTimeTotal(ctx context.Context, from *string, to *string) (*graphql.TimeTotal, error) {
+	log.C(ctx).Info("timeEntryResolver called for my time total")
+	return r.getTotal(ctx, "/time_entries/total", from, to)
+}
+
+func (r *Resolver) getTotal(ctx context.Context, url string, from *string, to *string) (*graphql.TimeTotal, error) {
+	response, err := r.httpClient.Do(ctx, http.MethodGet, url+converters.ConvertTimeRangeToQuery(from, to), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting time total: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var total models.TimeTotal
+	if err = json.Unmarshal(response, &total); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.timeEntryConv.ConvertTimeTotalToGraphQL(total)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting time total to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting time total to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) convertTimeEntry(ctx context.Context, response []byte) (*graphql.TimeEntry, error) {
+	var entry models.TimeEntry
+	if err := json.Unmarshal(response, &entry); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	result, err := r.timeEntryConv.ConvertTimeEntryToGraphQL(entry)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting time entry to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting time entry to graphql: %w", err)
+	}
+	return result, nil
+}
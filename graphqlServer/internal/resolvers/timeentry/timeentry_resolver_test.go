@@ -0,0 +1,139 @@
+package timeentry_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/timeentry"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestTimeEntry_RunningTimerResolver(t *testing.T) {
+	startedAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	inputEntry := models.TimeEntry{ID: "1", TodoID: "2", UserID: "3", StartedAt: startedAt, CreatedAt: startedAt}
+	expectedEntry := &graphql.TimeEntry{ID: "1", TodoID: "2", UserID: "3", StartedAt: "2026-10-18T09:00:00Z", CreatedAt: "2026-10-18T09:00:00Z"}
+
+	tests := []struct {
+		name               string
+		mockResp           []byte
+		mockErr            error
+		expectError        bool
+		expectEntry        *graphql.TimeEntry
+		timeEntryConverter func() *automock.TimeEntryConverter
+	}{
+		{
+			name:        "running timer",
+			mockResp:    []byte(`{"id": "1", "todo_id": "2", "user_id": "3", "started_at": "2026-10-18T09:00:00Z", "ended_at": null, "note": "", "created_at": "2026-10-18T09:00:00Z"}`),
+			expectEntry: expectedEntry,
+			timeEntryConverter: func() *automock.TimeEntryConverter {
+				timeEntryConverter := &automock.TimeEntryConverter{}
+				timeEntryConverter.EXPECT().ConvertTimeEntryToGraphQL(inputEntry).Return(expectedEntry, nil)
+				return timeEntryConverter
+			},
+		},
+		{
+			name:     "no running timer",
+			mockResp: []byte{},
+			timeEntryConverter: func() *automock.TimeEntryConverter {
+				return &automock.TimeEntryConverter{}
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("failed to get running timer"),
+			expectError: true,
+			timeEntryConverter: func() *automock.TimeEntryConverter {
+				return &automock.TimeEntryConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", "/timer", mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := timeentry.NewResolver(mockClient, tt.timeEntryConverter())
+
+			result, err := r.RunningTimer(context.Background())
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectEntry, result)
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", "/timer", mock.Anything)
+		})
+	}
+}
+
+func TestTimeEntry_MyTimeTotalResolver(t *testing.T) {
+	from := "2026-10-01T00:00:00Z"
+	url := "/time_entries/total?from=2026-10-01T00%3A00%3A00Z"
+	expectedTotal := &graphql.TimeTotal{Seconds: 5400, Entries: 2}
+
+	tests := []struct {
+		name               string
+		mockResp           []byte
+		mockErr            error
+		expectError        bool
+		expectTotal        *graphql.TimeTotal
+		timeEntryConverter func() *automock.TimeEntryConverter
+	}{
+		{
+			name:        "successful time total",
+			mockResp:    []byte(`{"seconds": 5400, "entries": 2}`),
+			expectTotal: expectedTotal,
+			timeEntryConverter: func() *automock.TimeEntryConverter {
+				timeEntryConverter := &automock.TimeEntryConverter{}
+				timeEntryConverter.EXPECT().ConvertTimeTotalToGraphQL(models.TimeTotal{Seconds: 5400, Entries: 2}).Return(expectedTotal, nil)
+				return timeEntryConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("failed to get time total"),
+			expectError: true,
+			timeEntryConverter: func() *automock.TimeEntryConverter {
+				return &automock.TimeEntryConverter{}
+			},
+		},
+		{
+			name:        "failed to unmarshal response",
+			mockResp:    []byte(`invalid JSON`),
+			expectError: true,
+			timeEntryConverter: func() *automock.TimeEntryConverter {
+				return &automock.TimeEntryConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", url, mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := timeentry.NewResolver(mockClient, tt.timeEntryConverter())
+
+			result, err := r.MyTimeTotal(context.Background(), &from, nil)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectTotal, result)
+			}
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", url, mock.Anything)
+		})
+	}
+}
@@ -7,9 +7,12 @@ import (
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type Resolver struct {
@@ -54,9 +57,15 @@ func (r *Resolver) TodosGlobal(ctx context.Context) ([]*graphql.Todo, error) {
 	return result, nil
 }
 
-func (r *Resolver) Todos(ctx context.Context) ([]*graphql.Todo, error) {
+func (r *Resolver) Todos(ctx context.Context, filter *graphql.TodoFilterInput, orderBy *graphql.TodoOrderInput) ([]*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called todos")
-	response, err := r.httpClient.Do(ctx, http.MethodGet, "/todos/user/all", nil)
+	query, err := converters.ConvertTodoFilterToQuery(filter, orderBy)
+	if err != nil {
+		log.C(ctx).Errorf("error converting todo filter: %v", err)
+		return nil, fmt.Errorf("error converting filter: %w", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodGet, "/todos/user/all"+query, nil)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todos: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
@@ -75,6 +84,16 @@ func (r *Resolver) Todos(ctx context.Context) ([]*graphql.Todo, error) {
 	return result, nil
 }
 
+func (r *Resolver) RestoreTodo(ctx context.Context, id string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called restore todo")
+	_, err := r.httpClient.Do(ctx, http.MethodPost, fmt.Sprintf("/trash/todos/%s/restore", id), nil)
+	if err != nil {
+		log.C(ctx).Errorf("error restoring todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.Todo(ctx, id)
+}
+
 func (r *Resolver) Todo(ctx context.Context, id string) (*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called todo")
 	url := fmt.Sprintf("/todos/%s", id)
@@ -211,38 +230,32 @@ func (r *Resolver) CreateTodo(ctx context.Context, input graphql.CreateTodoInput
 	return r.todoConv.ConvertTodoToGraphQL(todo)
 }
 
-func (r *Resolver) UpdateTodo(ctx context.Context, id string, input graphql.UpdateTodoInput) (*graphql.Todo, error) {
+func (r *Resolver) UpdateTodo(ctx context.Context, id string, input graphql.UpdateTodoInput, expectedVersion *int) (*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called update")
-	httpInput, err := r.todoConv.ConvertUpdateTodoInput(input)
+	patch, err := r.todoConv.ConvertUpdateTodoInput(input)
 	if err != nil {
 		log.C(ctx).Errorf("error converting todos: %v", err)
-		return nil, fmt.Errorf("error converting update todo input to struct: %w", err)
+		return nil, fmt.Errorf("error converting update todo input to patch: %w", err)
 	}
-	body, err := json.Marshal(httpInput)
+	body, err := json.Marshal(patch)
 	if err != nil {
 		log.C(ctx).Errorf("error marshalling response: %v", err)
-		return nil, fmt.Errorf("error marshalling user: %v", err)
+		return nil, fmt.Errorf("error marshalling patch: %v", err)
 	}
 	log.C(ctx).Debugf("body: %v", string(body))
 
 	url := fmt.Sprintf("/todos/%s", id)
 
-	_, err = r.httpClient.Do(ctx, http.MethodPut, url, body)
+	response, err := r.httpClient.Do(client.WithExpectedVersion(ctx, expectedVersion), http.MethodPatch, url, body)
 	if err != nil {
 		log.C(ctx).Errorf("error executing request: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-
-	responseGet, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
-		return nil, fmt.Errorf("error executing request: %w", err)
-	}
-	log.C(ctx).Debugf("todo response get: %v", responseGet)
+	log.C(ctx).Debugf("todo response: %v", string(response))
 
 	var t models.Todo
 
-	if err = json.Unmarshal(responseGet, &t); err != nil {
+	if err = json.Unmarshal(response, &t); err != nil {
 		log.C(ctx).Errorf("error unmarshalling response: %v", err)
 		return nil, fmt.Errorf("error unmarshalling response: %w", err)
 	}
@@ -276,9 +289,14 @@ func (r *Resolver) DeleteTodo(ctx context.Context, id string) (*graphql.Todo, er
 	return r.todoConv.ConvertTodoToGraphQL(t)
 }
 
-func (r *Resolver) TodosByList(ctx context.Context, id string) ([]*graphql.Todo, error) {
+func (r *Resolver) TodosByList(ctx context.Context, id string, filter *graphql.TodoFilterInput, orderBy *graphql.TodoOrderInput) ([]*graphql.Todo, error) {
 	log.C(ctx).Infof("todoResolver for TodoByList is called")
-	url := fmt.Sprintf("/lists/%s/todos", id)
+	query, err := converters.ConvertTodoFilterToQuery(filter, orderBy)
+	if err != nil {
+		log.C(ctx).Errorf("error converting todo filter: %v", err)
+		return nil, fmt.Errorf("error converting filter: %w", err)
+	}
+	url := fmt.Sprintf("/lists/%s/todos", id) + query
 
 	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -298,7 +316,7 @@ func (r *Resolver) TodosByList(ctx context.Context, id string) ([]*graphql.Todo,
 	return result, nil
 }
 
-func (r *Resolver) UpdateTodoTitle(ctx context.Context, id string, title string) (*graphql.Todo, error) {
+func (r *Resolver) UpdateTodoTitle(ctx context.Context, id string, title string, expectedVersion *int) (*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called update todo title")
 	url := fmt.Sprintf("/todos/%s/title", id)
 
@@ -314,7 +332,7 @@ func (r *Resolver) UpdateTodoTitle(ctx context.Context, id string, title string)
 		return nil, fmt.Errorf("error marshalling title: %v", err)
 	}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+	response, err := r.httpClient.Do(client.WithExpectedVersion(ctx, expectedVersion), http.MethodPatch, url, body)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todo: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
@@ -335,7 +353,7 @@ func (r *Resolver) UpdateTodoTitle(ctx context.Context, id string, title string)
 	return graphTodo, nil
 }
 
-func (r *Resolver) UpdateTodoDescription(ctx context.Context, id string, description string) (*graphql.Todo, error) {
+func (r *Resolver) UpdateTodoDescription(ctx context.Context, id string, description string, expectedVersion *int) (*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called update todo description")
 	url := fmt.Sprintf("/todos/%s/description", id)
 
@@ -351,7 +369,7 @@ func (r *Resolver) UpdateTodoDescription(ctx context.Context, id string, descrip
 		return nil, fmt.Errorf("error marshalling description: %v", err)
 	}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+	response, err := r.httpClient.Do(client.WithExpectedVersion(ctx, expectedVersion), http.MethodPatch, url, body)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todo: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
@@ -372,7 +390,7 @@ func (r *Resolver) UpdateTodoDescription(ctx context.Context, id string, descrip
 	return graphTodo, nil
 }
 
-func (r *Resolver) UpdateTodoPriority(ctx context.Context, id string, priority graphql.Priority) (*graphql.Todo, error) {
+func (r *Resolver) UpdateTodoPriority(ctx context.Context, id string, priority graphql.Priority, expectedVersion *int) (*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called update todo priority")
 	url := fmt.Sprintf("/todos/%s/priority", id)
 
@@ -388,7 +406,7 @@ func (r *Resolver) UpdateTodoPriority(ctx context.Context, id string, priority g
 		return nil, fmt.Errorf("error marshalling priority: %v", err)
 	}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+	response, err := r.httpClient.Do(client.WithExpectedVersion(ctx, expectedVersion), http.MethodPatch, url, body)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todo: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
@@ -409,7 +427,7 @@ func (r *Resolver) UpdateTodoPriority(ctx context.Context, id string, priority g
 	return graphTodo, nil
 }
 
-func (r *Resolver) UpdateTodoAssignTo(ctx context.Context, id string, userID string) (*graphql.Todo, error) {
+func (r *Resolver) UpdateTodoAssignTo(ctx context.Context, id string, userID string, expectedVersion *int) (*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called update todo assigned to user")
 	url := fmt.Sprintf("/todos/%s/assign_to", id)
 
@@ -425,7 +443,7 @@ func (r *Resolver) UpdateTodoAssignTo(ctx context.Context, id string, userID str
 		return nil, fmt.Errorf("error marshalling userID: %v", err)
 	}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+	response, err := r.httpClient.Do(client.WithExpectedVersion(ctx, expectedVersion), http.MethodPatch, url, body)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todo: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
@@ -446,9 +464,12 @@ func (r *Resolver) UpdateTodoAssignTo(ctx context.Context, id string, userID str
 	return graphTodo, nil
 }
 
-func (r *Resolver) CompleteTodo(ctx context.Context, id string) (*graphql.Todo, error) {
+func (r *Resolver) CompleteTodo(ctx context.Context, id string, withSubtasks *bool) (*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called complete todo")
 	url := fmt.Sprintf("/todos/%s/complete", id)
+	if withSubtasks != nil && *withSubtasks {
+		url += "?subtasks=true"
+	}
 
 	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, nil)
 	if err != nil {
@@ -471,6 +492,321 @@ func (r *Resolver) CompleteTodo(ctx context.Context, id string) (*graphql.Todo,
 	return graphTodo, nil
 }
 
+func (r *Resolver) ReopenTodo(ctx context.Context, id string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called reopen todo")
+	url := fmt.Sprintf("/todos/%s/reopen", id)
+
+	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("error reopening todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var todo models.Todo
+	if err = json.Unmarshal(response, &todo); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
+	if err != nil {
+		log.C(ctx).Errorf("error converting todos: %v", err)
+		return nil, fmt.Errorf("error converting todo: %w", err)
+	}
+	log.C(ctx).Debugf("converted todo: %v", graphTodo)
+	return graphTodo, nil
+}
+
+func (r *Resolver) ReorderTodo(ctx context.Context, id string, beforeID *string, afterID *string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called reorder todo")
+	url := fmt.Sprintf("/todos/%s/position", id)
+
+	var reorderData struct {
+		BeforeID *string `json:"before_id,omitempty"`
+		AfterID  *string `json:"after_id,omitempty"`
+	}
+
+	reorderData.BeforeID = beforeID
+	reorderData.AfterID = afterID
+
+	body, err := json.Marshal(reorderData)
+	if err != nil {
+		log.C(ctx).Errorf("failed to marshal position for the todo: %v", err)
+		return nil, fmt.Errorf("error marshalling position: %v", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+	if err != nil {
+		log.C(ctx).Errorf("error reordering todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var todo models.Todo
+	if err = json.Unmarshal(response, &todo); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
+	if err != nil {
+		log.C(ctx).Errorf("error converting todos: %v", err)
+		return nil, fmt.Errorf("error converting todo: %w", err)
+	}
+	log.C(ctx).Debugf("converted todo: %v", graphTodo)
+	return graphTodo, nil
+}
+
+func (r *Resolver) MoveTodo(ctx context.Context, id string, targetListID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called move todo")
+	url := fmt.Sprintf("/todos/%s/move/%s", id, targetListID)
+
+	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("error moving todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var todo models.Todo
+	if err = json.Unmarshal(response, &todo); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
+	if err != nil {
+		log.C(ctx).Errorf("error converting todos: %v", err)
+		return nil, fmt.Errorf("error converting todo: %w", err)
+	}
+	log.C(ctx).Debugf("converted todo: %v", graphTodo)
+	return graphTodo, nil
+}
+
+func (r *Resolver) TransitionTodo(ctx context.Context, id string, statusID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called transition todo")
+	url := fmt.Sprintf("/todos/%s/status/%s", id, statusID)
+
+	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("error transitioning todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var todo models.Todo
+	if err = json.Unmarshal(response, &todo); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
+	if err != nil {
+		log.C(ctx).Errorf("error converting todos: %v", err)
+		return nil, fmt.Errorf("error converting todo: %w", err)
+	}
+	log.C(ctx).Debugf("converted todo: %v", graphTodo)
+	return graphTodo, nil
+}
+
+// SmartView returns the view of the caller's todos, computed by the todo
+// service in the given time zone.
+func (r *Resolver) SmartView(ctx context.Context, view constants.SmartView, timeZone *string, days *int) (*graphql.TodoView, error) {
+	log.C(ctx).Infof("todoResolver called smart view %s", view)
+	params := url.Values{}
+	if timeZone != nil {
+		params.Set("tz", *timeZone)
+	}
+	if days != nil {
+		params.Set("days", strconv.Itoa(*days))
+	}
+	path := "/views/" + string(view)
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting smart view %s: %v", view, err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var result models.SmartView
+	if err = json.Unmarshal(response, &result); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	graphView, err := r.todoConv.ConvertTodoViewToGraphQL(result)
+	if err != nil {
+		log.C(ctx).Errorf("error converting smart view: %v", err)
+		return nil, fmt.Errorf("error converting smart view: %w", err)
+	}
+	return graphView, nil
+}
+
+func (r *Resolver) BulkUpdateTodos(ctx context.Context, input graphql.BulkTodoInput) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called bulk update todos")
+	operation, err := r.todoConv.ConvertBulkTodoInput(input)
+	if err != nil {
+		log.C(ctx).Errorf("failed to convert bulk todo input: %v", err)
+		return nil, fmt.Errorf("error converting input: %w", err)
+	}
+
+	body, err := json.Marshal(operation)
+	if err != nil {
+		log.C(ctx).Errorf("failed to marshal bulk operation: %v", err)
+		return nil, fmt.Errorf("error marshalling bulk operation: %v", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodPost, "/todos/bulk", body)
+	if err != nil {
+		log.C(ctx).Errorf("error bulk updating todos: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var todos []*models.Todo
+	if err = json.Unmarshal(response, &todos); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+	result, err := r.todoConv.ConvertMultipleTodoToGraphQL(todos)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting multiple todos to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting multiple todos to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) QuickAddTodo(ctx context.Context, listID string, text string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called quick add todo")
+	body, err := json.Marshal(models.QuickAdd{ListID: listID, Text: text})
+	if err != nil {
+		log.C(ctx).Errorf("failed to marshal quick add request: %v", err)
+		return nil, fmt.Errorf("error marshalling quick add request: %v", err)
+	}
+
+	response, err := r.httpClient.Do(ctx, http.MethodPost, "/todos/quick", body)
+	if err != nil {
+		log.C(ctx).Errorf("error quick adding todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var todo models.Todo
+	if err = json.Unmarshal(response, &todo); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
+	if err != nil {
+		log.C(ctx).Errorf("error converting todos: %v", err)
+		return nil, fmt.Errorf("error converting todo: %w", err)
+	}
+	log.C(ctx).Debugf("converted todo: %v", graphTodo)
+	return graphTodo, nil
+}
+
+func (r *Resolver) Parent(ctx context.Context, obj *graphql.Todo) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called for parent")
+	if obj == nil {
+		return nil, nil
+	}
+	todo, err := r.getTodo(ctx, obj.ID)
+	if err != nil {
+		log.C(ctx).Errorf("error getting todo: %v", err)
+		return nil, err
+	}
+	if todo.ParentID == nil {
+		return nil, nil
+	}
+	parent, err := r.getTodo(ctx, *todo.ParentID)
+	if err != nil {
+		log.C(ctx).Errorf("error getting parent todo: %v", err)
+		return nil, err
+	}
+	return r.todoConv.ConvertTodoToGraphQL(parent)
+}
+
+func (r *Resolver) Subtasks(ctx context.Context, obj *graphql.Todo) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called for subtasks")
+	if obj == nil {
+		return nil, nil
+	}
+	url := fmt.Sprintf("/todos/%s/subtasks", obj.ID)
+
+	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting subtasks for todo %s: %v", obj.ID, err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	var todos []*models.Todo
+	if err = json.Unmarshal(response, &todos); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+	result, err := r.todoConv.ConvertMultipleTodoToGraphQL(todos)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting multiple todos to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting multiple todos to graphql: %w", err)
+	}
+	return result, nil
+}
+
+func (r *Resolver) BlockedBy(ctx context.Context, obj *graphql.Todo) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called for blocked by")
+	if obj == nil {
+		return nil, nil
+	}
+	return r.dependencies(ctx, fmt.Sprintf("/todos/%s/blocked_by", obj.ID))
+}
+
+func (r *Resolver) Blocking(ctx context.Context, obj *graphql.Todo) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called for blocking")
+	if obj == nil {
+		return nil, nil
+	}
+	return r.dependencies(ctx, fmt.Sprintf("/todos/%s/blocking", obj.ID))
+}
+
+func (r *Resolver) AddTodoDependency(ctx context.Context, todoID string, blockedByID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called add todo dependency")
+	url := fmt.Sprintf("/todos/%s/blocked_by/%s", todoID, blockedByID)
+
+	if _, err := r.httpClient.Do(ctx, http.MethodPost, url, nil); err != nil {
+		log.C(ctx).Errorf("error adding todo dependency: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.Todo(ctx, todoID)
+}
+
+func (r *Resolver) RemoveTodoDependency(ctx context.Context, todoID string, blockedByID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called remove todo dependency")
+	url := fmt.Sprintf("/todos/%s/blocked_by/%s", todoID, blockedByID)
+
+	if _, err := r.httpClient.Do(ctx, http.MethodDelete, url, nil); err != nil {
+		log.C(ctx).Errorf("error removing todo dependency: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.Todo(ctx, todoID)
+}
+
+func (r *Resolver) dependencies(ctx context.Context, url string) ([]*graphql.Todo, error) {
+	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting todo dependencies: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	var todos []*models.Todo
+	if err = json.Unmarshal(response, &todos); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+	result, err := r.todoConv.ConvertMultipleTodoToGraphQL(todos)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting multiple todos to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting multiple todos to graphql: %w", err)
+	}
+	return result, nil
+}
+
 func (r *Resolver) getTodo(ctx context.Context, todoID string) (models.Todo, error) {
 	log.C(ctx).Info("todoResolver called get")
 	url := fmt.Sprintf("/todos/%s", todoID)
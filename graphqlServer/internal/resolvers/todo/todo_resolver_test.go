@@ -4,10 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	graphql2 "github.com/99designs/gqlgen/graphql"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
 	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/todo"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -28,8 +32,14 @@ func TestTodos_TodoResolver(t *testing.T) {
 		},
 	}
 
+	completed := false
+	high := graphql.PriorityHigh
+	descending := true
+
 	tests := []struct {
 		name          string
+		filter        *graphql.TodoFilterInput
+		orderBy       *graphql.TodoOrderInput
 		mockMethod    string
 		mockURL       string
 		mockResp      []byte
@@ -54,6 +64,24 @@ func TestTodos_TodoResolver(t *testing.T) {
 				return todoConverter
 			},
 		},
+		{
+			name:        "successful filtered todos fetch",
+			filter:      &graphql.TodoFilterInput{Completed: &completed, Priority: &high},
+			orderBy:     &graphql.TodoOrderInput{Field: graphql.TodoSortFieldDueDate, Descending: &descending},
+			mockMethod:  "GET",
+			mockURL:     "/todos/user/all?completed=false&order=desc&priority=high&sort=due_date",
+			mockResp:    []byte(`[{"ID": "1", "Title": "Test Todo"}]`),
+			mockErr:     nil,
+			expectError: false,
+			expectTodos: []*graphql.Todo{
+				{ID: "1", Title: "Test Todo"},
+			},
+			todoConverter: func() *automock.TodoConverter {
+				todoConverter := &automock.TodoConverter{}
+				todoConverter.EXPECT().ConvertMultipleTodoToGraphQL(inputTodo).Return(expectedTodo, nil)
+				return todoConverter
+			},
+		},
 		{
 			name:        "failed HTTP request",
 			mockMethod:  "GET",
@@ -90,7 +118,7 @@ func TestTodos_TodoResolver(t *testing.T) {
 
 			r := todo.NewResolver(mockClient, todoConverter, nil, nil)
 
-			result, err := r.Todos(context.Background())
+			result, err := r.Todos(context.Background(), tt.filter, tt.orderBy)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -337,15 +365,13 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 	title := "Updated Todo"
 
 	updateTodoInput := graphql.UpdateTodoInput{
-		Title: &title,
+		Title:   graphql2.OmittableOf(&title),
+		DueDate: graphql2.OmittableOf[*string](nil),
 	}
 
-	httpInput := models.Todo{
-		ID:    "1",
-		Title: "Updated Todo",
-	}
+	patch := map[string]interface{}{"title": &title, "due_date": nil}
 
-	data, _ := json.Marshal(inputTodo)
+	data := []byte(`{"due_date":null,"title":"Updated Todo"}`)
 
 	tests := []struct {
 		name        string
@@ -363,14 +389,13 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 			input: updateTodoInput,
 			mockConvert: func() *automock.TodoConverter {
 				todoConverter := &automock.TodoConverter{}
-				todoConverter.EXPECT().ConvertUpdateTodoInput(updateTodoInput).Return(httpInput, nil)
+				todoConverter.EXPECT().ConvertUpdateTodoInput(updateTodoInput).Return(patch, nil)
 				todoConverter.EXPECT().ConvertTodoToGraphQL(inputTodo).Return(&expectedTodo, nil)
 				return todoConverter
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "PUT", "/todos/1", data).Return([]byte(`"1"`), nil)
-				mockClient.On("Do", mock.Anything, "GET", "/todos/1", mock.Anything).Return([]byte(`{"ID": "1", "Title": "Updated Todo"}`), nil)
+				mockClient.On("Do", mock.Anything, "PATCH", "/todos/1", data).Return([]byte(`{"id": "1", "title": "Updated Todo"}`), nil)
 				return mockClient
 			},
 			mockErr:     nil,
@@ -383,7 +408,7 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 			input: updateTodoInput,
 			mockConvert: func() *automock.TodoConverter {
 				todoConverter := &automock.TodoConverter{}
-				todoConverter.EXPECT().ConvertUpdateTodoInput(updateTodoInput).Return(models.Todo{}, errors.New("conversion error"))
+				todoConverter.EXPECT().ConvertUpdateTodoInput(updateTodoInput).Return(nil, errors.New("conversion error"))
 				return todoConverter
 			},
 			mockDo: func() *mock2.ClientMock {
@@ -399,38 +424,18 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 			input: updateTodoInput,
 			mockConvert: func() *automock.TodoConverter {
 				todoConverter := &automock.TodoConverter{}
-				todoConverter.EXPECT().ConvertUpdateTodoInput(updateTodoInput).Return(httpInput, nil)
+				todoConverter.EXPECT().ConvertUpdateTodoInput(updateTodoInput).Return(patch, nil)
 				return todoConverter
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "PUT", "/todos/1", data).Return([]byte(`"1"`), errors.New("failed to update todo"))
+				mockClient.On("Do", mock.Anything, "PATCH", "/todos/1", data).Return([]byte(nil), errors.New("failed to update todo"))
 				return mockClient
 			},
 			mockErr:     errors.New("failed to update todo"),
 			expectError: true,
 			expectTodo:  nil,
 		},
-		{
-			name:  "failed to fetch todo after update",
-			id:    "1",
-			input: updateTodoInput,
-			mockConvert: func() *automock.TodoConverter {
-				todoConverter := &automock.TodoConverter{}
-				todoConverter.EXPECT().ConvertUpdateTodoInput(updateTodoInput).Return(httpInput, nil)
-				todoConverter.EXPECT().ConvertTodoToGraphQL(inputTodo).Return(&expectedTodo, nil)
-				return todoConverter
-			},
-			mockDo: func() *mock2.ClientMock {
-				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "PUT", "/todos/1", data).Return([]byte(`"1"`), nil)
-				mockClient.On("Do", mock.Anything, "GET", "/todos/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch todo"))
-				return mockClient
-			},
-			mockErr:     errors.New("failed to fetch todo"),
-			expectError: true,
-			expectTodo:  nil,
-		},
 	}
 
 	for _, tt := range tests {
@@ -440,7 +445,7 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 
 			r := todo.NewResolver(mockClient, todoConverter, nil, nil)
 
-			result, err := r.UpdateTodo(context.Background(), tt.id, tt.input)
+			result, err := r.UpdateTodo(context.Background(), tt.id, tt.input, nil)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -455,6 +460,21 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 	}
 }
 
+func TestUpdateTodoTitleVersionMismatch_TodoResolver(t *testing.T) {
+	mockClient := new(mock2.ClientMock)
+	mockClient.On("Do", mock.Anything, "PATCH", "/todos/1/title", []byte(`{"title":"Updated Todo"}`)).
+		Return([]byte(nil), fmt.Errorf("status code 412: %w", client.ErrVersionMismatch))
+
+	r := todo.NewResolver(mockClient, &automock.TodoConverter{}, nil, nil)
+	version := 2
+
+	result, err := r.UpdateTodoTitle(context.Background(), "1", "Updated Todo", &version)
+
+	assert.ErrorIs(t, err, client.ErrVersionMismatch)
+	assert.Nil(t, result)
+	mockClient.AssertExpectations(t)
+}
+
 func TestDeleteTodo_TodoResolver(t *testing.T) {
 	expectedTodo := graphql.Todo{
 		ID:    "1",
@@ -568,3 +588,147 @@ func TestDeleteTodo_TodoResolver(t *testing.T) {
 		})
 	}
 }
+
+func TestSubtasks_TodoResolver(t *testing.T) {
+	expectedTodo := []*graphql.Todo{
+		{
+			ID:    "2",
+			Title: "Subtask",
+		},
+	}
+	inputTodo := []*models.Todo{
+		{
+			ID:    "2",
+			Title: "Subtask",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		mockResp      []byte
+		mockErr       error
+		expectError   bool
+		expectTodos   []*graphql.Todo
+		todoConverter func() *automock.TodoConverter
+	}{
+		{
+			name:        "successful subtasks fetch",
+			mockResp:    []byte(`[{"ID": "2", "Title": "Subtask"}]`),
+			mockErr:     nil,
+			expectError: false,
+			expectTodos: expectedTodo,
+			todoConverter: func() *automock.TodoConverter {
+				todoConverter := &automock.TodoConverter{}
+				todoConverter.EXPECT().ConvertMultipleTodoToGraphQL(inputTodo).Return(expectedTodo, nil)
+				return todoConverter
+			},
+		},
+		{
+			name:        "failed HTTP request",
+			mockResp:    nil,
+			mockErr:     errors.New("failed to fetch subtasks"),
+			expectError: true,
+			expectTodos: nil,
+			todoConverter: func() *automock.TodoConverter {
+				return &automock.TodoConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", "/todos/1/subtasks", mock.Anything).Return(tt.mockResp, tt.mockErr)
+
+			todoConverter := tt.todoConverter()
+
+			r := todo.NewResolver(mockClient, todoConverter, nil, nil)
+
+			result, err := r.Subtasks(context.Background(), &graphql.Todo{ID: "1"})
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectTodos, result)
+			}
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", "/todos/1/subtasks", mock.Anything)
+		})
+	}
+}
+
+func TestSmartView_TodoResolver(t *testing.T) {
+	date := "2026-10-19"
+	timeZone := "Europe/Sofia"
+	days := 3
+	inputView := models.SmartView{
+		View:     constants.SmartViewUpcoming,
+		TimeZone: timeZone,
+		Groups:   []models.TodoDateGroup{{Date: &date, Todos: []models.Todo{{ID: "1", Title: "Test Todo"}}}},
+	}
+	expectedView := &graphql.TodoView{
+		View:     graphql.SmartViewUpcoming,
+		TimeZone: timeZone,
+		Groups:   []*graphql.TodoDateGroup{{Date: &date, Todos: []*graphql.Todo{{ID: "1", Title: "Test Todo"}}}},
+	}
+
+	tests := []struct {
+		name          string
+		timeZone      *string
+		days          *int
+		url           string
+		mockResp      []byte
+		mockErr       error
+		expectError   bool
+		expectView    *graphql.TodoView
+		todoConverter func() *automock.TodoConverter
+	}{
+		{
+			name:       "upcoming days in the time zone",
+			timeZone:   &timeZone,
+			days:       &days,
+			url:        "/views/upcoming?days=3&tz=Europe%2FSofia",
+			mockResp:   []byte(`{"view": "upcoming", "time_zone": "Europe/Sofia", "groups": [{"date": "2026-10-19", "todos": [{"id": "1", "title": "Test Todo"}]}]}`),
+			expectView: expectedView,
+			todoConverter: func() *automock.TodoConverter {
+				todoConverter := &automock.TodoConverter{}
+				todoConverter.EXPECT().ConvertTodoViewToGraphQL(inputView).Return(expectedView, nil)
+				return todoConverter
+			},
+		},
+		{
+			name:        "failed HTTP request",
+			url:         "/views/upcoming",
+			mockErr:     errors.New("invalid smart view"),
+			expectError: true,
+			todoConverter: func() *automock.TodoConverter {
+				return &automock.TodoConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", tt.url, mock.Anything).Return(tt.mockResp, tt.mockErr)
+
+			r := todo.NewResolver(mockClient, tt.todoConverter(), nil, nil)
+
+			result, err := r.SmartView(context.Background(), constants.SmartViewUpcoming, tt.timeZone, tt.days)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectView, result)
+			}
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", tt.url, mock.Anything)
+		})
+	}
+}
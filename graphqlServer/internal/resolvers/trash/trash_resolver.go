@@ -0,0 +1,47 @@
+package trash
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+type Resolver struct {
+	httpClient client.Client
+	trashConv  converters.TrashConverter
+}
+
+func NewResolver(client client.Client, converter converters.TrashConverter) *Resolver {
+	return &Resolver{
+		httpClient: client,
+		trashConv:  converter,
+	}
+}
+
+func (r *Resolver) Trash(ctx context.Context) ([]*graphql.TrashItem, error) {
+	log.C(ctx).Info("trashResolver called trash")
+	response, err := r.httpClient.Do(ctx, http.MethodGet, "/trash", nil)
+	if err != nil {
+		log.C(ctx).Errorf("error getting trash: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	var items []models.TrashItem
+	if err = json.Unmarshal(response, &items); err != nil {
+		log.C(ctx).Errorf("error unmarshalling response: %v", err)
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+
+	graphItems, err := r.trashConv.ConvertMultipleTrashItemsToGraphQL(items)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting trash items to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting trash items to graphql: %w", err)
+	}
+	return graphItems, nil
+}
@@ -0,0 +1,83 @@
+package trash_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/trash"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestTrash_TrashResolver(t *testing.T) {
+	deletedAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	inputItems := []models.TrashItem{
+		{Type: constants.TrashItemTodo, ID: "1", ListID: "2", Title: "Buy milk", DeletedAt: deletedAt},
+	}
+	expectedItems := []*graphql.TrashItem{
+		{Type: graphql.TrashItemTypeTodo, ID: "1", ListID: "2", Title: "Buy milk", DeletedAt: "2026-10-18T09:00:00Z"},
+	}
+
+	tests := []struct {
+		name           string
+		mockResp       []byte
+		mockErr        error
+		expectError    bool
+		expectItems    []*graphql.TrashItem
+		trashConverter func() *automock.TrashConverter
+	}{
+		{
+			name:        "successful trash listing",
+			mockResp:    []byte(`[{"type": "todo", "id": "1", "list_id": "2", "title": "Buy milk", "deleted_at": "2026-10-18T09:00:00Z"}]`),
+			expectItems: expectedItems,
+			trashConverter: func() *automock.TrashConverter {
+				trashConverter := &automock.TrashConverter{}
+				trashConverter.EXPECT().ConvertMultipleTrashItemsToGraphQL(inputItems).Return(expectedItems, nil)
+				return trashConverter
+			},
+		},
+		{
+			name:        "failed http request",
+			mockErr:     errors.New("failed to get trash"),
+			expectError: true,
+			trashConverter: func() *automock.TrashConverter {
+				return &automock.TrashConverter{}
+			},
+		},
+		{
+			name:        "failed to unmarshal response",
+			mockResp:    []byte(`invalid JSON`),
+			expectError: true,
+			trashConverter: func() *automock.TrashConverter {
+				return &automock.TrashConverter{}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+
+			mockClient.On("Do", mock.Anything, "GET", "/trash", mock.Anything).Return(tt.mockResp, tt.mockErr)
+			r := trash.NewResolver(mockClient, tt.trashConverter())
+
+			result, err := r.Trash(context.Background())
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectItems, result)
+			}
+
+			mockClient.AssertCalled(t, "Do", mock.Anything, "GET", "/trash", mock.Anything)
+		})
+	}
+}
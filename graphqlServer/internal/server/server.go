@@ -32,6 +32,7 @@ func NewServer(config client.APIConfig) *Server {
 	}
 
 	srv := handler.NewDefaultServer(graph.NewExecutableSchema(gqlCfg))
+	srv.SetErrorPresenter(resolvers.ErrorPresenter)
 	router := mux.NewRouter()
 	router.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_todos_parent_id;
+
+ALTER TABLE todos
+    DROP CONSTRAINT IF EXISTS check_parent_is_not_self;
+
+ALTER TABLE todos
+    DROP COLUMN IF EXISTS parent_id;
+
+COMMIT;
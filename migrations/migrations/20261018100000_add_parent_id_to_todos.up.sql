@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE todos
+    ADD COLUMN parent_id UUID REFERENCES todos(id) ON DELETE CASCADE;
+
+ALTER TABLE todos
+    ADD CONSTRAINT check_parent_is_not_self
+    CHECK ( parent_id IS NULL OR parent_id <> id );
+
+CREATE INDEX idx_todos_parent_id ON todos(parent_id);
+
+COMMIT;
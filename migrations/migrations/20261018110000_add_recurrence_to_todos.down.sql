@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE todos
+    DROP COLUMN IF EXISTS recurrence;
+
+COMMIT;
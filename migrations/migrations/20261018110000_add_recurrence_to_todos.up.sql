@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE todos
+    ADD COLUMN recurrence JSONB;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_todo_dependencies_blocked_by_id;
+
+DROP TABLE IF EXISTS todo_dependencies;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE todo_dependencies (
+    todo_id UUID REFERENCES todos(id) ON DELETE CASCADE,
+    blocked_by_id UUID REFERENCES todos(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (todo_id, blocked_by_id),
+    CONSTRAINT check_dependency_is_not_self CHECK ( todo_id <> blocked_by_id )
+);
+
+CREATE INDEX idx_todo_dependencies_blocked_by_id ON todo_dependencies(blocked_by_id);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE todos
+    ADD COLUMN completed_at TIMESTAMP;
+
+UPDATE todos
+SET completed_at = updated_at
+WHERE completed = TRUE;
+
+COMMIT;
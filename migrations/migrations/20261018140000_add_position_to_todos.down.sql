@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_todos_list_id_position;
+
+ALTER TABLE todos
+    DROP COLUMN IF EXISTS position;
+
+COMMIT;
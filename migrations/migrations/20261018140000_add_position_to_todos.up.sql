@@ -0,0 +1,16 @@
+BEGIN;
+
+ALTER TABLE todos
+    ADD COLUMN position VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE todos
+SET position = ranked.position
+FROM (
+    SELECT id, LPAD(TO_HEX(ROW_NUMBER() OVER (PARTITION BY list_id ORDER BY created_at, id)), 6, '0') AS position
+    FROM todos
+) AS ranked
+WHERE todos.id = ranked.id;
+
+CREATE INDEX idx_todos_list_id_position ON todos(list_id, position COLLATE "C");
+
+COMMIT;
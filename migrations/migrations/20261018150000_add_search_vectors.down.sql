@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_lists_search_vector;
+DROP INDEX IF EXISTS idx_todos_search_vector;
+
+ALTER TABLE lists
+    DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE todos
+    DROP COLUMN IF EXISTS search_vector;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+ALTER TABLE todos
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+    ) STORED;
+
+ALTER TABLE lists
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX idx_todos_search_vector ON todos USING GIN (search_vector);
+CREATE INDEX idx_lists_search_vector ON lists USING GIN (search_vector);
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE reminders (
+    id UUID PRIMARY KEY NOT NULL,
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    remind_at TIMESTAMP,
+    offset_minutes INTEGER CHECK (offset_minutes >= 0),
+    channel VARCHAR(50) NOT NULL CHECK (channel IN ('email', 'webhook')),
+    target TEXT NOT NULL DEFAULT '',
+    status VARCHAR(50) NOT NULL CHECK (status IN ('pending', 'sending', 'sent', 'failed')) DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP,
+    locked_until TIMESTAMP,
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK ((remind_at IS NULL) <> (offset_minutes IS NULL))
+);
+
+CREATE INDEX idx_reminders_todo_id ON reminders(todo_id);
+CREATE INDEX idx_reminders_undelivered ON reminders(status) WHERE status IN ('pending', 'sending');
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS comment_mentions;
+DROP TABLE IF EXISTS comments;
+
+COMMIT;
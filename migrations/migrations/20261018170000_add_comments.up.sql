@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE comments (
+    id UUID PRIMARY KEY NOT NULL,
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE comment_mentions (
+    comment_id UUID NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (comment_id, user_id)
+);
+
+CREATE INDEX idx_comments_todo_id ON comments(todo_id, created_at);
+CREATE INDEX idx_comment_mentions_user_id ON comment_mentions(user_id);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS attachments_record_orphan ON attachments;
+DROP FUNCTION IF EXISTS record_attachment_orphan();
+DROP TABLE IF EXISTS attachment_orphans;
+DROP TABLE IF EXISTS attachments;
+
+COMMIT;
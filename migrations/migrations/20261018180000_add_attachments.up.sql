@@ -0,0 +1,35 @@
+BEGIN;
+
+CREATE TABLE attachments (
+    id UUID PRIMARY KEY NOT NULL,
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    uploaded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL CHECK (size >= 0),
+    storage_key TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_attachments_todo_id ON attachments(todo_id);
+
+-- Blobs cannot be removed inside a transaction, so every deleted attachment,
+-- including those removed by cascades from todos and lists, leaves its storage
+-- key here until the blob janitor deletes the blob.
+CREATE TABLE attachment_orphans (
+    storage_key TEXT PRIMARY KEY NOT NULL,
+    deleted_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE FUNCTION record_attachment_orphan() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO attachment_orphans (storage_key) VALUES (OLD.storage_key) ON CONFLICT DO NOTHING;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER attachments_record_orphan
+    AFTER DELETE ON attachments
+    FOR EACH ROW EXECUTE FUNCTION record_attachment_orphan();
+
+COMMIT;
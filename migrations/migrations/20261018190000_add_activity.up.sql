@@ -0,0 +1,18 @@
+BEGIN;
+
+-- todo_id has no foreign key so the entry for a deleted todo stays in the
+-- history of its list. Deleting a list removes its whole history.
+CREATE TABLE activity (
+    id UUID PRIMARY KEY NOT NULL,
+    list_id UUID NOT NULL REFERENCES lists(id) ON DELETE CASCADE,
+    todo_id UUID,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    action VARCHAR(64) NOT NULL,
+    changes JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_activity_list_id ON activity(list_id, created_at DESC);
+CREATE INDEX idx_activity_todo_id ON activity(todo_id, created_at DESC) WHERE todo_id IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_todos_deleted_at;
+DROP INDEX IF EXISTS idx_lists_deleted_at;
+
+DELETE FROM todos WHERE deleted_at IS NOT NULL;
+DELETE FROM lists WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE todos
+    DROP COLUMN IF EXISTS deleted_at;
+
+ALTER TABLE lists
+    DROP COLUMN IF EXISTS deleted_at;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- Deleting a list or a todo only sets deleted_at. Todos trashed together with
+-- their list or parent share its deleted_at, which is how a restore finds the
+-- rows to bring back. The trash purger removes the rows for good once they are
+-- older than the retention period.
+ALTER TABLE lists
+    ADD COLUMN deleted_at TIMESTAMP;
+
+ALTER TABLE todos
+    ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX idx_lists_deleted_at ON lists(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_todos_deleted_at ON todos(deleted_at) WHERE deleted_at IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS time_entries;
+
+ALTER TABLE todos DROP COLUMN IF EXISTS estimate_minutes;
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+ALTER TABLE todos ADD COLUMN estimate_minutes INTEGER CHECK (estimate_minutes >= 0);
+
+CREATE TABLE time_entries (
+    id UUID PRIMARY KEY NOT NULL,
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    started_at TIMESTAMP NOT NULL,
+    ended_at TIMESTAMP,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (ended_at IS NULL OR ended_at >= started_at)
+);
+
+-- An entry without ended_at is a running timer; a user can have at most one.
+CREATE UNIQUE INDEX idx_time_entries_running ON time_entries(user_id) WHERE ended_at IS NULL;
+CREATE INDEX idx_time_entries_todo_id ON time_entries(todo_id, started_at);
+CREATE INDEX idx_time_entries_user_id ON time_entries(user_id, started_at);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE todos DROP COLUMN IF EXISTS custom_fields;
+
+DROP TABLE IF EXISTS list_custom_fields;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE list_custom_fields (
+    id UUID PRIMARY KEY NOT NULL,
+    list_id UUID NOT NULL REFERENCES lists(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('text', 'number', 'date', 'single_select', 'checkbox')),
+    options JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (list_id, name)
+);
+
+-- Values are keyed by the ID of the field they belong to.
+ALTER TABLE todos ADD COLUMN custom_fields JSONB;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+ALTER TABLE todos ADD COLUMN tags jsonb;
+ALTER TABLE lists ADD COLUMN tags jsonb;
+
+UPDATE todos SET tags = todo_tag_names(id);
+UPDATE lists SET tags = list_tag_names(id);
+
+DROP FUNCTION IF EXISTS todo_tag_names(UUID);
+DROP FUNCTION IF EXISTS list_tag_names(UUID);
+
+DROP TABLE IF EXISTS todo_tags;
+DROP TABLE IF EXISTS list_tags;
+DROP TABLE IF EXISTS tags;
+
+COMMIT;
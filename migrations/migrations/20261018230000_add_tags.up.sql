@@ -0,0 +1,89 @@
+BEGIN;
+
+CREATE TABLE tags (
+    id UUID PRIMARY KEY NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    color VARCHAR(7),
+    description TEXT,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    list_id UUID REFERENCES lists(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK ((user_id IS NULL) <> (list_id IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_tags_user_name ON tags(user_id, lower(name)) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_tags_list_name ON tags(list_id, lower(name)) WHERE list_id IS NOT NULL;
+
+CREATE TABLE todo_tags (
+    todo_id UUID REFERENCES todos(id) ON DELETE CASCADE,
+    tag_id UUID REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (todo_id, tag_id)
+);
+
+CREATE TABLE list_tags (
+    list_id UUID REFERENCES lists(id) ON DELETE CASCADE,
+    tag_id UUID REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (list_id, tag_id)
+);
+
+CREATE INDEX idx_todo_tags_tag_id ON todo_tags(tag_id);
+CREATE INDEX idx_list_tags_tag_id ON list_tags(tag_id);
+
+-- Tags of a list become personal tags of its owner and tags of a todo become
+-- tags of the todo's list. Names are matched case-insensitively.
+CREATE TEMPORARY TABLE legacy_list_tags ON COMMIT DROP AS
+SELECT DISTINCT l.id AS list_id, l.owner_id, LEFT(TRIM(tag.name), 64) AS name
+FROM lists l
+CROSS JOIN LATERAL jsonb_array_elements_text(
+    CASE WHEN jsonb_typeof(l.tags) = 'array' THEN l.tags ELSE '[]'::jsonb END
+) AS tag(name)
+WHERE l.owner_id IS NOT NULL AND TRIM(tag.name) <> '';
+
+CREATE TEMPORARY TABLE legacy_todo_tags ON COMMIT DROP AS
+SELECT DISTINCT t.id AS todo_id, t.list_id, LEFT(TRIM(tag.name), 64) AS name
+FROM todos t
+CROSS JOIN LATERAL jsonb_array_elements_text(
+    CASE WHEN jsonb_typeof(t.tags) = 'array' THEN t.tags ELSE '[]'::jsonb END
+) AS tag(name)
+WHERE t.list_id IS NOT NULL AND TRIM(tag.name) <> '';
+
+INSERT INTO tags (id, name, user_id)
+SELECT gen_random_uuid(), MIN(name), owner_id
+FROM legacy_list_tags
+GROUP BY owner_id, lower(name);
+
+INSERT INTO tags (id, name, list_id)
+SELECT gen_random_uuid(), MIN(name), list_id
+FROM legacy_todo_tags
+GROUP BY list_id, lower(name);
+
+INSERT INTO list_tags (list_id, tag_id)
+SELECT DISTINCT n.list_id, g.id
+FROM legacy_list_tags n
+JOIN tags g ON g.user_id = n.owner_id AND lower(g.name) = lower(n.name);
+
+INSERT INTO todo_tags (todo_id, tag_id)
+SELECT DISTINCT n.todo_id, g.id
+FROM legacy_todo_tags n
+JOIN tags g ON g.list_id = n.list_id AND lower(g.name) = lower(n.name);
+
+ALTER TABLE todos DROP COLUMN tags;
+ALTER TABLE lists DROP COLUMN tags;
+
+-- The tag names of a todo or list as a JSON array, so the tags can still be
+-- read as a column.
+CREATE FUNCTION todo_tag_names(UUID) RETURNS jsonb AS $$
+    SELECT jsonb_agg(g.name ORDER BY lower(g.name))
+    FROM todo_tags tt
+    JOIN tags g ON g.id = tt.tag_id
+    WHERE tt.todo_id = $1
+$$ LANGUAGE SQL STABLE;
+
+CREATE FUNCTION list_tag_names(UUID) RETURNS jsonb AS $$
+    SELECT jsonb_agg(g.name ORDER BY lower(g.name))
+    FROM list_tags lt
+    JOIN tags g ON g.id = lt.tag_id
+    WHERE lt.list_id = $1
+$$ LANGUAGE SQL STABLE;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS list_status_for(UUID, UUID, TEXT);
+
+ALTER TABLE todos DROP COLUMN IF EXISTS status_id;
+
+DROP TABLE IF EXISTS list_statuses;
+
+COMMIT;
@@ -0,0 +1,43 @@
+BEGIN;
+
+-- Statuses are the columns of the board of a list. Todos in a done status are
+-- completed, todos in an open status are not.
+CREATE TABLE list_statuses (
+    id UUID PRIMARY KEY NOT NULL,
+    list_id UUID NOT NULL REFERENCES lists(id) ON DELETE CASCADE,
+    name VARCHAR(64) NOT NULL,
+    category TEXT NOT NULL CHECK (category IN ('open', 'done')),
+    position INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX list_statuses_list_name_idx ON list_statuses (list_id, lower(name));
+
+INSERT INTO list_statuses (id, list_id, name, category, position)
+SELECT gen_random_uuid(), l.id, s.name, s.category, s.position
+FROM lists l
+CROSS JOIN (VALUES ('To do', 'open', 0), ('In progress', 'open', 1), ('Done', 'done', 2)) AS s(name, category, position);
+
+ALTER TABLE todos ADD COLUMN status_id UUID REFERENCES list_statuses(id);
+
+UPDATE todos t
+SET status_id = s.id
+FROM list_statuses s
+WHERE s.list_id = t.list_id AND s.position = CASE WHEN t.completed THEN 2 ELSE 0 END;
+
+ALTER TABLE todos ALTER COLUMN status_id SET NOT NULL;
+
+CREATE INDEX todos_status_id_idx ON todos (status_id);
+
+-- list_status_for returns the given status when it belongs to the list and is
+-- in the category, and the first status of the list in the category otherwise.
+-- It keeps the status of a todo in line with its completed flag.
+CREATE FUNCTION list_status_for(UUID, UUID, TEXT) RETURNS UUID AS $$
+    SELECT id
+    FROM list_statuses
+    WHERE list_id = $1 AND category = $3
+    ORDER BY COALESCE(id = $2, false) DESC, position, id
+    LIMIT 1
+$$ LANGUAGE SQL STABLE;
+
+COMMIT;
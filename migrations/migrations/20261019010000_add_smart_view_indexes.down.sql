@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_todos_open_undated;
+DROP INDEX IF EXISTS idx_todos_open_due_date;
+DROP INDEX IF EXISTS idx_list_access_user_id;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+-- The smart views list the open todos of every list a user can access by due
+-- date, or those without one for the inbox, and are polled by the clients.
+CREATE INDEX idx_list_access_user_id ON list_access(user_id) WHERE status IN ('owner', 'accepted');
+CREATE INDEX idx_todos_open_due_date ON todos(due_date) WHERE completed = FALSE AND deleted_at IS NULL;
+CREATE INDEX idx_todos_open_undated ON todos(list_id, created_at) WHERE due_date IS NULL AND completed = FALSE AND deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE saved_filters (
+    id UUID PRIMARY KEY NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    expression TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_saved_filters_user_name ON saved_filters(user_id, lower(name));
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS increment_lists_version ON lists;
+DROP TRIGGER IF EXISTS increment_todos_version ON todos;
+
+DROP FUNCTION IF EXISTS increment_version();
+
+ALTER TABLE lists DROP COLUMN IF EXISTS version;
+ALTER TABLE todos DROP COLUMN IF EXISTS version;
+
+COMMIT;
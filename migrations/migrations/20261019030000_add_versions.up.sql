@@ -0,0 +1,24 @@
+BEGIN;
+
+ALTER TABLE todos ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE lists ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE FUNCTION increment_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER increment_lists_version
+    BEFORE UPDATE ON lists
+    FOR EACH ROW
+EXECUTE FUNCTION increment_version();
+
+CREATE OR REPLACE TRIGGER increment_todos_version
+    BEFORE UPDATE ON todos
+    FOR EACH ROW
+EXECUTE FUNCTION increment_version();
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE list_templates (
+    id UUID PRIMARY KEY NOT NULL,
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    tags JSONB,
+    todos JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_list_templates_owner_id ON list_templates(owner_id);
+
+COMMIT;
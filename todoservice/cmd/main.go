@@ -3,10 +3,15 @@ package main
 import (
 	"context"
 	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
 	database "github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/reminders"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/trash"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/time"
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
 )
@@ -41,6 +46,56 @@ func main() {
 	if err = envconfig.Process("", &oauth2Config); err != nil {
 		fmt.Printf("Error on setup oauth2 config %+v", err)
 	}
-	restServer := http.NewServer(db, oauth2Config)
+	var workerConfig reminders.WorkerConfig
+	if err = envconfig.Process("", &workerConfig); err != nil {
+		fmt.Printf("Error on setup reminder worker config %+v", err)
+		return
+	}
+	var smtpConfig reminders.SMTPConfig
+	if err = envconfig.Process("", &smtpConfig); err != nil {
+		fmt.Printf("Error on setup smtp config %+v", err)
+		return
+	}
+	var webhookConfig reminders.WebhookConfig
+	if err = envconfig.Process("", &webhookConfig); err != nil {
+		fmt.Printf("Error on setup webhook config %+v", err)
+		return
+	}
+	notifiers := map[constants.ReminderChannel]reminders.Notifier{
+		constants.ReminderChannelWebhook: reminders.NewWebhookNotifier(webhookConfig),
+	}
+	if smtpConfig.Host != "" {
+		notifiers[constants.ReminderChannelEmail] = reminders.NewSMTPNotifier(smtpConfig)
+	}
+	reminderWorker := reminders.NewWorker(db, reminders.NewSQLXReminderRepository(), notifiers, time.Time{}, workerConfig)
+	go reminderWorker.Run(ctx)
+
+	var attachmentConfig attachments.Config
+	if err = envconfig.Process("", &attachmentConfig); err != nil {
+		fmt.Printf("Error on setup attachments config %+v", err)
+		return
+	}
+	var janitorConfig attachments.JanitorConfig
+	if err = envconfig.Process("", &janitorConfig); err != nil {
+		fmt.Printf("Error on setup attachment janitor config %+v", err)
+		return
+	}
+	blobStore, err := attachments.NewLocalBlobStore(attachmentConfig.Dir)
+	if err != nil {
+		log.C(ctx).Fatal(err)
+		return
+	}
+	attachmentJanitor := attachments.NewJanitor(db, attachments.NewSQLXAttachmentRepository(), blobStore, janitorConfig)
+	go attachmentJanitor.Run(ctx)
+
+	var purgerConfig trash.PurgerConfig
+	if err = envconfig.Process("", &purgerConfig); err != nil {
+		fmt.Printf("Error on setup trash purger config %+v", err)
+		return
+	}
+	trashPurger := trash.NewPurger(db, trash.NewSQLXTrashRepository(), time.Time{}, purgerConfig)
+	go trashPurger.Run(ctx)
+
+	restServer := http.NewServer(db, oauth2Config, blobStore, attachmentConfig)
 	restServer.Start()
 }
@@ -0,0 +1,104 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+//go:generate mockery --name=ActivityRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type ActivityRepository interface {
+	Create(ctx context.Context, activity models.Activity) error
+	GetByListID(ctx context.Context, listID string, limit int) ([]models.Activity, error)
+	GetByTodoID(ctx context.Context, todoID string, limit int) ([]models.Activity, error)
+}
+
+const selectActivity = `
+	SELECT id, list_id, todo_id, actor_id, action, changes, created_at
+	FROM activity
+`
+
+type SQLXActivityRepository struct {
+	converter *Converter
+}
+
+var _ ActivityRepository = &SQLXActivityRepository{}
+
+func NewSQLXActivityRepository() ActivityRepository {
+	return &SQLXActivityRepository{converter: NewConverter()}
+}
+
+func (r *SQLXActivityRepository) Create(ctx context.Context, activity models.Activity) error {
+	log.C(ctx).Info("creating activity repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	entity, err := r.converter.ConvertActivityToEntity(activity)
+	if err != nil {
+		return err
+	}
+	if err = pkg.ValidateUUID(entity.ID); err != nil {
+		log.C(ctx).Errorf("invalid entity id: %v", err)
+		return fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	query := `
+		INSERT INTO activity (id, list_id, todo_id, actor_id, action, changes, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err = tx.ExecContext(ctx, query,
+		entity.ID,
+		entity.ListID,
+		entity.TodoID,
+		entity.ActorID,
+		entity.Action,
+		entity.Changes,
+		entity.CreatedAt,
+	)
+	if err != nil {
+		log.C(ctx).Errorf("failed to insert activity: %v", err)
+		return fmt.Errorf("failed to create activity: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXActivityRepository) GetByListID(ctx context.Context, listID string, limit int) ([]models.Activity, error) {
+	log.C(ctx).Info("getting activity by list id repository")
+	return r.getActivity(ctx, selectActivity+` WHERE list_id = $1 ORDER BY created_at DESC, id LIMIT $2`, listID, limit)
+}
+
+func (r *SQLXActivityRepository) GetByTodoID(ctx context.Context, todoID string, limit int) ([]models.Activity, error) {
+	log.C(ctx).Info("getting activity by todo id repository")
+	return r.getActivity(ctx, selectActivity+` WHERE todo_id = $1 ORDER BY created_at DESC, id LIMIT $2`, todoID, limit)
+}
+
+func (r *SQLXActivityRepository) getActivity(ctx context.Context, query string, args ...interface{}) ([]models.Activity, error) {
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	var entities []Entity
+	if err = tx.SelectContext(ctx, &entities, query, args...); err != nil {
+		log.C(ctx).Errorf("failed to get activity: %v", err)
+		return nil, fmt.Errorf("failed to get activity: %w", err)
+	}
+
+	activities := make([]models.Activity, 0, len(entities))
+	for _, entity := range entities {
+		activity, err := r.converter.ConvertActivityToModel(entity)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, activity)
+	}
+	return activities, nil
+}
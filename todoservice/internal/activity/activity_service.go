@@ -0,0 +1,92 @@
+package activity
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"time"
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+//go:generate mockery --name=ActivityService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type ActivityService interface {
+	Record(ctx context.Context, action constants.ActivityAction, listID string, todoID *string, before, after any) error
+	ListByList(ctx context.Context, listID string, limit int) ([]models.Activity, error)
+	ListByTodo(ctx context.Context, todoID string, limit int) ([]models.Activity, error)
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ ActivityService = &service{}
+
+type service struct {
+	repo        ActivityRepository
+	uuidService UUIDService
+	timeService TimeService
+}
+
+func NewService(repo ActivityRepository, uuidService UUIDService, timeService TimeService) ActivityService {
+	return &service{repo: repo, uuidService: uuidService, timeService: timeService}
+}
+
+// Record stores the action together with the fields that differ between
+// before and after. The actor is the authenticated user of the request. It
+// uses the transaction in ctx, so the entry is only kept if the change itself
+// is committed. Updates that did not change any field are not recorded.
+func (s *service) Record(ctx context.Context, action constants.ActivityAction, listID string, todoID *string, before, after any) error {
+	log.C(ctx).Infof("recording %s activity service", action)
+	changes, err := Diff(before, after)
+	if err != nil {
+		return err
+	}
+	if before != nil && after != nil && len(changes) == 0 {
+		return nil
+	}
+
+	var actorID *string
+	if userID, ok := ctx.Value("user_id").(string); ok && userID != "" {
+		actorID = &userID
+	}
+	return s.repo.Create(ctx, models.Activity{
+		ID:        s.uuidService.Generate(),
+		ListID:    listID,
+		TodoID:    todoID,
+		ActorID:   actorID,
+		Action:    action,
+		Changes:   changes,
+		CreatedAt: s.timeService.Now(),
+	})
+}
+
+func (s *service) ListByList(ctx context.Context, listID string, limit int) ([]models.Activity, error) {
+	log.C(ctx).Info("listing list activity service")
+	return s.repo.GetByListID(ctx, listID, normalizeLimit(limit))
+}
+
+func (s *service) ListByTodo(ctx context.Context, todoID string, limit int) ([]models.Activity, error) {
+	log.C(ctx).Info("listing todo activity service")
+	return s.repo.GetByTodoID(ctx, todoID, normalizeLimit(limit))
+}
+
+func normalizeLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
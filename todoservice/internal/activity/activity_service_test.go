@@ -0,0 +1,85 @@
+package activity_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/activity"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/activity/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestServiceRecord(t *testing.T) {
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	actor := "user"
+	todoID := "todo"
+	userCtx := context.WithValue(context.Background(), "user_id", actor)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		before any
+		after  any
+		repo   func() *automock.ActivityRepository
+	}{
+		{
+			name:   "Record change made by the user of the request",
+			ctx:    userCtx,
+			before: models.Todo{ID: todoID, Title: "Old"},
+			after:  models.Todo{ID: todoID, Title: "New"},
+			repo: func() *automock.ActivityRepository {
+				repo := &automock.ActivityRepository{}
+				repo.EXPECT().Create(userCtx, models.Activity{
+					ID:        "1",
+					ListID:    "list",
+					TodoID:    &todoID,
+					ActorID:   &actor,
+					Action:    constants.ActivityTodoUpdated,
+					Changes:   []models.FieldChange{{Field: "title", Before: json.RawMessage(`"Old"`), After: json.RawMessage(`"New"`)}},
+					CreatedAt: now,
+				}).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:   "Record change without a user",
+			ctx:    context.Background(),
+			before: nil,
+			after:  models.Todo{ID: todoID, Title: "New"},
+			repo: func() *automock.ActivityRepository {
+				repo := &automock.ActivityRepository{}
+				repo.EXPECT().Create(context.Background(), mock.MatchedBy(func(entry models.Activity) bool {
+					return entry.ActorID == nil && len(entry.Changes) > 0
+				})).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:   "Skip update that changed nothing",
+			ctx:    userCtx,
+			before: models.Todo{ID: todoID, Title: "Same"},
+			after:  models.Todo{ID: todoID, Title: "Same"},
+			repo: func() *automock.ActivityRepository {
+				return &automock.ActivityRepository{}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return("1").Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := activity.NewService(repo, uuidService, timeService)
+			err := svc.Record(tt.ctx, constants.ActivityTodoUpdated, "list", &todoID, tt.before, tt.after)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ActivityRepository is an autogenerated mock type for the ActivityRepository type
+type ActivityRepository struct {
+	mock.Mock
+}
+
+type ActivityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ActivityRepository) EXPECT() *ActivityRepository_Expecter {
+	return &ActivityRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *ActivityRepository) Create(ctx context.Context, _a1 models.Activity) error {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Activity) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ActivityRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ActivityRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 models.Activity
+func (_e *ActivityRepository_Expecter) Create(ctx interface{}, _a1 interface{}) *ActivityRepository_Create_Call {
+	return &ActivityRepository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *ActivityRepository_Create_Call) Run(run func(ctx context.Context, _a1 models.Activity)) *ActivityRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Activity))
+	})
+	return _c
+}
+
+func (_c *ActivityRepository_Create_Call) Return(_a0 error) *ActivityRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ActivityRepository_Create_Call) RunAndReturn(run func(context.Context, models.Activity) error) *ActivityRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByListID provides a mock function with given fields: ctx, listID, limit
+func (_m *ActivityRepository) GetByListID(ctx context.Context, listID string, limit int) ([]models.Activity, error) {
+	ret := _m.Called(ctx, listID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByListID")
+	}
+
+	var r0 []models.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]models.Activity, error)); ok {
+		return rf(ctx, listID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []models.Activity); ok {
+		r0 = rf(ctx, listID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, listID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivityRepository_GetByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByListID'
+type ActivityRepository_GetByListID_Call struct {
+	*mock.Call
+}
+
+// GetByListID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - limit int
+func (_e *ActivityRepository_Expecter) GetByListID(ctx interface{}, listID interface{}, limit interface{}) *ActivityRepository_GetByListID_Call {
+	return &ActivityRepository_GetByListID_Call{Call: _e.mock.On("GetByListID", ctx, listID, limit)}
+}
+
+func (_c *ActivityRepository_GetByListID_Call) Run(run func(ctx context.Context, listID string, limit int)) *ActivityRepository_GetByListID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ActivityRepository_GetByListID_Call) Return(_a0 []models.Activity, _a1 error) *ActivityRepository_GetByListID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ActivityRepository_GetByListID_Call) RunAndReturn(run func(context.Context, string, int) ([]models.Activity, error)) *ActivityRepository_GetByListID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTodoID provides a mock function with given fields: ctx, todoID, limit
+func (_m *ActivityRepository) GetByTodoID(ctx context.Context, todoID string, limit int) ([]models.Activity, error) {
+	ret := _m.Called(ctx, todoID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTodoID")
+	}
+
+	var r0 []models.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]models.Activity, error)); ok {
+		return rf(ctx, todoID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []models.Activity); ok {
+		r0 = rf(ctx, todoID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, todoID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivityRepository_GetByTodoID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTodoID'
+type ActivityRepository_GetByTodoID_Call struct {
+	*mock.Call
+}
+
+// GetByTodoID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - limit int
+func (_e *ActivityRepository_Expecter) GetByTodoID(ctx interface{}, todoID interface{}, limit interface{}) *ActivityRepository_GetByTodoID_Call {
+	return &ActivityRepository_GetByTodoID_Call{Call: _e.mock.On("GetByTodoID", ctx, todoID, limit)}
+}
+
+func (_c *ActivityRepository_GetByTodoID_Call) Run(run func(ctx context.Context, todoID string, limit int)) *ActivityRepository_GetByTodoID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ActivityRepository_GetByTodoID_Call) Return(_a0 []models.Activity, _a1 error) *ActivityRepository_GetByTodoID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ActivityRepository_GetByTodoID_Call) RunAndReturn(run func(context.Context, string, int) ([]models.Activity, error)) *ActivityRepository_GetByTodoID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewActivityRepository creates a new instance of ActivityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewActivityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ActivityRepository {
+	mock := &ActivityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,211 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	constants "github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// ActivityService is an autogenerated mock type for the ActivityService type
+type ActivityService struct {
+	mock.Mock
+}
+
+type ActivityService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ActivityService) EXPECT() *ActivityService_Expecter {
+	return &ActivityService_Expecter{mock: &_m.Mock}
+}
+
+// ListByList provides a mock function with given fields: ctx, listID, limit
+func (_m *ActivityService) ListByList(ctx context.Context, listID string, limit int) ([]models.Activity, error) {
+	ret := _m.Called(ctx, listID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByList")
+	}
+
+	var r0 []models.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]models.Activity, error)); ok {
+		return rf(ctx, listID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []models.Activity); ok {
+		r0 = rf(ctx, listID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, listID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivityService_ListByList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByList'
+type ActivityService_ListByList_Call struct {
+	*mock.Call
+}
+
+// ListByList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - limit int
+func (_e *ActivityService_Expecter) ListByList(ctx interface{}, listID interface{}, limit interface{}) *ActivityService_ListByList_Call {
+	return &ActivityService_ListByList_Call{Call: _e.mock.On("ListByList", ctx, listID, limit)}
+}
+
+func (_c *ActivityService_ListByList_Call) Run(run func(ctx context.Context, listID string, limit int)) *ActivityService_ListByList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ActivityService_ListByList_Call) Return(_a0 []models.Activity, _a1 error) *ActivityService_ListByList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ActivityService_ListByList_Call) RunAndReturn(run func(context.Context, string, int) ([]models.Activity, error)) *ActivityService_ListByList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByTodo provides a mock function with given fields: ctx, todoID, limit
+func (_m *ActivityService) ListByTodo(ctx context.Context, todoID string, limit int) ([]models.Activity, error) {
+	ret := _m.Called(ctx, todoID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByTodo")
+	}
+
+	var r0 []models.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]models.Activity, error)); ok {
+		return rf(ctx, todoID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []models.Activity); ok {
+		r0 = rf(ctx, todoID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, todoID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivityService_ListByTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByTodo'
+type ActivityService_ListByTodo_Call struct {
+	*mock.Call
+}
+
+// ListByTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - limit int
+func (_e *ActivityService_Expecter) ListByTodo(ctx interface{}, todoID interface{}, limit interface{}) *ActivityService_ListByTodo_Call {
+	return &ActivityService_ListByTodo_Call{Call: _e.mock.On("ListByTodo", ctx, todoID, limit)}
+}
+
+func (_c *ActivityService_ListByTodo_Call) Run(run func(ctx context.Context, todoID string, limit int)) *ActivityService_ListByTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ActivityService_ListByTodo_Call) Return(_a0 []models.Activity, _a1 error) *ActivityService_ListByTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ActivityService_ListByTodo_Call) RunAndReturn(run func(context.Context, string, int) ([]models.Activity, error)) *ActivityService_ListByTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: ctx, action, listID, todoID, before, after
+func (_m *ActivityService) Record(ctx context.Context, action constants.ActivityAction, listID string, todoID *string, before interface{}, after interface{}) error {
+	ret := _m.Called(ctx, action, listID, todoID, before, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, constants.ActivityAction, string, *string, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, action, listID, todoID, before, after)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ActivityService_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type ActivityService_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - action constants.ActivityAction
+//   - listID string
+//   - todoID *string
+//   - before interface{}
+//   - after interface{}
+func (_e *ActivityService_Expecter) Record(ctx interface{}, action interface{}, listID interface{}, todoID interface{}, before interface{}, after interface{}) *ActivityService_Record_Call {
+	return &ActivityService_Record_Call{Call: _e.mock.On("Record", ctx, action, listID, todoID, before, after)}
+}
+
+func (_c *ActivityService_Record_Call) Run(run func(ctx context.Context, action constants.ActivityAction, listID string, todoID *string, before interface{}, after interface{})) *ActivityService_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(constants.ActivityAction), args[2].(string), args[3].(*string), args[4].(interface{}), args[5].(interface{}))
+	})
+	return _c
+}
+
+func (_c *ActivityService_Record_Call) Return(_a0 error) *ActivityService_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ActivityService_Record_Call) RunAndReturn(run func(context.Context, constants.ActivityAction, string, *string, interface{}, interface{}) error) *ActivityService_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewActivityService creates a new instance of ActivityService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewActivityService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ActivityService {
+	mock := &ActivityService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
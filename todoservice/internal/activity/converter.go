@@ -0,0 +1,52 @@
+package activity
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertActivityToModel(entity Entity) (models.Activity, error) {
+	changes := []models.FieldChange{}
+	if len(entity.Changes) > 0 {
+		if err := json.Unmarshal(entity.Changes, &changes); err != nil {
+			return models.Activity{}, fmt.Errorf("failed to decode activity changes: %w", err)
+		}
+	}
+	return models.Activity{
+		ID:        entity.ID,
+		ListID:    entity.ListID,
+		TodoID:    entity.TodoID,
+		ActorID:   entity.ActorID,
+		Action:    constants.ActivityAction(entity.Action),
+		Changes:   changes,
+		CreatedAt: entity.CreatedAt,
+	}, nil
+}
+
+func (c *Converter) ConvertActivityToEntity(activity models.Activity) (Entity, error) {
+	changes := activity.Changes
+	if changes == nil {
+		changes = []models.FieldChange{}
+	}
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return Entity{}, fmt.Errorf("failed to encode activity changes: %w", err)
+	}
+	return Entity{
+		ID:        activity.ID,
+		ListID:    activity.ListID,
+		TodoID:    activity.TodoID,
+		ActorID:   activity.ActorID,
+		Action:    string(activity.Action),
+		Changes:   encoded,
+		CreatedAt: activity.CreatedAt,
+	}, nil
+}
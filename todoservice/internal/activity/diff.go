@@ -0,0 +1,77 @@
+package activity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"sort"
+)
+
+// ignoredFields are JSON fields that change as a side effect of every
+// mutation or are derived from other rows, so they would only add noise.
+var ignoredFields = map[string]bool{
+	"id":               true,
+	"creation_date":    true,
+	"last_update_date": true,
+	"subtasks":         true,
+	"shared_with":      true,
+}
+
+// Diff compares the JSON representation of before and after field by field
+// and returns the changed fields sorted by name. Either side may be nil, for
+// example when something was created or deleted.
+func Diff(before, after any) ([]models.FieldChange, error) {
+	beforeFields, err := jsonFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := jsonFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(beforeFields)+len(afterFields))
+	for name := range beforeFields {
+		names = append(names, name)
+	}
+	for name := range afterFields {
+		if _, ok := beforeFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := make([]models.FieldChange, 0)
+	for _, name := range names {
+		if ignoredFields[name] {
+			continue
+		}
+		oldValue, newValue := beforeFields[name], afterFields[name]
+		if bytes.Equal(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, models.FieldChange{Field: name, Before: oldValue, After: newValue})
+	}
+	return changes, nil
+}
+
+func jsonFields(value any) (map[string]json.RawMessage, error) {
+	if value == nil {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode activity value: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(encoded, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode activity value: %w", err)
+	}
+	for name, field := range fields {
+		if string(field) == "null" {
+			delete(fields, name)
+		}
+	}
+	return fields, nil
+}
@@ -0,0 +1,66 @@
+package activity_test
+
+import (
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/activity"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	assignee := "alice"
+	before := models.Todo{
+		ID:        "1",
+		ListID:    "list",
+		Title:     "Pay rent",
+		Tags:      json.RawMessage(`["home"]`),
+		Priority:  constants.PriorityLow,
+		UpdatedAt: time.Date(2026, time.October, 17, 9, 0, 0, 0, time.UTC),
+	}
+	after := before
+	after.Title = "Pay rent and bills"
+	after.Tags = json.RawMessage(`[ "home" ]`)
+	after.AssignedTo = &assignee
+	after.UpdatedAt = time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   any
+		after    any
+		expected []models.FieldChange
+	}{
+		{
+			name:   "Only changed fields are reported in name order",
+			before: before,
+			after:  after,
+			expected: []models.FieldChange{
+				{Field: "assigned_to", After: json.RawMessage(`"alice"`)},
+				{Field: "title", Before: json.RawMessage(`"Pay rent"`), After: json.RawMessage(`"Pay rent and bills"`)},
+			},
+		},
+		{
+			name:     "No changes",
+			before:   before,
+			after:    before,
+			expected: []models.FieldChange{},
+		},
+		{
+			name:   "Removed value has no after",
+			before: map[string]string{"blocked_by": "2"},
+			expected: []models.FieldChange{
+				{Field: "blocked_by", Before: json.RawMessage(`"2"`)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := activity.Diff(tt.before, tt.after)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, changes)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package activity
+
+import "time"
+
+type Entity struct {
+	ID        string    `db:"id"`
+	ListID    string    `db:"list_id"`
+	TodoID    *string   `db:"todo_id"`
+	ActorID   *string   `db:"actor_id"`
+	Action    string    `db:"action"`
+	Changes   []byte    `db:"changes"`
+	CreatedAt time.Time `db:"created_at"`
+}
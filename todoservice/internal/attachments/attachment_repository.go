@@ -0,0 +1,183 @@
+package attachments
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+)
+
+//go:generate mockery --name=AttachmentRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment models.Attachment) (string, error)
+	Get(ctx context.Context, id string, todoID string) (models.Attachment, error)
+	GetByTodoID(ctx context.Context, todoID string) ([]models.Attachment, error)
+	Delete(ctx context.Context, id string, todoID string) error
+	ClaimOrphans(ctx context.Context, limit int) ([]string, error)
+	DeleteOrphans(ctx context.Context, storageKeys []string) error
+}
+
+const selectAttachments = `
+	SELECT id, todo_id, uploaded_by, filename, content_type, size, storage_key, created_at
+	FROM attachments
+`
+
+type SQLXAttachmentRepository struct {
+	converter *Converter
+}
+
+var _ AttachmentRepository = &SQLXAttachmentRepository{}
+
+func NewSQLXAttachmentRepository() AttachmentRepository {
+	return &SQLXAttachmentRepository{converter: NewConverter()}
+}
+
+func (r *SQLXAttachmentRepository) Create(ctx context.Context, attachment models.Attachment) (string, error) {
+	log.C(ctx).Info("creating attachment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertAttachmentToEntity(attachment)
+	if err = pkg.ValidateUUID(entity.ID); err != nil {
+		log.C(ctx).Errorf("invalid entity id: %v", err)
+		return "", fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	query := `
+		INSERT INTO attachments (id, todo_id, uploaded_by, filename, content_type, size, storage_key, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query,
+		entity.ID,
+		entity.TodoID,
+		entity.UploadedBy,
+		entity.Filename,
+		entity.ContentType,
+		entity.Size,
+		entity.StorageKey,
+		entity.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to insert attachment: %v", err)
+		return "", fmt.Errorf("failed to create attachment: %w", err)
+	}
+	return id, nil
+}
+
+func (r *SQLXAttachmentRepository) Get(ctx context.Context, id string, todoID string) (models.Attachment, error) {
+	log.C(ctx).Info("getting attachment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Attachment{}, err
+	}
+
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, selectAttachments+` WHERE id = $1 AND todo_id = $2`, id, todoID)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.C(ctx).Errorf("attachment with id %s not found", id)
+		return models.Attachment{}, fmt.Errorf("attachment not found: %w", err)
+	}
+	if err != nil {
+		log.C(ctx).Errorf("failed to get attachment: %v", err)
+		return models.Attachment{}, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	return r.converter.ConvertAttachmentToModel(entity), nil
+}
+
+func (r *SQLXAttachmentRepository) GetByTodoID(ctx context.Context, todoID string) ([]models.Attachment, error) {
+	log.C(ctx).Info("getting attachments by todo id repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	var entities []Entity
+	if err = tx.SelectContext(ctx, &entities, selectAttachments+` WHERE todo_id = $1 ORDER BY created_at, id`, todoID); err != nil {
+		log.C(ctx).Errorf("failed to get attachments for todo %s: %v", todoID, err)
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	attachments := make([]models.Attachment, 0, len(entities))
+	for _, entity := range entities {
+		attachments = append(attachments, r.converter.ConvertAttachmentToModel(entity))
+	}
+	return attachments, nil
+}
+
+// Delete removes the attachment metadata. The trigger on the attachments
+// table records the storage key so the janitor removes the blob later.
+func (r *SQLXAttachmentRepository) Delete(ctx context.Context, id string, todoID string) error {
+	log.C(ctx).Info("deleting attachment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM attachments WHERE id = $1 AND todo_id = $2`, id, todoID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to delete attachment: %v", err)
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("attachment not found: %w", sql.ErrNoRows)
+	}
+	return nil
+}
+
+// ClaimOrphans locks up to limit storage keys of deleted attachments. Rows
+// locked by another janitor are skipped.
+func (r *SQLXAttachmentRepository) ClaimOrphans(ctx context.Context, limit int) ([]string, error) {
+	log.C(ctx).Info("claiming attachment orphans repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT storage_key FROM attachment_orphans
+		ORDER BY deleted_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	var keys []string
+	if err = tx.SelectContext(ctx, &keys, query, limit); err != nil {
+		log.C(ctx).Errorf("failed to claim attachment orphans: %v", err)
+		return nil, fmt.Errorf("failed to claim attachment orphans: %w", err)
+	}
+	return keys, nil
+}
+
+func (r *SQLXAttachmentRepository) DeleteOrphans(ctx context.Context, storageKeys []string) error {
+	log.C(ctx).Info("deleting attachment orphans repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM attachment_orphans WHERE storage_key = ANY($1)`, pq.Array(storageKeys)); err != nil {
+		log.C(ctx).Errorf("failed to delete attachment orphans: %v", err)
+		return fmt.Errorf("failed to delete attachment orphans: %w", err)
+	}
+	return nil
+}
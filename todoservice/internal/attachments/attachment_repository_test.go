@@ -0,0 +1,86 @@
+package attachments_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+)
+
+func TestSQLXAttachmentRepositoryDelete(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := attachments.NewSQLXAttachmentRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful delete",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("DELETE FROM attachments WHERE id = \\$1 AND todo_id = \\$2").
+					WithArgs("1", "todo").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed delete when the attachment does not exist",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("DELETE FROM attachments").
+					WithArgs("1", "todo").
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("attachment not found: %w", sql.ErrNoRows),
+		},
+		{
+			name: "Failed delete due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("DELETE FROM attachments").
+					WithArgs("1", "todo").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to delete attachment: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.Delete(ctx, "1", "todo")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
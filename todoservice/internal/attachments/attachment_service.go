@@ -0,0 +1,189 @@
+package attachments
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	sniffLength       = 512
+	maxFilenameLength = 255
+	defaultFilename   = "attachment"
+)
+
+var (
+	ErrInvalidAttachment      = errors.New("invalid attachment")
+	ErrAttachmentNotFound     = errors.New("attachment not found")
+	ErrAttachmentTooLarge     = errors.New("attachment is too large")
+	ErrUnsupportedContentType = errors.New("unsupported attachment content type")
+)
+
+type Config struct {
+	Dir          string   `envconfig:"APP_ATTACHMENTS_DIR" default:"data/attachments"`
+	MaxSize      int64    `envconfig:"APP_ATTACHMENTS_MAX_SIZE" default:"10485760"`
+	ContentTypes []string `envconfig:"APP_ATTACHMENTS_CONTENT_TYPES" default:"image/png,image/jpeg,image/gif,image/webp,application/pdf,text/plain"`
+}
+
+//go:generate mockery --name=AttachmentService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type AttachmentService interface {
+	UploadAttachment(ctx context.Context, attachment models.Attachment, content io.Reader) (models.Attachment, error)
+	ListAttachments(ctx context.Context, todoID string) ([]models.Attachment, error)
+	OpenAttachment(ctx context.Context, id string, todoID string) (models.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, id string, todoID string) error
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ AttachmentService = &service{}
+
+type service struct {
+	repo         AttachmentRepository
+	store        BlobStore
+	uuidService  UUIDService
+	timeService  TimeService
+	maxSize      int64
+	contentTypes map[string]bool
+}
+
+func NewService(repo AttachmentRepository, store BlobStore, uuidService UUIDService, timeService TimeService, config Config) AttachmentService {
+	contentTypes := make(map[string]bool, len(config.ContentTypes))
+	for _, contentType := range config.ContentTypes {
+		contentTypes[strings.ToLower(strings.TrimSpace(contentType))] = true
+	}
+	return &service{
+		repo:         repo,
+		store:        store,
+		uuidService:  uuidService,
+		timeService:  timeService,
+		maxSize:      config.MaxSize,
+		contentTypes: contentTypes,
+	}
+}
+
+// UploadAttachment streams content into the blob store and records its
+// metadata. The content type is sniffed from the data rather than trusted
+// from the client. The blob is removed again if the upload is rejected or
+// the metadata cannot be stored.
+func (s *service) UploadAttachment(ctx context.Context, attachment models.Attachment, content io.Reader) (models.Attachment, error) {
+	log.C(ctx).Info("uploading attachment service")
+	reader := bufio.NewReaderSize(content, sniffLength)
+	head, err := reader.Peek(sniffLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return models.Attachment{}, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	if len(head) == 0 {
+		return models.Attachment{}, fmt.Errorf("%w: file is empty", ErrInvalidAttachment)
+	}
+	contentType := http.DetectContentType(head)
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !s.contentTypes[mediaType] {
+		return models.Attachment{}, fmt.Errorf("%w: %s", ErrUnsupportedContentType, contentType)
+	}
+
+	attachment.ID = s.uuidService.Generate()
+	attachment.StorageKey = attachment.ID
+	attachment.Filename = sanitizeFilename(attachment.Filename)
+	attachment.ContentType = contentType
+	attachment.CreatedAt = s.timeService.Now()
+
+	size, err := s.store.Put(ctx, attachment.StorageKey, io.LimitReader(reader, s.maxSize+1))
+	if err != nil {
+		log.C(ctx).Errorf("storing attachment for todo %s failed: %v", attachment.TodoID, err)
+		return models.Attachment{}, err
+	}
+	if size > s.maxSize {
+		s.discardBlob(ctx, attachment.StorageKey)
+		return models.Attachment{}, fmt.Errorf("%w: limit is %d bytes", ErrAttachmentTooLarge, s.maxSize)
+	}
+	attachment.Size = size
+
+	if _, err = s.repo.Create(ctx, attachment); err != nil {
+		log.C(ctx).Errorf("creating attachment for todo %s failed", attachment.TodoID)
+		s.discardBlob(ctx, attachment.StorageKey)
+		return models.Attachment{}, err
+	}
+	return attachment, nil
+}
+
+func (s *service) ListAttachments(ctx context.Context, todoID string) ([]models.Attachment, error) {
+	log.C(ctx).Info("listing attachments service")
+	return s.repo.GetByTodoID(ctx, todoID)
+}
+
+func (s *service) OpenAttachment(ctx context.Context, id string, todoID string) (models.Attachment, io.ReadCloser, error) {
+	log.C(ctx).Info("opening attachment service")
+	attachment, err := s.repo.Get(ctx, id, todoID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Attachment{}, nil, ErrAttachmentNotFound
+	}
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+
+	content, err := s.store.Get(ctx, attachment.StorageKey)
+	if errors.Is(err, ErrBlobNotFound) {
+		log.C(ctx).Errorf("blob of attachment %s is missing", id)
+		return models.Attachment{}, nil, ErrAttachmentNotFound
+	}
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+	return attachment, content, nil
+}
+
+func (s *service) DeleteAttachment(ctx context.Context, id string, todoID string) error {
+	log.C(ctx).Info("deleting attachment service")
+	err := s.repo.Delete(ctx, id, todoID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrAttachmentNotFound
+	}
+	return err
+}
+
+func (s *service) discardBlob(ctx context.Context, key string) {
+	if err := s.store.Delete(ctx, key); err != nil {
+		log.C(ctx).Errorf("failed to discard blob %s: %v", key, err)
+	}
+}
+
+// sanitizeFilename keeps only the base name of the uploaded file, drops
+// control characters and caps the length, since the name is echoed back in
+// Content-Disposition headers.
+func sanitizeFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name))
+	if name == "" || name == "." || name == "/" {
+		return defaultFilename
+	}
+	for len(name) > maxFilenameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
@@ -0,0 +1,218 @@
+package attachments_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServiceUploadAttachment(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	uploader := "user"
+	config := attachments.Config{MaxSize: 16, ContentTypes: []string{"text/plain", "image/png"}}
+	drain := func(ctx context.Context, key string, content io.Reader) (int64, error) {
+		return io.Copy(io.Discard, content)
+	}
+
+	stored := models.Attachment{
+		ID:          "1",
+		TodoID:      "todo",
+		UploadedBy:  &uploader,
+		Filename:    "notes.txt",
+		ContentType: "text/plain; charset=utf-8",
+		Size:        11,
+		StorageKey:  "1",
+		CreatedAt:   now,
+	}
+
+	tests := []struct {
+		name          string
+		filename      string
+		content       string
+		repo          func() *automock.AttachmentRepository
+		store         func() *automock.BlobStore
+		expected      models.Attachment
+		expectedError error
+	}{
+		{
+			name:     "Upload attachment with a sanitized filename",
+			filename: `C:\Users\alice\notes.txt`,
+			content:  "hello world",
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Create(ctx, stored).Return(stored.ID, nil).Once()
+				return repo
+			},
+			store: func() *automock.BlobStore {
+				store := &automock.BlobStore{}
+				store.EXPECT().Put(ctx, "1", mock.Anything).RunAndReturn(drain).Once()
+				return store
+			},
+			expected: stored,
+		},
+		{
+			name:     "Error when the content type is not allowed",
+			filename: "page.html",
+			content:  "<html><body>hi</body></html>",
+			repo: func() *automock.AttachmentRepository {
+				return &automock.AttachmentRepository{}
+			},
+			store: func() *automock.BlobStore {
+				return &automock.BlobStore{}
+			},
+			expectedError: attachments.ErrUnsupportedContentType,
+		},
+		{
+			name:     "Error when the file is empty",
+			filename: "empty.txt",
+			repo: func() *automock.AttachmentRepository {
+				return &automock.AttachmentRepository{}
+			},
+			store: func() *automock.BlobStore {
+				return &automock.BlobStore{}
+			},
+			expectedError: attachments.ErrInvalidAttachment,
+		},
+		{
+			name:     "Error and discard the blob when the file is too large",
+			filename: "notes.txt",
+			content:  strings.Repeat("a", 17),
+			repo: func() *automock.AttachmentRepository {
+				return &automock.AttachmentRepository{}
+			},
+			store: func() *automock.BlobStore {
+				store := &automock.BlobStore{}
+				store.EXPECT().Put(ctx, "1", mock.Anything).RunAndReturn(drain).Once()
+				store.EXPECT().Delete(ctx, "1").Return(nil).Once()
+				return store
+			},
+			expectedError: attachments.ErrAttachmentTooLarge,
+		},
+		{
+			name:     "Error and discard the blob when storing metadata fails",
+			filename: "notes.txt",
+			content:  "hello world",
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Create(ctx, stored).Return("", err).Once()
+				return repo
+			},
+			store: func() *automock.BlobStore {
+				store := &automock.BlobStore{}
+				store.EXPECT().Put(ctx, "1", mock.Anything).RunAndReturn(drain).Once()
+				store.EXPECT().Delete(ctx, "1").Return(nil).Once()
+				return store
+			},
+			expectedError: err,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			store := tt.store()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return("1").Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			svc := attachments.NewService(repo, store, uuidService, timeService, config)
+
+			result, err := svc.UploadAttachment(ctx, models.Attachment{TodoID: "todo", UploadedBy: &uploader, Filename: tt.filename}, bytes.NewBufferString(tt.content))
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+			mock.AssertExpectationsForObjects(t, repo, store)
+		})
+	}
+}
+
+func TestServiceOpenAttachment(t *testing.T) {
+	ctx := context.Background()
+	attachment := models.Attachment{ID: "1", TodoID: "todo", StorageKey: "key"}
+	content := io.NopCloser(strings.NewReader("hello"))
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.AttachmentRepository
+		store         func() *automock.BlobStore
+		expectedError error
+	}{
+		{
+			name: "Open attachment",
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Get(ctx, "1", "todo").Return(attachment, nil).Once()
+				return repo
+			},
+			store: func() *automock.BlobStore {
+				store := &automock.BlobStore{}
+				store.EXPECT().Get(ctx, "key").Return(content, nil).Once()
+				return store
+			},
+		},
+		{
+			name: "Error when the attachment does not exist",
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Get(ctx, "1", "todo").Return(models.Attachment{}, fmt.Errorf("attachment not found: %w", sql.ErrNoRows)).Once()
+				return repo
+			},
+			store: func() *automock.BlobStore {
+				return &automock.BlobStore{}
+			},
+			expectedError: attachments.ErrAttachmentNotFound,
+		},
+		{
+			name: "Error when the blob is missing",
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Get(ctx, "1", "todo").Return(attachment, nil).Once()
+				return repo
+			},
+			store: func() *automock.BlobStore {
+				store := &automock.BlobStore{}
+				store.EXPECT().Get(ctx, "key").Return(nil, attachments.ErrBlobNotFound).Once()
+				return store
+			},
+			expectedError: attachments.ErrAttachmentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			store := tt.store()
+			svc := attachments.NewService(repo, store, &automock.UUIDService{}, &automock.TimeService{}, attachments.Config{})
+
+			result, reader, err := svc.OpenAttachment(ctx, "1", "todo")
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, reader)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, attachment, result)
+				assert.Equal(t, content, reader)
+			}
+			mock.AssertExpectationsForObjects(t, repo, store)
+		})
+	}
+}
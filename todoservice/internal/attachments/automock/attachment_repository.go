@@ -0,0 +1,365 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+type AttachmentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AttachmentRepository) EXPECT() *AttachmentRepository_Expecter {
+	return &AttachmentRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimOrphans provides a mock function with given fields: ctx, limit
+func (_m *AttachmentRepository) ClaimOrphans(ctx context.Context, limit int) ([]string, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimOrphans")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]string, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []string); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_ClaimOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimOrphans'
+type AttachmentRepository_ClaimOrphans_Call struct {
+	*mock.Call
+}
+
+// ClaimOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *AttachmentRepository_Expecter) ClaimOrphans(ctx interface{}, limit interface{}) *AttachmentRepository_ClaimOrphans_Call {
+	return &AttachmentRepository_ClaimOrphans_Call{Call: _e.mock.On("ClaimOrphans", ctx, limit)}
+}
+
+func (_c *AttachmentRepository_ClaimOrphans_Call) Run(run func(ctx context.Context, limit int)) *AttachmentRepository_ClaimOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_ClaimOrphans_Call) Return(_a0 []string, _a1 error) *AttachmentRepository_ClaimOrphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_ClaimOrphans_Call) RunAndReturn(run func(context.Context, int) ([]string, error)) *AttachmentRepository_ClaimOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, attachment
+func (_m *AttachmentRepository) Create(ctx context.Context, attachment models.Attachment) (string, error) {
+	ret := _m.Called(ctx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Attachment) (string, error)); ok {
+		return rf(ctx, attachment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Attachment) string); ok {
+		r0 = rf(ctx, attachment)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Attachment) error); ok {
+		r1 = rf(ctx, attachment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AttachmentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment models.Attachment
+func (_e *AttachmentRepository_Expecter) Create(ctx interface{}, attachment interface{}) *AttachmentRepository_Create_Call {
+	return &AttachmentRepository_Create_Call{Call: _e.mock.On("Create", ctx, attachment)}
+}
+
+func (_c *AttachmentRepository_Create_Call) Run(run func(ctx context.Context, attachment models.Attachment)) *AttachmentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Attachment))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_Create_Call) Return(_a0 string, _a1 error) *AttachmentRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_Create_Call) RunAndReturn(run func(context.Context, models.Attachment) (string, error)) *AttachmentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, todoID
+func (_m *AttachmentRepository) Delete(ctx context.Context, id string, todoID string) error {
+	ret := _m.Called(ctx, id, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, todoID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type AttachmentRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - todoID string
+func (_e *AttachmentRepository_Expecter) Delete(ctx interface{}, id interface{}, todoID interface{}) *AttachmentRepository_Delete_Call {
+	return &AttachmentRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, todoID)}
+}
+
+func (_c *AttachmentRepository_Delete_Call) Run(run func(ctx context.Context, id string, todoID string)) *AttachmentRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_Delete_Call) Return(_a0 error) *AttachmentRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentRepository_Delete_Call) RunAndReturn(run func(context.Context, string, string) error) *AttachmentRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrphans provides a mock function with given fields: ctx, storageKeys
+func (_m *AttachmentRepository) DeleteOrphans(ctx context.Context, storageKeys []string) error {
+	ret := _m.Called(ctx, storageKeys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrphans")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, storageKeys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentRepository_DeleteOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrphans'
+type AttachmentRepository_DeleteOrphans_Call struct {
+	*mock.Call
+}
+
+// DeleteOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - storageKeys []string
+func (_e *AttachmentRepository_Expecter) DeleteOrphans(ctx interface{}, storageKeys interface{}) *AttachmentRepository_DeleteOrphans_Call {
+	return &AttachmentRepository_DeleteOrphans_Call{Call: _e.mock.On("DeleteOrphans", ctx, storageKeys)}
+}
+
+func (_c *AttachmentRepository_DeleteOrphans_Call) Run(run func(ctx context.Context, storageKeys []string)) *AttachmentRepository_DeleteOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_DeleteOrphans_Call) Return(_a0 error) *AttachmentRepository_DeleteOrphans_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentRepository_DeleteOrphans_Call) RunAndReturn(run func(context.Context, []string) error) *AttachmentRepository_DeleteOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id, todoID
+func (_m *AttachmentRepository) Get(ctx context.Context, id string, todoID string) (models.Attachment, error) {
+	ret := _m.Called(ctx, id, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 models.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Attachment, error)); ok {
+		return rf(ctx, id, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Attachment); ok {
+		r0 = rf(ctx, id, todoID)
+	} else {
+		r0 = ret.Get(0).(models.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type AttachmentRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - todoID string
+func (_e *AttachmentRepository_Expecter) Get(ctx interface{}, id interface{}, todoID interface{}) *AttachmentRepository_Get_Call {
+	return &AttachmentRepository_Get_Call{Call: _e.mock.On("Get", ctx, id, todoID)}
+}
+
+func (_c *AttachmentRepository_Get_Call) Run(run func(ctx context.Context, id string, todoID string)) *AttachmentRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_Get_Call) Return(_a0 models.Attachment, _a1 error) *AttachmentRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_Get_Call) RunAndReturn(run func(context.Context, string, string) (models.Attachment, error)) *AttachmentRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTodoID provides a mock function with given fields: ctx, todoID
+func (_m *AttachmentRepository) GetByTodoID(ctx context.Context, todoID string) ([]models.Attachment, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTodoID")
+	}
+
+	var r0 []models.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Attachment, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Attachment); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_GetByTodoID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTodoID'
+type AttachmentRepository_GetByTodoID_Call struct {
+	*mock.Call
+}
+
+// GetByTodoID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+func (_e *AttachmentRepository_Expecter) GetByTodoID(ctx interface{}, todoID interface{}) *AttachmentRepository_GetByTodoID_Call {
+	return &AttachmentRepository_GetByTodoID_Call{Call: _e.mock.On("GetByTodoID", ctx, todoID)}
+}
+
+func (_c *AttachmentRepository_GetByTodoID_Call) Run(run func(ctx context.Context, todoID string)) *AttachmentRepository_GetByTodoID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_GetByTodoID_Call) Return(_a0 []models.Attachment, _a1 error) *AttachmentRepository_GetByTodoID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_GetByTodoID_Call) RunAndReturn(run func(context.Context, string) ([]models.Attachment, error)) *AttachmentRepository_GetByTodoID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentRepository {
+	mock := &AttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,271 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// AttachmentService is an autogenerated mock type for the AttachmentService type
+type AttachmentService struct {
+	mock.Mock
+}
+
+type AttachmentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AttachmentService) EXPECT() *AttachmentService_Expecter {
+	return &AttachmentService_Expecter{mock: &_m.Mock}
+}
+
+// DeleteAttachment provides a mock function with given fields: ctx, id, todoID
+func (_m *AttachmentService) DeleteAttachment(ctx context.Context, id string, todoID string) error {
+	ret := _m.Called(ctx, id, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, todoID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentService_DeleteAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachment'
+type AttachmentService_DeleteAttachment_Call struct {
+	*mock.Call
+}
+
+// DeleteAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - todoID string
+func (_e *AttachmentService_Expecter) DeleteAttachment(ctx interface{}, id interface{}, todoID interface{}) *AttachmentService_DeleteAttachment_Call {
+	return &AttachmentService_DeleteAttachment_Call{Call: _e.mock.On("DeleteAttachment", ctx, id, todoID)}
+}
+
+func (_c *AttachmentService_DeleteAttachment_Call) Run(run func(ctx context.Context, id string, todoID string)) *AttachmentService_DeleteAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_DeleteAttachment_Call) Return(_a0 error) *AttachmentService_DeleteAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentService_DeleteAttachment_Call) RunAndReturn(run func(context.Context, string, string) error) *AttachmentService_DeleteAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAttachments provides a mock function with given fields: ctx, todoID
+func (_m *AttachmentService) ListAttachments(ctx context.Context, todoID string) ([]models.Attachment, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAttachments")
+	}
+
+	var r0 []models.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Attachment, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Attachment); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentService_ListAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAttachments'
+type AttachmentService_ListAttachments_Call struct {
+	*mock.Call
+}
+
+// ListAttachments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+func (_e *AttachmentService_Expecter) ListAttachments(ctx interface{}, todoID interface{}) *AttachmentService_ListAttachments_Call {
+	return &AttachmentService_ListAttachments_Call{Call: _e.mock.On("ListAttachments", ctx, todoID)}
+}
+
+func (_c *AttachmentService_ListAttachments_Call) Run(run func(ctx context.Context, todoID string)) *AttachmentService_ListAttachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_ListAttachments_Call) Return(_a0 []models.Attachment, _a1 error) *AttachmentService_ListAttachments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentService_ListAttachments_Call) RunAndReturn(run func(context.Context, string) ([]models.Attachment, error)) *AttachmentService_ListAttachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenAttachment provides a mock function with given fields: ctx, id, todoID
+func (_m *AttachmentService) OpenAttachment(ctx context.Context, id string, todoID string) (models.Attachment, io.ReadCloser, error) {
+	ret := _m.Called(ctx, id, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenAttachment")
+	}
+
+	var r0 models.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Attachment, io.ReadCloser, error)); ok {
+		return rf(ctx, id, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Attachment); ok {
+		r0 = rf(ctx, id, todoID)
+	} else {
+		r0 = ret.Get(0).(models.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) io.ReadCloser); ok {
+		r1 = rf(ctx, id, todoID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, id, todoID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// AttachmentService_OpenAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenAttachment'
+type AttachmentService_OpenAttachment_Call struct {
+	*mock.Call
+}
+
+// OpenAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - todoID string
+func (_e *AttachmentService_Expecter) OpenAttachment(ctx interface{}, id interface{}, todoID interface{}) *AttachmentService_OpenAttachment_Call {
+	return &AttachmentService_OpenAttachment_Call{Call: _e.mock.On("OpenAttachment", ctx, id, todoID)}
+}
+
+func (_c *AttachmentService_OpenAttachment_Call) Run(run func(ctx context.Context, id string, todoID string)) *AttachmentService_OpenAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_OpenAttachment_Call) Return(_a0 models.Attachment, _a1 io.ReadCloser, _a2 error) *AttachmentService_OpenAttachment_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *AttachmentService_OpenAttachment_Call) RunAndReturn(run func(context.Context, string, string) (models.Attachment, io.ReadCloser, error)) *AttachmentService_OpenAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadAttachment provides a mock function with given fields: ctx, attachment, content
+func (_m *AttachmentService) UploadAttachment(ctx context.Context, attachment models.Attachment, content io.Reader) (models.Attachment, error) {
+	ret := _m.Called(ctx, attachment, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadAttachment")
+	}
+
+	var r0 models.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Attachment, io.Reader) (models.Attachment, error)); ok {
+		return rf(ctx, attachment, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Attachment, io.Reader) models.Attachment); ok {
+		r0 = rf(ctx, attachment, content)
+	} else {
+		r0 = ret.Get(0).(models.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Attachment, io.Reader) error); ok {
+		r1 = rf(ctx, attachment, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentService_UploadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadAttachment'
+type AttachmentService_UploadAttachment_Call struct {
+	*mock.Call
+}
+
+// UploadAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment models.Attachment
+//   - content io.Reader
+func (_e *AttachmentService_Expecter) UploadAttachment(ctx interface{}, attachment interface{}, content interface{}) *AttachmentService_UploadAttachment_Call {
+	return &AttachmentService_UploadAttachment_Call{Call: _e.mock.On("UploadAttachment", ctx, attachment, content)}
+}
+
+func (_c *AttachmentService_UploadAttachment_Call) Run(run func(ctx context.Context, attachment models.Attachment, content io.Reader)) *AttachmentService_UploadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Attachment), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_UploadAttachment_Call) Return(_a0 models.Attachment, _a1 error) *AttachmentService_UploadAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentService_UploadAttachment_Call) RunAndReturn(run func(context.Context, models.Attachment, io.Reader) (models.Attachment, error)) *AttachmentService_UploadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAttachmentService creates a new instance of AttachmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentService {
+	mock := &AttachmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
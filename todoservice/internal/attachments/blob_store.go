@@ -0,0 +1,22 @@
+package attachments
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	ErrBlobNotFound   = errors.New("blob not found")
+	ErrInvalidBlobKey = errors.New("invalid blob key")
+)
+
+// BlobStore keeps attachment contents. Put streams the content and returns
+// the number of bytes written. Deleting a missing blob is not an error.
+//
+//go:generate mockery --name=BlobStore --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) (int64, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
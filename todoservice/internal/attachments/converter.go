@@ -0,0 +1,35 @@
+package attachments
+
+import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertAttachmentToModel(entity Entity) models.Attachment {
+	return models.Attachment{
+		ID:          entity.ID,
+		TodoID:      entity.TodoID,
+		UploadedBy:  entity.UploadedBy,
+		Filename:    entity.Filename,
+		ContentType: entity.ContentType,
+		Size:        entity.Size,
+		StorageKey:  entity.StorageKey,
+		CreatedAt:   entity.CreatedAt,
+	}
+}
+
+func (c *Converter) ConvertAttachmentToEntity(attachment models.Attachment) Entity {
+	return Entity{
+		ID:          attachment.ID,
+		TodoID:      attachment.TodoID,
+		UploadedBy:  attachment.UploadedBy,
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		StorageKey:  attachment.StorageKey,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
@@ -0,0 +1,14 @@
+package attachments
+
+import "time"
+
+type Entity struct {
+	ID          string    `db:"id"`
+	TodoID      string    `db:"todo_id"`
+	UploadedBy  *string   `db:"uploaded_by"`
+	Filename    string    `db:"filename"`
+	ContentType string    `db:"content_type"`
+	Size        int64     `db:"size"`
+	StorageKey  string    `db:"storage_key"`
+	CreatedAt   time.Time `db:"created_at"`
+}
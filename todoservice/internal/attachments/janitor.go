@@ -0,0 +1,83 @@
+package attachments
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+type JanitorConfig struct {
+	Interval  time.Duration `envconfig:"APP_ATTACHMENTS_JANITOR_INTERVAL" default:"1m"`
+	BatchSize int           `envconfig:"APP_ATTACHMENTS_JANITOR_BATCH_SIZE" default:"100"`
+}
+
+// Janitor removes the blobs of deleted attachments. Attachments disappear
+// with their todo or list inside a database transaction, which cannot undo
+// a blob deletion, so the blobs are only removed once the deletion committed.
+type Janitor struct {
+	database *sqlx.DB
+	repo     AttachmentRepository
+	store    BlobStore
+	config   JanitorConfig
+}
+
+func NewJanitor(database *sqlx.DB, repo AttachmentRepository, store BlobStore, config JanitorConfig) *Janitor {
+	return &Janitor{database: database, repo: repo, store: store, config: config}
+}
+
+// Run sweeps orphaned blobs every interval until ctx is cancelled.
+func (j *Janitor) Run(ctx context.Context) {
+	log.C(ctx).Infof("starting attachment janitor with interval %s", j.config.Interval)
+	ticker := time.NewTicker(j.config.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := j.Sweep(ctx); err != nil {
+			log.C(ctx).Errorf("sweeping attachment blobs failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("stopping attachment janitor")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep deletes one batch of orphaned blobs and returns how many were removed.
+// Keys whose blob could not be deleted stay recorded and are retried later.
+func (j *Janitor) Sweep(ctx context.Context) (int, error) {
+	tx, err := j.database.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+	ctx = db.SaveToContext(ctx, tx)
+
+	keys, err := j.repo.ClaimOrphans(ctx, j.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if err = j.store.Delete(ctx, key); err != nil {
+			log.C(ctx).Errorf("deleting blob %s failed: %v", key, err)
+			continue
+		}
+		deleted = append(deleted, key)
+	}
+	if len(deleted) == 0 {
+		return 0, nil
+	}
+
+	if err = j.repo.DeleteOrphans(ctx, deleted); err != nil {
+		return 0, err
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return len(deleted), nil
+}
@@ -0,0 +1,78 @@
+package attachments_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+)
+
+func TestJanitorSweep(t *testing.T) {
+	config := attachments.JanitorConfig{BatchSize: 10}
+	err := errors.New("error")
+
+	tests := []struct {
+		name            string
+		setup           func(mockDB sqlxmock.Sqlmock, repo *automock.AttachmentRepository, store *automock.BlobStore)
+		expectedDeleted int
+		expectedError   error
+	}{
+		{
+			name: "Delete orphaned blobs and keep the ones that failed",
+			setup: func(mockDB sqlxmock.Sqlmock, repo *automock.AttachmentRepository, store *automock.BlobStore) {
+				mockDB.ExpectBegin()
+				repo.EXPECT().ClaimOrphans(mock.Anything, config.BatchSize).Return([]string{"a", "b", "c"}, nil).Once()
+				store.EXPECT().Delete(mock.Anything, "a").Return(nil).Once()
+				store.EXPECT().Delete(mock.Anything, "b").Return(err).Once()
+				store.EXPECT().Delete(mock.Anything, "c").Return(nil).Once()
+				repo.EXPECT().DeleteOrphans(mock.Anything, []string{"a", "c"}).Return(nil).Once()
+				mockDB.ExpectCommit()
+			},
+			expectedDeleted: 2,
+		},
+		{
+			name: "Nothing to sweep",
+			setup: func(mockDB sqlxmock.Sqlmock, repo *automock.AttachmentRepository, store *automock.BlobStore) {
+				mockDB.ExpectBegin()
+				repo.EXPECT().ClaimOrphans(mock.Anything, config.BatchSize).Return(nil, nil).Once()
+				mockDB.ExpectRollback()
+			},
+		},
+		{
+			name: "Error when claiming fails",
+			setup: func(mockDB sqlxmock.Sqlmock, repo *automock.AttachmentRepository, store *automock.BlobStore) {
+				mockDB.ExpectBegin()
+				repo.EXPECT().ClaimOrphans(mock.Anything, config.BatchSize).Return(nil, err).Once()
+				mockDB.ExpectRollback()
+			},
+			expectedError: err,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, mockDB, dbErr := sqlxmock.Newx()
+			require.NoError(t, dbErr)
+			repo := &automock.AttachmentRepository{}
+			store := &automock.BlobStore{}
+			tt.setup(mockDB, repo, store)
+			janitor := attachments.NewJanitor(database, repo, store, config)
+
+			deleted, sweepErr := janitor.Sweep(context.Background())
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, sweepErr, tt.expectedError)
+			} else {
+				require.NoError(t, sweepErr)
+				assert.Equal(t, tt.expectedDeleted, deleted)
+			}
+			require.NoError(t, mockDB.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, repo, store)
+		})
+	}
+}
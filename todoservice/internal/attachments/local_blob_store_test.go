@@ -0,0 +1,57 @@
+package attachments_test
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := attachments.NewLocalBlobStore(dir)
+	require.NoError(t, err)
+
+	written, err := store.Put(ctx, "blob", strings.NewReader("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), written)
+
+	reader, err := store.Get(ctx, "blob")
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "hello", string(content))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary upload files must not be left behind")
+
+	require.NoError(t, store.Delete(ctx, "blob"))
+	require.NoError(t, store.Delete(ctx, "blob"))
+	_, err = store.Get(ctx, "blob")
+	assert.ErrorIs(t, err, attachments.ErrBlobNotFound)
+}
+
+func TestLocalBlobStoreRejectsKeysOutsideTheDirectory(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := attachments.NewLocalBlobStore(filepath.Join(dir, "blobs"))
+	require.NoError(t, err)
+
+	for _, key := range []string{"", ".", "..", "../escape", "a/b", `a\b`, ".hidden"} {
+		_, err = store.Put(ctx, key, strings.NewReader("hello"))
+		assert.ErrorIs(t, err, attachments.ErrInvalidBlobKey, key)
+		_, err = store.Get(ctx, key)
+		assert.ErrorIs(t, err, attachments.ErrInvalidBlobKey, key)
+		assert.ErrorIs(t, store.Delete(ctx, key), attachments.ErrInvalidBlobKey, key)
+	}
+	_, err = os.Stat(filepath.Join(dir, "escape"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,415 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// CommentRepository is an autogenerated mock type for the CommentRepository type
+type CommentRepository struct {
+	mock.Mock
+}
+
+type CommentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CommentRepository) EXPECT() *CommentRepository_Expecter {
+	return &CommentRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, comment
+func (_m *CommentRepository) Create(ctx context.Context, comment models.Comment) (string, error) {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Comment) (string, error)); ok {
+		return rf(ctx, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Comment) string); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Comment) error); ok {
+		r1 = rf(ctx, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type CommentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment models.Comment
+func (_e *CommentRepository_Expecter) Create(ctx interface{}, comment interface{}) *CommentRepository_Create_Call {
+	return &CommentRepository_Create_Call{Call: _e.mock.On("Create", ctx, comment)}
+}
+
+func (_c *CommentRepository_Create_Call) Run(run func(ctx context.Context, comment models.Comment)) *CommentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Comment))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_Create_Call) Return(_a0 string, _a1 error) *CommentRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_Create_Call) RunAndReturn(run func(context.Context, models.Comment) (string, error)) *CommentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *CommentRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type CommentRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *CommentRepository_Expecter) Delete(ctx interface{}, id interface{}) *CommentRepository_Delete_Call {
+	return &CommentRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *CommentRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *CommentRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_Delete_Call) Return(_a0 error) *CommentRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *CommentRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *CommentRepository) Get(ctx context.Context, id string) (models.Comment, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Comment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Comment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type CommentRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *CommentRepository_Expecter) Get(ctx interface{}, id interface{}) *CommentRepository_Get_Call {
+	return &CommentRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *CommentRepository_Get_Call) Run(run func(ctx context.Context, id string)) *CommentRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_Get_Call) Return(_a0 models.Comment, _a1 error) *CommentRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_Get_Call) RunAndReturn(run func(context.Context, string) (models.Comment, error)) *CommentRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTodoID provides a mock function with given fields: ctx, todoID
+func (_m *CommentRepository) GetByTodoID(ctx context.Context, todoID string) ([]models.Comment, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTodoID")
+	}
+
+	var r0 []models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Comment, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Comment); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_GetByTodoID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTodoID'
+type CommentRepository_GetByTodoID_Call struct {
+	*mock.Call
+}
+
+// GetByTodoID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+func (_e *CommentRepository_Expecter) GetByTodoID(ctx interface{}, todoID interface{}) *CommentRepository_GetByTodoID_Call {
+	return &CommentRepository_GetByTodoID_Call{Call: _e.mock.On("GetByTodoID", ctx, todoID)}
+}
+
+func (_c *CommentRepository_GetByTodoID_Call) Run(run func(ctx context.Context, todoID string)) *CommentRepository_GetByTodoID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_GetByTodoID_Call) Return(_a0 []models.Comment, _a1 error) *CommentRepository_GetByTodoID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_GetByTodoID_Call) RunAndReturn(run func(context.Context, string) ([]models.Comment, error)) *CommentRepository_GetByTodoID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMentioning provides a mock function with given fields: ctx, userID
+func (_m *CommentRepository) GetMentioning(ctx context.Context, userID string) ([]models.Comment, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMentioning")
+	}
+
+	var r0 []models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Comment, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Comment); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentRepository_GetMentioning_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMentioning'
+type CommentRepository_GetMentioning_Call struct {
+	*mock.Call
+}
+
+// GetMentioning is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *CommentRepository_Expecter) GetMentioning(ctx interface{}, userID interface{}) *CommentRepository_GetMentioning_Call {
+	return &CommentRepository_GetMentioning_Call{Call: _e.mock.On("GetMentioning", ctx, userID)}
+}
+
+func (_c *CommentRepository_GetMentioning_Call) Run(run func(ctx context.Context, userID string)) *CommentRepository_GetMentioning_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_GetMentioning_Call) Return(_a0 []models.Comment, _a1 error) *CommentRepository_GetMentioning_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentRepository_GetMentioning_Call) RunAndReturn(run func(context.Context, string) ([]models.Comment, error)) *CommentRepository_GetMentioning_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMentions provides a mock function with given fields: ctx, commentID, userIDs
+func (_m *CommentRepository) SetMentions(ctx context.Context, commentID string, userIDs []string) error {
+	ret := _m.Called(ctx, commentID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMentions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, commentID, userIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentRepository_SetMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMentions'
+type CommentRepository_SetMentions_Call struct {
+	*mock.Call
+}
+
+// SetMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commentID string
+//   - userIDs []string
+func (_e *CommentRepository_Expecter) SetMentions(ctx interface{}, commentID interface{}, userIDs interface{}) *CommentRepository_SetMentions_Call {
+	return &CommentRepository_SetMentions_Call{Call: _e.mock.On("SetMentions", ctx, commentID, userIDs)}
+}
+
+func (_c *CommentRepository_SetMentions_Call) Run(run func(ctx context.Context, commentID string, userIDs []string)) *CommentRepository_SetMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_SetMentions_Call) Return(_a0 error) *CommentRepository_SetMentions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentRepository_SetMentions_Call) RunAndReturn(run func(context.Context, string, []string) error) *CommentRepository_SetMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBody provides a mock function with given fields: ctx, id, body, updatedAt
+func (_m *CommentRepository) UpdateBody(ctx context.Context, id string, body string, updatedAt time.Time) error {
+	ret := _m.Called(ctx, id, body, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBody")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, id, body, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentRepository_UpdateBody_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBody'
+type CommentRepository_UpdateBody_Call struct {
+	*mock.Call
+}
+
+// UpdateBody is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - body string
+//   - updatedAt time.Time
+func (_e *CommentRepository_Expecter) UpdateBody(ctx interface{}, id interface{}, body interface{}, updatedAt interface{}) *CommentRepository_UpdateBody_Call {
+	return &CommentRepository_UpdateBody_Call{Call: _e.mock.On("UpdateBody", ctx, id, body, updatedAt)}
+}
+
+func (_c *CommentRepository_UpdateBody_Call) Run(run func(ctx context.Context, id string, body string, updatedAt time.Time)) *CommentRepository_UpdateBody_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *CommentRepository_UpdateBody_Call) Return(_a0 error) *CommentRepository_UpdateBody_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentRepository_UpdateBody_Call) RunAndReturn(run func(context.Context, string, string, time.Time) error) *CommentRepository_UpdateBody_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCommentRepository creates a new instance of CommentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommentRepository {
+	mock := &CommentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,318 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CommentService is an autogenerated mock type for the CommentService type
+type CommentService struct {
+	mock.Mock
+}
+
+type CommentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CommentService) EXPECT() *CommentService_Expecter {
+	return &CommentService_Expecter{mock: &_m.Mock}
+}
+
+// CreateComment provides a mock function with given fields: ctx, comment
+func (_m *CommentService) CreateComment(ctx context.Context, comment models.Comment) (models.Comment, error) {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComment")
+	}
+
+	var r0 models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Comment) (models.Comment, error)); ok {
+		return rf(ctx, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Comment) models.Comment); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Get(0).(models.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Comment) error); ok {
+		r1 = rf(ctx, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentService_CreateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateComment'
+type CommentService_CreateComment_Call struct {
+	*mock.Call
+}
+
+// CreateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment models.Comment
+func (_e *CommentService_Expecter) CreateComment(ctx interface{}, comment interface{}) *CommentService_CreateComment_Call {
+	return &CommentService_CreateComment_Call{Call: _e.mock.On("CreateComment", ctx, comment)}
+}
+
+func (_c *CommentService_CreateComment_Call) Run(run func(ctx context.Context, comment models.Comment)) *CommentService_CreateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Comment))
+	})
+	return _c
+}
+
+func (_c *CommentService_CreateComment_Call) Return(_a0 models.Comment, _a1 error) *CommentService_CreateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentService_CreateComment_Call) RunAndReturn(run func(context.Context, models.Comment) (models.Comment, error)) *CommentService_CreateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteComment provides a mock function with given fields: ctx, id, todoID, userID
+func (_m *CommentService) DeleteComment(ctx context.Context, id string, todoID string, userID string) error {
+	ret := _m.Called(ctx, id, todoID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, id, todoID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommentService_DeleteComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteComment'
+type CommentService_DeleteComment_Call struct {
+	*mock.Call
+}
+
+// DeleteComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - todoID string
+//   - userID string
+func (_e *CommentService_Expecter) DeleteComment(ctx interface{}, id interface{}, todoID interface{}, userID interface{}) *CommentService_DeleteComment_Call {
+	return &CommentService_DeleteComment_Call{Call: _e.mock.On("DeleteComment", ctx, id, todoID, userID)}
+}
+
+func (_c *CommentService_DeleteComment_Call) Run(run func(ctx context.Context, id string, todoID string, userID string)) *CommentService_DeleteComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *CommentService_DeleteComment_Call) Return(_a0 error) *CommentService_DeleteComment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CommentService_DeleteComment_Call) RunAndReturn(run func(context.Context, string, string, string) error) *CommentService_DeleteComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function with given fields: ctx, todoID
+func (_m *CommentService) ListComments(ctx context.Context, todoID string) ([]models.Comment, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 []models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Comment, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Comment); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentService_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type CommentService_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+func (_e *CommentService_Expecter) ListComments(ctx interface{}, todoID interface{}) *CommentService_ListComments_Call {
+	return &CommentService_ListComments_Call{Call: _e.mock.On("ListComments", ctx, todoID)}
+}
+
+func (_c *CommentService_ListComments_Call) Run(run func(ctx context.Context, todoID string)) *CommentService_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CommentService_ListComments_Call) Return(_a0 []models.Comment, _a1 error) *CommentService_ListComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentService_ListComments_Call) RunAndReturn(run func(context.Context, string) ([]models.Comment, error)) *CommentService_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMentions provides a mock function with given fields: ctx, userID
+func (_m *CommentService) ListMentions(ctx context.Context, userID string) ([]models.Comment, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMentions")
+	}
+
+	var r0 []models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Comment, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Comment); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentService_ListMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMentions'
+type CommentService_ListMentions_Call struct {
+	*mock.Call
+}
+
+// ListMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *CommentService_Expecter) ListMentions(ctx interface{}, userID interface{}) *CommentService_ListMentions_Call {
+	return &CommentService_ListMentions_Call{Call: _e.mock.On("ListMentions", ctx, userID)}
+}
+
+func (_c *CommentService_ListMentions_Call) Run(run func(ctx context.Context, userID string)) *CommentService_ListMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CommentService_ListMentions_Call) Return(_a0 []models.Comment, _a1 error) *CommentService_ListMentions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentService_ListMentions_Call) RunAndReturn(run func(context.Context, string) ([]models.Comment, error)) *CommentService_ListMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function with given fields: ctx, comment
+func (_m *CommentService) UpdateComment(ctx context.Context, comment models.Comment) (models.Comment, error) {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 models.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Comment) (models.Comment, error)); ok {
+		return rf(ctx, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Comment) models.Comment); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Get(0).(models.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Comment) error); ok {
+		r1 = rf(ctx, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommentService_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type CommentService_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment models.Comment
+func (_e *CommentService_Expecter) UpdateComment(ctx interface{}, comment interface{}) *CommentService_UpdateComment_Call {
+	return &CommentService_UpdateComment_Call{Call: _e.mock.On("UpdateComment", ctx, comment)}
+}
+
+func (_c *CommentService_UpdateComment_Call) Run(run func(ctx context.Context, comment models.Comment)) *CommentService_UpdateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Comment))
+	})
+	return _c
+}
+
+func (_c *CommentService_UpdateComment_Call) Return(_a0 models.Comment, _a1 error) *CommentService_UpdateComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CommentService_UpdateComment_Call) RunAndReturn(run func(context.Context, models.Comment) (models.Comment, error)) *CommentService_UpdateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCommentService creates a new instance of CommentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCommentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CommentService {
+	mock := &CommentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TimeService is an autogenerated mock type for the TimeService type
+type TimeService struct {
+	mock.Mock
+}
+
+type TimeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TimeService) EXPECT() *TimeService_Expecter {
+	return &TimeService_Expecter{mock: &_m.Mock}
+}
+
+// Now provides a mock function with no fields
+func (_m *TimeService) Now() time.Time {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Now")
+	}
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	return r0
+}
+
+// TimeService_Now_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Now'
+type TimeService_Now_Call struct {
+	*mock.Call
+}
+
+// Now is a helper method to define mock.On call
+func (_e *TimeService_Expecter) Now() *TimeService_Now_Call {
+	return &TimeService_Now_Call{Call: _e.mock.On("Now")}
+}
+
+func (_c *TimeService_Now_Call) Run(run func()) *TimeService_Now_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TimeService_Now_Call) Return(_a0 time.Time) *TimeService_Now_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimeService_Now_Call) RunAndReturn(run func() time.Time) *TimeService_Now_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTimeService creates a new instance of TimeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTimeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TimeService {
+	mock := &TimeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,197 @@
+package comments
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+	"time"
+)
+
+//go:generate mockery --name=CommentRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type CommentRepository interface {
+	Create(ctx context.Context, comment models.Comment) (string, error)
+	Get(ctx context.Context, id string) (models.Comment, error)
+	GetByTodoID(ctx context.Context, todoID string) ([]models.Comment, error)
+	GetMentioning(ctx context.Context, userID string) ([]models.Comment, error)
+	UpdateBody(ctx context.Context, id string, body string, updatedAt time.Time) error
+	Delete(ctx context.Context, id string) error
+	SetMentions(ctx context.Context, commentID string, userIDs []string) error
+}
+
+const selectComments = `
+	SELECT c.id, c.todo_id, c.author_id, c.body, c.created_at, c.updated_at,
+	       ARRAY(SELECT m.user_id::text FROM comment_mentions m WHERE m.comment_id = c.id ORDER BY m.user_id) AS mentions
+	FROM comments c
+`
+
+type SQLXCommentRepository struct {
+	converter *Converter
+}
+
+var _ CommentRepository = &SQLXCommentRepository{}
+
+func NewSQLXCommentRepository() CommentRepository {
+	return &SQLXCommentRepository{converter: NewConverter()}
+}
+
+func (r *SQLXCommentRepository) Create(ctx context.Context, comment models.Comment) (string, error) {
+	log.C(ctx).Info("creating comment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertCommentToEntity(comment)
+	if err = pkg.ValidateUUID(entity.ID); err != nil {
+		log.C(ctx).Errorf("invalid entity id: %v", err)
+		return "", fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	query := `
+		INSERT INTO comments (id, todo_id, author_id, body, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query,
+		entity.ID,
+		entity.TodoID,
+		entity.AuthorID,
+		entity.Body,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to insert comment: %v", err)
+		return "", fmt.Errorf("failed to create comment: %w", err)
+	}
+	return id, nil
+}
+
+func (r *SQLXCommentRepository) Get(ctx context.Context, id string) (models.Comment, error) {
+	log.C(ctx).Info("getting comment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Comment{}, err
+	}
+
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, selectComments+`WHERE c.id = $1`, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get comment: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Comment{}, fmt.Errorf("comment not found: %w", err)
+		}
+		return models.Comment{}, fmt.Errorf("failed to get comment: %w", err)
+	}
+	return r.converter.ConvertCommentToModel(entity), nil
+}
+
+func (r *SQLXCommentRepository) GetByTodoID(ctx context.Context, todoID string) ([]models.Comment, error) {
+	log.C(ctx).Info("getting comments of todo repository")
+	return r.getComments(ctx, selectComments+`WHERE c.todo_id = $1 ORDER BY c.created_at, c.id`, todoID)
+}
+
+// GetMentioning returns the comments mentioning the user on todos of lists the
+// user can still access, newest first.
+func (r *SQLXCommentRepository) GetMentioning(ctx context.Context, userID string) ([]models.Comment, error) {
+	log.C(ctx).Info("getting comments mentioning user repository")
+	query := selectComments + `
+		JOIN comment_mentions cm ON cm.comment_id = c.id AND cm.user_id = $1
+		JOIN todos t ON t.id = c.todo_id AND t.deleted_at IS NULL
+		JOIN list_access a ON a.list_id = t.list_id AND a.user_id = $1 AND a.status IN ('owner', 'accepted')
+		ORDER BY c.created_at DESC, c.id
+	`
+	return r.getComments(ctx, query, userID)
+}
+
+func (r *SQLXCommentRepository) getComments(ctx context.Context, query string, args ...interface{}) ([]models.Comment, error) {
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, query, args...)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get comments: %v", err)
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	result := make([]models.Comment, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertCommentToModel(entity))
+	}
+	return result, nil
+}
+
+func (r *SQLXCommentRepository) UpdateBody(ctx context.Context, id string, body string, updatedAt time.Time) error {
+	log.C(ctx).Info("updating comment body repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `UPDATE comments SET body = $2, updated_at = $3 WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, query, id, body, updatedAt); err != nil {
+		log.C(ctx).Errorf("failed to update comment: %v", err)
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXCommentRepository) Delete(ctx context.Context, id string) error {
+	log.C(ctx).Info("deleting comment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `DELETE FROM comments WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+		log.C(ctx).Errorf("failed to delete comment: %v", err)
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	return nil
+}
+
+// SetMentions replaces the users mentioned by the comment.
+func (r *SQLXCommentRepository) SetMentions(ctx context.Context, commentID string, userIDs []string) error {
+	log.C(ctx).Info("setting comment mentions repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM comment_mentions WHERE comment_id = $1`, commentID); err != nil {
+		log.C(ctx).Errorf("failed to clear comment mentions: %v", err)
+		return fmt.Errorf("failed to set comment mentions: %w", err)
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO comment_mentions (comment_id, user_id)
+		SELECT $1, unnest($2::uuid[])
+		ON CONFLICT DO NOTHING
+	`
+	if _, err = tx.ExecContext(ctx, query, commentID, pq.Array(userIDs)); err != nil {
+		log.C(ctx).Errorf("failed to insert comment mentions: %v", err)
+		return fmt.Errorf("failed to set comment mentions: %w", err)
+	}
+	return nil
+}
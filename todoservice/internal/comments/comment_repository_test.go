@@ -0,0 +1,89 @@
+package comments_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/comments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXCommentRepositoryGetMentioning(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := comments.NewSQLXCommentRepository()
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		setupMocks     func()
+		expectedResult []models.Comment
+		expectedError  error
+	}{
+		{
+			name: "Successful get of comments mentioning the user",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT (.+) FROM comments c JOIN comment_mentions cm ON cm.comment_id = c.id AND cm.user_id = \\$1 (.+) a.status IN \\('owner', 'accepted'\\)").
+					WithArgs("user").
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "todo_id", "author_id", "body", "created_at", "updated_at", "mentions"}).
+						AddRow("1", "todo", "author", "Hi @user@example.com", now, now, "{user}"))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: []models.Comment{{
+				ID:        "1",
+				TodoID:    "todo",
+				AuthorID:  "author",
+				Body:      "Hi @user@example.com",
+				Mentions:  []string{"user"},
+				CreatedAt: now,
+				UpdatedAt: now,
+			}},
+		},
+		{
+			name: "Failed get due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT (.+) FROM comments c").
+					WithArgs("user").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get comments: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.GetMentioning(ctx, "user")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package comments
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/users"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrEmptyComment     = errors.New("comment must not be empty")
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrNotCommentAuthor = errors.New("only the author can change a comment")
+)
+
+var mentionPattern = regexp.MustCompile(`@([A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,})`)
+
+//go:generate mockery --name=CommentService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type CommentService interface {
+	CreateComment(ctx context.Context, comment models.Comment) (models.Comment, error)
+	ListComments(ctx context.Context, todoID string) ([]models.Comment, error)
+	ListMentions(ctx context.Context, userID string) ([]models.Comment, error)
+	UpdateComment(ctx context.Context, comment models.Comment) (models.Comment, error)
+	DeleteComment(ctx context.Context, id string, todoID string, userID string) error
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ CommentService = &service{}
+
+type service struct {
+	repo        CommentRepository
+	userService users.UserService
+	uuidService UUIDService
+	timeService TimeService
+}
+
+func NewService(repo CommentRepository, userService users.UserService, uuidService UUIDService, timeService TimeService) CommentService {
+	return &service{repo: repo, userService: userService, uuidService: uuidService, timeService: timeService}
+}
+
+func (s *service) CreateComment(ctx context.Context, comment models.Comment) (models.Comment, error) {
+	log.C(ctx).Info("creating comment service")
+	comment.Body = strings.TrimSpace(comment.Body)
+	if comment.Body == "" {
+		return models.Comment{}, ErrEmptyComment
+	}
+
+	comment.ID = s.uuidService.Generate()
+	comment.CreatedAt = s.timeService.Now()
+	comment.UpdatedAt = comment.CreatedAt
+	if _, err := s.repo.Create(ctx, comment); err != nil {
+		log.C(ctx).Errorf("creating comment on todo with id %s failed", comment.TodoID)
+		return models.Comment{}, err
+	}
+	if err := s.saveMentions(ctx, comment.ID, comment.Body); err != nil {
+		return models.Comment{}, err
+	}
+	return s.repo.Get(ctx, comment.ID)
+}
+
+func (s *service) ListComments(ctx context.Context, todoID string) ([]models.Comment, error) {
+	log.C(ctx).Info("listing comments service")
+	return s.repo.GetByTodoID(ctx, todoID)
+}
+
+func (s *service) ListMentions(ctx context.Context, userID string) ([]models.Comment, error) {
+	log.C(ctx).Info("listing comments mentioning user service")
+	return s.repo.GetMentioning(ctx, userID)
+}
+
+// UpdateComment replaces the body of the comment with comment.ID. Only the
+// author given in comment.AuthorID may do so.
+func (s *service) UpdateComment(ctx context.Context, comment models.Comment) (models.Comment, error) {
+	log.C(ctx).Info("updating comment service")
+	body := strings.TrimSpace(comment.Body)
+	if body == "" {
+		return models.Comment{}, ErrEmptyComment
+	}
+	if _, err := s.getOwnComment(ctx, comment.ID, comment.TodoID, comment.AuthorID); err != nil {
+		return models.Comment{}, err
+	}
+
+	if err := s.repo.UpdateBody(ctx, comment.ID, body, s.timeService.Now()); err != nil {
+		log.C(ctx).Errorf("updating comment with id %s failed", comment.ID)
+		return models.Comment{}, err
+	}
+	if err := s.saveMentions(ctx, comment.ID, body); err != nil {
+		return models.Comment{}, err
+	}
+	return s.repo.Get(ctx, comment.ID)
+}
+
+func (s *service) DeleteComment(ctx context.Context, id string, todoID string, userID string) error {
+	log.C(ctx).Info("deleting comment service")
+	if _, err := s.getOwnComment(ctx, id, todoID, userID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *service) getOwnComment(ctx context.Context, id string, todoID string, userID string) (models.Comment, error) {
+	comment, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Comment{}, ErrCommentNotFound
+	}
+	if err != nil {
+		log.C(ctx).Errorf("getting comment with id %s failed", id)
+		return models.Comment{}, err
+	}
+	if comment.TodoID != todoID {
+		return models.Comment{}, ErrCommentNotFound
+	}
+	if comment.AuthorID != userID {
+		log.C(ctx).Errorf("user %s is not the author of comment %s", userID, id)
+		return models.Comment{}, ErrNotCommentAuthor
+	}
+	return comment, nil
+}
+
+// saveMentions stores the users mentioned in the body. Mentions of unknown
+// emails are left as plain text.
+func (s *service) saveMentions(ctx context.Context, commentID string, body string) error {
+	var userIDs []string
+	seen := map[string]bool{}
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		email := match[1]
+		if seen[strings.ToLower(email)] {
+			continue
+		}
+		seen[strings.ToLower(email)] = true
+
+		user, err := s.userService.GetUserByEmail(ctx, email)
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Debugf("mentioned email %s does not belong to a user", email)
+			continue
+		}
+		if err != nil {
+			log.C(ctx).Errorf("getting mentioned user %s failed", email)
+			return err
+		}
+		userIDs = append(userIDs, user.ID)
+	}
+	return s.repo.SetMentions(ctx, commentID, userIDs)
+}
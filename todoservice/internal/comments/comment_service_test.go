@@ -0,0 +1,186 @@
+package comments_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/comments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/comments/automock"
+	usersautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/users/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestServiceCreateComment(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	notFound := fmt.Errorf("user not found: %w", sql.ErrNoRows)
+
+	input := models.Comment{TodoID: "todo", AuthorID: "author", Body: " Can @alice@example.com and @ALICE@example.com or @ghost@example.com check? "}
+	stored := models.Comment{
+		ID:        "1",
+		TodoID:    "todo",
+		AuthorID:  "author",
+		Body:      "Can @alice@example.com and @ALICE@example.com or @ghost@example.com check?",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	created := stored
+	created.Mentions = []string{"alice"}
+
+	tests := []struct {
+		name          string
+		input         models.Comment
+		repo          func() *automock.CommentRepository
+		userService   func() *usersautomock.UserService
+		expected      models.Comment
+		expectedError error
+	}{
+		{
+			name:  "Create comment and resolve mentions",
+			input: input,
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Create(ctx, stored).Return(stored.ID, nil).Once()
+				repo.EXPECT().SetMentions(ctx, stored.ID, []string{"alice"}).Return(nil).Once()
+				repo.EXPECT().Get(ctx, stored.ID).Return(created, nil).Once()
+				return repo
+			},
+			userService: func() *usersautomock.UserService {
+				userService := &usersautomock.UserService{}
+				userService.EXPECT().GetUserByEmail(ctx, "alice@example.com").Return(models.User{ID: "alice"}, nil).Once()
+				userService.EXPECT().GetUserByEmail(ctx, "ghost@example.com").Return(models.User{}, notFound).Once()
+				return userService
+			},
+			expected: created,
+		},
+		{
+			name:  "Error when the comment is empty",
+			input: models.Comment{TodoID: "todo", AuthorID: "author", Body: "  "},
+			repo: func() *automock.CommentRepository {
+				return &automock.CommentRepository{}
+			},
+			userService: func() *usersautomock.UserService {
+				return &usersautomock.UserService{}
+			},
+			expectedError: comments.ErrEmptyComment,
+		},
+		{
+			name:  "Error when resolving a mention fails",
+			input: input,
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Create(ctx, stored).Return(stored.ID, nil).Once()
+				return repo
+			},
+			userService: func() *usersautomock.UserService {
+				userService := &usersautomock.UserService{}
+				userService.EXPECT().GetUserByEmail(ctx, "alice@example.com").Return(models.User{}, err).Once()
+				return userService
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			userService := tt.userService()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(stored.ID).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo, userService)
+
+			svc := comments.NewService(repo, userService, uuidService, timeService)
+			result, err := svc.CreateComment(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateComment(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	existing := models.Comment{ID: "1", TodoID: "todo", AuthorID: "author", Body: "old"}
+	updated := models.Comment{ID: "1", TodoID: "todo", AuthorID: "author", Body: "new", Mentions: []string{}}
+
+	tests := []struct {
+		name          string
+		input         models.Comment
+		repo          func() *automock.CommentRepository
+		expected      models.Comment
+		expectedError error
+	}{
+		{
+			name:  "Author updates the comment",
+			input: models.Comment{ID: "1", TodoID: "todo", AuthorID: "author", Body: "new"},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(existing, nil).Once()
+				repo.EXPECT().UpdateBody(ctx, "1", "new", now).Return(nil).Once()
+				repo.EXPECT().SetMentions(ctx, "1", []string(nil)).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(updated, nil).Once()
+				return repo
+			},
+			expected: updated,
+		},
+		{
+			name:  "Error when another user updates the comment",
+			input: models.Comment{ID: "1", TodoID: "todo", AuthorID: "intruder", Body: "new"},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(existing, nil).Once()
+				return repo
+			},
+			expectedError: comments.ErrNotCommentAuthor,
+		},
+		{
+			name:  "Error when the comment belongs to another todo",
+			input: models.Comment{ID: "1", TodoID: "other", AuthorID: "author", Body: "new"},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(existing, nil).Once()
+				return repo
+			},
+			expectedError: comments.ErrCommentNotFound,
+		},
+		{
+			name:  "Error when the comment does not exist",
+			input: models.Comment{ID: "1", TodoID: "todo", AuthorID: "author", Body: "new"},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(models.Comment{}, fmt.Errorf("comment not found: %w", sql.ErrNoRows)).Once()
+				return repo
+			},
+			expectedError: comments.ErrCommentNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := comments.NewService(repo, &usersautomock.UserService{}, &automock.UUIDService{}, timeService)
+			result, err := svc.UpdateComment(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package comments
+
+import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertCommentToModel(entity Entity) models.Comment {
+	mentions := []string(entity.Mentions)
+	if mentions == nil {
+		mentions = []string{}
+	}
+	return models.Comment{
+		ID:        entity.ID,
+		TodoID:    entity.TodoID,
+		AuthorID:  entity.AuthorID,
+		Body:      entity.Body,
+		Mentions:  mentions,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ConvertCommentToEntity(comment models.Comment) Entity {
+	return Entity{
+		ID:        comment.ID,
+		TodoID:    comment.TodoID,
+		AuthorID:  comment.AuthorID,
+		Body:      comment.Body,
+		Mentions:  comment.Mentions,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
@@ -0,0 +1,16 @@
+package comments
+
+import (
+	"github.com/lib/pq"
+	"time"
+)
+
+type Entity struct {
+	ID        string         `db:"id"`
+	TodoID    string         `db:"todo_id"`
+	AuthorID  string         `db:"author_id"`
+	Body      string         `db:"body"`
+	Mentions  pq.StringArray `db:"mentions"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
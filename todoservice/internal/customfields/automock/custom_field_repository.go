@@ -0,0 +1,413 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// CustomFieldRepository is an autogenerated mock type for the CustomFieldRepository type
+type CustomFieldRepository struct {
+	mock.Mock
+}
+
+type CustomFieldRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CustomFieldRepository) EXPECT() *CustomFieldRepository_Expecter {
+	return &CustomFieldRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, field
+func (_m *CustomFieldRepository) Create(ctx context.Context, field models.CustomField) (string, error) {
+	ret := _m.Called(ctx, field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CustomField) (string, error)); ok {
+		return rf(ctx, field)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CustomField) string); ok {
+		r0 = rf(ctx, field)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CustomField) error); ok {
+		r1 = rf(ctx, field)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type CustomFieldRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - field models.CustomField
+func (_e *CustomFieldRepository_Expecter) Create(ctx interface{}, field interface{}) *CustomFieldRepository_Create_Call {
+	return &CustomFieldRepository_Create_Call{Call: _e.mock.On("Create", ctx, field)}
+}
+
+func (_c *CustomFieldRepository_Create_Call) Run(run func(ctx context.Context, field models.CustomField)) *CustomFieldRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CustomField))
+	})
+	return _c
+}
+
+func (_c *CustomFieldRepository_Create_Call) Return(_a0 string, _a1 error) *CustomFieldRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldRepository_Create_Call) RunAndReturn(run func(context.Context, models.CustomField) (string, error)) *CustomFieldRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *CustomFieldRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CustomFieldRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type CustomFieldRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *CustomFieldRepository_Expecter) Delete(ctx interface{}, id interface{}) *CustomFieldRepository_Delete_Call {
+	return &CustomFieldRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *CustomFieldRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *CustomFieldRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CustomFieldRepository_Delete_Call) Return(_a0 error) *CustomFieldRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CustomFieldRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *CustomFieldRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *CustomFieldRepository) Get(ctx context.Context, id string) (models.CustomField, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 models.CustomField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.CustomField, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.CustomField); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.CustomField)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type CustomFieldRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *CustomFieldRepository_Expecter) Get(ctx interface{}, id interface{}) *CustomFieldRepository_Get_Call {
+	return &CustomFieldRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *CustomFieldRepository_Get_Call) Run(run func(ctx context.Context, id string)) *CustomFieldRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CustomFieldRepository_Get_Call) Return(_a0 models.CustomField, _a1 error) *CustomFieldRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldRepository_Get_Call) RunAndReturn(run func(context.Context, string) (models.CustomField, error)) *CustomFieldRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByListID provides a mock function with given fields: ctx, listID
+func (_m *CustomFieldRepository) GetByListID(ctx context.Context, listID string) ([]models.CustomField, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByListID")
+	}
+
+	var r0 []models.CustomField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.CustomField, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.CustomField); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CustomField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldRepository_GetByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByListID'
+type CustomFieldRepository_GetByListID_Call struct {
+	*mock.Call
+}
+
+// GetByListID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *CustomFieldRepository_Expecter) GetByListID(ctx interface{}, listID interface{}) *CustomFieldRepository_GetByListID_Call {
+	return &CustomFieldRepository_GetByListID_Call{Call: _e.mock.On("GetByListID", ctx, listID)}
+}
+
+func (_c *CustomFieldRepository_GetByListID_Call) Run(run func(ctx context.Context, listID string)) *CustomFieldRepository_GetByListID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CustomFieldRepository_GetByListID_Call) Return(_a0 []models.CustomField, _a1 error) *CustomFieldRepository_GetByListID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldRepository_GetByListID_Call) RunAndReturn(run func(context.Context, string) ([]models.CustomField, error)) *CustomFieldRepository_GetByListID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodoValues provides a mock function with given fields: ctx, todoID
+func (_m *CustomFieldRepository) GetTodoValues(ctx context.Context, todoID string) ([]models.CustomFieldValue, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodoValues")
+	}
+
+	var r0 []models.CustomFieldValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.CustomFieldValue, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.CustomFieldValue); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CustomFieldValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldRepository_GetTodoValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodoValues'
+type CustomFieldRepository_GetTodoValues_Call struct {
+	*mock.Call
+}
+
+// GetTodoValues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+func (_e *CustomFieldRepository_Expecter) GetTodoValues(ctx interface{}, todoID interface{}) *CustomFieldRepository_GetTodoValues_Call {
+	return &CustomFieldRepository_GetTodoValues_Call{Call: _e.mock.On("GetTodoValues", ctx, todoID)}
+}
+
+func (_c *CustomFieldRepository_GetTodoValues_Call) Run(run func(ctx context.Context, todoID string)) *CustomFieldRepository_GetTodoValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CustomFieldRepository_GetTodoValues_Call) Return(_a0 []models.CustomFieldValue, _a1 error) *CustomFieldRepository_GetTodoValues_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldRepository_GetTodoValues_Call) RunAndReturn(run func(context.Context, string) ([]models.CustomFieldValue, error)) *CustomFieldRepository_GetTodoValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveValues provides a mock function with given fields: ctx, fieldID, listID, keep
+func (_m *CustomFieldRepository) RemoveValues(ctx context.Context, fieldID string, listID string, keep []string) error {
+	ret := _m.Called(ctx, fieldID, listID, keep)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveValues")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) error); ok {
+		r0 = rf(ctx, fieldID, listID, keep)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CustomFieldRepository_RemoveValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveValues'
+type CustomFieldRepository_RemoveValues_Call struct {
+	*mock.Call
+}
+
+// RemoveValues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fieldID string
+//   - listID string
+//   - keep []string
+func (_e *CustomFieldRepository_Expecter) RemoveValues(ctx interface{}, fieldID interface{}, listID interface{}, keep interface{}) *CustomFieldRepository_RemoveValues_Call {
+	return &CustomFieldRepository_RemoveValues_Call{Call: _e.mock.On("RemoveValues", ctx, fieldID, listID, keep)}
+}
+
+func (_c *CustomFieldRepository_RemoveValues_Call) Run(run func(ctx context.Context, fieldID string, listID string, keep []string)) *CustomFieldRepository_RemoveValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *CustomFieldRepository_RemoveValues_Call) Return(_a0 error) *CustomFieldRepository_RemoveValues_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CustomFieldRepository_RemoveValues_Call) RunAndReturn(run func(context.Context, string, string, []string) error) *CustomFieldRepository_RemoveValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, field
+func (_m *CustomFieldRepository) Update(ctx context.Context, field models.CustomField) error {
+	ret := _m.Called(ctx, field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CustomField) error); ok {
+		r0 = rf(ctx, field)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CustomFieldRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type CustomFieldRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - field models.CustomField
+func (_e *CustomFieldRepository_Expecter) Update(ctx interface{}, field interface{}) *CustomFieldRepository_Update_Call {
+	return &CustomFieldRepository_Update_Call{Call: _e.mock.On("Update", ctx, field)}
+}
+
+func (_c *CustomFieldRepository_Update_Call) Run(run func(ctx context.Context, field models.CustomField)) *CustomFieldRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CustomField))
+	})
+	return _c
+}
+
+func (_c *CustomFieldRepository_Update_Call) Return(_a0 error) *CustomFieldRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CustomFieldRepository_Update_Call) RunAndReturn(run func(context.Context, models.CustomField) error) *CustomFieldRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCustomFieldRepository creates a new instance of CustomFieldRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomFieldRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomFieldRepository {
+	mock := &CustomFieldRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
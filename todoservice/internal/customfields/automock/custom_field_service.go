@@ -0,0 +1,378 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// CustomFieldService is an autogenerated mock type for the CustomFieldService type
+type CustomFieldService struct {
+	mock.Mock
+}
+
+type CustomFieldService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CustomFieldService) EXPECT() *CustomFieldService_Expecter {
+	return &CustomFieldService_Expecter{mock: &_m.Mock}
+}
+
+// CreateField provides a mock function with given fields: ctx, field
+func (_m *CustomFieldService) CreateField(ctx context.Context, field models.CustomField) (models.CustomField, error) {
+	ret := _m.Called(ctx, field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateField")
+	}
+
+	var r0 models.CustomField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CustomField) (models.CustomField, error)); ok {
+		return rf(ctx, field)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CustomField) models.CustomField); ok {
+		r0 = rf(ctx, field)
+	} else {
+		r0 = ret.Get(0).(models.CustomField)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CustomField) error); ok {
+		r1 = rf(ctx, field)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldService_CreateField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateField'
+type CustomFieldService_CreateField_Call struct {
+	*mock.Call
+}
+
+// CreateField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - field models.CustomField
+func (_e *CustomFieldService_Expecter) CreateField(ctx interface{}, field interface{}) *CustomFieldService_CreateField_Call {
+	return &CustomFieldService_CreateField_Call{Call: _e.mock.On("CreateField", ctx, field)}
+}
+
+func (_c *CustomFieldService_CreateField_Call) Run(run func(ctx context.Context, field models.CustomField)) *CustomFieldService_CreateField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CustomField))
+	})
+	return _c
+}
+
+func (_c *CustomFieldService_CreateField_Call) Return(_a0 models.CustomField, _a1 error) *CustomFieldService_CreateField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldService_CreateField_Call) RunAndReturn(run func(context.Context, models.CustomField) (models.CustomField, error)) *CustomFieldService_CreateField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteField provides a mock function with given fields: ctx, id, listID
+func (_m *CustomFieldService) DeleteField(ctx context.Context, id string, listID string) error {
+	ret := _m.Called(ctx, id, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteField")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, listID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CustomFieldService_DeleteField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteField'
+type CustomFieldService_DeleteField_Call struct {
+	*mock.Call
+}
+
+// DeleteField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - listID string
+func (_e *CustomFieldService_Expecter) DeleteField(ctx interface{}, id interface{}, listID interface{}) *CustomFieldService_DeleteField_Call {
+	return &CustomFieldService_DeleteField_Call{Call: _e.mock.On("DeleteField", ctx, id, listID)}
+}
+
+func (_c *CustomFieldService_DeleteField_Call) Run(run func(ctx context.Context, id string, listID string)) *CustomFieldService_DeleteField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CustomFieldService_DeleteField_Call) Return(_a0 error) *CustomFieldService_DeleteField_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CustomFieldService_DeleteField_Call) RunAndReturn(run func(context.Context, string, string) error) *CustomFieldService_DeleteField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFields provides a mock function with given fields: ctx, listID
+func (_m *CustomFieldService) ListFields(ctx context.Context, listID string) ([]models.CustomField, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFields")
+	}
+
+	var r0 []models.CustomField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.CustomField, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.CustomField); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CustomField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldService_ListFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFields'
+type CustomFieldService_ListFields_Call struct {
+	*mock.Call
+}
+
+// ListFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *CustomFieldService_Expecter) ListFields(ctx interface{}, listID interface{}) *CustomFieldService_ListFields_Call {
+	return &CustomFieldService_ListFields_Call{Call: _e.mock.On("ListFields", ctx, listID)}
+}
+
+func (_c *CustomFieldService_ListFields_Call) Run(run func(ctx context.Context, listID string)) *CustomFieldService_ListFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CustomFieldService_ListFields_Call) Return(_a0 []models.CustomField, _a1 error) *CustomFieldService_ListFields_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldService_ListFields_Call) RunAndReturn(run func(context.Context, string) ([]models.CustomField, error)) *CustomFieldService_ListFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTodoValues provides a mock function with given fields: ctx, todoID
+func (_m *CustomFieldService) ListTodoValues(ctx context.Context, todoID string) ([]models.CustomFieldValue, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTodoValues")
+	}
+
+	var r0 []models.CustomFieldValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.CustomFieldValue, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.CustomFieldValue); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CustomFieldValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldService_ListTodoValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodoValues'
+type CustomFieldService_ListTodoValues_Call struct {
+	*mock.Call
+}
+
+// ListTodoValues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+func (_e *CustomFieldService_Expecter) ListTodoValues(ctx interface{}, todoID interface{}) *CustomFieldService_ListTodoValues_Call {
+	return &CustomFieldService_ListTodoValues_Call{Call: _e.mock.On("ListTodoValues", ctx, todoID)}
+}
+
+func (_c *CustomFieldService_ListTodoValues_Call) Run(run func(ctx context.Context, todoID string)) *CustomFieldService_ListTodoValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CustomFieldService_ListTodoValues_Call) Return(_a0 []models.CustomFieldValue, _a1 error) *CustomFieldService_ListTodoValues_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldService_ListTodoValues_Call) RunAndReturn(run func(context.Context, string) ([]models.CustomFieldValue, error)) *CustomFieldService_ListTodoValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateField provides a mock function with given fields: ctx, field
+func (_m *CustomFieldService) UpdateField(ctx context.Context, field models.CustomField) (models.CustomField, error) {
+	ret := _m.Called(ctx, field)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateField")
+	}
+
+	var r0 models.CustomField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CustomField) (models.CustomField, error)); ok {
+		return rf(ctx, field)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CustomField) models.CustomField); ok {
+		r0 = rf(ctx, field)
+	} else {
+		r0 = ret.Get(0).(models.CustomField)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CustomField) error); ok {
+		r1 = rf(ctx, field)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldService_UpdateField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateField'
+type CustomFieldService_UpdateField_Call struct {
+	*mock.Call
+}
+
+// UpdateField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - field models.CustomField
+func (_e *CustomFieldService_Expecter) UpdateField(ctx interface{}, field interface{}) *CustomFieldService_UpdateField_Call {
+	return &CustomFieldService_UpdateField_Call{Call: _e.mock.On("UpdateField", ctx, field)}
+}
+
+func (_c *CustomFieldService_UpdateField_Call) Run(run func(ctx context.Context, field models.CustomField)) *CustomFieldService_UpdateField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CustomField))
+	})
+	return _c
+}
+
+func (_c *CustomFieldService_UpdateField_Call) Return(_a0 models.CustomField, _a1 error) *CustomFieldService_UpdateField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldService_UpdateField_Call) RunAndReturn(run func(context.Context, models.CustomField) (models.CustomField, error)) *CustomFieldService_UpdateField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateValues provides a mock function with given fields: ctx, listID, values
+func (_m *CustomFieldService) ValidateValues(ctx context.Context, listID string, values map[string]interface{}) (map[string]interface{}, error) {
+	ret := _m.Called(ctx, listID, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateValues")
+	}
+
+	var r0 map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) (map[string]interface{}, error)); ok {
+		return rf(ctx, listID, values)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) map[string]interface{}); ok {
+		r0 = rf(ctx, listID, values)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]interface{}) error); ok {
+		r1 = rf(ctx, listID, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomFieldService_ValidateValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateValues'
+type CustomFieldService_ValidateValues_Call struct {
+	*mock.Call
+}
+
+// ValidateValues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - values map[string]interface{}
+func (_e *CustomFieldService_Expecter) ValidateValues(ctx interface{}, listID interface{}, values interface{}) *CustomFieldService_ValidateValues_Call {
+	return &CustomFieldService_ValidateValues_Call{Call: _e.mock.On("ValidateValues", ctx, listID, values)}
+}
+
+func (_c *CustomFieldService_ValidateValues_Call) Run(run func(ctx context.Context, listID string, values map[string]interface{})) *CustomFieldService_ValidateValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *CustomFieldService_ValidateValues_Call) Return(_a0 map[string]interface{}, _a1 error) *CustomFieldService_ValidateValues_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomFieldService_ValidateValues_Call) RunAndReturn(run func(context.Context, string, map[string]interface{}) (map[string]interface{}, error)) *CustomFieldService_ValidateValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCustomFieldService creates a new instance of CustomFieldService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomFieldService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomFieldService {
+	mock := &CustomFieldService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,64 @@
+package customfields
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertCustomFieldToModel(entity Entity) models.CustomField {
+	options := []string{}
+	if entity.Options.Valid {
+		if err := json.Unmarshal([]byte(entity.Options.String), &options); err != nil {
+			options = []string{}
+		}
+	}
+	return models.CustomField{
+		ID:        entity.ID,
+		ListID:    entity.ListID,
+		Name:      entity.Name,
+		Type:      entity.Type,
+		Options:   options,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func (c *Converter) ConvertCustomFieldToEntity(field models.CustomField) Entity {
+	options := field.Options
+	if options == nil {
+		options = []string{}
+	}
+	data, err := json.Marshal(options)
+	if err != nil {
+		data = []byte("[]")
+	}
+	return Entity{
+		ID:        field.ID,
+		ListID:    field.ListID,
+		Name:      field.Name,
+		Type:      field.Type,
+		Options:   sql.NullString{String: string(data), Valid: true},
+		CreatedAt: field.CreatedAt,
+	}
+}
+
+func (c *Converter) ConvertValueToModel(entity ValueEntity) models.CustomFieldValue {
+	var value interface{}
+	if entity.Value.Valid {
+		if err := json.Unmarshal([]byte(entity.Value.String), &value); err != nil {
+			value = nil
+		}
+	}
+	return models.CustomFieldValue{
+		FieldID: entity.FieldID,
+		Name:    entity.Name,
+		Type:    entity.Type,
+		Value:   value,
+	}
+}
@@ -0,0 +1,213 @@
+package customfields
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+)
+
+//go:generate mockery --name=CustomFieldRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type CustomFieldRepository interface {
+	Create(ctx context.Context, field models.CustomField) (string, error)
+	Get(ctx context.Context, id string) (models.CustomField, error)
+	GetByListID(ctx context.Context, listID string) ([]models.CustomField, error)
+	Update(ctx context.Context, field models.CustomField) error
+	Delete(ctx context.Context, id string) error
+	RemoveValues(ctx context.Context, fieldID string, listID string, keep []string) error
+	GetTodoValues(ctx context.Context, todoID string) ([]models.CustomFieldValue, error)
+}
+
+const selectCustomFields = `
+	SELECT id, list_id, name, type, options, created_at
+	FROM list_custom_fields
+`
+
+type SQLXCustomFieldRepository struct {
+	converter *Converter
+}
+
+var _ CustomFieldRepository = &SQLXCustomFieldRepository{}
+
+func NewSQLXCustomFieldRepository() CustomFieldRepository {
+	return &SQLXCustomFieldRepository{converter: NewConverter()}
+}
+
+func (r *SQLXCustomFieldRepository) Create(ctx context.Context, field models.CustomField) (string, error) {
+	log.C(ctx).Info("creating custom field repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertCustomFieldToEntity(field)
+	if err = pkg.ValidateUUID(entity.ID); err != nil {
+		log.C(ctx).Errorf("invalid entity id: %v", err)
+		return "", fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	query := `
+		INSERT INTO list_custom_fields (id, list_id, name, type, options, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query,
+		entity.ID,
+		entity.ListID,
+		entity.Name,
+		entity.Type,
+		entity.Options,
+		entity.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to insert custom field: %v", err)
+		return "", fmt.Errorf("failed to create custom field: %w", err)
+	}
+	return id, nil
+}
+
+func (r *SQLXCustomFieldRepository) Get(ctx context.Context, id string) (models.CustomField, error) {
+	log.C(ctx).Info("getting custom field repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.CustomField{}, err
+	}
+
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, selectCustomFields+`WHERE id = $1`, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get custom field: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.CustomField{}, fmt.Errorf("custom field not found: %w", err)
+		}
+		return models.CustomField{}, fmt.Errorf("failed to get custom field: %w", err)
+	}
+	return r.converter.ConvertCustomFieldToModel(entity), nil
+}
+
+func (r *SQLXCustomFieldRepository) GetByListID(ctx context.Context, listID string) ([]models.CustomField, error) {
+	log.C(ctx).Info("getting custom fields of list repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, selectCustomFields+`WHERE list_id = $1 ORDER BY created_at, id`, listID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get custom fields: %v", err)
+		return nil, fmt.Errorf("failed to get custom fields: %w", err)
+	}
+
+	result := make([]models.CustomField, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertCustomFieldToModel(entity))
+	}
+	return result, nil
+}
+
+// Update changes the name and options of the field. The type of a field
+// never changes.
+func (r *SQLXCustomFieldRepository) Update(ctx context.Context, field models.CustomField) error {
+	log.C(ctx).Info("updating custom field repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	entity := r.converter.ConvertCustomFieldToEntity(field)
+	query := `UPDATE list_custom_fields SET name = $2, options = $3 WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, query, entity.ID, entity.Name, entity.Options); err != nil {
+		log.C(ctx).Errorf("failed to update custom field: %v", err)
+		return fmt.Errorf("failed to update custom field: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXCustomFieldRepository) Delete(ctx context.Context, id string) error {
+	log.C(ctx).Info("deleting custom field repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `DELETE FROM list_custom_fields WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+		log.C(ctx).Errorf("failed to delete custom field: %v", err)
+		return fmt.Errorf("failed to delete custom field: %w", err)
+	}
+	return nil
+}
+
+// RemoveValues drops the values of the field from the todos of the list,
+// trashed ones included. Values listed in keep are left untouched; a nil keep
+// removes every value.
+func (r *SQLXCustomFieldRepository) RemoveValues(ctx context.Context, fieldID string, listID string, keep []string) error {
+	log.C(ctx).Info("removing custom field values repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `
+		UPDATE todos
+		SET custom_fields = custom_fields - $1::text
+		WHERE list_id = $2 AND custom_fields -> $1::text IS NOT NULL
+	`
+	args := []interface{}{fieldID, listID}
+	if keep != nil {
+		query += ` AND NOT (custom_fields ->> $1::text = ANY($3))`
+		args = append(args, pq.Array(keep))
+	}
+
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		log.C(ctx).Errorf("failed to remove custom field values: %v", err)
+		return fmt.Errorf("failed to remove custom field values: %w", err)
+	}
+	return nil
+}
+
+// GetTodoValues returns the value the todo has for every field of its list,
+// in the order the fields were defined.
+func (r *SQLXCustomFieldRepository) GetTodoValues(ctx context.Context, todoID string) ([]models.CustomFieldValue, error) {
+	log.C(ctx).Info("getting custom field values of todo repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT f.id AS field_id, f.name, f.type, t.custom_fields -> f.id::text AS value
+		FROM todos t
+		JOIN list_custom_fields f ON f.list_id = t.list_id
+		WHERE t.id = $1 AND t.deleted_at IS NULL
+		ORDER BY f.created_at, f.id
+	`
+
+	var entities []ValueEntity
+	err = tx.SelectContext(ctx, &entities, query, todoID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get custom field values: %v", err)
+		return nil, fmt.Errorf("failed to get custom field values: %w", err)
+	}
+
+	result := make([]models.CustomFieldValue, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertValueToModel(entity))
+	}
+	return result, nil
+}
@@ -0,0 +1,158 @@
+package customfields_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/customfields"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+)
+
+func TestSQLXCustomFieldRepositoryGetTodoValues(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := customfields.NewSQLXCustomFieldRepository()
+
+	testCases := []struct {
+		name           string
+		setupMocks     func()
+		expectedResult []models.CustomFieldValue
+		expectedError  error
+	}{
+		{
+			name: "Successful get of values with unset fields",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT f.id AS field_id, f.name, f.type, t.custom_fields -> f.id::text AS value FROM todos t JOIN list_custom_fields f ON f.list_id = t.list_id WHERE t.id = \\$1 AND t.deleted_at IS NULL ORDER BY f.created_at, f.id").
+					WithArgs("todo").
+					WillReturnRows(sqlxmock.NewRows([]string{"field_id", "name", "type", "value"}).
+						AddRow("1", "Points", "number", "3").
+						AddRow("2", "Stage", "single_select", nil))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: []models.CustomFieldValue{
+				{FieldID: "1", Name: "Points", Type: constants.CustomFieldNumber, Value: float64(3)},
+				{FieldID: "2", Name: "Stage", Type: constants.CustomFieldSingleSelect},
+			},
+		},
+		{
+			name: "Failed get due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("FROM todos t JOIN list_custom_fields f").
+					WithArgs("todo").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get custom field values: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.GetTodoValues(ctx, "todo")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXCustomFieldRepositoryRemoveValues(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := customfields.NewSQLXCustomFieldRepository()
+
+	testCases := []struct {
+		name          string
+		keep          []string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful removal of every value",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE todos SET custom_fields = custom_fields - \\$1::text WHERE list_id = \\$2 AND custom_fields -> \\$1::text IS NOT NULL$").
+					WithArgs("field", "list").
+					WillReturnResult(sqlxmock.NewResult(0, 3))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Successful removal of values outside the options",
+			keep: []string{"a", "b"},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("IS NOT NULL AND NOT \\(custom_fields ->> \\$1::text = ANY\\(\\$3\\)\\)").
+					WithArgs("field", "list", pq.Array([]string{"a", "b"})).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed removal due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE todos SET custom_fields").
+					WithArgs("field", "list").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to remove custom field values: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.RemoveValues(ctx, "field", "list", tc.keep)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package customfields
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxFieldNameLength = 100
+
+var (
+	ErrInvalidCustomField   = errors.New("invalid custom field")
+	ErrDuplicateCustomField = errors.New("a custom field with this name already exists in the list")
+	ErrCustomFieldNotFound  = errors.New("custom field not found")
+	ErrInvalidFieldValue    = errors.New("invalid custom field value")
+)
+
+//go:generate mockery --name=CustomFieldService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type CustomFieldService interface {
+	CreateField(ctx context.Context, field models.CustomField) (models.CustomField, error)
+	ListFields(ctx context.Context, listID string) ([]models.CustomField, error)
+	UpdateField(ctx context.Context, field models.CustomField) (models.CustomField, error)
+	DeleteField(ctx context.Context, id string, listID string) error
+	ListTodoValues(ctx context.Context, todoID string) ([]models.CustomFieldValue, error)
+	ValidateValues(ctx context.Context, listID string, values map[string]interface{}) (map[string]interface{}, error)
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ CustomFieldService = &service{}
+
+type service struct {
+	repo        CustomFieldRepository
+	uuidService UUIDService
+	timeService TimeService
+}
+
+func NewService(repo CustomFieldRepository, uuidService UUIDService, timeService TimeService) CustomFieldService {
+	return &service{repo: repo, uuidService: uuidService, timeService: timeService}
+}
+
+func (s *service) CreateField(ctx context.Context, field models.CustomField) (models.CustomField, error) {
+	log.C(ctx).Info("creating custom field service")
+	field.Name = strings.TrimSpace(field.Name)
+	if err := validateField(field); err != nil {
+		return models.CustomField{}, err
+	}
+	if err := s.ensureUniqueName(ctx, field); err != nil {
+		return models.CustomField{}, err
+	}
+
+	field.ID = s.uuidService.Generate()
+	field.Options = normalizeOptions(field.Options)
+	field.CreatedAt = s.timeService.Now()
+	if _, err := s.repo.Create(ctx, field); err != nil {
+		log.C(ctx).Errorf("creating custom field in list with id %s failed", field.ListID)
+		return models.CustomField{}, err
+	}
+	return s.repo.Get(ctx, field.ID)
+}
+
+func (s *service) ListFields(ctx context.Context, listID string) ([]models.CustomField, error) {
+	log.C(ctx).Info("listing custom fields service")
+	return s.repo.GetByListID(ctx, listID)
+}
+
+// UpdateField renames the field and replaces its options. Values of a single
+// select field that are no longer among the options are removed from the
+// todos of the list.
+func (s *service) UpdateField(ctx context.Context, field models.CustomField) (models.CustomField, error) {
+	log.C(ctx).Info("updating custom field service")
+	existing, err := s.getField(ctx, field.ID, field.ListID)
+	if err != nil {
+		return models.CustomField{}, err
+	}
+
+	existing.Name = strings.TrimSpace(field.Name)
+	existing.Options = field.Options
+	if err = validateField(existing); err != nil {
+		return models.CustomField{}, err
+	}
+	if err = s.ensureUniqueName(ctx, existing); err != nil {
+		return models.CustomField{}, err
+	}
+
+	existing.Options = normalizeOptions(existing.Options)
+	if err = s.repo.Update(ctx, existing); err != nil {
+		log.C(ctx).Errorf("updating custom field with id %s failed", field.ID)
+		return models.CustomField{}, err
+	}
+	if existing.Type == constants.CustomFieldSingleSelect {
+		if err = s.repo.RemoveValues(ctx, existing.ID, existing.ListID, existing.Options); err != nil {
+			log.C(ctx).Errorf("removing stale values of custom field with id %s failed", field.ID)
+			return models.CustomField{}, err
+		}
+	}
+	return s.repo.Get(ctx, existing.ID)
+}
+
+// DeleteField removes the field together with the values todos have for it.
+func (s *service) DeleteField(ctx context.Context, id string, listID string) error {
+	log.C(ctx).Info("deleting custom field service")
+	field, err := s.getField(ctx, id, listID)
+	if err != nil {
+		return err
+	}
+	if err = s.repo.RemoveValues(ctx, field.ID, field.ListID, nil); err != nil {
+		log.C(ctx).Errorf("removing values of custom field with id %s failed", id)
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *service) ListTodoValues(ctx context.Context, todoID string) ([]models.CustomFieldValue, error) {
+	log.C(ctx).Info("listing custom field values service")
+	return s.repo.GetTodoValues(ctx, todoID)
+}
+
+// ValidateValues checks the values against the fields of the list and returns
+// them in their stored form. Values are keyed by field ID; a nil value means
+// the todo has no value for that field.
+func (s *service) ValidateValues(ctx context.Context, listID string, values map[string]interface{}) (map[string]interface{}, error) {
+	log.C(ctx).Info("validating custom field values service")
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	fields, err := s.repo.GetByListID(ctx, listID)
+	if err != nil {
+		log.C(ctx).Errorf("getting custom fields of list with id %s failed", listID)
+		return nil, err
+	}
+	byID := make(map[string]models.CustomField, len(fields))
+	for _, field := range fields {
+		byID[field.ID] = field
+	}
+
+	result := make(map[string]interface{}, len(values))
+	for id, value := range values {
+		field, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: field %s does not belong to the list", ErrInvalidFieldValue, id)
+		}
+		if value == nil {
+			continue
+		}
+		normalized, err := normalizeValue(field, value)
+		if err != nil {
+			return nil, err
+		}
+		result[id] = normalized
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+func (s *service) getField(ctx context.Context, id string, listID string) (models.CustomField, error) {
+	field, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.CustomField{}, ErrCustomFieldNotFound
+	}
+	if err != nil {
+		log.C(ctx).Errorf("getting custom field with id %s failed", id)
+		return models.CustomField{}, err
+	}
+	if field.ListID != listID {
+		return models.CustomField{}, ErrCustomFieldNotFound
+	}
+	return field, nil
+}
+
+func (s *service) ensureUniqueName(ctx context.Context, field models.CustomField) error {
+	fields, err := s.repo.GetByListID(ctx, field.ListID)
+	if err != nil {
+		log.C(ctx).Errorf("getting custom fields of list with id %s failed", field.ListID)
+		return err
+	}
+	for _, other := range fields {
+		if other.ID != field.ID && strings.EqualFold(other.Name, field.Name) {
+			return ErrDuplicateCustomField
+		}
+	}
+	return nil
+}
+
+func validateField(field models.CustomField) error {
+	if field.Name == "" || len(field.Name) > maxFieldNameLength {
+		return fmt.Errorf("%w: name must be between 1 and %d characters", ErrInvalidCustomField, maxFieldNameLength)
+	}
+	switch field.Type {
+	case constants.CustomFieldSingleSelect:
+		if len(normalizeOptions(field.Options)) == 0 {
+			return fmt.Errorf("%w: single select fields need at least one option", ErrInvalidCustomField)
+		}
+	case constants.CustomFieldText, constants.CustomFieldNumber, constants.CustomFieldDate, constants.CustomFieldCheckbox:
+		if len(field.Options) > 0 {
+			return fmt.Errorf("%w: only single select fields have options", ErrInvalidCustomField)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidCustomField, field.Type)
+	}
+	return nil
+}
+
+// normalizeOptions trims the options and drops blank and repeated ones,
+// keeping the order they were given in.
+func normalizeOptions(options []string) []string {
+	result := make([]string, 0, len(options))
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || seen[option] {
+			continue
+		}
+		seen[option] = true
+		result = append(result, option)
+	}
+	return result
+}
+
+func normalizeValue(field models.CustomField, value interface{}) (interface{}, error) {
+	invalid := fmt.Errorf("%w: %q expects a %s value", ErrInvalidFieldValue, field.Name, field.Type)
+	switch field.Type {
+	case constants.CustomFieldText:
+		if v, ok := value.(string); ok {
+			return v, nil
+		}
+	case constants.CustomFieldNumber:
+		var n float64
+		switch v := value.(type) {
+		case float64:
+			n = v
+		case int:
+			n = float64(v)
+		case string:
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, invalid
+			}
+			n = parsed
+		default:
+			return nil, invalid
+		}
+		if math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, invalid
+		}
+		return n, nil
+	case constants.CustomFieldDate:
+		if v, ok := value.(string); ok {
+			parsed, err := time.Parse(constants.DateFormat, strings.TrimSpace(v))
+			if err != nil {
+				return nil, invalid
+			}
+			return parsed.UTC().Format(constants.DateFormat), nil
+		}
+	case constants.CustomFieldSingleSelect:
+		if v, ok := value.(string); ok {
+			for _, option := range field.Options {
+				if option == v {
+					return v, nil
+				}
+			}
+			return nil, fmt.Errorf("%w: %q is not an option of %q", ErrInvalidFieldValue, v, field.Name)
+		}
+	case constants.CustomFieldCheckbox:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			parsed, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return nil, invalid
+			}
+			return parsed, nil
+		}
+	}
+	return nil, invalid
+}
@@ -0,0 +1,301 @@
+package customfields_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/customfields"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/customfields/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestServiceCreateField(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	created := models.CustomField{ID: "1", ListID: "list", Name: "Stage", Type: constants.CustomFieldSingleSelect, Options: []string{"todo", "done"}, CreatedAt: now}
+
+	tests := []struct {
+		name          string
+		input         models.CustomField
+		repo          func() *automock.CustomFieldRepository
+		expected      models.CustomField
+		expectedError error
+	}{
+		{
+			name:  "Create a single select field with normalized options",
+			input: models.CustomField{ListID: "list", Name: " Stage ", Type: constants.CustomFieldSingleSelect, Options: []string{"todo", " done", "todo", ""}},
+			repo: func() *automock.CustomFieldRepository {
+				repo := &automock.CustomFieldRepository{}
+				repo.EXPECT().GetByListID(ctx, "list").Return([]models.CustomField{{ID: "2", Name: "Points"}}, nil).Once()
+				repo.EXPECT().Create(ctx, created).Return(created.ID, nil).Once()
+				repo.EXPECT().Get(ctx, created.ID).Return(created, nil).Once()
+				return repo
+			},
+			expected: created,
+		},
+		{
+			name:          "Error when a single select field has no options",
+			input:         models.CustomField{ListID: "list", Name: "Stage", Type: constants.CustomFieldSingleSelect},
+			repo:          func() *automock.CustomFieldRepository { return &automock.CustomFieldRepository{} },
+			expectedError: customfields.ErrInvalidCustomField,
+		},
+		{
+			name:          "Error when a text field has options",
+			input:         models.CustomField{ListID: "list", Name: "Notes", Type: constants.CustomFieldText, Options: []string{"a"}},
+			repo:          func() *automock.CustomFieldRepository { return &automock.CustomFieldRepository{} },
+			expectedError: customfields.ErrInvalidCustomField,
+		},
+		{
+			name:          "Error when the type is unknown",
+			input:         models.CustomField{ListID: "list", Name: "Notes", Type: "rich_text"},
+			repo:          func() *automock.CustomFieldRepository { return &automock.CustomFieldRepository{} },
+			expectedError: customfields.ErrInvalidCustomField,
+		},
+		{
+			name:  "Error when the name is taken",
+			input: models.CustomField{ListID: "list", Name: "points", Type: constants.CustomFieldNumber},
+			repo: func() *automock.CustomFieldRepository {
+				repo := &automock.CustomFieldRepository{}
+				repo.EXPECT().GetByListID(ctx, "list").Return([]models.CustomField{{ID: "2", Name: "Points"}}, nil).Once()
+				return repo
+			},
+			expectedError: customfields.ErrDuplicateCustomField,
+		},
+		{
+			name:  "Error when creating fails",
+			input: models.CustomField{ListID: "list", Name: "Points", Type: constants.CustomFieldNumber},
+			repo: func() *automock.CustomFieldRepository {
+				repo := &automock.CustomFieldRepository{}
+				repo.EXPECT().GetByListID(ctx, "list").Return(nil, nil).Once()
+				repo.EXPECT().Create(ctx, mock.Anything).Return("", err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(created.ID).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := customfields.NewService(repo, uuidService, timeService)
+			result, err := svc.CreateField(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateField(t *testing.T) {
+	ctx := context.Background()
+	notFound := fmt.Errorf("custom field not found: %w", sql.ErrNoRows)
+
+	existing := models.CustomField{ID: "1", ListID: "list", Name: "Stage", Type: constants.CustomFieldSingleSelect, Options: []string{"todo", "done"}}
+	updated := existing
+	updated.Name = "Phase"
+	updated.Options = []string{"todo", "doing"}
+
+	tests := []struct {
+		name          string
+		input         models.CustomField
+		repo          func() *automock.CustomFieldRepository
+		expected      models.CustomField
+		expectedError error
+	}{
+		{
+			name:  "Update removes values that are no longer options",
+			input: models.CustomField{ID: "1", ListID: "list", Name: "Phase", Options: []string{"todo", "doing"}},
+			repo: func() *automock.CustomFieldRepository {
+				repo := &automock.CustomFieldRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(existing, nil).Once()
+				repo.EXPECT().GetByListID(ctx, "list").Return([]models.CustomField{existing}, nil).Once()
+				repo.EXPECT().Update(ctx, updated).Return(nil).Once()
+				repo.EXPECT().RemoveValues(ctx, "1", "list", []string{"todo", "doing"}).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(updated, nil).Once()
+				return repo
+			},
+			expected: updated,
+		},
+		{
+			name:  "Error when the field belongs to another list",
+			input: models.CustomField{ID: "1", ListID: "other", Name: "Phase"},
+			repo: func() *automock.CustomFieldRepository {
+				repo := &automock.CustomFieldRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(existing, nil).Once()
+				return repo
+			},
+			expectedError: customfields.ErrCustomFieldNotFound,
+		},
+		{
+			name:  "Error when the field does not exist",
+			input: models.CustomField{ID: "1", ListID: "list", Name: "Phase"},
+			repo: func() *automock.CustomFieldRepository {
+				repo := &automock.CustomFieldRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(models.CustomField{}, notFound).Once()
+				return repo
+			},
+			expectedError: customfields.ErrCustomFieldNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := customfields.NewService(repo, &automock.UUIDService{}, &automock.TimeService{})
+			result, err := svc.UpdateField(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceDeleteField(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	field := models.CustomField{ID: "1", ListID: "list", Name: "Points", Type: constants.CustomFieldNumber}
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.CustomFieldRepository
+		expectedError error
+	}{
+		{
+			name: "Delete the field and its values",
+			repo: func() *automock.CustomFieldRepository {
+				repo := &automock.CustomFieldRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(field, nil).Once()
+				repo.EXPECT().RemoveValues(ctx, "1", "list", []string(nil)).Return(nil).Once()
+				repo.EXPECT().Delete(ctx, "1").Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name: "Error when removing the values fails",
+			repo: func() *automock.CustomFieldRepository {
+				repo := &automock.CustomFieldRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(field, nil).Once()
+				repo.EXPECT().RemoveValues(ctx, "1", "list", []string(nil)).Return(err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := customfields.NewService(repo, &automock.UUIDService{}, &automock.TimeService{})
+			err := svc.DeleteField(ctx, "1", "list")
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServiceValidateValues(t *testing.T) {
+	ctx := context.Background()
+	fields := []models.CustomField{
+		{ID: "text", Name: "Notes", Type: constants.CustomFieldText},
+		{ID: "number", Name: "Points", Type: constants.CustomFieldNumber},
+		{ID: "date", Name: "Review", Type: constants.CustomFieldDate},
+		{ID: "select", Name: "Stage", Type: constants.CustomFieldSingleSelect, Options: []string{"todo", "done"}},
+		{ID: "checkbox", Name: "Billable", Type: constants.CustomFieldCheckbox},
+	}
+
+	tests := []struct {
+		name          string
+		values        map[string]interface{}
+		expected      map[string]interface{}
+		expectedError error
+	}{
+		{
+			name: "Values are normalized to their stored form",
+			values: map[string]interface{}{
+				"text":     "hello",
+				"number":   "2.5",
+				"date":     "2026-10-18T12:00:00+02:00",
+				"select":   "done",
+				"checkbox": "true",
+			},
+			expected: map[string]interface{}{
+				"text":     "hello",
+				"number":   2.5,
+				"date":     "2026-10-18T10:00:00Z",
+				"select":   "done",
+				"checkbox": true,
+			},
+		},
+		{
+			name:     "Nil values are dropped",
+			values:   map[string]interface{}{"text": nil, "number": float64(3)},
+			expected: map[string]interface{}{"number": float64(3)},
+		},
+		{
+			name:          "Error when the field is not part of the list",
+			values:        map[string]interface{}{"unknown": "x"},
+			expectedError: customfields.ErrInvalidFieldValue,
+		},
+		{
+			name:          "Error when the option does not exist",
+			values:        map[string]interface{}{"select": "doing"},
+			expectedError: customfields.ErrInvalidFieldValue,
+		},
+		{
+			name:          "Error when the number cannot be parsed",
+			values:        map[string]interface{}{"number": "many"},
+			expectedError: customfields.ErrInvalidFieldValue,
+		},
+		{
+			name:          "Error when the date is not RFC3339",
+			values:        map[string]interface{}{"date": "18/10/2026"},
+			expectedError: customfields.ErrInvalidFieldValue,
+		},
+		{
+			name:          "Error when a checkbox gets text",
+			values:        map[string]interface{}{"checkbox": "maybe"},
+			expectedError: customfields.ErrInvalidFieldValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &automock.CustomFieldRepository{}
+			repo.EXPECT().GetByListID(ctx, "list").Return(fields, nil).Once()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := customfields.NewService(repo, &automock.UUIDService{}, &automock.TimeService{})
+			result, err := svc.ValidateValues(ctx, "list", tt.values)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
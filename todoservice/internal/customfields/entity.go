@@ -0,0 +1,23 @@
+package customfields
+
+import (
+	"database/sql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+type Entity struct {
+	ID        string                    `db:"id"`
+	ListID    string                    `db:"list_id"`
+	Name      string                    `db:"name"`
+	Type      constants.CustomFieldType `db:"type"`
+	Options   sql.NullString            `db:"options"`
+	CreatedAt time.Time                 `db:"created_at"`
+}
+
+type ValueEntity struct {
+	FieldID string                    `db:"field_id"`
+	Name    string                    `db:"name"`
+	Type    constants.CustomFieldType `db:"type"`
+	Value   sql.NullString            `db:"value"`
+}
@@ -0,0 +1,81 @@
+package activity
+
+import (
+	"context"
+	"encoding/json"
+	activitydomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/activity"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	service  activitydomain.ActivityService
+	database *sqlx.DB
+}
+
+func NewHandler(service activitydomain.ActivityService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+func (h *Handler) ListListActivity(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list list activity handler")
+	h.listActivity(w, r, h.service.ListByList)
+}
+
+func (h *Handler) ListTodoActivity(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list todo activity handler")
+	h.listActivity(w, r, h.service.ListByTodo)
+}
+
+// listActivity returns the newest entries first. The optional limit query
+// parameter caps the number of entries.
+func (h *Handler) listActivity(w http.ResponseWriter, r *http.Request, list func(ctx context.Context, id string, limit int) ([]models.Activity, error)) {
+	id := mux.Vars(r)["id"]
+	limit := 0
+	if rawLimit := r.URL.Query().Get("limit"); rawLimit != "" {
+		var err error
+		limit, err = strconv.Atoi(rawLimit)
+		if err != nil {
+			log.C(r.Context()).Errorf("invalid activity limit: %v", err)
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing activity handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := list(ctx, id, limit)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing activity handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing activity handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,222 @@
+package attachment
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+)
+
+// uploadField is the multipart form field that carries the file.
+const uploadField = "file"
+
+type Handler struct {
+	service  attachments.AttachmentService
+	database *sqlx.DB
+}
+
+func NewHandler(service attachments.AttachmentService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+// UploadAttachment reads a multipart/form-data request and streams the part
+// named "file" to the attachment service without buffering it in memory.
+func (h *Handler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("upload attachment handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while uploading attachment handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		log.C(r.Context()).Errorf("error while uploading attachment handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	var part io.ReadCloser
+	var filename string
+	for {
+		next, err := reader.NextPart()
+		if err != nil {
+			log.C(r.Context()).Errorf("error while uploading attachment handler: %v", err)
+			http.Error(w, "Invalid request payload: missing "+uploadField+" field", http.StatusBadRequest)
+			return
+		}
+		if next.FormName() == uploadField {
+			part, filename = next, next.FileName()
+			break
+		}
+		next.Close()
+	}
+	defer part.Close()
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while uploading attachment handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	created, err := h.service.UploadAttachment(ctx, models.Attachment{
+		TodoID:     mux.Vars(r)["id"],
+		UploadedBy: &userID,
+		Filename:   filename,
+	}, part)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while uploading attachment handler: %v", err)
+		http.Error(w, err.Error(), attachmentErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while uploading attachment handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListAttachments(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list attachments handler")
+	todoID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing attachments handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListAttachments(ctx, todoID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing attachments handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing attachments handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// DownloadAttachment streams the file back. It is always served as a
+// download with sniffing disabled, so uploaded content is never rendered
+// inline by the browser.
+func (h *Handler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("download attachment handler")
+	vars := mux.Vars(r)
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while downloading attachment handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	attachment, content, err := h.service.OpenAttachment(ctx, vars["attachment_id"], vars["id"])
+	if err != nil {
+		log.C(r.Context()).Errorf("error while downloading attachment handler: %v", err)
+		http.Error(w, err.Error(), attachmentErrorStatus(err))
+		return
+	}
+	defer content.Close()
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while downloading attachment handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(w, content); err != nil {
+		log.C(r.Context()).Errorf("error while streaming attachment %s: %v", attachment.ID, err)
+	}
+}
+
+func (h *Handler) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete attachment handler")
+	vars := mux.Vars(r)
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while deleting attachment handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if err = h.service.DeleteAttachment(ctx, vars["attachment_id"], vars["id"]); err != nil {
+		log.C(r.Context()).Errorf("error while deleting attachment handler: %v", err)
+		http.Error(w, err.Error(), attachmentErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while deleting attachment handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func attachmentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, attachments.ErrInvalidAttachment):
+		return http.StatusBadRequest
+	case errors.Is(err, attachments.ErrAttachmentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, attachments.ErrAttachmentTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, attachments.ErrUnsupportedContentType):
+		return http.StatusUnsupportedMediaType
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,260 @@
+package comment
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/comments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+type Handler struct {
+	service  comments.CommentService
+	database *sqlx.DB
+}
+
+func NewHandler(service comments.CommentService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+func (h *Handler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create comment handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while creating comment handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	var comment models.Comment
+	if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
+		log.C(r.Context()).Errorf("error while creating comment handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	comment.TodoID = mux.Vars(r)["id"]
+	comment.AuthorID = userID
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating comment handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	created, err := h.service.CreateComment(ctx, comment)
+	log.C(r.Context()).Debugf("create comment handler for comment: %v", created)
+	if errors.Is(err, comments.ErrEmptyComment) {
+		log.C(r.Context()).Errorf("error while creating comment handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating comment handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while creating comment handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListComments(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list comments handler")
+	todoID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing comments handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListComments(ctx, todoID)
+	log.C(r.Context()).Debugf("list comments handler for todo %s: %v", todoID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing comments handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing comments handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListMentions(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list mentions handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while listing mentions handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing mentions handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListMentions(ctx, userID)
+	log.C(r.Context()).Debugf("list mentions handler for user %s: %v", userID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing mentions handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing mentions handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("update comment handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while updating comment handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	var comment models.Comment
+	if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
+		log.C(r.Context()).Errorf("error while updating comment handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	vars := mux.Vars(r)
+	comment.ID = vars["comment_id"]
+	comment.TodoID = vars["id"]
+	comment.AuthorID = userID
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating comment handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	updated, err := h.service.UpdateComment(ctx, comment)
+	log.C(r.Context()).Debugf("update comment handler for comment: %v", updated)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating comment handler: %v", err)
+		http.Error(w, err.Error(), commentErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while updating comment handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete comment handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while deleting comment handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	vars := mux.Vars(r)
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while deleting comment handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if err = h.service.DeleteComment(ctx, vars["comment_id"], vars["id"], userID); err != nil {
+		log.C(r.Context()).Errorf("error while deleting comment handler: %v", err)
+		http.Error(w, err.Error(), commentErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while deleting comment handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func commentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, comments.ErrEmptyComment):
+		return http.StatusBadRequest
+	case errors.Is(err, comments.ErrCommentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, comments.ErrNotCommentAuthor):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
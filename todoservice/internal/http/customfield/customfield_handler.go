@@ -0,0 +1,232 @@
+package customfield
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/customfields"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+type Handler struct {
+	service  customfields.CustomFieldService
+	database *sqlx.DB
+}
+
+func NewHandler(service customfields.CustomFieldService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+func (h *Handler) CreateField(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create custom field handler")
+	var field models.CustomField
+	if err := json.NewDecoder(r.Body).Decode(&field); err != nil {
+		log.C(r.Context()).Errorf("error while creating custom field handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	field.ListID = mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating custom field handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	created, err := h.service.CreateField(ctx, field)
+	log.C(r.Context()).Debugf("create custom field handler for field: %v", created)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating custom field handler: %v", err)
+		http.Error(w, err.Error(), customFieldErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while creating custom field handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListFields(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list custom fields handler")
+	listID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing custom fields handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListFields(ctx, listID)
+	log.C(r.Context()).Debugf("list custom fields handler for list %s: %v", listID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing custom fields handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing custom fields handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// UpdateField renames the field and replaces its options. The type given in
+// the body is ignored since a field keeps its type.
+func (h *Handler) UpdateField(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("update custom field handler")
+	var field models.CustomField
+	if err := json.NewDecoder(r.Body).Decode(&field); err != nil {
+		log.C(r.Context()).Errorf("error while updating custom field handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	vars := mux.Vars(r)
+	field.ID = vars["field_id"]
+	field.ListID = vars["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating custom field handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	updated, err := h.service.UpdateField(ctx, field)
+	log.C(r.Context()).Debugf("update custom field handler for field: %v", updated)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating custom field handler: %v", err)
+		http.Error(w, err.Error(), customFieldErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while updating custom field handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) DeleteField(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete custom field handler")
+	vars := mux.Vars(r)
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while deleting custom field handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if err = h.service.DeleteField(ctx, vars["field_id"], vars["id"]); err != nil {
+		log.C(r.Context()).Errorf("error while deleting custom field handler: %v", err)
+		http.Error(w, err.Error(), customFieldErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while deleting custom field handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListTodoValues responds with the value the todo has for every field of its
+// list. Fields the todo has no value for are included with a null value.
+func (h *Handler) ListTodoValues(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list custom field values handler")
+	todoID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing custom field values handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListTodoValues(ctx, todoID)
+	log.C(r.Context()).Debugf("list custom field values handler for todo %s: %v", todoID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing custom field values handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing custom field values handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func customFieldErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, customfields.ErrInvalidCustomField):
+		return http.StatusBadRequest
+	case errors.Is(err, customfields.ErrCustomFieldNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, customfields.ErrDuplicateCustomField):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,15 +1,23 @@
 package list
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/etag"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/mergepatch"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
 	"github.com/gorilla/mux"
 	"github.com/jmoiron/sqlx"
+	"io"
 	"net/http"
 )
 
@@ -44,6 +52,9 @@ func (h *Handler) CreateList(w http.ResponseWriter, r *http.Request) {
 
 	createdList, err := h.service.CreateList(ctx, list)
 	log.C(r.Context()).Debugf("create list handler with id: %v", createdList)
+	if validation.WriteError(w, err) {
+		return
+	}
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -93,6 +104,7 @@ func (h *Handler) GetList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	etag.Set(w, list.Version)
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(list); err != nil {
@@ -127,6 +139,10 @@ func (h *Handler) UpdateList(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, id) {
+		return
+	}
+
 	_, err = h.service.GetList(ctx, list.ID)
 	log.C(r.Context()).Debugf("update list handler with id: %v", list.ID)
 	if err != nil {
@@ -137,6 +153,9 @@ func (h *Handler) UpdateList(w http.ResponseWriter, r *http.Request) {
 
 	err = h.service.UpdateList(ctx, list)
 	log.C(r.Context()).Debugf("update list handler with id: %v", list.ID)
+	if validation.WriteError(w, err) {
+		return
+	}
 	if err != nil {
 		log.C(r.Context()).Errorf("update list handler: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -152,6 +171,69 @@ func (h *Handler) UpdateList(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// PatchList applies the JSON merge patch in the body to the list and responds
+// with the updated list.
+func (h *Handler) PatchList(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("patch list")
+	id := mux.Vars(r)["id"]
+
+	patch, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while patching list handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while patching list handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if !h.checkVersion(ctx, w, r, id) {
+		return
+	}
+
+	list, err := h.service.PatchList(ctx, id, patch)
+	if validation.WriteError(w, err) {
+		return
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		log.C(r.Context()).Errorf("patch list handler: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, mergepatch.ErrInvalidPatch) {
+		log.C(r.Context()).Errorf("patch list handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("patch list handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while committing transaction in patch list handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	etag.Set(w, list.Version)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
 func (h *Handler) DeleteList(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("delete list")
 	vars := mux.Vars(r)
@@ -169,6 +251,10 @@ func (h *Handler) DeleteList(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, id) {
+		return
+	}
+
 	_, err = h.service.GetList(ctx, id)
 	log.C(r.Context()).Debugf("delete list handler with id: %v", id)
 	if err != nil {
@@ -231,6 +317,12 @@ func (h *Handler) ListAllByUser(w http.ResponseWriter, r *http.Request) {
 
 func (h *Handler) GetAllLists(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("get all lists")
+	filter, err := converters.ToListFilter(r.URL.Query())
+	if err != nil {
+		log.C(r.Context()).Errorf("invalid list filter: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	ctx := r.Context()
 
 	tx, err := h.database.BeginTxx(ctx, nil)
@@ -243,7 +335,7 @@ func (h *Handler) GetAllLists(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
-	result, err := h.service.GetAllLists(ctx)
+	result, nextCursor, err := h.service.FilterLists(ctx, filter)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting all lists handler: %v", err)
 		http.Error(w, err.Error(), http.StatusNotFound)
@@ -257,6 +349,9 @@ func (h *Handler) GetAllLists(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	if nextCursor != "" {
+		w.Header().Set(constants.NextCursorHeader, nextCursor)
+	}
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(result); err != nil {
 		http.Error(w, "failed to encode response", http.StatusInternalServerError)
@@ -520,6 +615,10 @@ func (h *Handler) UpdateListDescription(w http.ResponseWriter, r *http.Request)
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, listID) {
+		return
+	}
+
 	_, err = h.service.GetList(ctx, listID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating list description handler: %v", err)
@@ -540,6 +639,7 @@ func (h *Handler) UpdateListDescription(w http.ResponseWriter, r *http.Request)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	etag.Set(w, updatedList.Version)
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedList); err != nil {
@@ -579,6 +679,10 @@ func (h *Handler) UpdateListName(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, listID) {
+		return
+	}
+
 	_, err = h.service.GetList(ctx, listID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating list name handler: %v", err)
@@ -588,6 +692,9 @@ func (h *Handler) UpdateListName(w http.ResponseWriter, r *http.Request) {
 
 	updatedList, err := h.service.UpdateListName(ctx, listID, updateData.Name)
 	log.C(r.Context()).Debugf("update list name handler with list: %v", updatedList)
+	if validation.WriteError(w, err) {
+		return
+	}
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating list name handler: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -599,6 +706,7 @@ func (h *Handler) UpdateListName(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	etag.Set(w, updatedList.Version)
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedList); err != nil {
@@ -763,60 +871,6 @@ func (h *Handler) GetPendingLists(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (h *Handler) GetAllUserTodos(w http.ResponseWriter, r *http.Request) {
-	log.C(r.Context()).Info("get all todos by user handler")
-	userID, ok := r.Context().Value("user_id").(string)
-	if !ok {
-		log.C(r.Context()).Errorf("error while getting all todos by user handler missing user id in the context")
-		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-		return
-	}
-	ctx := r.Context()
-
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting all todos by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	access, err := h.service.ListAllByUserID(ctx, userID)
-	accepted, err := h.service.GetAcceptedLists(ctx, userID)
-	combined := append(access, accepted...)
-	var result []models.Todo
-	for _, el := range combined {
-		todosForList, err := h.service.GetAllTodosForList(ctx, el.ListID)
-		if err != nil {
-			log.C(r.Context()).Errorf("error while getting the list with id: %s; %v", el.ListID, err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		result = append(result, todosForList...)
-	}
-	log.C(r.Context()).Debugf("get lists by user id handler: %v", result)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting list by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting list by user id transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
-
-	w.Header().Set("Content-Type", constants.ContentTypeJSON)
-	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(result); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
-		return
-	}
-}
-
 func (h *Handler) GetAccessesByListID(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("get accesses by list id handler")
 	vars := mux.Vars(r)
@@ -855,3 +909,32 @@ func (h *Handler) GetAccessesByListID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// checkVersion compares the versions in the If-Match header of the request, if
+// any, with the version of the list and locks the list for the transaction in
+// ctx. It writes the error response and returns false when none of them match.
+func (h *Handler) checkVersion(ctx context.Context, w http.ResponseWriter, r *http.Request, id string) bool {
+	versions, ok := etag.IfMatch(r)
+	if !ok {
+		return true
+	}
+	err := lists.ErrVersionMismatch
+	for _, version := range versions {
+		if err = h.service.CheckVersion(ctx, id, version); !errors.Is(err, lists.ErrVersionMismatch) {
+			break
+		}
+	}
+	if err == nil {
+		return true
+	}
+	log.C(r.Context()).Errorf("error while checking list version: %v", err)
+	switch {
+	case errors.Is(err, lists.ErrVersionMismatch):
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+	case errors.Is(err, sql.ErrNoRows):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/list"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists/automock"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
@@ -229,7 +230,7 @@ func TestGetAllListsHandler(t *testing.T) {
 			name: "Get All List",
 			mockService: func() *automock.ListService {
 				mockService := &automock.ListService{}
-				mockService.EXPECT().GetAllLists(mock.Anything).Return(model, nil).Once()
+				mockService.EXPECT().FilterLists(mock.Anything, models.ListFilter{}).Return(model, "", nil).Once()
 				return mockService
 			},
 			mockDatabase: func() {
@@ -243,7 +244,7 @@ func TestGetAllListsHandler(t *testing.T) {
 			name: "Error when get all lists fails",
 			mockService: func() *automock.ListService {
 				mockService := &automock.ListService{}
-				mockService.EXPECT().GetAllLists(mock.Anything).Return([]models.List{}, err).Once()
+				mockService.EXPECT().FilterLists(mock.Anything, models.ListFilter{}).Return([]models.List{}, "", err).Once()
 				return mockService
 			},
 			mockDatabase: func() {
@@ -589,6 +590,90 @@ func TestUpdateListHandler(t *testing.T) {
 	}
 }
 
+func TestUpdateListHandlerIfMatch(t *testing.T) {
+	db, mockDatabase, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	id := "1"
+	modelInput := models.List{ID: id, Name: "Test List", OwnerID: "user1", Version: 3}
+
+	tests := []struct {
+		name               string
+		ifMatch            string
+		mockService        func() *automock.ListService
+		mockDatabase       func()
+		expectedStatusCode int
+	}{
+		{
+			name:    "Update when the version matches",
+			ifMatch: `"3"`,
+			mockService: func() *automock.ListService {
+				mockService := &automock.ListService{}
+				mockService.EXPECT().CheckVersion(mock.Anything, id, 3).Return(nil).Once()
+				mockService.EXPECT().GetList(mock.Anything, id).Return(modelInput, nil).Once()
+				mockService.EXPECT().UpdateList(mock.Anything, mock.Anything).Return(nil).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit()
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:    "Error when the list was changed since it was read",
+			ifMatch: `"2"`,
+			mockService: func() *automock.ListService {
+				mockService := &automock.ListService{}
+				mockService.EXPECT().CheckVersion(mock.Anything, id, 2).Return(lists.ErrVersionMismatch).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:    "Error when none of the listed versions match",
+			ifMatch: `"1", "2"`,
+			mockService: func() *automock.ListService {
+				mockService := &automock.ListService{}
+				mockService.EXPECT().CheckVersion(mock.Anything, id, 1).Return(lists.ErrVersionMismatch).Once()
+				mockService.EXPECT().CheckVersion(mock.Anything, id, 2).Return(lists.ErrVersionMismatch).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusPreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := list.NewHandler(mockService, db)
+
+			body, _ := json.Marshal(modelInput)
+			req, _ := http.NewRequest(http.MethodPut, "/lists/update/1", bytes.NewBuffer(body))
+			req = mux.SetURLVars(req, map[string]string{"id": id})
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req.Header.Set("If-Match", tt.ifMatch)
+			w := httptest.NewRecorder()
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			tt.mockDatabase()
+
+			handler.UpdateList(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if err := mockDatabase.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
 func TestDeleteListHandler(t *testing.T) {
 	db, mockDatabase, err := sqlxmock.Newx()
 	require.NoError(t, err)
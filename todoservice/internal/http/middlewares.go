@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
@@ -86,6 +87,10 @@ func (m *Middleware) Protected(next http.Handler, neededRole constants.Role, acc
 			m.isOwner(w, r, next)
 		case constants.HasAccessTodo:
 			m.hasTodoAccess(w, r, next)
+		case constants.CanMoveTodo:
+			m.canMoveTodo(w, r, next)
+		case constants.CanBulkTodos:
+			m.canBulkTodos(w, r, next)
 		case constants.HasAccessList:
 			m.hasListAccess(w, r, next)
 		case constants.NoRestriction:
@@ -284,15 +289,17 @@ func (m *Middleware) hasTodoAccess(w http.ResponseWriter, r *http.Request, next
 	var listID string
 	var todo models.Todo
 
-	bodyBytes, err := io.ReadAll(r.Body)
-	if err != nil {
-		log.C(ctx).Errorf("cannot read request body: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	r.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
-
+	// The body is only read when the todo is not in the path, so uploads to
+	// routes with an id are streamed to the handler untouched.
 	if id == "" {
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			log.C(ctx).Errorf("cannot read request body: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+
 		if err := json.Unmarshal(bodyBytes, &todo); err != nil {
 			log.C(ctx).Errorf("cannot get todo from the body in hasAccess middleware: %v", err)
 			http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -353,23 +360,36 @@ func (m *Middleware) hasTodoAccess(w http.ResponseWriter, r *http.Request, next
 		}
 		listID = todo.ListID
 	}
+	listIDs := []string{listID}
 
-	hasAccess := false
-	for _, access := range listsAccess {
-		if access.ListID == listID {
-			hasAccess = true
-			break
+	if blockerID := vars["blocker_id"]; blockerID != "" {
+		blocker, err := m.todoService.GetTodo(ctx, blockerID)
+		if err != nil {
+			log.C(ctx).Errorf("middleware cannot get blocking todo for a user: %v", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
 		}
+		listIDs = append(listIDs, blocker.ListID)
 	}
-	for _, access := range listsAccepted {
-		if access.ListID == listID {
-			hasAccess = true
-			break
+
+	for _, id := range listIDs {
+		hasAccess := false
+		for _, access := range listsAccess {
+			if access.ListID == id {
+				hasAccess = true
+				break
+			}
+		}
+		for _, access := range listsAccepted {
+			if access.ListID == id {
+				hasAccess = true
+				break
+			}
+		}
+		if !hasAccess {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
 		}
-	}
-	if !hasAccess {
-		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
-		return
 	}
 	err = tx.Commit()
 	if err != nil {
@@ -380,6 +400,170 @@ func (m *Middleware) hasTodoAccess(w http.ResponseWriter, r *http.Request, next
 	next.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// canMoveTodo requires writer access to both the list the todo currently
+// belongs to and the list it is moved to.
+func (m *Middleware) canMoveTodo(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	ctx := r.Context()
+	log.C(ctx).Info("can move todo middleware")
+	vars := mux.Vars(r)
+	id := vars["id"]
+	targetListID := vars["list_id"]
+
+	var claim *jwt.Claims
+	if user, isAdmin := authorizeAdmin(r); isAdmin {
+		log.C(ctx).Debugf("user is admin: %v", claim)
+		next.ServeHTTP(w, r)
+		return
+	} else if user == nil {
+		log.C(ctx).Debug("there is no user in the context")
+		http.Error(w, "there is no user in the context", http.StatusUnauthorized)
+		return
+	} else {
+		claim = user
+	}
+	log.C(ctx).Debugf("the email and role are: %s, %s", claim.Email, claim.Role)
+
+	tx, err := m.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(ctx).Errorf("canMoveTodo middleware transaction failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	user, err := m.userService.GetUserByEmail(ctx, claim.Email)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	todo, err := m.todoService.GetTodo(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("middleware cannot get todo for a user: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, listID := range []string{todo.ListID, targetListID} {
+		if err = m.hasWriterAccess(ctx, listID, user.ID); err != nil {
+			log.C(ctx).Errorf("canMoveTodo middleware: %v", err)
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.C(ctx).Errorf("canMoveTodo middleware transaction failed to commit: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError)+" error committing the transaction", http.StatusInternalServerError)
+		return
+	}
+	next.ServeHTTP(w, r)
+}
+
+// canBulkTodos requires writer access to every list touched by a bulk
+// operation. Each list is checked once no matter how many of its todos are in
+// the batch; the target list of a move counts as touched as well.
+func (m *Middleware) canBulkTodos(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	ctx := r.Context()
+	log.C(ctx).Info("can bulk todos middleware")
+
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.C(ctx).Errorf("cannot read request body: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
+
+	var operation models.BulkOperation
+	if err = json.Unmarshal(bodyBytes, &operation); err != nil {
+		log.C(ctx).Errorf("cannot get bulk operation from the body in canBulkTodos middleware: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	var claim *jwt.Claims
+	if user, isAdmin := authorizeAdmin(r); isAdmin {
+		log.C(ctx).Debugf("user is admin: %v", claim)
+		next.ServeHTTP(w, r)
+		return
+	} else if user == nil {
+		log.C(ctx).Debug("there is no user in the context")
+		http.Error(w, "there is no user in the context", http.StatusUnauthorized)
+		return
+	} else {
+		claim = user
+	}
+	log.C(ctx).Debugf("the email and role are: %s, %s", claim.Email, claim.Role)
+
+	tx, err := m.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(ctx).Errorf("canBulkTodos middleware transaction failed: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	user, err := m.userService.GetUserByEmail(ctx, claim.Email)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var listIDs []string
+	seen := make(map[string]bool)
+	addList := func(listID string) {
+		if listID != "" && !seen[listID] {
+			seen[listID] = true
+			listIDs = append(listIDs, listID)
+		}
+	}
+	for _, id := range operation.IDs {
+		todo, err := m.todoService.GetTodo(ctx, id)
+		if err != nil {
+			log.C(ctx).Errorf("middleware cannot get todo with ID %s: %v", id, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		addList(todo.ListID)
+	}
+	if operation.Action == constants.BulkMove {
+		addList(operation.ListID)
+	}
+
+	for _, listID := range listIDs {
+		if err = m.hasWriterAccess(ctx, listID, user.ID); err != nil {
+			log.C(ctx).Errorf("canBulkTodos middleware: %v", err)
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		log.C(ctx).Errorf("canBulkTodos middleware transaction failed to commit: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError)+" error committing the transaction", http.StatusInternalServerError)
+		return
+	}
+	next.ServeHTTP(w, r)
+}
+
+func (m *Middleware) hasWriterAccess(ctx context.Context, listID, userID string) error {
+	access, err := m.listService.GetAccess(ctx, listID, userID)
+	if err != nil {
+		return fmt.Errorf("user %s has no access to list with ID %s: %w", userID, listID, err)
+	}
+	if access.Status != constants.StatusOwner && access.Status != constants.StatusAccepted ||
+		constants.RolePower(access.Role) < constants.RolePower(constants.Writer) {
+		return fmt.Errorf("user %s does not have writer access to list with ID %s", userID, listID)
+	}
+	return nil
+}
+
 func (m *Middleware) JWTMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
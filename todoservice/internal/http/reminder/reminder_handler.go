@@ -0,0 +1,164 @@
+package reminder
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/reminders"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+type Handler struct {
+	service  reminders.ReminderService
+	database *sqlx.DB
+}
+
+func NewHandler(service reminders.ReminderService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+func (h *Handler) CreateReminder(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create reminder handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while creating reminder handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	var reminder models.Reminder
+	if err := json.NewDecoder(r.Body).Decode(&reminder); err != nil {
+		log.C(r.Context()).Errorf("error while creating reminder handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	reminder.TodoID = mux.Vars(r)["id"]
+	reminder.UserID = userID
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating reminder handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	created, err := h.service.CreateReminder(ctx, reminder)
+	log.C(r.Context()).Debugf("create reminder handler for reminder: %v", created)
+	if errors.Is(err, reminders.ErrInvalidReminder) {
+		log.C(r.Context()).Errorf("error while creating reminder handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating reminder handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while creating reminder handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListReminders(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list reminders handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while listing reminders handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	todoID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing reminders handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListReminders(ctx, todoID, userID)
+	log.C(r.Context()).Debugf("list reminders handler for todo %s: %v", todoID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing reminders handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing reminders handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) DeleteReminder(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete reminder handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while deleting reminder handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	vars := mux.Vars(r)
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while deleting reminder handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	err = h.service.DeleteReminder(ctx, vars["reminder_id"], vars["id"], userID)
+	if errors.Is(err, reminders.ErrReminderNotFound) {
+		log.C(r.Context()).Errorf("error while deleting reminder handler: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while deleting reminder handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while deleting reminder handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
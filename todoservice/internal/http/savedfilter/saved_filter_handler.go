@@ -0,0 +1,315 @@
+package savedfilter
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/savedfilters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+type Handler struct {
+	service  savedfilters.SavedFilterService
+	database *sqlx.DB
+}
+
+func NewHandler(service savedfilters.SavedFilterService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+func (h *Handler) CreateSavedFilter(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create saved filter handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while creating saved filter handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	var filter models.SavedFilter
+	if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+		log.C(r.Context()).Errorf("error while creating saved filter handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	filter.UserID = userID
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating saved filter handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	created, err := h.service.CreateFilter(ctx, filter)
+	log.C(r.Context()).Debugf("create saved filter handler for filter: %v", created)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating saved filter handler: %v", err)
+		http.Error(w, err.Error(), savedFilterErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while creating saved filter handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListSavedFilters(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list saved filters handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while listing saved filters handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing saved filters handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListFilters(ctx, userID)
+	log.C(r.Context()).Debugf("list saved filters handler for user %s: %v", userID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing saved filters handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing saved filters handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) GetSavedFilter(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("get saved filter handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while getting saved filter handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	filterID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting saved filter handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.GetFilter(ctx, filterID, userID)
+	log.C(r.Context()).Debugf("get saved filter handler for filter: %v", result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting saved filter handler: %v", err)
+		http.Error(w, err.Error(), savedFilterErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while getting saved filter handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// UpdateSavedFilter renames the saved filter and replaces its expression.
+func (h *Handler) UpdateSavedFilter(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("update saved filter handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while updating saved filter handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	var filter models.SavedFilter
+	if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+		log.C(r.Context()).Errorf("error while updating saved filter handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	filter.ID = mux.Vars(r)["id"]
+	filter.UserID = userID
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating saved filter handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	updated, err := h.service.UpdateFilter(ctx, filter)
+	log.C(r.Context()).Debugf("update saved filter handler for filter: %v", updated)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating saved filter handler: %v", err)
+		http.Error(w, err.Error(), savedFilterErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while updating saved filter handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) DeleteSavedFilter(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete saved filter handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while deleting saved filter handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	filterID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while deleting saved filter handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if err = h.service.DeleteFilter(ctx, filterID, userID); err != nil {
+		log.C(r.Context()).Errorf("error while deleting saved filter handler: %v", err)
+		http.Error(w, err.Error(), savedFilterErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while deleting saved filter handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListSavedFilterTodos responds with the todos matching the saved filter. The
+// usual todo filter and paging query parameters narrow the result down further
+// and the tz query parameter sets the IANA time zone of the dates in the
+// expression. The cursor of the next page, if any, is sent in the
+// X-Next-Cursor header.
+func (h *Handler) ListSavedFilterTodos(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list saved filter todos handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while listing saved filter todos handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	filter, err := converters.ToTodoFilter(r.URL.Query())
+	if err != nil {
+		log.C(r.Context()).Errorf("invalid todo filter: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filterID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing saved filter todos handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, nextCursor, err := h.service.FilterTodos(ctx, filterID, userID, r.URL.Query().Get("tz"), filter)
+	log.C(r.Context()).Debugf("list saved filter todos handler for filter %s: %v", filterID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing saved filter todos handler: %v", err)
+		http.Error(w, err.Error(), savedFilterErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing saved filter todos handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	if nextCursor != "" {
+		w.Header().Set(constants.NextCursorHeader, nextCursor)
+	}
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func savedFilterErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, savedfilters.ErrInvalidFilter):
+		return http.StatusBadRequest
+	case errors.Is(err, savedfilters.ErrFilterNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, savedfilters.ErrDuplicateFilter):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,82 @@
+package search
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	searchdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/search"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	service  searchdomain.SearchService
+	database *sqlx.DB
+}
+
+func NewHandler(service searchdomain.SearchService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+func (h *Handler) Search(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("search handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while searching handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query().Get("q")
+	limit := 0
+	if rawLimit := r.URL.Query().Get("limit"); rawLimit != "" {
+		var err error
+		limit, err = strconv.Atoi(rawLimit)
+		if err != nil {
+			log.C(r.Context()).Errorf("invalid search limit: %v", err)
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while searching handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	results, err := h.service.Search(ctx, userID, query, limit)
+	log.C(r.Context()).Debugf("search handler results: %v", results)
+	if errors.Is(err, searchdomain.ErrEmptyQuery) {
+		log.C(r.Context()).Errorf("error while searching handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while searching handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while searching handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
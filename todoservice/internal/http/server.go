@@ -1,12 +1,36 @@
 package http
 
 import (
+	activitydomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/activity"
+	attachmentdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	commentdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/comments"
+	customfielddomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/customfields"
+	httpactivity "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/activity"
+	httpattachment "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/attachment"
+	httpcomment "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/comment"
+	httpcustomfield "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/customfield"
 	httplist "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/list"
+	httpreminder "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/reminder"
+	httpsavedfilter "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/savedfilter"
+	httpsearch "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/search"
+	httpstatus "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/status"
+	httptag "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/tag"
+	httptemplate "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/template"
+	httptimeentry "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/timeentry"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/todo"
+	httptrash "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/trash"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/user"
 	listsdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/oauth2"
+	reminderdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/reminders"
+	savedfilterdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/savedfilters"
+	searchdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/search"
+	statusdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	tagdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
+	templatedomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/templates"
+	timeentrydomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/timeentries"
 	tododomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
+	trashdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/trash"
 	userdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/users"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	token "github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
@@ -16,56 +40,141 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/cors"
 	"log"
+	"net"
 	"net/http"
 )
 
 type Server struct {
-	ListHandler   *httplist.Handler
-	TodoHandler   *todo.Handler
-	UserHandler   *user.Handler
-	Oauth2Handler *oauth2.Handler
-	Middleware    Middlewares
+	ListHandler        *httplist.Handler
+	TodoHandler        *todo.Handler
+	UserHandler        *user.Handler
+	SearchHandler      *httpsearch.Handler
+	ReminderHandler    *httpreminder.Handler
+	CommentHandler     *httpcomment.Handler
+	AttachmentHandler  *httpattachment.Handler
+	ActivityHandler    *httpactivity.Handler
+	TrashHandler       *httptrash.Handler
+	TimeEntryHandler   *httptimeentry.Handler
+	CustomFieldHandler *httpcustomfield.Handler
+	TagHandler         *httptag.Handler
+	StatusHandler      *httpstatus.Handler
+	SavedFilterHandler *httpsavedfilter.Handler
+	TemplateHandler    *httptemplate.Handler
+	Oauth2Handler      *oauth2.Handler
+	Middleware         Middlewares
 }
 
-func NewServer(db *sqlx.DB, config token.ConfigOAuth2) *Server {
+func NewServer(db *sqlx.DB, config token.ConfigOAuth2, blobStore attachmentdomain.BlobStore, attachmentConfig attachmentdomain.Config) *Server {
 	listRepo := listsdomain.NewSQLXListRepository()
 	todoRepo := tododomain.NewSQLXTodoRepository()
 	userRepo := userdomain.NewSQLXUserRepository()
+	searchRepo := searchdomain.NewSQLXSearchRepository()
+	reminderRepo := reminderdomain.NewSQLXReminderRepository()
+	commentRepo := commentdomain.NewSQLXCommentRepository()
+	attachmentRepo := attachmentdomain.NewSQLXAttachmentRepository()
+	activityRepo := activitydomain.NewSQLXActivityRepository()
+	trashRepo := trashdomain.NewSQLXTrashRepository()
+	timeEntryRepo := timeentrydomain.NewSQLXTimeEntryRepository()
+	customFieldRepo := customfielddomain.NewSQLXCustomFieldRepository()
+	tagRepo := tagdomain.NewSQLXTagRepository()
+	statusRepo := statusdomain.NewSQLXStatusRepository()
+	savedFilterRepo := savedfilterdomain.NewSQLXSavedFilterRepository()
+	templateRepo := templatedomain.NewSQLXTemplateRepository()
 
 	uuidServer := uid.NewService()
 	timeServer := time.Time{}
 
-	listService := listsdomain.NewService(listRepo, uuidServer, timeServer)
-	todoService := tododomain.NewService(todoRepo, uuidServer, timeServer)
+	activityService := activitydomain.NewService(activityRepo, uuidServer, timeServer)
+	customFieldService := customfielddomain.NewService(customFieldRepo, uuidServer, timeServer)
+	tagService := tagdomain.NewService(tagRepo, uuidServer, timeServer)
+	statusService := statusdomain.NewService(statusRepo, uuidServer, timeServer)
+	listService := listsdomain.NewService(listRepo, activityService, tagService, statusService, uuidServer, timeServer)
+	todoService := tododomain.NewService(todoRepo, activityService, customFieldService, tagService, statusService, uuidServer, timeServer)
 	userService := userdomain.NewService(userRepo, uuidServer, timeServer)
+	searchService := searchdomain.NewService(searchRepo)
+	reminderService := reminderdomain.NewService(reminderRepo, net.DefaultResolver, uuidServer, timeServer)
+	commentService := commentdomain.NewService(commentRepo, userService, uuidServer, timeServer)
+	attachmentService := attachmentdomain.NewService(attachmentRepo, blobStore, uuidServer, timeServer, attachmentConfig)
+	trashService := trashdomain.NewService(trashRepo, activityService)
+	timeEntryService := timeentrydomain.NewService(timeEntryRepo, uuidServer, timeServer)
+	savedFilterService := savedfilterdomain.NewService(savedFilterRepo, todoService, uuidServer, timeServer)
+	templateService := templatedomain.NewService(templateRepo, listService, todoService, uuidServer, timeServer)
 
 	listHandler := httplist.NewHandler(listService, db)
 	todoHandler := todo.NewHandler(todoService, db)
 	userHandler := user.NewHandler(userService, db)
+	searchHandler := httpsearch.NewHandler(searchService, db)
+	reminderHandler := httpreminder.NewHandler(reminderService, db)
+	commentHandler := httpcomment.NewHandler(commentService, db)
+	attachmentHandler := httpattachment.NewHandler(attachmentService, db)
+	activityHandler := httpactivity.NewHandler(activityService, db)
+	trashHandler := httptrash.NewHandler(trashService, db)
+	timeEntryHandler := httptimeentry.NewHandler(timeEntryService, db)
+	customFieldHandler := httpcustomfield.NewHandler(customFieldService, db)
+	tagHandler := httptag.NewHandler(tagService, db)
+	statusHandler := httpstatus.NewHandler(statusService, db)
+	savedFilterHandler := httpsavedfilter.NewHandler(savedFilterService, db)
+	templateHandler := httptemplate.NewHandler(templateService, db)
 
 	oauth2Handler := oauth2.NewOAuth2(config, userService, db)
 	tokenParser := token.NewTokenParser(config)
 	middleware := NewMiddleware(userService, listService, todoService, tokenParser, db)
 
 	return &Server{
-		ListHandler:   listHandler,
-		TodoHandler:   todoHandler,
-		UserHandler:   userHandler,
-		Oauth2Handler: oauth2Handler,
-		Middleware:    middleware,
+		ListHandler:        listHandler,
+		TodoHandler:        todoHandler,
+		UserHandler:        userHandler,
+		SearchHandler:      searchHandler,
+		ReminderHandler:    reminderHandler,
+		CommentHandler:     commentHandler,
+		AttachmentHandler:  attachmentHandler,
+		ActivityHandler:    activityHandler,
+		TrashHandler:       trashHandler,
+		TimeEntryHandler:   timeEntryHandler,
+		CustomFieldHandler: customFieldHandler,
+		TagHandler:         tagHandler,
+		StatusHandler:      statusHandler,
+		SavedFilterHandler: savedFilterHandler,
+		TemplateHandler:    templateHandler,
+		Oauth2Handler:      oauth2Handler,
+		Middleware:         middleware,
 	}
 }
 
-func NewServerWithServices(db *sqlx.DB, listService listsdomain.ListService, todoService tododomain.TodoService, userService userdomain.UserService, middleware Middlewares) *Server {
+func NewServerWithServices(db *sqlx.DB, listService listsdomain.ListService, todoService tododomain.TodoService, userService userdomain.UserService, searchService searchdomain.SearchService, reminderService reminderdomain.ReminderService, commentService commentdomain.CommentService, attachmentService attachmentdomain.AttachmentService, activityService activitydomain.ActivityService, trashService trashdomain.TrashService, timeEntryService timeentrydomain.TimeEntryService, customFieldService customfielddomain.CustomFieldService, tagService tagdomain.TagService, statusService statusdomain.StatusService, savedFilterService savedfilterdomain.SavedFilterService, templateService templatedomain.TemplateService, middleware Middlewares) *Server {
 	listHandler := httplist.NewHandler(listService, db)
 	todoHandler := todo.NewHandler(todoService, db)
 	userHandler := user.NewHandler(userService, db)
+	searchHandler := httpsearch.NewHandler(searchService, db)
+	reminderHandler := httpreminder.NewHandler(reminderService, db)
+	commentHandler := httpcomment.NewHandler(commentService, db)
+	attachmentHandler := httpattachment.NewHandler(attachmentService, db)
+	activityHandler := httpactivity.NewHandler(activityService, db)
+	trashHandler := httptrash.NewHandler(trashService, db)
+	timeEntryHandler := httptimeentry.NewHandler(timeEntryService, db)
+	customFieldHandler := httpcustomfield.NewHandler(customFieldService, db)
+	tagHandler := httptag.NewHandler(tagService, db)
+	statusHandler := httpstatus.NewHandler(statusService, db)
+	savedFilterHandler := httpsavedfilter.NewHandler(savedFilterService, db)
+	templateHandler := httptemplate.NewHandler(templateService, db)
 
 	return &Server{
-		ListHandler: listHandler,
-		TodoHandler: todoHandler,
-		UserHandler: userHandler,
-		Middleware:  middleware,
+		ListHandler:        listHandler,
+		TodoHandler:        todoHandler,
+		UserHandler:        userHandler,
+		SearchHandler:      searchHandler,
+		ReminderHandler:    reminderHandler,
+		CommentHandler:     commentHandler,
+		AttachmentHandler:  attachmentHandler,
+		ActivityHandler:    activityHandler,
+		TrashHandler:       trashHandler,
+		TimeEntryHandler:   timeEntryHandler,
+		CustomFieldHandler: customFieldHandler,
+		TagHandler:         tagHandler,
+		StatusHandler:      statusHandler,
+		SavedFilterHandler: savedFilterHandler,
+		TemplateHandler:    templateHandler,
+		Middleware:         middleware,
 	}
 }
 
@@ -92,24 +201,98 @@ func (s *Server) RegisterRoutes(router *mux.Router) {
 	protectedRouter.Handle("/lists/{list_id:[a-zA-Z0-9-]+}/todos", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.ListTodosByListID), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/owner", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetListOwnerID), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/users", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetUsersByListID), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/activity", s.Middleware.Protected(http.HandlerFunc(s.ActivityHandler.ListListActivity), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/fields", s.Middleware.Protected(http.HandlerFunc(s.CustomFieldHandler.ListFields), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/fields", s.Middleware.Protected(http.HandlerFunc(s.CustomFieldHandler.CreateField), constants.Writer, constants.IsOwner)).Methods(http.MethodPost)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/fields/{field_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.CustomFieldHandler.UpdateField), constants.Writer, constants.IsOwner)).Methods(http.MethodPut)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/fields/{field_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.CustomFieldHandler.DeleteField), constants.Writer, constants.IsOwner)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/tags", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.ListListTags), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/tags", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.CreateListTag), constants.Writer, constants.IsOwner)).Methods(http.MethodPost)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/statuses", s.Middleware.Protected(http.HandlerFunc(s.StatusHandler.ListStatuses), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/statuses", s.Middleware.Protected(http.HandlerFunc(s.StatusHandler.CreateStatus), constants.Writer, constants.IsOwner)).Methods(http.MethodPost)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/statuses/order", s.Middleware.Protected(http.HandlerFunc(s.StatusHandler.ReorderStatuses), constants.Writer, constants.IsOwner)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/statuses/{status_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.StatusHandler.UpdateStatus), constants.Writer, constants.IsOwner)).Methods(http.MethodPut)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/statuses/{status_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.StatusHandler.DeleteStatus), constants.Writer, constants.IsOwner)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/template", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.SaveListAsTemplate), constants.Writer, constants.HasAccessList)).Methods(http.MethodPost)
+	protectedRouter.Handle("/filters", s.Middleware.Protected(http.HandlerFunc(s.SavedFilterHandler.ListSavedFilters), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/filters", s.Middleware.Protected(http.HandlerFunc(s.SavedFilterHandler.CreateSavedFilter), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/filters/{id:[a-zA-Z0-9-]+}/todos", s.Middleware.Protected(http.HandlerFunc(s.SavedFilterHandler.ListSavedFilterTodos), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/filters/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.SavedFilterHandler.GetSavedFilter), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/filters/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.SavedFilterHandler.UpdateSavedFilter), constants.Writer, constants.NoRestriction)).Methods(http.MethodPut)
+	protectedRouter.Handle("/filters/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.SavedFilterHandler.DeleteSavedFilter), constants.Writer, constants.NoRestriction)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/templates", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.ListTemplates), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/templates", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.CreateTemplate), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/templates/{id:[a-zA-Z0-9-]+}/instantiate", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.InstantiateTemplate), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/templates/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.GetTemplate), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/templates/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.UpdateTemplate), constants.Writer, constants.NoRestriction)).Methods(http.MethodPut)
+	protectedRouter.Handle("/templates/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.DeleteTemplate), constants.Writer, constants.NoRestriction)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/tags", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.ListTags), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/tags", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.CreateTag), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/tags/{id:[a-zA-Z0-9-]+}/merge/{target_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.MergeTags), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/tags/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.GetTag), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/tags/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.UpdateTag), constants.Writer, constants.NoRestriction)).Methods(http.MethodPut)
+	protectedRouter.Handle("/tags/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.DeleteTag), constants.Writer, constants.NoRestriction)).Methods(http.MethodDelete)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/description", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.UpdateListDescription), constants.Writer, constants.HasAccessList)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/name", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.UpdateListName), constants.Writer, constants.HasAccessList)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetList), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.UpdateList), constants.Writer, constants.HasAccessList)).Methods(http.MethodPut)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.PatchList), constants.Writer, constants.HasAccessList)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.DeleteList), constants.Writer, constants.HasAccessList)).Methods(http.MethodDelete)
 
 	protectedRouter.Handle("/todos/create", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.CreateTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/bulk", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.BulkUpdateTodos), constants.Writer, constants.CanBulkTodos)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/quick", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.QuickAddTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
 	protectedRouter.Handle("/todos/all", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.GetAllTodos), constants.Admin, constants.NoRestriction)).Methods(http.MethodGet)
-	protectedRouter.Handle("/todos/user/all", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetAllUserTodos), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/views/{view:today|upcoming|overdue|inbox}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.GetSmartView), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/user/all", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.GetUserTodos), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/reminders", s.Middleware.Protected(http.HandlerFunc(s.ReminderHandler.ListReminders), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/reminders", s.Middleware.Protected(http.HandlerFunc(s.ReminderHandler.CreateReminder), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/reminders/{reminder_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ReminderHandler.DeleteReminder), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/comments", s.Middleware.Protected(http.HandlerFunc(s.CommentHandler.ListComments), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/comments", s.Middleware.Protected(http.HandlerFunc(s.CommentHandler.CreateComment), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/comments/{comment_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.CommentHandler.UpdateComment), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodPut)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/comments/{comment_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.CommentHandler.DeleteComment), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/subtasks", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.ListSubtasks), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/subtasks", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.CreateSubtask), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/blocked_by", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.ListBlockedBy), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/blocked_by/{blocker_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.AddDependency), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/blocked_by/{blocker_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.RemoveDependency), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/blocking", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.ListBlocking), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/complete", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.CompleteTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/reopen", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.ReopenTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/status/{status_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.TransitionTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/position", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.ReorderTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/move/{list_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.MoveTodoToList), constants.Writer, constants.CanMoveTodo)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/title", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateTodoTitle), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/assign_to", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateAssignedTo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/priority", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateTodoPriority), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/description", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateTodoDescription), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.GetTodo), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPut)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.PatchTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.DeleteTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodDelete)
 
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/custom_fields", s.Middleware.Protected(http.HandlerFunc(s.CustomFieldHandler.ListTodoValues), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/activity", s.Middleware.Protected(http.HandlerFunc(s.ActivityHandler.ListTodoActivity), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/attachments", s.Middleware.Protected(http.HandlerFunc(s.AttachmentHandler.ListAttachments), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/attachments", s.Middleware.Protected(http.HandlerFunc(s.AttachmentHandler.UploadAttachment), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/attachments/{attachment_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.AttachmentHandler.DownloadAttachment), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/attachments/{attachment_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.AttachmentHandler.DeleteAttachment), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/timer/start", s.Middleware.Protected(http.HandlerFunc(s.TimeEntryHandler.StartTimer), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/time_entries", s.Middleware.Protected(http.HandlerFunc(s.TimeEntryHandler.ListTimeEntries), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/time_entries", s.Middleware.Protected(http.HandlerFunc(s.TimeEntryHandler.CreateTimeEntry), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/time_entries/{entry_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TimeEntryHandler.DeleteTimeEntry), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/time_total", s.Middleware.Protected(http.HandlerFunc(s.TimeEntryHandler.GetTodoTotal), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/time_total", s.Middleware.Protected(http.HandlerFunc(s.TimeEntryHandler.GetListTotal), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
+	protectedRouter.Handle("/timer", s.Middleware.Protected(http.HandlerFunc(s.TimeEntryHandler.GetRunningTimer), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/timer/stop", s.Middleware.Protected(http.HandlerFunc(s.TimeEntryHandler.StopTimer), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/time_entries/total", s.Middleware.Protected(http.HandlerFunc(s.TimeEntryHandler.GetUserTotal), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/comments/mentions", s.Middleware.Protected(http.HandlerFunc(s.CommentHandler.ListMentions), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/trash", s.Middleware.Protected(http.HandlerFunc(s.TrashHandler.ListTrash), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/trash/lists/{id:[a-zA-Z0-9-]+}/restore", s.Middleware.Protected(http.HandlerFunc(s.TrashHandler.RestoreList), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/trash/todos/{id:[a-zA-Z0-9-]+}/restore", s.Middleware.Protected(http.HandlerFunc(s.TrashHandler.RestoreTodo), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/search", s.Middleware.Protected(http.HandlerFunc(s.SearchHandler.Search), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+
 	protectedRouter.Handle("/users/create", s.Middleware.Protected(http.HandlerFunc(s.UserHandler.CreateUser), constants.Admin, constants.NoRestriction)).Methods(http.MethodPost)
 	protectedRouter.Handle("/users/all", s.Middleware.Protected(http.HandlerFunc(s.UserHandler.GetAllUsers), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/users/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.UserHandler.UpdateUser), constants.Admin, constants.NoRestriction)).Methods(http.MethodPut)
@@ -133,8 +316,9 @@ func (s *Server) Start() {
 	})
 	c := cors.New(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:8000"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Authorization", "Content-Type"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Authorization", "Content-Type", "If-Match"},
+		ExposedHeaders:   []string{"ETag"},
 		AllowCredentials: true,
 	})
 	router.Use(HandlePreflight)
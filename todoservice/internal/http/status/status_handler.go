@@ -0,0 +1,243 @@
+package status
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+type Handler struct {
+	service  statuses.StatusService
+	database *sqlx.DB
+}
+
+func NewHandler(service statuses.StatusService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+func (h *Handler) CreateStatus(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create status handler")
+	var status models.WorkflowStatus
+	if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+		log.C(r.Context()).Errorf("error while creating status handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	status.ListID = mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating status handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	created, err := h.service.CreateStatus(ctx, status)
+	log.C(r.Context()).Debugf("create status handler for status: %v", created)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating status handler: %v", err)
+		http.Error(w, err.Error(), statusErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while creating status handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListStatuses(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list statuses handler")
+	listID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing statuses handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListStatuses(ctx, listID)
+	log.C(r.Context()).Debugf("list statuses handler for list %s: %v", listID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing statuses handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing statuses handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// UpdateStatus renames the status. The category given in the body is ignored
+// since a status keeps its category.
+func (h *Handler) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("update status handler")
+	var status models.WorkflowStatus
+	if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+		log.C(r.Context()).Errorf("error while updating status handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	vars := mux.Vars(r)
+	status.ID = vars["status_id"]
+	status.ListID = vars["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating status handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	updated, err := h.service.UpdateStatus(ctx, status)
+	log.C(r.Context()).Debugf("update status handler for status: %v", updated)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating status handler: %v", err)
+		http.Error(w, err.Error(), statusErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while updating status handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// DeleteStatus removes the status. Its todos move to the status given by the
+// move_to query parameter, or to the first other status of the same category.
+func (h *Handler) DeleteStatus(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete status handler")
+	vars := mux.Vars(r)
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while deleting status handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if err = h.service.DeleteStatus(ctx, vars["status_id"], vars["id"], r.URL.Query().Get("move_to")); err != nil {
+		log.C(r.Context()).Errorf("error while deleting status handler: %v", err)
+		http.Error(w, err.Error(), statusErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while deleting status handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderStatuses expects the IDs of every status of the list in their new
+// order.
+func (h *Handler) ReorderStatuses(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("reorder statuses handler")
+	listID := mux.Vars(r)["id"]
+
+	var reorderData struct {
+		IDs []string `json:"ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reorderData); err != nil {
+		log.C(r.Context()).Errorf("error while reordering statuses handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while reordering statuses handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ReorderStatuses(ctx, listID, reorderData.IDs)
+	log.C(r.Context()).Debugf("reorder statuses handler for list %s: %v", listID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while reordering statuses handler: %v", err)
+		http.Error(w, err.Error(), statusErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while reordering statuses handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func statusErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, statuses.ErrInvalidStatus):
+		return http.StatusBadRequest
+	case errors.Is(err, statuses.ErrStatusNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, statuses.ErrDuplicateStatus), errors.Is(err, statuses.ErrLastStatus):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
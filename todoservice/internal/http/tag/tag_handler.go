@@ -0,0 +1,363 @@
+package tag
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+type Handler struct {
+	service  tags.TagService
+	database *sqlx.DB
+}
+
+func NewHandler(service tags.TagService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+// CreateTag creates a personal tag of the user.
+func (h *Handler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create tag handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while creating tag handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	var tag models.Tag
+	if err := json.NewDecoder(r.Body).Decode(&tag); err != nil {
+		log.C(r.Context()).Errorf("error while creating tag handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	tag.UserID = &userID
+	tag.ListID = nil
+	h.createTag(w, r, tag)
+}
+
+// CreateListTag creates a tag that can only be put on todos of the list.
+func (h *Handler) CreateListTag(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create list tag handler")
+	var tag models.Tag
+	if err := json.NewDecoder(r.Body).Decode(&tag); err != nil {
+		log.C(r.Context()).Errorf("error while creating list tag handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	listID := mux.Vars(r)["id"]
+	tag.UserID = nil
+	tag.ListID = &listID
+	h.createTag(w, r, tag)
+}
+
+func (h *Handler) createTag(w http.ResponseWriter, r *http.Request, tag models.Tag) {
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating tag handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	created, err := h.service.CreateTag(ctx, tag)
+	log.C(r.Context()).Debugf("create tag handler for tag: %v", created)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating tag handler: %v", err)
+		http.Error(w, err.Error(), tagErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while creating tag handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// ListTags responds with the personal tags of the user and the tags of the
+// lists the user owns or has accepted.
+func (h *Handler) ListTags(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list tags handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while listing tags handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing tags handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListTags(ctx, userID)
+	log.C(r.Context()).Debugf("list tags handler for user %s: %v", userID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing tags handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing tags handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListListTags(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list tags of list handler")
+	listID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing tags of list handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListListTags(ctx, listID)
+	log.C(r.Context()).Debugf("list tags of list handler for list %s: %v", listID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing tags of list handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing tags of list handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) GetTag(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("get tag handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while getting tag handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	tagID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting tag handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.GetTag(ctx, tagID, userID)
+	log.C(r.Context()).Debugf("get tag handler for tag: %v", result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting tag handler: %v", err)
+		http.Error(w, err.Error(), tagErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while getting tag handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// UpdateTag renames the tag and changes its colour and description. The scope
+// given in the body is ignored since a tag keeps its scope.
+func (h *Handler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("update tag handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while updating tag handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	var tag models.Tag
+	if err := json.NewDecoder(r.Body).Decode(&tag); err != nil {
+		log.C(r.Context()).Errorf("error while updating tag handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	tag.ID = mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating tag handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	updated, err := h.service.UpdateTag(ctx, tag, userID)
+	log.C(r.Context()).Debugf("update tag handler for tag: %v", updated)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating tag handler: %v", err)
+		http.Error(w, err.Error(), tagErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while updating tag handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete tag handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while deleting tag handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	tagID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while deleting tag handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if err = h.service.DeleteTag(ctx, tagID, userID); err != nil {
+		log.C(r.Context()).Errorf("error while deleting tag handler: %v", err)
+		http.Error(w, err.Error(), tagErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while deleting tag handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MergeTags merges the tag into the target tag and responds with the target
+// tag.
+func (h *Handler) MergeTags(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("merge tags handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while merging tags handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	vars := mux.Vars(r)
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while merging tags handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	merged, err := h.service.MergeTags(ctx, vars["id"], vars["target_id"], userID)
+	log.C(r.Context()).Debugf("merge tags handler for tag: %v", merged)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while merging tags handler: %v", err)
+		http.Error(w, err.Error(), tagErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while merging tags handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(merged); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func tagErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, tags.ErrInvalidTag), errors.Is(err, tags.ErrIncompatibleTags):
+		return http.StatusBadRequest
+	case errors.Is(err, tags.ErrTagNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, tags.ErrTagReadOnly):
+		return http.StatusForbidden
+	case errors.Is(err, tags.ErrDuplicateTag):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
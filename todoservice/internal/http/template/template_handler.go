@@ -0,0 +1,382 @@
+package template
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/templates"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"io"
+	"net/http"
+)
+
+type Handler struct {
+	service  templates.TemplateService
+	database *sqlx.DB
+}
+
+func NewHandler(service templates.TemplateService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+func (h *Handler) CreateTemplate(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create template handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while creating template handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	var template models.ListTemplate
+	if err := json.NewDecoder(r.Body).Decode(&template); err != nil {
+		log.C(r.Context()).Errorf("error while creating template handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	template.OwnerID = userID
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating template handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	created, err := h.service.CreateTemplate(ctx, template)
+	log.C(r.Context()).Debugf("create template handler for template: %v", created)
+	if validation.WriteError(w, err) {
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating template handler: %v", err)
+		http.Error(w, err.Error(), templateErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while creating template handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// SaveListAsTemplate saves the list in the path as a template of the user.
+// The optional body names the template and sets the anchor date the dates of
+// the todos are counted from.
+func (h *Handler) SaveListAsTemplate(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("save list as template handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while saving list as template handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	request, err := decodeAnchor(r)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while saving list as template handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	listID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while saving list as template handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	created, err := h.service.SaveListAsTemplate(ctx, listID, userID, request)
+	log.C(r.Context()).Debugf("save list as template handler for list %s: %v", listID, created)
+	if validation.WriteError(w, err) {
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while saving list as template handler: %v", err)
+		http.Error(w, err.Error(), templateErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while saving list as template handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListTemplates(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list templates handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while listing templates handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing templates handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListTemplates(ctx, userID)
+	log.C(r.Context()).Debugf("list templates handler for user %s: %v", userID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing templates handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing templates handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) GetTemplate(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("get template handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while getting template handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	templateID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting template handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.GetTemplate(ctx, templateID, userID)
+	log.C(r.Context()).Debugf("get template handler for template: %v", result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting template handler: %v", err)
+		http.Error(w, err.Error(), templateErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while getting template handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// UpdateTemplate replaces the name, description, tags and todos of the
+// template.
+func (h *Handler) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("update template handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while updating template handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	var template models.ListTemplate
+	if err := json.NewDecoder(r.Body).Decode(&template); err != nil {
+		log.C(r.Context()).Errorf("error while updating template handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	template.ID = mux.Vars(r)["id"]
+	template.OwnerID = userID
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating template handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	updated, err := h.service.UpdateTemplate(ctx, template)
+	log.C(r.Context()).Debugf("update template handler for template: %v", updated)
+	if validation.WriteError(w, err) {
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating template handler: %v", err)
+		http.Error(w, err.Error(), templateErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while updating template handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updated); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete template handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while deleting template handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	templateID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while deleting template handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if err = h.service.DeleteTemplate(ctx, templateID, userID); err != nil {
+		log.C(r.Context()).Errorf("error while deleting template handler: %v", err)
+		http.Error(w, err.Error(), templateErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while deleting template handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// InstantiateTemplate creates a list owned by the user from the template and
+// responds with it. The optional body names the list and sets the anchor date
+// the dates of the todos are resolved against.
+func (h *Handler) InstantiateTemplate(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("instantiate template handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while instantiating template handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	request, err := decodeAnchor(r)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while instantiating template handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	templateID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while instantiating template handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	list, err := h.service.InstantiateTemplate(ctx, templateID, userID, request)
+	log.C(r.Context()).Debugf("instantiate template handler for template %s: %v", templateID, list)
+	if validation.WriteError(w, err) {
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while instantiating template handler: %v", err)
+		http.Error(w, err.Error(), templateErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while instantiating template handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// decodeAnchor reads the name and anchor of the request. An empty body leaves
+// both to the service.
+func decodeAnchor(r *http.Request) (models.TemplateAnchor, error) {
+	var request models.TemplateAnchor
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+		return models.TemplateAnchor{}, err
+	}
+	return request, nil
+}
+
+func templateErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, templates.ErrTemplateNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
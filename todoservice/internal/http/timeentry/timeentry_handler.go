@@ -0,0 +1,351 @@
+package timeentry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/timeentries"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"io"
+	"net/http"
+)
+
+type Handler struct {
+	service  timeentries.TimeEntryService
+	database *sqlx.DB
+}
+
+func NewHandler(service timeentries.TimeEntryService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+// StartTimer starts a timer on the todo. The request body is optional and
+// may carry a note for the entry.
+func (h *Handler) StartTimer(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("start timer handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while starting timer handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	var body struct {
+		Note string `json:"note"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		log.C(r.Context()).Errorf("error while starting timer handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while starting timer handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	started, err := h.service.StartTimer(ctx, mux.Vars(r)["id"], userID, body.Note)
+	log.C(r.Context()).Debugf("start timer handler for entry: %v", started)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while starting timer handler: %v", err)
+		http.Error(w, err.Error(), timeEntryErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while starting timer handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(started); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) StopTimer(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("stop timer handler")
+	h.writeRunningTimer(w, r, h.service.StopTimer)
+}
+
+// GetRunningTimer responds with the running timer of the user or with no
+// content when the user has none.
+func (h *Handler) GetRunningTimer(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("get running timer handler")
+	h.writeRunningTimer(w, r, h.service.GetRunningTimer)
+}
+
+func (h *Handler) writeRunningTimer(w http.ResponseWriter, r *http.Request, get func(ctx context.Context, userID string) (models.TimeEntry, error)) {
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while getting running timer handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting running timer handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	entry, err := get(ctx, userID)
+	log.C(r.Context()).Debugf("running timer handler for user %s: %v", userID, entry)
+	if errors.Is(err, timeentries.ErrNoRunningTimer) && r.Method == http.MethodGet {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting running timer handler: %v", err)
+		http.Error(w, err.Error(), timeEntryErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while getting running timer handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(entry); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create time entry handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while creating time entry handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	var entry models.TimeEntry
+	if err := json.NewDecoder(r.Body).Decode(&entry); err != nil {
+		log.C(r.Context()).Errorf("error while creating time entry handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+	entry.TodoID = mux.Vars(r)["id"]
+	entry.UserID = userID
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating time entry handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	created, err := h.service.CreateTimeEntry(ctx, entry)
+	log.C(r.Context()).Debugf("create time entry handler for entry: %v", created)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating time entry handler: %v", err)
+		http.Error(w, err.Error(), timeEntryErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while creating time entry handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListTimeEntries(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list time entries handler")
+	todoID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing time entries handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	result, err := h.service.ListTimeEntries(ctx, todoID)
+	log.C(r.Context()).Debugf("list time entries handler for todo %s: %v", todoID, result)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing time entries handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing time entries handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) DeleteTimeEntry(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete time entry handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while deleting time entry handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	vars := mux.Vars(r)
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while deleting time entry handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if err = h.service.DeleteTimeEntry(ctx, vars["entry_id"], vars["id"], userID); err != nil {
+		log.C(r.Context()).Errorf("error while deleting time entry handler: %v", err)
+		http.Error(w, err.Error(), timeEntryErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while deleting time entry handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) GetTodoTotal(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("get todo time total handler")
+	h.writeTotal(w, r, func(filter *models.TimeEntryFilter) bool {
+		filter.TodoID = mux.Vars(r)["id"]
+		return true
+	})
+}
+
+func (h *Handler) GetListTotal(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("get list time total handler")
+	h.writeTotal(w, r, func(filter *models.TimeEntryFilter) bool {
+		filter.ListID = mux.Vars(r)["id"]
+		return true
+	})
+}
+
+func (h *Handler) GetUserTotal(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("get user time total handler")
+	h.writeTotal(w, r, func(filter *models.TimeEntryFilter) bool {
+		userID, ok := r.Context().Value("user_id").(string)
+		if !ok {
+			log.C(r.Context()).Errorf("error while getting user time total handler missing user id in the context")
+			http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return false
+		}
+		filter.UserID = userID
+		return true
+	})
+}
+
+// writeTotal responds with the logged time in the range given by the optional
+// from and to query parameters. scope narrows the filter down to a todo, list
+// or user and reports whether the request can go on.
+func (h *Handler) writeTotal(w http.ResponseWriter, r *http.Request, scope func(filter *models.TimeEntryFilter) bool) {
+	filter, err := converters.ToTimeEntryFilter(r.URL.Query())
+	if err != nil {
+		log.C(r.Context()).Errorf("invalid time range: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !scope(&filter) {
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting time total handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	total, err := h.service.GetTotal(ctx, filter)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting time total handler: %v", err)
+		http.Error(w, err.Error(), timeEntryErrorStatus(err))
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while getting time total handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(total); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func timeEntryErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, timeentries.ErrInvalidTimeEntry), errors.Is(err, timeentries.ErrInvalidTimeRange):
+		return http.StatusBadRequest
+	case errors.Is(err, timeentries.ErrNoRunningTimer), errors.Is(err, timeentries.ErrTimeEntryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, timeentries.ErrNotTimeEntryOwner):
+		return http.StatusForbidden
+	case errors.Is(err, timeentries.ErrTimerRunning):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
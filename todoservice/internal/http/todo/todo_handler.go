@@ -2,18 +2,28 @@ package todo
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/customfields"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/quickadd"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/etag"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/mergepatch"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
 	"github.com/gorilla/mux"
 	"github.com/jmoiron/sqlx"
 	"io"
 	"net/http"
+	"strconv"
 )
 
 type Handler struct {
@@ -63,6 +73,9 @@ func (h *Handler) CreateTodo(w http.ResponseWriter, r *http.Request) {
 	ctx = db.SaveToContext(ctx, tx)
 	createdTodo, err := h.service.CreateTodo(ctx, todo)
 	log.C(r.Context()).Debugf("todo handler create success, createdTodo: %v", createdTodo)
+	if validation.WriteError(w, err) {
+		return
+	}
 	if err != nil {
 		log.C(r.Context()).Errorf("error while todo handler create err: %v", err)
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -112,6 +125,7 @@ func (h *Handler) GetTodo(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	etag.Set(w, todo.Version)
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(todo); err != nil {
@@ -144,6 +158,10 @@ func (h *Handler) UpdateTodo(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
 	_, err = h.service.GetTodo(ctx, todoID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
@@ -152,9 +170,18 @@ func (h *Handler) UpdateTodo(w http.ResponseWriter, r *http.Request) {
 
 	err = h.service.UpdateTodo(ctx, todo)
 	log.C(r.Context()).Debugf("todo handler update success, todo: %v", todo)
+	if validation.WriteError(w, err) {
+		return
+	}
+	if errors.Is(err, customfields.ErrInvalidFieldValue) || errors.Is(err, statuses.ErrStatusNotFound) {
+		log.C(r.Context()).Errorf("error while todo handler update err: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		log.C(r.Context()).Errorf("error while todo handler update err: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
 	if err = tx.Commit(); err != nil {
@@ -166,6 +193,69 @@ func (h *Handler) UpdateTodo(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// PatchTodo applies the JSON merge patch in the body to the todo and responds
+// with the updated todo.
+func (h *Handler) PatchTodo(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("todo handler patch request")
+	todoID := mux.Vars(r)["id"]
+
+	patch, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while todo handler patch req body err: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while todo handler patch tx err: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
+	todo, err := h.service.PatchTodo(ctx, todoID, patch)
+	if validation.WriteError(w, err) {
+		return
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		log.C(r.Context()).Errorf("error while todo handler patch err: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, mergepatch.ErrInvalidPatch) || errors.Is(err, customfields.ErrInvalidFieldValue) || errors.Is(err, statuses.ErrStatusNotFound) {
+		log.C(r.Context()).Errorf("error while todo handler patch err: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while todo handler patch err: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while todo handler patch tx err: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	etag.Set(w, todo.Version)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(todo); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
 func (h *Handler) DeleteTodo(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("todo handler delete request")
 	vars := mux.Vars(r)
@@ -183,6 +273,10 @@ func (h *Handler) DeleteTodo(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, id) {
+		return
+	}
+
 	_, err = h.service.GetTodo(ctx, id)
 	log.C(r.Context()).Debugf("todo handler delete success, todo: %v", id)
 	if err != nil {
@@ -207,14 +301,72 @@ func (h *Handler) DeleteTodo(w http.ResponseWriter, r *http.Request) {
 
 func (h *Handler) ListTodosByListID(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("todo handler list request")
-	vars := mux.Vars(r)
-	listID := vars["list_id"]
+	filter, err := converters.ToTodoFilter(r.URL.Query())
+	if err != nil {
+		log.C(r.Context()).Errorf("invalid todo filter: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter.ListID = mux.Vars(r)["list_id"]
+	h.writeFilteredTodos(w, r, filter)
+}
+
+func (h *Handler) GetAllTodos(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("todo handler get all request")
+	filter, err := converters.ToTodoFilter(r.URL.Query())
+	if err != nil {
+		log.C(r.Context()).Errorf("invalid todo filter: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.writeFilteredTodos(w, r, filter)
+}
+
+func (h *Handler) GetUserTodos(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("todo handler get user todos request")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while getting all todos by user handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	filter, err := converters.ToTodoFilter(r.URL.Query())
+	if err != nil {
+		log.C(r.Context()).Errorf("invalid todo filter: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter.AccessibleBy = userID
+	h.writeFilteredTodos(w, r, filter)
+}
+
+// GetSmartView responds with a smart view of the caller's todos. The time zone
+// is given as an IANA name in the tz query parameter and the length of the
+// upcoming view in days.
+func (h *Handler) GetSmartView(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("todo handler smart view request")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while getting smart view handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	view := constants.SmartView(mux.Vars(r)["view"])
+	days := 0
+	if value := r.URL.Query().Get("days"); value != "" {
+		var err error
+		if days, err = strconv.Atoi(value); err != nil {
+			log.C(r.Context()).Errorf("invalid days of smart view: %v", err)
+			http.Error(w, "days must be a number", http.StatusBadRequest)
+			return
+		}
+	}
 
 	ctx := r.Context()
 
 	tx, err := h.database.BeginTxx(ctx, nil)
 	if err != nil {
-		log.C(r.Context()).Errorf("todo handler list tx err: %v", err)
+		log.C(r.Context()).Errorf("error while getting smart view handler: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -222,33 +374,39 @@ func (h *Handler) ListTodosByListID(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
-	todosByUser, err := h.service.ListTodosByListID(ctx, listID)
+	result, err := h.service.SmartView(ctx, userID, view, r.URL.Query().Get("tz"), days)
+	if errors.Is(err, todos.ErrInvalidView) {
+		log.C(r.Context()).Errorf("error while getting smart view handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	if err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler list tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		log.C(r.Context()).Errorf("error while getting smart view handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
 	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler list tx err: %v", err)
+		log.C(r.Context()).Errorf("error while getting smart view handler transaction does not commit: %v", err)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(todosByUser); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
 		return
 	}
 }
 
-func (h *Handler) GetAllTodos(w http.ResponseWriter, r *http.Request) {
-	log.C(r.Context()).Info("todo handler get all request")
+// writeFilteredTodos responds with the todos matching the filter. The cursor
+// of the next page, if any, is sent in the X-Next-Cursor header.
+func (h *Handler) writeFilteredTodos(w http.ResponseWriter, r *http.Request, filter models.TodoFilter) {
 	ctx := r.Context()
 
 	tx, err := h.database.BeginTxx(ctx, nil)
 	if err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler get tx err: %v", err)
+		log.C(r.Context()).Errorf("todo handler filter tx err: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -256,22 +414,25 @@ func (h *Handler) GetAllTodos(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
-	todosByUser, err := h.service.GetAllTodos(ctx)
-	log.C(r.Context()).Debugf("todo handler get all success, todos: %v", todosByUser)
+	filteredTodos, nextCursor, err := h.service.FilterTodos(ctx, filter)
+	log.C(r.Context()).Debugf("todo handler filter success, todos: %v", filteredTodos)
 	if err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler get all err: %v", err)
+		log.C(r.Context()).Errorf("erorr while todo handler filter err: %v", err)
 		http.Error(w, err.Error(), http.StatusNotFound)
 		return
 	}
 
 	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler get all tx err: %v", err)
+		log.C(r.Context()).Errorf("erorr while todo handler filter tx err: %v", err)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	if nextCursor != "" {
+		w.Header().Set(constants.NextCursorHeader, nextCursor)
+	}
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(todosByUser); err != nil {
+	if err := json.NewEncoder(w).Encode(filteredTodos); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -293,6 +454,10 @@ func (h *Handler) CompleteTodo(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
 	_, err = h.service.GetTodo(ctx, todoID)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while completing todo handler, there is no such todo: %v", err)
@@ -300,8 +465,18 @@ func (h *Handler) CompleteTodo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedTodo, err := h.service.CompleteTodo(ctx, todoID)
+	var updatedTodo models.Todo
+	if r.URL.Query().Get("subtasks") == "true" {
+		updatedTodo, err = h.service.CompleteTodoWithSubtasks(ctx, todoID)
+	} else {
+		updatedTodo, err = h.service.CompleteTodo(ctx, todoID)
+	}
 	log.C(r.Context()).Debugf("complete todo handler for todo: %v", updatedTodo)
+	if errors.Is(err, todos.ErrTodoBlocked) {
+		log.C(r.Context()).Errorf("erorr while completing todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while completing todo handler: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -313,6 +488,7 @@ func (h *Handler) CompleteTodo(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	etag.Set(w, updatedTodo.Version)
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
@@ -321,6 +497,55 @@ func (h *Handler) CompleteTodo(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (h *Handler) ReopenTodo(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("reopen todo handler")
+	todoID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while reopening todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
+	_, err = h.service.GetTodo(ctx, todoID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while reopening todo handler, there is no such todo: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	reopenedTodo, err := h.service.ReopenTodo(ctx, todoID)
+	log.C(r.Context()).Debugf("reopen todo handler for todo: %v", reopenedTodo)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while reopening todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while reopening todo handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	etag.Set(w, reopenedTodo.Version)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(reopenedTodo); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
 func (h *Handler) UpdateTodoDescription(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("update todo description handler")
 	todoID := mux.Vars(r)["id"]
@@ -352,6 +577,10 @@ func (h *Handler) UpdateTodoDescription(w http.ResponseWriter, r *http.Request)
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
 	_, err = h.service.GetTodo(ctx, todoID)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while updating todo description handler: %v", err)
@@ -372,6 +601,7 @@ func (h *Handler) UpdateTodoDescription(w http.ResponseWriter, r *http.Request)
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	etag.Set(w, updatedTodo.Version)
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
@@ -411,6 +641,10 @@ func (h *Handler) UpdateTodoTitle(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
 	_, err = h.service.GetTodo(ctx, todoID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating todo title handler: %v", err)
@@ -420,6 +654,9 @@ func (h *Handler) UpdateTodoTitle(w http.ResponseWriter, r *http.Request) {
 
 	updatedTodo, err := h.service.UpdateTodoTitle(ctx, todoID, updateData.Title)
 	log.C(r.Context()).Debugf("update todo title handler with list: %v", updatedTodo)
+	if validation.WriteError(w, err) {
+		return
+	}
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while updating todo title handler: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -431,6 +668,7 @@ func (h *Handler) UpdateTodoTitle(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	etag.Set(w, updatedTodo.Version)
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
@@ -472,6 +710,10 @@ func (h *Handler) UpdateTodoPriority(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
 	_, err = h.service.GetTodo(ctx, todoID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating todo priority handler: %v", err)
@@ -481,6 +723,9 @@ func (h *Handler) UpdateTodoPriority(w http.ResponseWriter, r *http.Request) {
 
 	updatedTodo, err := h.service.UpdateTodoPriority(ctx, todoID, priority)
 	log.C(r.Context()).Debugf("update todo priority handler with list: %v", updatedTodo)
+	if validation.WriteError(w, err) {
+		return
+	}
 	if err != nil {
 		log.C(r.Context()).Errorf("error updating todo priority handler: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -492,6 +737,7 @@ func (h *Handler) UpdateTodoPriority(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	etag.Set(w, updatedTodo.Version)
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
@@ -532,6 +778,10 @@ func (h *Handler) UpdateAssignedTo(w http.ResponseWriter, r *http.Request) {
 
 	ctx = db.SaveToContext(ctx, tx)
 
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
 	_, err = h.service.GetTodo(ctx, todoID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating todo priority handler, there is no such todo: %v", err)
@@ -541,6 +791,9 @@ func (h *Handler) UpdateAssignedTo(w http.ResponseWriter, r *http.Request) {
 
 	updatedTodo, err := h.service.UpdateAssignedTo(ctx, todoID, updateData.UserID)
 	log.C(r.Context()).Debugf("update todo assigned_to handler with list: %v", updatedTodo)
+	if validation.WriteError(w, err) {
+		return
+	}
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating todo assigned_to handler: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -552,6 +805,7 @@ func (h *Handler) UpdateAssignedTo(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	etag.Set(w, updatedTodo.Version)
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
@@ -559,3 +813,587 @@ func (h *Handler) UpdateAssignedTo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+func (h *Handler) ListSubtasks(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list subtasks handler")
+	todoID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing subtasks handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	_, err = h.service.GetTodo(ctx, todoID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing subtasks handler, there is no such todo: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	subtasks, err := h.service.ListSubtasks(ctx, todoID)
+	log.C(r.Context()).Debugf("list subtasks handler for todo: %v", subtasks)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing subtasks handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing subtasks handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(subtasks); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) CreateSubtask(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create subtask handler")
+	parentID := mux.Vars(r)["id"]
+
+	var subtask models.Todo
+	if err := json.NewDecoder(r.Body).Decode(&subtask); err != nil {
+		log.C(r.Context()).Errorf("error while create subtask handler request body err: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while create subtask handler tx err: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	parent, err := h.service.GetTodo(ctx, parentID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while create subtask handler, there is no such todo: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	subtask.ParentID = &parent.ID
+	subtask.ListID = parent.ListID
+
+	createdID, err := h.service.CreateTodo(ctx, subtask)
+	log.C(r.Context()).Debugf("create subtask handler, created subtask: %v", createdID)
+	if validation.WriteError(w, err) {
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while create subtask handler err: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while create subtask handler tx err: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(createdID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListBlockedBy(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list blocked by handler")
+	todoID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while list blocked by handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	_, err = h.service.GetTodo(ctx, todoID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while list blocked by handler, there is no such todo: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	dependencies, err := h.service.ListBlockedBy(ctx, todoID)
+	log.C(r.Context()).Debugf("list blocked by handler for todo: %v", dependencies)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while list blocked by handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while list blocked by handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(dependencies); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) ListBlocking(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list blocking handler")
+	todoID := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while list blocking handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	_, err = h.service.GetTodo(ctx, todoID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while list blocking handler, there is no such todo: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	dependencies, err := h.service.ListBlocking(ctx, todoID)
+	log.C(r.Context()).Debugf("list blocking handler for todo: %v", dependencies)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while list blocking handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while list blocking handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(dependencies); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) AddDependency(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("add todo dependency handler")
+	vars := mux.Vars(r)
+	todoID := vars["id"]
+	blockerID := vars["blocker_id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while add todo dependency handler tx err: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	for _, id := range []string{todoID, blockerID} {
+		if _, err = h.service.GetTodo(ctx, id); err != nil {
+			log.C(r.Context()).Errorf("error while add todo dependency handler, there is no such todo: %v", err)
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+	}
+
+	err = h.service.AddDependency(ctx, todoID, blockerID)
+	if errors.Is(err, todos.ErrDependencyCycle) {
+		log.C(r.Context()).Errorf("error while add todo dependency handler err: %v", err)
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while add todo dependency handler err: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while add todo dependency handler tx err: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) RemoveDependency(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("remove todo dependency handler")
+	vars := mux.Vars(r)
+	todoID := vars["id"]
+	blockerID := vars["blocker_id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while remove todo dependency handler tx err: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if _, err = h.service.GetTodo(ctx, todoID); err != nil {
+		log.C(r.Context()).Errorf("error while remove todo dependency handler, there is no such todo: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err = h.service.RemoveDependency(ctx, todoID, blockerID); err != nil {
+		log.C(r.Context()).Errorf("error while remove todo dependency handler err: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while remove todo dependency handler tx err: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ReorderTodo(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("reorder todo handler")
+	todoID := mux.Vars(r)["id"]
+
+	var reorderData struct {
+		BeforeID string `json:"before_id"`
+		AfterID  string `json:"after_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reorderData); err != nil {
+		log.C(r.Context()).Errorf("error while reordering todo handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while reordering todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
+	_, err = h.service.GetTodo(ctx, todoID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while reordering todo handler, there is no such todo: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	reorderedTodo, err := h.service.ReorderTodo(ctx, todoID, reorderData.BeforeID, reorderData.AfterID)
+	log.C(r.Context()).Debugf("reorder todo handler for todo: %v", reorderedTodo)
+	if errors.Is(err, todos.ErrInvalidReorder) {
+		log.C(r.Context()).Errorf("error while reordering todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while reordering todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while reordering todo handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	etag.Set(w, reorderedTodo.Version)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(reorderedTodo); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) MoveTodoToList(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("move todo to list handler")
+	vars := mux.Vars(r)
+	todoID := vars["id"]
+	listID := vars["list_id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while moving todo to list handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
+	_, err = h.service.GetTodo(ctx, todoID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while moving todo to list handler, there is no such todo: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	movedTodo, err := h.service.MoveTodoToList(ctx, todoID, listID)
+	log.C(r.Context()).Debugf("move todo to list handler for todo: %v", movedTodo)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while moving todo to list handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while moving todo to list handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	etag.Set(w, movedTodo.Version)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(movedTodo); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) TransitionTodo(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("transition todo handler")
+	vars := mux.Vars(r)
+	todoID := vars["id"]
+	statusID := vars["status_id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while transitioning todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	if !h.checkVersion(ctx, w, r, todoID) {
+		return
+	}
+
+	_, err = h.service.GetTodo(ctx, todoID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while transitioning todo handler, there is no such todo: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	updatedTodo, err := h.service.TransitionTodo(ctx, todoID, statusID)
+	log.C(r.Context()).Debugf("transition todo handler for todo: %v", updatedTodo)
+	if errors.Is(err, statuses.ErrStatusNotFound) {
+		log.C(r.Context()).Errorf("error while transitioning todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, todos.ErrTodoBlocked) {
+		log.C(r.Context()).Errorf("error while transitioning todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while transitioning todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while transitioning todo handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	etag.Set(w, updatedTodo.Version)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) BulkUpdateTodos(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("bulk update todos handler")
+
+	var operation models.BulkOperation
+	if err := json.NewDecoder(r.Body).Decode(&operation); err != nil {
+		log.C(r.Context()).Errorf("error while bulk updating todos handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while bulk updating todos handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	updatedTodos, err := h.service.BulkUpdateTodos(ctx, operation)
+	log.C(r.Context()).Debugf("bulk update todos handler for todos: %v", updatedTodos)
+	if validation.WriteError(w, err) {
+		return
+	}
+	if errors.Is(err, todos.ErrInvalidBulk) {
+		log.C(r.Context()).Errorf("error while bulk updating todos handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, todos.ErrTodoBlocked) {
+		log.C(r.Context()).Errorf("error while bulk updating todos handler: %v", err)
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while bulk updating todos handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while bulk updating todos handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updatedTodos); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) QuickAddTodo(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("quick add todo handler")
+
+	var quickAdd models.QuickAdd
+	if err := json.NewDecoder(r.Body).Decode(&quickAdd); err != nil {
+		log.C(r.Context()).Errorf("error while quick adding todo handler: %v", err)
+		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while quick adding todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	createdTodo, err := h.service.QuickAddTodo(ctx, quickAdd.ListID, quickAdd.Text)
+	log.C(r.Context()).Debugf("quick add todo handler for todo: %v", createdTodo)
+	if validation.WriteError(w, err) {
+		return
+	}
+	if errors.Is(err, quickadd.ErrEmptyTitle) || errors.Is(err, todos.ErrUnknownAssignee) {
+		log.C(r.Context()).Errorf("error while quick adding todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while quick adding todo handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while quick adding todo handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(createdTodo); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// checkVersion compares the versions in the If-Match header of the request, if
+// any, with the version of the todo and locks the todo for the transaction in
+// ctx. It writes the error response and returns false when none of them match.
+func (h *Handler) checkVersion(ctx context.Context, w http.ResponseWriter, r *http.Request, id string) bool {
+	versions, ok := etag.IfMatch(r)
+	if !ok {
+		return true
+	}
+	err := todos.ErrVersionMismatch
+	for _, version := range versions {
+		if err = h.service.CheckVersion(ctx, id, version); !errors.Is(err, todos.ErrVersionMismatch) {
+			break
+		}
+	}
+	if err == nil {
+		return true
+	}
+	log.C(r.Context()).Errorf("error while checking todo version: %v", err)
+	switch {
+	case errors.Is(err, todos.ErrVersionMismatch):
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+	case errors.Is(err, sql.ErrNoRows):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+	return false
+}
@@ -2,12 +2,17 @@ package todo_test
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/todo"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos/automock"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/mergepatch"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -243,6 +248,22 @@ func TestUpdateTodoHandler(t *testing.T) {
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
 		},
+		{
+			name: "Error when the todo is invalid",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().GetTodo(mock.Anything, id).Return(modelInput, nil).Once()
+				mockService.EXPECT().UpdateTodo(mock.Anything, modelInput).Return(&validation.Errors{Fields: []validation.FieldError{{Field: "title", Message: "is required"}}}).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			urlVars:            map[string]string{"id": id},
+			expectedError:      err,
+		},
 		{
 			name: "Error when update todo fails",
 			mockService: func() *automock.TodoService {
@@ -299,6 +320,201 @@ func TestUpdateTodoHandler(t *testing.T) {
 	}
 }
 
+func TestUpdateTodoHandlerIfMatch(t *testing.T) {
+	db, mockDatabase, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	id := "1"
+	modelInput := models.Todo{ID: id, Title: "Test Todo", ListID: "list1", Version: 3}
+
+	tests := []struct {
+		name               string
+		ifMatch            string
+		mockService        func() *automock.TodoService
+		mockDatabase       func()
+		expectedStatusCode int
+	}{
+		{
+			name:    "Update when the version matches",
+			ifMatch: `"3"`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().CheckVersion(mock.Anything, id, 3).Return(nil).Once()
+				mockService.EXPECT().GetTodo(mock.Anything, id).Return(modelInput, nil).Once()
+				mockService.EXPECT().UpdateTodo(mock.Anything, mock.Anything).Return(nil).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit()
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:    "Error when the todo was changed since it was read",
+			ifMatch: `"2"`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().CheckVersion(mock.Anything, id, 2).Return(todos.ErrVersionMismatch).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusPreconditionFailed,
+		},
+		{
+			name:    "Update when one of the listed versions matches",
+			ifMatch: `"2", "3"`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().CheckVersion(mock.Anything, id, 2).Return(todos.ErrVersionMismatch).Once()
+				mockService.EXPECT().CheckVersion(mock.Anything, id, 3).Return(nil).Once()
+				mockService.EXPECT().GetTodo(mock.Anything, id).Return(modelInput, nil).Once()
+				mockService.EXPECT().UpdateTodo(mock.Anything, mock.Anything).Return(nil).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit()
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:    "Error when the entity tag is weak",
+			ifMatch: `W/"3"`,
+			mockService: func() *automock.TodoService {
+				return &automock.TodoService{}
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusPreconditionFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService, db)
+
+			body, _ := json.Marshal(modelInput)
+			req, _ := http.NewRequest(http.MethodPut, "/todos/1", bytes.NewBuffer(body))
+			req = mux.SetURLVars(req, map[string]string{"id": id})
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req.Header.Set("If-Match", tt.ifMatch)
+			w := httptest.NewRecorder()
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			tt.mockDatabase()
+
+			handler.UpdateTodo(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if err := mockDatabase.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestPatchTodoHandler(t *testing.T) {
+	db, mockDatabase, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	id := "1"
+	patch := `{"due_date": null}`
+
+	tests := []struct {
+		name               string
+		mockService        func() *automock.TodoService
+		mockDatabase       func()
+		expectedStatusCode int
+		expectedETag       string
+	}{
+		{
+			name: "Patch todo",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().PatchTodo(mock.Anything, id, []byte(patch)).Return(models.Todo{ID: id, Title: "Test Todo", Version: 4}, nil).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit()
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedETag:       `"4"`,
+		},
+		{
+			name: "Error when the patch is invalid",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().PatchTodo(mock.Anything, id, []byte(patch)).Return(models.Todo{}, mergepatch.ErrInvalidPatch).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error when the todo does not exist",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().PatchTodo(mock.Anything, id, []byte(patch)).Return(models.Todo{}, fmt.Errorf("todo not found: %w", sql.ErrNoRows)).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService, db)
+
+			req, _ := http.NewRequest(http.MethodPatch, "/todos/1", bytes.NewBufferString(patch))
+			req = mux.SetURLVars(req, map[string]string{"id": id})
+			req.Header.Set("Content-Type", mergepatch.ContentType)
+			w := httptest.NewRecorder()
+			defer mock.AssertExpectationsForObjects(t, mockService)
+			tt.mockDatabase()
+
+			handler.PatchTodo(w, req)
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Equal(t, tt.expectedETag, w.Header().Get("ETag"))
+
+			if err := mockDatabase.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestGetTodoHandlerSetsETag(t *testing.T) {
+	db, mockDatabase, err := sqlxmock.Newx()
+	require.NoError(t, err)
+
+	mockService := &automock.TodoService{}
+	mockService.EXPECT().GetTodo(mock.Anything, "1").Return(models.Todo{ID: "1", Title: "Test Todo", Version: 7}, nil).Once()
+	defer mock.AssertExpectationsForObjects(t, mockService)
+	mockDatabase.ExpectBegin()
+	mockDatabase.ExpectCommit()
+
+	req, _ := http.NewRequest(http.MethodGet, "/todos/1", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "1"})
+	w := httptest.NewRecorder()
+
+	todo.NewHandler(mockService, db).GetTodo(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"7"`, w.Header().Get("ETag"))
+	require.NoError(t, mockDatabase.ExpectationsWereMet())
+}
+
 func TestDeleteTodoHandler(t *testing.T) {
 	db, mockDatabase, err := sqlxmock.Newx()
 	require.NoError(t, err)
@@ -408,7 +624,7 @@ func TestListTodosByListIDHandler(t *testing.T) {
 			name: "List all by listID",
 			mockService: func() *automock.TodoService {
 				mockService := &automock.TodoService{}
-				mockService.EXPECT().ListTodosByListID(mock.Anything, id).Return([]models.Todo{model}, nil).Once()
+				mockService.EXPECT().FilterTodos(mock.Anything, models.TodoFilter{ListID: id}).Return([]models.Todo{model}, "", nil).Once()
 				return mockService
 			},
 			mockDatabase: func() {
@@ -423,7 +639,7 @@ func TestListTodosByListIDHandler(t *testing.T) {
 			name: "Error when list all by listID fails",
 			mockService: func() *automock.TodoService {
 				mockService := &automock.TodoService{}
-				mockService.EXPECT().ListTodosByListID(mock.Anything, id).Return([]models.Todo{}, err).Once()
+				mockService.EXPECT().FilterTodos(mock.Anything, models.TodoFilter{ListID: id}).Return([]models.Todo{}, "", err).Once()
 				return mockService
 			},
 			mockDatabase: func() {
@@ -478,3 +694,204 @@ func TestListTodosByListIDHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestListSubtasksHandler(t *testing.T) {
+	db, mockDatabase, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	err = errors.New("error")
+	id := "1"
+	parent := models.Todo{
+		ID:       id,
+		Title:    "Parent",
+		ListID:   "list1",
+		Subtasks: models.SubtaskProgress{Total: 1},
+	}
+	subtask := models.Todo{
+		ID:       "2",
+		Title:    "Subtask",
+		ListID:   "list1",
+		ParentID: &id,
+	}
+	tests := []struct {
+		name               string
+		mockService        func() *automock.TodoService
+		mockDatabase       func()
+		expectedStatusCode int
+		urlVars            map[string]string
+		expectedError      error
+	}{
+		{
+			name: "List subtasks of a todo",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().GetTodo(mock.Anything, id).Return(parent, nil).Once()
+				mockService.EXPECT().ListSubtasks(mock.Anything, id).Return([]models.Todo{subtask}, nil).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit()
+			},
+			expectedStatusCode: http.StatusOK,
+			urlVars:            map[string]string{"id": id},
+			expectedError:      nil,
+		},
+		{
+			name: "Error when parent todo does not exist",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().GetTodo(mock.Anything, id).Return(models.Todo{}, err).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusNotFound,
+			urlVars:            map[string]string{"id": id},
+			expectedError:      err,
+		},
+		{
+			name: "Error when listing subtasks fails",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().GetTodo(mock.Anything, id).Return(parent, nil).Once()
+				mockService.EXPECT().ListSubtasks(mock.Anything, id).Return(nil, err).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			urlVars:            map[string]string{"id": id},
+			expectedError:      err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService, db)
+
+			req, _ := http.NewRequest(http.MethodGet, "/todos/1/subtasks", nil)
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(req, tt.urlVars)
+			w := httptest.NewRecorder()
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			tt.mockDatabase()
+
+			handler.ListSubtasks(w, req)
+			resp := w.Result()
+			defer func() {
+				err := resp.Body.Close()
+				if err != nil {
+					t.Error(err)
+				}
+			}()
+
+			assert.Equal(t, tt.expectedStatusCode, resp.StatusCode)
+
+			if tt.expectedError == nil {
+				expectedResponse, _ := json.Marshal([]models.Todo{subtask})
+				var actualResponse bytes.Buffer
+				if _, err := actualResponse.ReadFrom(resp.Body); err != nil {
+					t.Error(err)
+				}
+				assert.JSONEq(t, string(expectedResponse), actualResponse.String())
+			}
+
+			if err := mockDatabase.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestAddDependencyHandler(t *testing.T) {
+	db, mockDatabase, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	err = errors.New("error")
+	id := "1"
+	blockerID := "2"
+	urlVars := map[string]string{"id": id, "blocker_id": blockerID}
+	tests := []struct {
+		name               string
+		mockService        func() *automock.TodoService
+		mockDatabase       func()
+		expectedStatusCode int
+	}{
+		{
+			name: "Add dependency between todos",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().GetTodo(mock.Anything, id).Return(models.Todo{ID: id}, nil).Once()
+				mockService.EXPECT().GetTodo(mock.Anything, blockerID).Return(models.Todo{ID: blockerID}, nil).Once()
+				mockService.EXPECT().AddDependency(mock.Anything, id, blockerID).Return(nil).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit()
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Error when blocking todo does not exist",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().GetTodo(mock.Anything, id).Return(models.Todo{ID: id}, nil).Once()
+				mockService.EXPECT().GetTodo(mock.Anything, blockerID).Return(models.Todo{}, err).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Conflict when dependency would create a cycle",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().GetTodo(mock.Anything, id).Return(models.Todo{ID: id}, nil).Once()
+				mockService.EXPECT().GetTodo(mock.Anything, blockerID).Return(models.Todo{ID: blockerID}, nil).Once()
+				mockService.EXPECT().AddDependency(mock.Anything, id, blockerID).Return(todos.ErrDependencyCycle).Once()
+				return mockService
+			},
+			mockDatabase: func() {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService, db)
+
+			req, _ := http.NewRequest(http.MethodPost, "/todos/1/blocked_by/2", nil)
+			req = mux.SetURLVars(req, urlVars)
+			w := httptest.NewRecorder()
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			tt.mockDatabase()
+
+			handler.AddDependency(w, req)
+			resp := w.Result()
+			defer func() {
+				err := resp.Body.Close()
+				if err != nil {
+					t.Error(err)
+				}
+			}()
+
+			assert.Equal(t, tt.expectedStatusCode, resp.StatusCode)
+
+			if err := mockDatabase.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
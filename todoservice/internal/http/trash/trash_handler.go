@@ -0,0 +1,117 @@
+package trash
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	trashdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/trash"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"net/http"
+)
+
+type Handler struct {
+	service  trashdomain.TrashService
+	database *sqlx.DB
+}
+
+func NewHandler(service trashdomain.TrashService, database *sqlx.DB) *Handler {
+	return &Handler{service: service, database: database}
+}
+
+func (h *Handler) ListTrash(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list trash handler")
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while listing trash handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing trash handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	items, err := h.service.ListTrash(ctx, userID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing trash handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while listing trash handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(items); err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *Handler) RestoreList(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("restore list handler")
+	h.restore(w, r, h.service.RestoreList)
+}
+
+func (h *Handler) RestoreTodo(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("restore todo handler")
+	h.restore(w, r, h.service.RestoreTodo)
+}
+
+// restore answers 404 both for items that are not in the trash and for items
+// the user has no access to, so it does not reveal what others deleted.
+func (h *Handler) restore(w http.ResponseWriter, r *http.Request, restore func(ctx context.Context, id string, userID string) error) {
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok {
+		log.C(r.Context()).Errorf("error while restoring handler missing user id in the context")
+		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	id := mux.Vars(r)["id"]
+
+	ctx := r.Context()
+
+	tx, err := h.database.BeginTxx(ctx, nil)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while restoring handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	ctx = db.SaveToContext(ctx, tx)
+
+	err = restore(ctx, id, userID)
+	if errors.Is(err, trashdomain.ErrNotInTrash) {
+		log.C(r.Context()).Errorf("error while restoring handler: %v", err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.C(r.Context()).Errorf("error while restoring handler: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.C(r.Context()).Errorf("error while restoring handler transaction does not commit: %v", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
 	"github.com/gorilla/mux"
 	"github.com/jmoiron/sqlx"
 	"net/http"
@@ -45,6 +46,9 @@ func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
 
 	createdUser, err := h.service.CreateUser(ctx, user)
 	log.C(r.Context()).Debugf("create user success: %v", createdUser)
+	if validation.WriteError(w, err) {
+		return
+	}
 	if err != nil {
 		log.C(r.Context()).Errorf("error while creating user failed: %v", err)
 		http.Error(w, "Failed to create user. Please ensure all required fields are correctly filled and there is no such a user already. Error details: "+err.Error(), http.StatusBadRequest)
@@ -130,6 +134,9 @@ func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 
 	err = h.service.UpdateUser(ctx, user)
 	log.C(r.Context()).Debugf("update user success: %v", user)
+	if validation.WriteError(w, err) {
+		return
+	}
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while updating user failed: %v", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -630,6 +630,65 @@ func (_c *ListRepository_GetAllTodosForList_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetFiltered provides a mock function with given fields: ctx, filter
+func (_m *ListRepository) GetFiltered(ctx context.Context, filter models.ListFilter) ([]models.List, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFiltered")
+	}
+
+	var r0 []models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListFilter) ([]models.List, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListFilter) []models.List); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ListFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRepository_GetFiltered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFiltered'
+type ListRepository_GetFiltered_Call struct {
+	*mock.Call
+}
+
+// GetFiltered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.ListFilter
+func (_e *ListRepository_Expecter) GetFiltered(ctx interface{}, filter interface{}) *ListRepository_GetFiltered_Call {
+	return &ListRepository_GetFiltered_Call{Call: _e.mock.On("GetFiltered", ctx, filter)}
+}
+
+func (_c *ListRepository_GetFiltered_Call) Run(run func(ctx context.Context, filter models.ListFilter)) *ListRepository_GetFiltered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ListFilter))
+	})
+	return _c
+}
+
+func (_c *ListRepository_GetFiltered_Call) Return(_a0 []models.List, _a1 error) *ListRepository_GetFiltered_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListRepository_GetFiltered_Call) RunAndReturn(run func(context.Context, models.ListFilter) ([]models.List, error)) *ListRepository_GetFiltered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetListOwnerID provides a mock function with given fields: ctx, listID
 func (_m *ListRepository) GetListOwnerID(ctx context.Context, listID string) (string, error) {
 	ret := _m.Called(ctx, listID)
@@ -864,6 +923,63 @@ func (_c *ListRepository_ListAllByUserID_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// LockVersion provides a mock function with given fields: ctx, id
+func (_m *ListRepository) LockVersion(ctx context.Context, id string) (int, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockVersion")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRepository_LockVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockVersion'
+type ListRepository_LockVersion_Call struct {
+	*mock.Call
+}
+
+// LockVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ListRepository_Expecter) LockVersion(ctx interface{}, id interface{}) *ListRepository_LockVersion_Call {
+	return &ListRepository_LockVersion_Call{Call: _e.mock.On("LockVersion", ctx, id)}
+}
+
+func (_c *ListRepository_LockVersion_Call) Run(run func(ctx context.Context, id string)) *ListRepository_LockVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ListRepository_LockVersion_Call) Return(_a0 int, _a1 error) *ListRepository_LockVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListRepository_LockVersion_Call) RunAndReturn(run func(context.Context, string) (int, error)) *ListRepository_LockVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, list
 func (_m *ListRepository) Update(ctx context.Context, list models.List) error {
 	ret := _m.Called(ctx, list)
@@ -71,6 +71,54 @@ func (_c *ListService_AcceptList_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// CheckVersion provides a mock function with given fields: ctx, id, version
+func (_m *ListService) CheckVersion(ctx context.Context, id string, version int) error {
+	ret := _m.Called(ctx, id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListService_CheckVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckVersion'
+type ListService_CheckVersion_Call struct {
+	*mock.Call
+}
+
+// CheckVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - version int
+func (_e *ListService_Expecter) CheckVersion(ctx interface{}, id interface{}, version interface{}) *ListService_CheckVersion_Call {
+	return &ListService_CheckVersion_Call{Call: _e.mock.On("CheckVersion", ctx, id, version)}
+}
+
+func (_c *ListService_CheckVersion_Call) Run(run func(ctx context.Context, id string, version int)) *ListService_CheckVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ListService_CheckVersion_Call) Return(_a0 error) *ListService_CheckVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ListService_CheckVersion_Call) RunAndReturn(run func(context.Context, string, int) error) *ListService_CheckVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAccess provides a mock function with given fields: ctx, list
 func (_m *ListService) CreateAccess(ctx context.Context, list models.Access) (models.Access, error) {
 	ret := _m.Called(ctx, list)
@@ -280,6 +328,72 @@ func (_c *ListService_DeleteList_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// FilterLists provides a mock function with given fields: ctx, filter
+func (_m *ListService) FilterLists(ctx context.Context, filter models.ListFilter) ([]models.List, string, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterLists")
+	}
+
+	var r0 []models.List
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListFilter) ([]models.List, string, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListFilter) []models.List); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ListFilter) string); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.ListFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListService_FilterLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterLists'
+type ListService_FilterLists_Call struct {
+	*mock.Call
+}
+
+// FilterLists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.ListFilter
+func (_e *ListService_Expecter) FilterLists(ctx interface{}, filter interface{}) *ListService_FilterLists_Call {
+	return &ListService_FilterLists_Call{Call: _e.mock.On("FilterLists", ctx, filter)}
+}
+
+func (_c *ListService_FilterLists_Call) Run(run func(ctx context.Context, filter models.ListFilter)) *ListService_FilterLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ListFilter))
+	})
+	return _c
+}
+
+func (_c *ListService_FilterLists_Call) Return(_a0 []models.List, _a1 string, _a2 error) *ListService_FilterLists_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ListService_FilterLists_Call) RunAndReturn(run func(context.Context, models.ListFilter) ([]models.List, string, error)) *ListService_FilterLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAcceptedLists provides a mock function with given fields: ctx, userID
 func (_m *ListService) GetAcceptedLists(ctx context.Context, userID string) ([]models.Access, error) {
 	ret := _m.Called(ctx, userID)
@@ -864,6 +978,64 @@ func (_c *ListService_ListAllByUserID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// PatchList provides a mock function with given fields: ctx, id, patch
+func (_m *ListService) PatchList(ctx context.Context, id string, patch []byte) (models.List, error) {
+	ret := _m.Called(ctx, id, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchList")
+	}
+
+	var r0 models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) (models.List, error)); ok {
+		return rf(ctx, id, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) models.List); ok {
+		r0 = rf(ctx, id, patch)
+	} else {
+		r0 = ret.Get(0).(models.List)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, id, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListService_PatchList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchList'
+type ListService_PatchList_Call struct {
+	*mock.Call
+}
+
+// PatchList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - patch []byte
+func (_e *ListService_Expecter) PatchList(ctx interface{}, id interface{}, patch interface{}) *ListService_PatchList_Call {
+	return &ListService_PatchList_Call{Call: _e.mock.On("PatchList", ctx, id, patch)}
+}
+
+func (_c *ListService_PatchList_Call) Run(run func(ctx context.Context, id string, patch []byte)) *ListService_PatchList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *ListService_PatchList_Call) Return(_a0 models.List, _a1 error) *ListService_PatchList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListService_PatchList_Call) RunAndReturn(run func(context.Context, string, []byte) (models.List, error)) *ListService_PatchList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateList provides a mock function with given fields: ctx, list
 func (_m *ListService) UpdateList(ctx context.Context, list models.List) error {
 	ret := _m.Called(ctx, list)
@@ -22,6 +22,7 @@ func (c *Converter) ConvertListToModel(entity Entity) models.List {
 		CreatedAt:   entity.CreatedAt,
 		UpdatedAt:   entity.UpdatedAt,
 		Visibility:  entity.Visibility,
+		Version:     entity.Version,
 	}
 }
 
@@ -36,6 +37,7 @@ func (c *Converter) ConvertListToEntity(list models.List) Entity {
 		CreatedAt:   list.CreatedAt,
 		UpdatedAt:   list.UpdatedAt,
 		Visibility:  list.Visibility,
+		Version:     list.Version,
 	}
 }
 
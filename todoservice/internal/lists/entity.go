@@ -16,6 +16,7 @@ type Entity struct {
 	CreatedAt   time.Time            `db:"created_at"`
 	UpdatedAt   time.Time            `db:"updated_at"`
 	Visibility  constants.Visibility `db:"visibility"`
+	Version     int                  `db:"version"`
 }
 
 type AccessEntity struct {
@@ -0,0 +1,65 @@
+package lists
+
+import (
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"strings"
+	"time"
+)
+
+type sortColumn struct {
+	expression string
+	cast       string
+}
+
+var sortColumns = map[constants.SortKey]sortColumn{
+	constants.SortCreatedAt: {expression: "created_at", cast: "timestamp"},
+	constants.SortName:      {expression: "name", cast: "text"},
+}
+
+// buildFilter returns the WHERE and ORDER BY clauses for the filter together
+// with their arguments. Trashed rows never match. The filter's sort key must be
+// set.
+func buildFilter(filter models.ListFilter) (string, string, []interface{}) {
+	conditions := []string{"deleted_at IS NULL"}
+	var args []interface{}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.Visibility != "" {
+		conditions = append(conditions, "visibility = "+arg(filter.Visibility))
+	}
+	if filter.Tag != "" {
+		conditions = append(conditions, "id IN (SELECT lt.list_id FROM list_tags lt JOIN tags g ON g.id = lt.tag_id WHERE lower(g.name) = lower("+
+			arg(filter.Tag)+"))")
+	}
+
+	column := sortColumns[filter.Sort]
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+	if filter.After != nil {
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s (%s::%s, %s::uuid)",
+			column.expression, comparison, arg(filter.After.Value), column.cast, arg(filter.After.ID)))
+	}
+
+	where := "WHERE " + strings.Join(conditions, " AND ")
+	orderBy := fmt.Sprintf("ORDER BY %s %s, id %s", column.expression, direction, direction)
+	if filter.Limit > 0 {
+		orderBy += " LIMIT " + arg(filter.Limit)
+	}
+	return where, orderBy, args
+}
+
+// sortValue returns the value of the list's sort key the way it is stored in a
+// cursor.
+func sortValue(list models.List, key constants.SortKey) string {
+	if key == constants.SortName {
+		return list.Name
+	}
+	return list.CreatedAt.Format(time.RFC3339Nano)
+}
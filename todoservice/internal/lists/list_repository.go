@@ -18,6 +18,7 @@ type ListRepository interface {
 	Update(ctx context.Context, list models.List) error
 	Get(ctx context.Context, id string) (models.List, error)
 	GetAll(ctx context.Context) ([]models.List, error)
+	GetFiltered(ctx context.Context, filter models.ListFilter) ([]models.List, error)
 	GetAccess(ctx context.Context, listID string, userID string) (models.Access, error)
 	GetUsersByListID(ctx context.Context, listID string) ([]models.Access, error)
 	GetListOwnerID(ctx context.Context, listID string) (string, error)
@@ -33,6 +34,7 @@ type ListRepository interface {
 	AcceptList(ctx context.Context, listID string, userID string) error
 	GetAccessesByListID(ctx context.Context, listID string) ([]models.Access, error)
 	GetAcceptedLists(ctx context.Context, listID string) ([]models.Access, error)
+	LockVersion(ctx context.Context, id string) (int, error)
 }
 
 type SQLXListRepository struct {
@@ -57,8 +59,8 @@ func (r *SQLXListRepository) Create(ctx context.Context, list models.List) (stri
 	log.C(ctx).Debugf("successfull converted list: %v", entity)
 
 	insertListQuery := `
-		INSERT INTO lists (id, name, description, owner_id, visibility, tags, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO lists (id, name, description, owner_id, visibility, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING id
 	`
 
@@ -69,7 +71,6 @@ func (r *SQLXListRepository) Create(ctx context.Context, list models.List) (stri
 		entity.Description,
 		entity.OwnerID,
 		entity.Visibility,
-		entity.Tags,
 		entity.CreatedAt,
 		entity.UpdatedAt,
 	).Scan(&id)
@@ -121,9 +122,9 @@ func (r *SQLXListRepository) Get(ctx context.Context, id string) (models.List, e
 	}
 
 	query := `
-		SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at
+		SELECT id, name, description, owner_id, visibility, list_tag_names(id) AS tags, created_at, updated_at, version
 		FROM lists
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 `
 	var entity Entity
 	err = tx.GetContext(ctx, &entity, query, id)
@@ -164,12 +165,12 @@ func (r *SQLXListRepository) Update(ctx context.Context, list models.List) error
 
 	updateListQuery := `
 		UPDATE lists
-		SET name = $1, description = $2, visibility = $3, tags = $4
-		WHERE id = $5
+		SET name = $1, description = $2, visibility = $3
+		WHERE id = $4 AND deleted_at IS NULL
 	`
 
 	_, err = tx.ExecContext(ctx, updateListQuery, entity.Name, entity.Description,
-		entity.Visibility, entity.Tags, entity.ID)
+		entity.Visibility, entity.ID)
 	if err != nil {
 		log.C(ctx).Errorf("failed to update list: %v", err)
 		return fmt.Errorf("failed to update list: %w", err)
@@ -206,7 +207,18 @@ func (r *SQLXListRepository) Delete(ctx context.Context, id string) error {
 		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
 		return err
 	}
-	deleteQuery := `DELETE FROM lists WHERE id = $1`
+	deleteQuery := `
+		WITH trashed AS (
+			UPDATE lists
+			SET deleted_at = NOW()
+			WHERE id = $1 AND deleted_at IS NULL
+			RETURNING id, deleted_at
+		)
+		UPDATE todos
+		SET deleted_at = trashed.deleted_at
+		FROM trashed
+		WHERE todos.list_id = trashed.id AND todos.deleted_at IS NULL
+	`
 	_, err = tx.ExecContext(ctx, deleteQuery, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to delete lists: %v", err)
@@ -227,6 +239,7 @@ func (r *SQLXListRepository) ListAllByUserID(ctx context.Context, userID string)
 		SELECT list_id, user_id, access_level, status
 		FROM list_access
 		WHERE user_id = $1 AND status = 'owner'
+		  AND list_id IN (SELECT id FROM lists WHERE deleted_at IS NULL)
 	`
 
 	var lists []AccessEntity
@@ -259,6 +272,7 @@ func (r *SQLXListRepository) GetAcceptedLists(ctx context.Context, userID string
 		SELECT list_id, user_id, access_level, status
 		FROM list_access
 		WHERE user_id = $1 AND status = 'accepted'
+		  AND list_id IN (SELECT id FROM lists WHERE deleted_at IS NULL)
 	`
 
 	var lists []AccessEntity
@@ -291,6 +305,7 @@ func (r *SQLXListRepository) GetPendingLists(ctx context.Context, userID string)
 		SELECT list_id, user_id, access_level, status
 		FROM list_access
 		WHERE user_id = $1 AND status = 'pending'
+		  AND list_id IN (SELECT id FROM lists WHERE deleted_at IS NULL)
 	`
 
 	var lists []AccessEntity
@@ -320,8 +335,9 @@ func (r *SQLXListRepository) GetAll(ctx context.Context) ([]models.List, error)
 		return []models.List{}, err
 	}
 	query := `
-		SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at
+		SELECT id, name, description, owner_id, visibility, list_tag_names(id) AS tags, created_at, updated_at, version
 		FROM lists
+		WHERE deleted_at IS NULL
 	`
 
 	var lists []Entity
@@ -360,6 +376,48 @@ func (r *SQLXListRepository) GetAll(ctx context.Context) ([]models.List, error)
 	return result, nil
 }
 
+func (r *SQLXListRepository) GetFiltered(ctx context.Context, filter models.ListFilter) ([]models.List, error) {
+	log.C(ctx).Info("listing filtered lists repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	where, orderBy, args := buildFilter(filter)
+	query := `
+		SELECT id, name, description, owner_id, visibility, list_tag_names(id) AS tags, created_at, updated_at, version
+		FROM lists
+		` + where + `
+		` + orderBy
+
+	var lists []Entity
+	err = tx.SelectContext(ctx, &lists, query, args...)
+	if err != nil {
+		log.C(ctx).Errorf("failed to fetch filtered lists: %v", err)
+		return nil, fmt.Errorf("failed to get filtered lists: %w", err)
+	}
+
+	sharedWithQuery := `
+		SELECT user_id
+		FROM list_access
+		WHERE list_id = $1
+	`
+
+	result := make([]models.List, 0, len(lists))
+	for _, entity := range lists {
+		var sharedWith []string
+		err = tx.SelectContext(ctx, &sharedWith, sharedWithQuery, entity.ID)
+		if err != nil {
+			log.C(ctx).Errorf("failed to fetch lists: %v", err)
+			return nil, fmt.Errorf("failed to fetch list_access: %w", err)
+		}
+		entity.SharedWith = sharedWith
+		result = append(result, r.converter.ConvertListToModel(entity))
+	}
+	return result, nil
+}
+
 func (r *SQLXListRepository) GetUsersByListID(ctx context.Context, listID string) ([]models.Access, error) {
 	log.C(ctx).Info("listing all lists repository")
 	tx, err := db.FromContext(ctx)
@@ -406,7 +464,7 @@ func (r *SQLXListRepository) GetListOwnerID(ctx context.Context, listID string)
 	query := `
 		SELECT owner_id
 		FROM lists
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	var ownerID string
@@ -537,7 +595,7 @@ func (r *SQLXListRepository) UpdateListDescription(ctx context.Context, listID s
 	updateListQuery := `
 		UPDATE lists
 		SET description = $1
-		WHERE id = $2
+		WHERE id = $2 AND deleted_at IS NULL
 	`
 
 	_, err = tx.ExecContext(ctx, updateListQuery, description, listID)
@@ -567,7 +625,7 @@ func (r *SQLXListRepository) UpdateListName(ctx context.Context, listID string,
 	updateListQuery := `
 		UPDATE lists
 		SET name = $1
-		WHERE id = $2
+		WHERE id = $2 AND deleted_at IS NULL
 	`
 
 	_, err = tx.ExecContext(ctx, updateListQuery, name, listID)
@@ -594,9 +652,12 @@ func (r *SQLXListRepository) GetAllTodosForList(ctx context.Context, listID stri
 		return []models.Todo{}, err
 	}
 	query := `
-		SELECT id, title, description, list_id, priority, start_date, due_date, completed, tags, created_at, updated_at
+		SELECT id, title, description, list_id, priority, start_date, due_date, completed, todo_tag_names(id) AS tags, created_at, updated_at, parent_id, recurrence, completed_at, position, estimate_minutes, custom_fields, status_id,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL) AS subtasks_total,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL AND s.completed) AS subtasks_completed
 		FROM todos
-		WHERE list_id = $1
+		WHERE list_id = $1 AND deleted_at IS NULL
+		ORDER BY position COLLATE "C", created_at
 	`
 
 	var allTodos []todos.Entity
@@ -652,3 +713,27 @@ func (r *SQLXListRepository) GetAccessesByListID(ctx context.Context, listID str
 
 	return accesses, nil
 }
+
+// LockVersion returns the version of the list and locks its row until the
+// transaction ends, so the version cannot change before the caller writes.
+func (r *SQLXListRepository) LockVersion(ctx context.Context, id string) (int, error) {
+	log.C(ctx).Info("locking list version repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return 0, err
+	}
+
+	query := `SELECT version FROM lists WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+
+	var version int
+	err = tx.GetContext(ctx, &version, query, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to lock list version: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("list not found: %w", err)
+		}
+		return 0, fmt.Errorf("failed to lock list version: %w", err)
+	}
+	return version, nil
+}
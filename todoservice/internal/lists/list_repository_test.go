@@ -43,7 +43,7 @@ func TestSQLXListRepositoryCreate(t *testing.T) {
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectQuery(`^INSERT INTO lists`).WithArgs(
-					"1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, "tag1, tag2", sqlxmock.AnyArg(), sqlxmock.AnyArg(),
+					"1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, sqlxmock.AnyArg(), sqlxmock.AnyArg(),
 				).WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow("1"))
 
 				mockDB.ExpectExec(`^INSERT INTO list_access`).WithArgs("owner-id", "1", "admin", "owner").WillReturnResult(sqlxmock.NewResult(1, 1))
@@ -125,7 +125,7 @@ func TestSQLXListRepositoryGet(t *testing.T) {
 			id:   "1",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at FROM lists").WithArgs(
+				mockDB.ExpectQuery(`SELECT id, name, description, owner_id, visibility, list_tag_names\(id\) AS tags, created_at, updated_at, version FROM lists`).WithArgs(
 					"1").WillReturnRows(sqlxmock.NewRows([]string{"id", "name", "description", "owner_id", "visibility", "tags", "created_at", "updated_at"}).
 					AddRow("1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, "tag1, tag2", time.Time{}, time.Time{}))
 
@@ -150,7 +150,7 @@ func TestSQLXListRepositoryGet(t *testing.T) {
 			id:   "1",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at FROM lists").WithArgs("1").WillReturnError(errors.New("db error"))
+				mockDB.ExpectQuery(`SELECT id, name, description, owner_id, visibility, list_tag_names\(id\) AS tags, created_at, updated_at, version FROM lists`).WithArgs("1").WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
 			expectedList:  models.List{},
@@ -206,7 +206,7 @@ func TestSQLXListRepositoryUpdate(t *testing.T) {
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectExec("UPDATE lists").
-					WithArgs("Test List", "Test Description", constants.VisibilityShared, "tag1, tag2", "1").
+					WithArgs("Test List", "Test Description", constants.VisibilityShared, "1").
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 				mockDB.ExpectCommit()
 			},
@@ -287,7 +287,7 @@ func TestSQLXListRepositoryDelete(t *testing.T) {
 			id:   "1",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectExec(`^DELETE FROM lists`).
+				mockDB.ExpectExec("WITH trashed AS (.+) UPDATE todos SET deleted_at = trashed.deleted_at").
 					WithArgs("1").
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 				mockDB.ExpectCommit()
@@ -299,7 +299,7 @@ func TestSQLXListRepositoryDelete(t *testing.T) {
 			id:   "1",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectExec(`^DELETE FROM lists`).WillReturnError(errors.New("db error"))
+				mockDB.ExpectExec("WITH trashed AS (.+) UPDATE todos SET deleted_at = trashed.deleted_at").WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
 			expectedError: fmt.Errorf("failed to delete list: %w", errors.New("db error")),
@@ -436,7 +436,7 @@ func TestSQLXListRepositoryListAll(t *testing.T) {
 			name: "Successful get of all lists",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at FROM lists").
+				mockDB.ExpectQuery(`SELECT id, name, description, owner_id, visibility, list_tag_names\(id\) AS tags, created_at, updated_at, version FROM lists`).
 					WillReturnRows(sqlxmock.NewRows([]string{"id", "name", "description", "owner_id", "visibility", "tags", "created_at", "updated_at"}).
 						AddRow("1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, "tag1, tag2", time.Time{}, time.Time{}).
 						AddRow("1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, "tag1, tag2", time.Time{}, time.Time{}))
@@ -475,7 +475,7 @@ func TestSQLXListRepositoryListAll(t *testing.T) {
 			name: "Failed get all lists due to database error",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at FROM lists").WillReturnError(errors.New("db error"))
+				mockDB.ExpectQuery(`SELECT id, name, description, owner_id, visibility, list_tag_names\(id\) AS tags, created_at, updated_at, version FROM lists`).WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
 			expectedList:  []models.List{},
@@ -2,10 +2,19 @@ package lists
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/activity"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/converters"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/mergepatch"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
 	"time"
 )
 
@@ -16,9 +25,11 @@ type ListService interface {
 	GetList(ctx context.Context, id string) (models.List, error)
 	GetAccess(ctx context.Context, listID string, userID string) (models.Access, error)
 	GetAllLists(ctx context.Context) ([]models.List, error)
+	FilterLists(ctx context.Context, filter models.ListFilter) ([]models.List, string, error)
 	GetUsersByListID(ctx context.Context, listID string) ([]models.Access, error)
 	GetListOwnerID(ctx context.Context, listID string) (string, error)
 	UpdateList(ctx context.Context, list models.List) error
+	PatchList(ctx context.Context, id string, patch []byte) (models.List, error)
 	DeleteList(ctx context.Context, id string) error
 	DeleteAccess(ctx context.Context, listID string, userID string) error
 	ListAllByUserID(ctx context.Context, useID string) ([]models.Access, error)
@@ -29,8 +40,11 @@ type ListService interface {
 	AcceptList(ctx context.Context, listID string, userID string) error
 	GetAccessesByListID(ctx context.Context, listID string) ([]models.Access, error)
 	GetAcceptedLists(ctx context.Context, userID string) ([]models.Access, error)
+	CheckVersion(ctx context.Context, id string, version int) error
 }
 
+var ErrVersionMismatch = errors.New("list was changed since it was read")
+
 //go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
 type UUIDService interface {
 	Generate() string
@@ -45,25 +59,50 @@ var _ ListService = &service{}
 
 type service struct {
 	repo        ListRepository
+	activity    activity.ActivityService
+	tags        tags.TagService
+	statuses    statuses.StatusService
 	uuidService UUIDService
 	timeService TimeService
 }
 
-func NewService(repo ListRepository, uuidService UUIDService, timeService TimeService) ListService {
-	return &service{repo: repo, uuidService: uuidService, timeService: timeService}
+func NewService(repo ListRepository, activityService activity.ActivityService, tagService tags.TagService, statusService statuses.StatusService, uuidService UUIDService, timeService TimeService) ListService {
+	return &service{repo: repo, activity: activityService, tags: tagService, statuses: statusService, uuidService: uuidService, timeService: timeService}
 }
 
 func (s *service) CreateList(ctx context.Context, list models.List) (string, error) {
 	log.C(ctx).Info("creating list service")
+	if list.Visibility == "" {
+		list.Visibility = constants.VisibilityPrivate
+	}
 	if err := validateList(ctx, list); err != nil {
 		return "", err
 	}
+	tagNames, err := tags.Names(list.Tags)
+	if err != nil {
+		return "", err
+	}
 
 	list.ID = s.uuidService.Generate()
 	list.CreatedAt = s.timeService.Now()
 	list.UpdatedAt = s.timeService.Now()
 
-	return s.repo.Create(ctx, list)
+	id, err := s.repo.Create(ctx, list)
+	if err != nil {
+		return "", err
+	}
+	if err = s.tags.SetListTags(ctx, id, list.OwnerID, tagNames); err != nil {
+		log.C(ctx).Errorf("setting tags of list with id %s failed", id)
+		return "", err
+	}
+	if err = s.statuses.CreateDefaultStatuses(ctx, id); err != nil {
+		log.C(ctx).Errorf("creating statuses of list with id %s failed", id)
+		return "", err
+	}
+	if err = s.activity.Record(ctx, constants.ActivityListCreated, id, nil, nil, list); err != nil {
+		return "", err
+	}
+	return id, nil
 }
 
 func (s *service) GetList(ctx context.Context, id string) (models.List, error) {
@@ -71,28 +110,99 @@ func (s *service) GetList(ctx context.Context, id string) (models.List, error) {
 	return s.repo.Get(ctx, id)
 }
 
+// DeleteList moves the list and its todos to the trash.
 func (s *service) DeleteList(ctx context.Context, id string) error {
 	log.C(ctx).Info("deleting list service")
-	return s.repo.Delete(ctx, id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.activity.Record(ctx, constants.ActivityListDeleted, id, nil, nil, nil)
 }
 
 func (s *service) DeleteAccess(ctx context.Context, listId string, userID string) error {
 	log.C(ctx).Info("deleting list access service")
-	return s.repo.DeleteAccess(ctx, listId, userID)
+	access, err := s.repo.GetAccess(ctx, listId, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err = s.repo.DeleteAccess(ctx, listId, userID); err != nil {
+		return err
+	}
+	return s.activity.Record(ctx, constants.ActivityListUnshared, listId, nil, access, nil)
 }
 
 func (s *service) UpdateList(ctx context.Context, list models.List) error {
 	log.C(ctx).Info("updating list service")
-	if err := validateList(ctx, list); err != nil {
+	before, err := s.repo.Get(ctx, list.ID)
+	log.C(ctx).Debugf("updating list service with id %s", list.ID)
+	if err != nil {
 		return err
 	}
-	_, err := s.repo.Get(ctx, list.ID)
-	log.C(ctx).Debugf("updating list service with id %s", list.ID)
+	if list.Visibility == "" {
+		list.Visibility = before.Visibility
+	}
+	if err = validateList(ctx, list); err != nil {
+		return err
+	}
+	tagNames, err := tags.Names(list.Tags)
+	if err != nil {
+		return err
+	}
+
+	if err = s.repo.Update(ctx, list); err != nil {
+		return err
+	}
+	if err = s.tags.SetListTags(ctx, list.ID, before.OwnerID, tagNames); err != nil {
+		log.C(ctx).Errorf("setting tags of list with id %s failed", list.ID)
+		return err
+	}
+	after, err := s.repo.Get(ctx, list.ID)
 	if err != nil {
 		return err
 	}
+	return s.activity.Record(ctx, constants.ActivityListUpdated, list.ID, nil, before, after)
+}
 
-	return s.repo.Update(ctx, list)
+// patchableListFields are the members of a list PatchList changes, mapped to
+// whether null may clear them.
+var patchableListFields = map[string]bool{
+	"name":        false,
+	"description": true,
+	"visibility":  false,
+	"tags":        true,
+}
+
+// PatchList applies a JSON merge patch to the list and updates it like
+// UpdateList, so the fields missing from the patch keep their values.
+func (s *service) PatchList(ctx context.Context, id string, patch []byte) (models.List, error) {
+	log.C(ctx).Info("patching list service")
+	if err := mergepatch.Check(patch, patchableListFields); err != nil {
+		return models.List{}, err
+	}
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.List{}, err
+	}
+	document, err := json.Marshal(current)
+	if err != nil {
+		return models.List{}, fmt.Errorf("failed to marshal list: %w", err)
+	}
+	patched, err := mergepatch.Apply(document, patch)
+	if err != nil {
+		return models.List{}, err
+	}
+	var list models.List
+	if err = json.Unmarshal(patched, &list); err != nil {
+		return models.List{}, fmt.Errorf("%w: %v", mergepatch.ErrInvalidPatch, err)
+	}
+	list.ID = id
+	if err = s.UpdateList(ctx, list); err != nil {
+		return models.List{}, err
+	}
+	return s.repo.Get(ctx, id)
 }
 
 func (s *service) ListAllByUserID(ctx context.Context, userID string) ([]models.Access, error) {
@@ -115,6 +225,32 @@ func (s *service) GetAllLists(ctx context.Context) ([]models.List, error) {
 	return s.repo.GetAll(ctx)
 }
 
+// FilterLists returns the lists matching the filter and, when there are more
+// lists after the requested page, the cursor of the next page.
+func (s *service) FilterLists(ctx context.Context, filter models.ListFilter) ([]models.List, string, error) {
+	log.C(ctx).Info("filtering lists service")
+	if filter.Sort == "" {
+		filter.Sort = constants.SortCreatedAt
+	}
+	limit := filter.Limit
+	if limit > 0 {
+		filter.Limit = limit + 1
+	}
+
+	lists, err := s.repo.GetFiltered(ctx, filter)
+	if err != nil {
+		log.C(ctx).Errorf("filtering lists failed: %v", err)
+		return nil, "", err
+	}
+	if limit <= 0 || len(lists) <= limit {
+		return lists, "", nil
+	}
+
+	lists = lists[:limit]
+	last := lists[limit-1]
+	return lists, converters.CursorToString(models.Cursor{Value: sortValue(last, filter.Sort), ID: last.ID}), nil
+}
+
 func (s *service) GetUsersByListID(ctx context.Context, listID string) ([]models.Access, error) {
 	log.C(ctx).Info("getting all access service")
 	return s.repo.GetUsersByListID(ctx, listID)
@@ -132,17 +268,64 @@ func (s *service) GetAccess(ctx context.Context, listID, userID string) (models.
 
 func (s *service) CreateAccess(ctx context.Context, access models.Access) (models.Access, error) {
 	log.C(ctx).Info("creating access service")
-	return s.repo.CreateAccess(ctx, access)
+	created, err := s.repo.CreateAccess(ctx, access)
+	if err != nil {
+		return models.Access{}, err
+	}
+	if err = s.activity.Record(ctx, constants.ActivityListShared, created.ListID, nil, nil, created); err != nil {
+		return models.Access{}, err
+	}
+	return created, nil
 }
 
 func (s *service) UpdateListDescription(ctx context.Context, id, description string) (models.List, error) {
 	log.C(ctx).Info("updating list description service")
-	return s.repo.UpdateListDescription(ctx, id, description)
+	return s.changeList(ctx, id, func(ctx context.Context, id string) (models.List, error) {
+		return s.repo.UpdateListDescription(ctx, id, description)
+	})
 }
 
 func (s *service) UpdateListName(ctx context.Context, id, name string) (models.List, error) {
 	log.C(ctx).Info("updating list name service")
-	return s.repo.UpdateListName(ctx, id, name)
+	v := validation.New()
+	v.Length("name", name, 1, validation.MaxNameLength)
+	if err := v.Err(); err != nil {
+		return models.List{}, err
+	}
+	return s.changeList(ctx, id, func(ctx context.Context, id string) (models.List, error) {
+		return s.repo.UpdateListName(ctx, id, name)
+	})
+}
+
+// CheckVersion returns ErrVersionMismatch unless the list is at the version.
+// The list stays locked until the transaction ends, so a change made in the
+// same transaction cannot overwrite someone else's.
+func (s *service) CheckVersion(ctx context.Context, id string, version int) error {
+	log.C(ctx).Info("checking list version service")
+	current, err := s.repo.LockVersion(ctx, id)
+	if err != nil {
+		return err
+	}
+	if current != version {
+		return fmt.Errorf("%w: expected version %d, found %d", ErrVersionMismatch, version, current)
+	}
+	return nil
+}
+
+// changeList applies a single-field update and records the change.
+func (s *service) changeList(ctx context.Context, id string, change func(ctx context.Context, id string) (models.List, error)) (models.List, error) {
+	before, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.List{}, err
+	}
+	after, err := change(ctx, id)
+	if err != nil {
+		return models.List{}, err
+	}
+	if err = s.activity.Record(ctx, constants.ActivityListUpdated, id, nil, before, after); err != nil {
+		return models.List{}, err
+	}
+	return after, nil
 }
 
 func (s *service) GetAllTodosForList(ctx context.Context, listID string) ([]models.Todo, error) {
@@ -152,14 +335,28 @@ func (s *service) GetAllTodosForList(ctx context.Context, listID string) ([]mode
 
 func (s *service) AcceptList(ctx context.Context, listID string, userID string) error {
 	log.C(ctx).Info("accepting list service")
-	return s.repo.AcceptList(ctx, listID, userID)
+	before, err := s.repo.GetAccess(ctx, listID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err = s.repo.AcceptList(ctx, listID, userID); err != nil {
+		return err
+	}
+	after, err := s.repo.GetAccess(ctx, listID, userID)
+	if err != nil {
+		return err
+	}
+	return s.activity.Record(ctx, constants.ActivityListAccepted, listID, nil, before, after)
 }
 
 func validateList(ctx context.Context, list models.List) error {
 	log.C(ctx).Info("validating list service")
-	if len(list.SharedWith) != 0 && list.Visibility == constants.VisibilityPrivate {
-		log.C(ctx).Error("cannot have private visibility and be shared at the same time")
-		return errors.New("cannot have private visibility and be shared at the same time")
+	if err := validation.List(list).Err(); err != nil {
+		log.C(ctx).Errorf("invalid list: %v", err)
+		return err
 	}
 	return nil
 }
@@ -2,10 +2,17 @@ package lists_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	activityautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/activity/automock"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists/automock"
+	statusautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses/automock"
+	tagautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/tags/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/mergepatch"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -32,6 +39,7 @@ func TestServiceCreateList(t *testing.T) {
 		Description: "Test description",
 		OwnerID:     "1",
 		SharedWith:  nil,
+		Visibility:  constants.VisibilityPrivate,
 		CreatedAt:   mockTime,
 		UpdatedAt:   mockTime,
 	}
@@ -85,6 +93,14 @@ func TestServiceCreateList(t *testing.T) {
 			input:         modelInput,
 			expectedError: err,
 		},
+		{
+			name:          "Error when the input is invalid",
+			uuidService:   func() *automock.UUIDService { return &automock.UUIDService{} },
+			repo:          func() *automock.ListRepository { return &automock.ListRepository{} },
+			timeService:   func() *automock.TimeService { return &automock.TimeService{} },
+			input:         models.List{Name: " ", OwnerID: "1", Visibility: "secret"},
+			expectedError: validation.ErrValidation,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -93,7 +109,7 @@ func TestServiceCreateList(t *testing.T) {
 			repo := tt.repo()
 			defer mock.AssertExpectationsForObjects(t, timeService, repo, uuidService)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			_, err := svc.CreateList(ctx, tt.input)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -169,7 +185,7 @@ func TestServiceGetList(t *testing.T) {
 			repo := tt.repo()
 			defer mock.AssertExpectationsForObjects(t, repo)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			_, err := svc.GetList(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -201,6 +217,7 @@ func TestServiceUpdateList(t *testing.T) {
 		Description: "Test description",
 		OwnerID:     "1",
 		SharedWith:  nil,
+		Visibility:  constants.VisibilityPrivate,
 		CreatedAt:   mockTime,
 		UpdatedAt:   mockTime,
 	}
@@ -222,7 +239,7 @@ func TestServiceUpdateList(t *testing.T) {
 			},
 			repo: func() *automock.ListRepository {
 				repo := &automock.ListRepository{}
-				repo.EXPECT().Get(ctx, id).Return(model, nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(model, nil).Twice()
 				repo.EXPECT().Update(ctx, model).Return(nil).Once()
 				return repo
 			},
@@ -260,7 +277,7 @@ func TestServiceUpdateList(t *testing.T) {
 			repo := tt.repo()
 			defer mock.AssertExpectationsForObjects(t, repo, uuidService)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			err := svc.UpdateList(ctx, tt.input)
 
 			if tt.expectedError != nil {
@@ -273,6 +290,57 @@ func TestServiceUpdateList(t *testing.T) {
 	}
 }
 
+func TestServicePatchList(t *testing.T) {
+	ctx := context.Background()
+	id := "1"
+	stored := models.List{ID: id, Name: "Groceries", Description: "Weekly shopping", OwnerID: "owner", SharedWith: []string{"user"}, Tags: json.RawMessage(`["home"]`), Visibility: constants.VisibilityShared, Version: 3}
+
+	tests := []struct {
+		name          string
+		patch         string
+		repo          func() *automock.ListRepository
+		expectedError error
+	}{
+		{
+			name:  "Only the supplied fields change and null clears",
+			patch: `{"description": null}`,
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().Get(ctx, id).Return(stored, nil)
+				repo.EXPECT().Update(ctx, models.List{ID: id, Name: "Groceries", OwnerID: "owner", SharedWith: []string{"user"}, Tags: json.RawMessage(`["home"]`), Visibility: constants.VisibilityShared, Version: 3}).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:          "Error when the patch changes the owner",
+			patch:         `{"owner_id": "other"}`,
+			repo:          func() *automock.ListRepository { return &automock.ListRepository{} },
+			expectedError: validation.ErrValidation,
+		},
+		{
+			name:          "Error when the patch is not an object",
+			patch:         `"Groceries"`,
+			repo:          func() *automock.ListRepository { return &automock.ListRepository{} },
+			expectedError: mergepatch.ErrInvalidPatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), &automock.UUIDService{}, &automock.TimeService{})
+			list, err := svc.PatchList(ctx, id, []byte(tt.patch))
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, stored, list)
+			}
+		})
+	}
+}
+
 func TestServiceDeleteList(t *testing.T) {
 	id := "1"
 	err := errors.New("error")
@@ -327,7 +395,7 @@ func TestServiceDeleteList(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, repo)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			err := svc.DeleteList(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -398,7 +466,7 @@ func TestServiceListAllByUserID(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, repo, uuidService, timeService)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			_, err := svc.ListAllByUserID(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -476,7 +544,7 @@ func TestServiceGetAllLists(t *testing.T) {
 			repo := tt.repo()
 			defer mock.AssertExpectationsForObjects(t, repo)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			_, err := svc.GetAllLists(ctx)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -558,7 +626,7 @@ func TestServiceGetUsersByListID(t *testing.T) {
 			repo := tt.repo()
 			defer mock.AssertExpectationsForObjects(t, repo)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			_, err := svc.GetUsersByListID(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -624,7 +692,7 @@ func TestServiceListOwnerIDs(t *testing.T) {
 			repo := tt.repo()
 			defer mock.AssertExpectationsForObjects(t, repo)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			_, err := svc.GetListOwnerID(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -705,7 +773,7 @@ func TestServiceCreateAccess(t *testing.T) {
 			repo := tt.repo()
 			defer mock.AssertExpectationsForObjects(t, timeService, repo, uuidService)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			_, err := svc.CreateAccess(ctx, tt.input)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -777,7 +845,7 @@ func TestServiceGetAccess(t *testing.T) {
 			repo := tt.repo()
 			defer mock.AssertExpectationsForObjects(t, repo)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			_, err := svc.GetAccess(ctx, listID, userID)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -810,6 +878,7 @@ func TestServiceDeleteAccess(t *testing.T) {
 			},
 			repo: func() *automock.ListRepository {
 				repo := &automock.ListRepository{}
+				repo.EXPECT().GetAccess(ctx, listID, userID).Return(models.Access{ListID: listID, UserID: userID}, nil).Once()
 				repo.EXPECT().DeleteAccess(ctx, listID, userID).Return(nil).Once()
 				return repo
 			},
@@ -827,6 +896,7 @@ func TestServiceDeleteAccess(t *testing.T) {
 			},
 			repo: func() *automock.ListRepository {
 				repo := &automock.ListRepository{}
+				repo.EXPECT().GetAccess(ctx, listID, userID).Return(models.Access{ListID: listID, UserID: userID}, nil).Once()
 				repo.EXPECT().DeleteAccess(ctx, listID, userID).Return(err).Once()
 				return repo
 			},
@@ -844,7 +914,7 @@ func TestServiceDeleteAccess(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, repo)
 
-			svc := lists.NewService(repo, uuidService, timeService)
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), uuidService, timeService)
 			err := svc.DeleteAccess(ctx, listID, userID)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -855,3 +925,76 @@ func TestServiceDeleteAccess(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceCheckVersion(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.ListRepository
+		expectedError error
+	}{
+		{
+			name: "The version matches",
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().LockVersion(ctx, "1").Return(3, nil).Once()
+				return repo
+			},
+		},
+		{
+			name: "Error when the list was changed",
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().LockVersion(ctx, "1").Return(4, nil).Once()
+				return repo
+			},
+			expectedError: lists.ErrVersionMismatch,
+		},
+		{
+			name: "Error when locking fails",
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().LockVersion(ctx, "1").Return(0, err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := lists.NewService(repo, noopActivity(), noopTags(), noopStatuses(), &automock.UUIDService{}, &automock.TimeService{})
+			err := svc.CheckVersion(ctx, "1", 3)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// noopActivity accepts any activity, for tests that are not about recording it.
+func noopActivity() *activityautomock.ActivityService {
+	activityService := &activityautomock.ActivityService{}
+	activityService.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return activityService
+}
+
+// noopTags accepts any tags of lists.
+func noopTags() *tagautomock.TagService {
+	tagService := &tagautomock.TagService{}
+	tagService.EXPECT().SetListTags(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return tagService
+}
+
+// noopStatuses accepts the default statuses of new lists.
+func noopStatuses() *statusautomock.StatusService {
+	statusService := &statusautomock.StatusService{}
+	statusService.EXPECT().CreateDefaultStatuses(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return statusService
+}
@@ -0,0 +1,374 @@
+// Package quickadd turns free text such as "Pay rent every month on the 1st p1
+// #finance @alice" into the fields of a todo.
+package quickadd
+
+import (
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrEmptyTitle = errors.New("quick-add text has no title")
+
+// Result holds everything Parse recognised. Title is the text left over once
+// all recognised phrases are removed. Assignee is the mention without the @.
+type Result struct {
+	Title      string
+	DueDate    *time.Time
+	Priority   constants.PriorityLevel
+	Tags       []string
+	Assignee   string
+	Recurrence *models.Recurrence
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+var units = map[string]constants.RecurrenceFrequency{
+	"day": constants.RecurrenceDaily, "days": constants.RecurrenceDaily,
+	"week": constants.RecurrenceWeekly, "weeks": constants.RecurrenceWeekly,
+	"month": constants.RecurrenceMonthly, "months": constants.RecurrenceMonthly,
+}
+
+var priorities = map[string]constants.PriorityLevel{
+	"p1": constants.PriorityHigh, "!high": constants.PriorityHigh,
+	"p2": constants.PriorityMedium, "!medium": constants.PriorityMedium,
+	"p3": constants.PriorityLow, "!low": constants.PriorityLow,
+}
+
+var (
+	ordinalPattern = regexp.MustCompile(`^(\d{1,2})(st|nd|rd|th)$`)
+	clockPattern   = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+)
+
+type parser struct {
+	now    time.Time
+	tokens []string
+	result Result
+	date   *time.Time
+	hour   int
+	minute int
+	clock  bool
+}
+
+// Parse extracts the todo fields from text. Relative dates are resolved
+// against now. A weekday on its own means the nearest such day from today on,
+// "next" with a weekday the nearest one after today.
+func Parse(text string, now time.Time) (Result, error) {
+	p := &parser{now: now, tokens: strings.Fields(text)}
+
+	var title []string
+	for i := 0; i < len(p.tokens); {
+		if n := p.parseAt(i); n > 0 {
+			i += n
+			continue
+		}
+		title = append(title, p.tokens[i])
+		i++
+	}
+
+	p.result.Title = strings.Join(title, " ")
+	if p.result.Title == "" {
+		return Result{}, ErrEmptyTitle
+	}
+	p.result.DueDate = p.dueDate()
+	return p.result, nil
+}
+
+// word returns the lower-cased token at i without trailing punctuation, or an
+// empty string past the end of the text.
+func (p *parser) word(i int) string {
+	if i >= len(p.tokens) {
+		return ""
+	}
+	return strings.TrimRight(strings.ToLower(p.tokens[i]), ",;.")
+}
+
+// parseAt tries to recognise a phrase starting at token i and returns the
+// number of tokens it consumed.
+func (p *parser) parseAt(i int) int {
+	word := p.word(i)
+	switch {
+	case len(word) > 1 && word[0] == '#':
+		p.addTag(word[1:])
+		return 1
+	case len(word) > 1 && word[0] == '@':
+		p.result.Assignee = strings.TrimRight(p.tokens[i][1:], ",;.")
+		return 1
+	case priorities[word] != "":
+		p.result.Priority = priorities[word]
+		return 1
+	case word == "at":
+		if hour, minute, ok := parseClock(p.word(i + 1)); ok {
+			p.hour, p.minute, p.clock = hour, minute, true
+			return 2
+		}
+		return 0
+	}
+
+	if n := p.parseRecurrence(i); n > 0 {
+		return n
+	}
+	if date, n := p.parseDate(i); n > 0 {
+		p.date = &date
+		return n
+	}
+	if word == "on" || word == "by" || word == "due" {
+		if date, n := p.parseDate(i + 1); n > 0 {
+			p.date = &date
+			return n + 1
+		}
+	}
+	return 0
+}
+
+func (p *parser) addTag(tag string) {
+	for _, existing := range p.result.Tags {
+		if existing == tag {
+			return
+		}
+	}
+	p.result.Tags = append(p.result.Tags, tag)
+}
+
+func (p *parser) today() time.Time {
+	year, month, day := p.now.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, p.now.Location())
+}
+
+func (p *parser) parseDate(i int) (time.Time, int) {
+	today := p.today()
+	word := p.word(i)
+	switch word {
+	case "today", "tonight":
+		return today, 1
+	case "tomorrow", "tmr":
+		return today.AddDate(0, 0, 1), 1
+	case "next":
+		next := p.word(i + 1)
+		if day, ok := weekdays[next]; ok {
+			return nextWeekday(today.AddDate(0, 0, 1), day), 2
+		}
+		switch next {
+		case "week":
+			return today.AddDate(0, 0, 7), 2
+		case "month":
+			return today.AddDate(0, 1, 0), 2
+		}
+		return time.Time{}, 0
+	case "in":
+		count, err := strconv.Atoi(p.word(i + 1))
+		if err != nil || count <= 0 {
+			return time.Time{}, 0
+		}
+		switch units[p.word(i+2)] {
+		case constants.RecurrenceDaily:
+			return today.AddDate(0, 0, count), 3
+		case constants.RecurrenceWeekly:
+			return today.AddDate(0, 0, 7*count), 3
+		case constants.RecurrenceMonthly:
+			return today.AddDate(0, count, 0), 3
+		}
+		return time.Time{}, 0
+	}
+	if day, ok := weekdays[word]; ok {
+		return nextWeekday(today, day), 1
+	}
+	if date, err := time.ParseInLocation("2006-01-02", word, p.now.Location()); err == nil {
+		return date, 1
+	}
+	return time.Time{}, 0
+}
+
+func (p *parser) parseRecurrence(i int) int {
+	switch p.word(i) {
+	case "daily":
+		p.result.Recurrence = &models.Recurrence{Frequency: constants.RecurrenceDaily, Interval: 1}
+		return 1
+	case "weekly":
+		p.result.Recurrence = &models.Recurrence{Frequency: constants.RecurrenceWeekly, Interval: 1}
+		return 1
+	case "monthly":
+		p.result.Recurrence = &models.Recurrence{Frequency: constants.RecurrenceMonthly, Interval: 1}
+		return 1 + p.parseDayOfMonth(i+1)
+	case "every":
+	default:
+		return 0
+	}
+
+	rule := models.Recurrence{Interval: 1}
+	n := 1
+	word := p.word(i + n)
+	switch {
+	case word == "weekday":
+		rule.Frequency = constants.RecurrenceWeekly
+		rule.Weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+		n++
+	case isWeekday(word):
+		rule.Frequency = constants.RecurrenceWeekly
+		for {
+			day, ok := weekdays[p.word(i+n)]
+			if !ok {
+				break
+			}
+			rule.Weekdays = append(rule.Weekdays, day)
+			n++
+			if p.word(i+n) == "and" {
+				n++
+			}
+		}
+	case ordinalPattern.MatchString(word):
+		rule.Frequency = constants.RecurrenceMonthly
+		n += p.parseDayOfMonthInto(&rule, i+n)
+	default:
+		if word == "other" {
+			rule.Interval = 2
+			n++
+		} else if count, err := strconv.Atoi(word); err == nil && count > 0 {
+			rule.Interval = count
+			n++
+		}
+		frequency, ok := units[p.word(i+n)]
+		if !ok {
+			return 0
+		}
+		rule.Frequency = frequency
+		n++
+	}
+
+	p.result.Recurrence = &rule
+	if rule.Frequency == constants.RecurrenceMonthly && rule.DayOfMonth == 0 {
+		n += p.parseDayOfMonth(i + n)
+	}
+	return n
+}
+
+// parseDayOfMonth reads an optional "on the 1st" after a monthly rule.
+func (p *parser) parseDayOfMonth(i int) int {
+	n := 0
+	if p.word(i) == "on" {
+		n++
+	}
+	if p.word(i+n) == "the" {
+		n++
+	}
+	consumed := p.parseDayOfMonthInto(p.result.Recurrence, i+n)
+	if consumed == 0 {
+		return 0
+	}
+	return n + consumed
+}
+
+func (p *parser) parseDayOfMonthInto(rule *models.Recurrence, i int) int {
+	match := ordinalPattern.FindStringSubmatch(p.word(i))
+	if match == nil {
+		return 0
+	}
+	day, _ := strconv.Atoi(match[1])
+	if day < 1 || day > 31 {
+		return 0
+	}
+	rule.DayOfMonth = day
+	return 1
+}
+
+// dueDate combines the recognised date and time of day. Recurring todos
+// without an explicit date are due on their first occurrence from today on.
+func (p *parser) dueDate() *time.Time {
+	date := p.date
+	if date == nil && p.result.Recurrence != nil {
+		first := firstOccurrence(*p.result.Recurrence, p.today())
+		date = &first
+	}
+	if date == nil && p.clock {
+		today := p.today()
+		date = &today
+	}
+	if date == nil {
+		return nil
+	}
+	due := time.Date(date.Year(), date.Month(), date.Day(), p.hour, p.minute, 0, 0, date.Location())
+	return &due
+}
+
+func firstOccurrence(rule models.Recurrence, today time.Time) time.Time {
+	switch rule.Frequency {
+	case constants.RecurrenceWeekly:
+		if len(rule.Weekdays) == 0 {
+			return today
+		}
+		first := nextWeekday(today, rule.Weekdays[0])
+		for _, day := range rule.Weekdays[1:] {
+			if candidate := nextWeekday(today, day); candidate.Before(first) {
+				first = candidate
+			}
+		}
+		return first
+	case constants.RecurrenceMonthly:
+		if rule.DayOfMonth == 0 {
+			return today
+		}
+		year, month, _ := today.Date()
+		candidate := clampedDate(year, month, rule.DayOfMonth, today.Location())
+		if candidate.Before(today) {
+			candidate = clampedDate(year, month+1, rule.DayOfMonth, today.Location())
+		}
+		return candidate
+	default:
+		return today
+	}
+}
+
+func isWeekday(word string) bool {
+	_, ok := weekdays[word]
+	return ok
+}
+
+func nextWeekday(from time.Time, day time.Weekday) time.Time {
+	return from.AddDate(0, 0, (int(day)-int(from.Weekday())+7)%7)
+}
+
+func clampedDate(year int, month time.Month, day int, location *time.Location) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, location).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, location)
+}
+
+func parseClock(word string) (int, int, bool) {
+	match := clockPattern.FindStringSubmatch(word)
+	if match == nil || (match[2] == "" && match[3] == "") {
+		return 0, 0, false
+	}
+	hour, _ := strconv.Atoi(match[1])
+	minute := 0
+	if match[2] != "" {
+		minute, _ = strconv.Atoi(match[2])
+	}
+	switch match[3] {
+	case "am":
+		if hour == 12 {
+			hour = 0
+		}
+	case "pm":
+		if hour < 12 {
+			hour += 12
+		}
+	}
+	if hour > 23 || minute > 59 {
+		return 0, 0, false
+	}
+	return hour, minute, true
+}
@@ -0,0 +1,125 @@
+package quickadd_test
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/quickadd"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	// Wednesday
+	now := time.Date(2026, time.October, 14, 9, 30, 0, 0, time.UTC)
+	date := func(month time.Month, day, hour, minute int) *time.Time {
+		d := time.Date(2026, month, day, hour, minute, 0, 0, time.UTC)
+		return &d
+	}
+
+	tests := []struct {
+		name          string
+		input         string
+		expected      quickadd.Result
+		expectedError error
+	}{
+		{
+			name:  "Monthly recurrence with everything",
+			input: "Pay rent every month on the 1st p1 #finance @alice",
+			expected: quickadd.Result{
+				Title:      "Pay rent",
+				DueDate:    date(time.November, 1, 0, 0),
+				Priority:   constants.PriorityHigh,
+				Tags:       []string{"finance"},
+				Assignee:   "alice",
+				Recurrence: &models.Recurrence{Frequency: constants.RecurrenceMonthly, Interval: 1, DayOfMonth: 1},
+			},
+		},
+		{
+			name:  "Tomorrow at a time",
+			input: "Call Bob tomorrow at 5pm",
+			expected: quickadd.Result{
+				Title:   "Call Bob",
+				DueDate: date(time.October, 15, 17, 0),
+			},
+		},
+		{
+			name:  "Weekday names",
+			input: "Standup fri #work #work",
+			expected: quickadd.Result{
+				Title:   "Standup",
+				DueDate: date(time.October, 16, 0, 0),
+				Tags:    []string{"work"},
+			},
+		},
+		{
+			name:  "Next weekday skips today",
+			input: "Review by next wed p2",
+			expected: quickadd.Result{
+				Title:    "Review",
+				DueDate:  date(time.October, 21, 0, 0),
+				Priority: constants.PriorityMedium,
+			},
+		},
+		{
+			name:  "Relative days and ISO dates",
+			input: "Renew passport in 3 weeks",
+			expected: quickadd.Result{
+				Title:   "Renew passport",
+				DueDate: date(time.November, 4, 0, 0),
+			},
+		},
+		{
+			name:  "ISO date",
+			input: "Dentist on 2026-12-01 at 08:15",
+			expected: quickadd.Result{
+				Title:   "Dentist",
+				DueDate: date(time.December, 1, 8, 15),
+			},
+		},
+		{
+			name:  "Weekly recurrence on weekdays",
+			input: "Gym every mon and thu !low",
+			expected: quickadd.Result{
+				Title:      "Gym",
+				DueDate:    date(time.October, 15, 0, 0),
+				Priority:   constants.PriorityLow,
+				Recurrence: &models.Recurrence{Frequency: constants.RecurrenceWeekly, Interval: 1, Weekdays: []time.Weekday{time.Monday, time.Thursday}},
+			},
+		},
+		{
+			name:  "Every other week",
+			input: "Water plants every other week",
+			expected: quickadd.Result{
+				Title:      "Water plants",
+				DueDate:    date(time.October, 14, 0, 0),
+				Recurrence: &models.Recurrence{Frequency: constants.RecurrenceWeekly, Interval: 2},
+			},
+		},
+		{
+			name:  "Words that are not phrases stay in the title",
+			input: "Read in the park at noon",
+			expected: quickadd.Result{
+				Title: "Read in the park at noon",
+			},
+		},
+		{
+			name:          "Empty title",
+			input:         "tomorrow p1 #home",
+			expectedError: quickadd.ErrEmptyTitle,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := quickadd.Parse(test.input, now)
+			if test.expectedError != nil {
+				require.ErrorIs(t, err, test.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
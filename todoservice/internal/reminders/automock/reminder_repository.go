@@ -0,0 +1,363 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ReminderRepository is an autogenerated mock type for the ReminderRepository type
+type ReminderRepository struct {
+	mock.Mock
+}
+
+type ReminderRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReminderRepository) EXPECT() *ReminderRepository_Expecter {
+	return &ReminderRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDue provides a mock function with given fields: ctx, now, lockedUntil, limit
+func (_m *ReminderRepository) ClaimDue(ctx context.Context, now time.Time, lockedUntil time.Time, limit int) ([]models.ReminderDelivery, error) {
+	ret := _m.Called(ctx, now, lockedUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDue")
+	}
+
+	var r0 []models.ReminderDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]models.ReminderDelivery, error)); ok {
+		return rf(ctx, now, lockedUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []models.ReminderDelivery); ok {
+		r0 = rf(ctx, now, lockedUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReminderDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, now, lockedUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReminderRepository_ClaimDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDue'
+type ReminderRepository_ClaimDue_Call struct {
+	*mock.Call
+}
+
+// ClaimDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - lockedUntil time.Time
+//   - limit int
+func (_e *ReminderRepository_Expecter) ClaimDue(ctx interface{}, now interface{}, lockedUntil interface{}, limit interface{}) *ReminderRepository_ClaimDue_Call {
+	return &ReminderRepository_ClaimDue_Call{Call: _e.mock.On("ClaimDue", ctx, now, lockedUntil, limit)}
+}
+
+func (_c *ReminderRepository_ClaimDue_Call) Run(run func(ctx context.Context, now time.Time, lockedUntil time.Time, limit int)) *ReminderRepository_ClaimDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ReminderRepository_ClaimDue_Call) Return(_a0 []models.ReminderDelivery, _a1 error) *ReminderRepository_ClaimDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReminderRepository_ClaimDue_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int) ([]models.ReminderDelivery, error)) *ReminderRepository_ClaimDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, reminder
+func (_m *ReminderRepository) Create(ctx context.Context, reminder models.Reminder) (string, error) {
+	ret := _m.Called(ctx, reminder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Reminder) (string, error)); ok {
+		return rf(ctx, reminder)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Reminder) string); ok {
+		r0 = rf(ctx, reminder)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Reminder) error); ok {
+		r1 = rf(ctx, reminder)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReminderRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ReminderRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reminder models.Reminder
+func (_e *ReminderRepository_Expecter) Create(ctx interface{}, reminder interface{}) *ReminderRepository_Create_Call {
+	return &ReminderRepository_Create_Call{Call: _e.mock.On("Create", ctx, reminder)}
+}
+
+func (_c *ReminderRepository_Create_Call) Run(run func(ctx context.Context, reminder models.Reminder)) *ReminderRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Reminder))
+	})
+	return _c
+}
+
+func (_c *ReminderRepository_Create_Call) Return(_a0 string, _a1 error) *ReminderRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReminderRepository_Create_Call) RunAndReturn(run func(context.Context, models.Reminder) (string, error)) *ReminderRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, todoID, userID
+func (_m *ReminderRepository) Delete(ctx context.Context, id string, todoID string, userID string) error {
+	ret := _m.Called(ctx, id, todoID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, id, todoID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReminderRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ReminderRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - todoID string
+//   - userID string
+func (_e *ReminderRepository_Expecter) Delete(ctx interface{}, id interface{}, todoID interface{}, userID interface{}) *ReminderRepository_Delete_Call {
+	return &ReminderRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, todoID, userID)}
+}
+
+func (_c *ReminderRepository_Delete_Call) Run(run func(ctx context.Context, id string, todoID string, userID string)) *ReminderRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ReminderRepository_Delete_Call) Return(_a0 error) *ReminderRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReminderRepository_Delete_Call) RunAndReturn(run func(context.Context, string, string, string) error) *ReminderRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTodoID provides a mock function with given fields: ctx, todoID, userID
+func (_m *ReminderRepository) GetByTodoID(ctx context.Context, todoID string, userID string) ([]models.Reminder, error) {
+	ret := _m.Called(ctx, todoID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTodoID")
+	}
+
+	var r0 []models.Reminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.Reminder, error)); ok {
+		return rf(ctx, todoID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.Reminder); ok {
+		r0 = rf(ctx, todoID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, todoID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReminderRepository_GetByTodoID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTodoID'
+type ReminderRepository_GetByTodoID_Call struct {
+	*mock.Call
+}
+
+// GetByTodoID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - userID string
+func (_e *ReminderRepository_Expecter) GetByTodoID(ctx interface{}, todoID interface{}, userID interface{}) *ReminderRepository_GetByTodoID_Call {
+	return &ReminderRepository_GetByTodoID_Call{Call: _e.mock.On("GetByTodoID", ctx, todoID, userID)}
+}
+
+func (_c *ReminderRepository_GetByTodoID_Call) Run(run func(ctx context.Context, todoID string, userID string)) *ReminderRepository_GetByTodoID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ReminderRepository_GetByTodoID_Call) Return(_a0 []models.Reminder, _a1 error) *ReminderRepository_GetByTodoID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReminderRepository_GetByTodoID_Call) RunAndReturn(run func(context.Context, string, string) ([]models.Reminder, error)) *ReminderRepository_GetByTodoID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFailed provides a mock function with given fields: ctx, id, lastError, nextAttemptAt
+func (_m *ReminderRepository) MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt *time.Time) error {
+	ret := _m.Called(ctx, id, lastError, nextAttemptAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *time.Time) error); ok {
+		r0 = rf(ctx, id, lastError, nextAttemptAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReminderRepository_MarkFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFailed'
+type ReminderRepository_MarkFailed_Call struct {
+	*mock.Call
+}
+
+// MarkFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - lastError string
+//   - nextAttemptAt *time.Time
+func (_e *ReminderRepository_Expecter) MarkFailed(ctx interface{}, id interface{}, lastError interface{}, nextAttemptAt interface{}) *ReminderRepository_MarkFailed_Call {
+	return &ReminderRepository_MarkFailed_Call{Call: _e.mock.On("MarkFailed", ctx, id, lastError, nextAttemptAt)}
+}
+
+func (_c *ReminderRepository_MarkFailed_Call) Run(run func(ctx context.Context, id string, lastError string, nextAttemptAt *time.Time)) *ReminderRepository_MarkFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *ReminderRepository_MarkFailed_Call) Return(_a0 error) *ReminderRepository_MarkFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReminderRepository_MarkFailed_Call) RunAndReturn(run func(context.Context, string, string, *time.Time) error) *ReminderRepository_MarkFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSent provides a mock function with given fields: ctx, id, sentAt
+func (_m *ReminderRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	ret := _m.Called(ctx, id, sentAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, sentAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReminderRepository_MarkSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSent'
+type ReminderRepository_MarkSent_Call struct {
+	*mock.Call
+}
+
+// MarkSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - sentAt time.Time
+func (_e *ReminderRepository_Expecter) MarkSent(ctx interface{}, id interface{}, sentAt interface{}) *ReminderRepository_MarkSent_Call {
+	return &ReminderRepository_MarkSent_Call{Call: _e.mock.On("MarkSent", ctx, id, sentAt)}
+}
+
+func (_c *ReminderRepository_MarkSent_Call) Run(run func(ctx context.Context, id string, sentAt time.Time)) *ReminderRepository_MarkSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ReminderRepository_MarkSent_Call) Return(_a0 error) *ReminderRepository_MarkSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReminderRepository_MarkSent_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *ReminderRepository_MarkSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReminderRepository creates a new instance of ReminderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReminderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReminderRepository {
+	mock := &ReminderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,203 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReminderService is an autogenerated mock type for the ReminderService type
+type ReminderService struct {
+	mock.Mock
+}
+
+type ReminderService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReminderService) EXPECT() *ReminderService_Expecter {
+	return &ReminderService_Expecter{mock: &_m.Mock}
+}
+
+// CreateReminder provides a mock function with given fields: ctx, reminder
+func (_m *ReminderService) CreateReminder(ctx context.Context, reminder models.Reminder) (models.Reminder, error) {
+	ret := _m.Called(ctx, reminder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReminder")
+	}
+
+	var r0 models.Reminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Reminder) (models.Reminder, error)); ok {
+		return rf(ctx, reminder)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Reminder) models.Reminder); ok {
+		r0 = rf(ctx, reminder)
+	} else {
+		r0 = ret.Get(0).(models.Reminder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Reminder) error); ok {
+		r1 = rf(ctx, reminder)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReminderService_CreateReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReminder'
+type ReminderService_CreateReminder_Call struct {
+	*mock.Call
+}
+
+// CreateReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reminder models.Reminder
+func (_e *ReminderService_Expecter) CreateReminder(ctx interface{}, reminder interface{}) *ReminderService_CreateReminder_Call {
+	return &ReminderService_CreateReminder_Call{Call: _e.mock.On("CreateReminder", ctx, reminder)}
+}
+
+func (_c *ReminderService_CreateReminder_Call) Run(run func(ctx context.Context, reminder models.Reminder)) *ReminderService_CreateReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Reminder))
+	})
+	return _c
+}
+
+func (_c *ReminderService_CreateReminder_Call) Return(_a0 models.Reminder, _a1 error) *ReminderService_CreateReminder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReminderService_CreateReminder_Call) RunAndReturn(run func(context.Context, models.Reminder) (models.Reminder, error)) *ReminderService_CreateReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReminder provides a mock function with given fields: ctx, id, todoID, userID
+func (_m *ReminderService) DeleteReminder(ctx context.Context, id string, todoID string, userID string) error {
+	ret := _m.Called(ctx, id, todoID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, id, todoID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReminderService_DeleteReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReminder'
+type ReminderService_DeleteReminder_Call struct {
+	*mock.Call
+}
+
+// DeleteReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - todoID string
+//   - userID string
+func (_e *ReminderService_Expecter) DeleteReminder(ctx interface{}, id interface{}, todoID interface{}, userID interface{}) *ReminderService_DeleteReminder_Call {
+	return &ReminderService_DeleteReminder_Call{Call: _e.mock.On("DeleteReminder", ctx, id, todoID, userID)}
+}
+
+func (_c *ReminderService_DeleteReminder_Call) Run(run func(ctx context.Context, id string, todoID string, userID string)) *ReminderService_DeleteReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ReminderService_DeleteReminder_Call) Return(_a0 error) *ReminderService_DeleteReminder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReminderService_DeleteReminder_Call) RunAndReturn(run func(context.Context, string, string, string) error) *ReminderService_DeleteReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReminders provides a mock function with given fields: ctx, todoID, userID
+func (_m *ReminderService) ListReminders(ctx context.Context, todoID string, userID string) ([]models.Reminder, error) {
+	ret := _m.Called(ctx, todoID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReminders")
+	}
+
+	var r0 []models.Reminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.Reminder, error)); ok {
+		return rf(ctx, todoID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.Reminder); ok {
+		r0 = rf(ctx, todoID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, todoID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReminderService_ListReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReminders'
+type ReminderService_ListReminders_Call struct {
+	*mock.Call
+}
+
+// ListReminders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - userID string
+func (_e *ReminderService_Expecter) ListReminders(ctx interface{}, todoID interface{}, userID interface{}) *ReminderService_ListReminders_Call {
+	return &ReminderService_ListReminders_Call{Call: _e.mock.On("ListReminders", ctx, todoID, userID)}
+}
+
+func (_c *ReminderService_ListReminders_Call) Run(run func(ctx context.Context, todoID string, userID string)) *ReminderService_ListReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ReminderService_ListReminders_Call) Return(_a0 []models.Reminder, _a1 error) *ReminderService_ListReminders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReminderService_ListReminders_Call) RunAndReturn(run func(context.Context, string, string) ([]models.Reminder, error)) *ReminderService_ListReminders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReminderService creates a new instance of ReminderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReminderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReminderService {
+	mock := &ReminderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
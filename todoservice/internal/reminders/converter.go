@@ -0,0 +1,80 @@
+package reminders
+
+import (
+	"database/sql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"time"
+)
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertReminderToModel(entity Entity) models.Reminder {
+	reminder := models.Reminder{
+		ID:        entity.ID,
+		TodoID:    entity.TodoID,
+		UserID:    entity.UserID,
+		RemindAt:  convertNullTimeToTime(entity.RemindAt),
+		Channel:   entity.Channel,
+		Target:    entity.Target,
+		Status:    entity.Status,
+		Attempts:  entity.Attempts,
+		LastError: entity.LastError,
+		SentAt:    convertNullTimeToTime(entity.SentAt),
+		CreatedAt: entity.CreatedAt,
+	}
+	if entity.OffsetMinutes.Valid {
+		offset := int(entity.OffsetMinutes.Int64)
+		reminder.OffsetMinutes = &offset
+	}
+	return reminder
+}
+
+func (c *Converter) ConvertReminderToEntity(reminder models.Reminder) Entity {
+	entity := Entity{
+		ID:        reminder.ID,
+		TodoID:    reminder.TodoID,
+		UserID:    reminder.UserID,
+		Channel:   reminder.Channel,
+		Target:    reminder.Target,
+		Status:    reminder.Status,
+		Attempts:  reminder.Attempts,
+		LastError: reminder.LastError,
+		CreatedAt: reminder.CreatedAt,
+	}
+	if reminder.RemindAt != nil {
+		entity.RemindAt = sql.NullTime{Time: *reminder.RemindAt, Valid: true}
+	}
+	if reminder.OffsetMinutes != nil {
+		entity.OffsetMinutes = sql.NullInt64{Int64: int64(*reminder.OffsetMinutes), Valid: true}
+	}
+	if reminder.SentAt != nil {
+		entity.SentAt = sql.NullTime{Time: *reminder.SentAt, Valid: true}
+	}
+	return entity
+}
+
+func (c *Converter) ConvertDeliveryToModel(entity DeliveryEntity) models.ReminderDelivery {
+	return models.ReminderDelivery{
+		ReminderID: entity.ReminderID,
+		Channel:    entity.Channel,
+		Target:     entity.Target,
+		Email:      entity.Email,
+		TodoID:     entity.TodoID,
+		ListID:     entity.ListID,
+		TodoTitle:  entity.TodoTitle,
+		DueDate:    convertNullTimeToTime(entity.DueDate),
+		FireAt:     entity.FireAt,
+		Attempts:   entity.Attempts,
+	}
+}
+
+func convertNullTimeToTime(nullTime sql.NullTime) *time.Time {
+	if !nullTime.Valid {
+		return nil
+	}
+	return &nullTime.Time
+}
@@ -0,0 +1,35 @@
+package reminders
+
+import (
+	"database/sql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+type Entity struct {
+	ID            string                    `db:"id"`
+	TodoID        string                    `db:"todo_id"`
+	UserID        string                    `db:"user_id"`
+	RemindAt      sql.NullTime              `db:"remind_at"`
+	OffsetMinutes sql.NullInt64             `db:"offset_minutes"`
+	Channel       constants.ReminderChannel `db:"channel"`
+	Target        string                    `db:"target"`
+	Status        constants.ReminderStatus  `db:"status"`
+	Attempts      int                       `db:"attempts"`
+	LastError     string                    `db:"last_error"`
+	SentAt        sql.NullTime              `db:"sent_at"`
+	CreatedAt     time.Time                 `db:"created_at"`
+}
+
+type DeliveryEntity struct {
+	ReminderID string                    `db:"reminder_id"`
+	Channel    constants.ReminderChannel `db:"channel"`
+	Target     string                    `db:"target"`
+	Email      string                    `db:"email"`
+	TodoID     string                    `db:"todo_id"`
+	ListID     string                    `db:"list_id"`
+	TodoTitle  string                    `db:"todo_title"`
+	DueDate    sql.NullTime              `db:"due_date"`
+	FireAt     time.Time                 `db:"fire_at"`
+	Attempts   int                       `db:"attempts"`
+}
@@ -0,0 +1,14 @@
+package reminders
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// Notifier delivers a reminder over one channel. Deliveries are at-least-once,
+// so implementations pass the reminder ID along for receivers to deduplicate.
+//
+//go:generate mockery --name=Notifier --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type Notifier interface {
+	Notify(ctx context.Context, delivery models.ReminderDelivery) error
+}
@@ -0,0 +1,212 @@
+package reminders
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"time"
+)
+
+//go:generate mockery --name=ReminderRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type ReminderRepository interface {
+	Create(ctx context.Context, reminder models.Reminder) (string, error)
+	GetByTodoID(ctx context.Context, todoID string, userID string) ([]models.Reminder, error)
+	Delete(ctx context.Context, id string, todoID string, userID string) error
+	ClaimDue(ctx context.Context, now time.Time, lockedUntil time.Time, limit int) ([]models.ReminderDelivery, error)
+	MarkSent(ctx context.Context, id string, sentAt time.Time) error
+	MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt *time.Time) error
+}
+
+type SQLXReminderRepository struct {
+	converter *Converter
+}
+
+var _ ReminderRepository = &SQLXReminderRepository{}
+
+func NewSQLXReminderRepository() ReminderRepository {
+	return &SQLXReminderRepository{converter: NewConverter()}
+}
+
+func (r *SQLXReminderRepository) Create(ctx context.Context, reminder models.Reminder) (string, error) {
+	log.C(ctx).Info("creating reminder repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertReminderToEntity(reminder)
+	if err = pkg.ValidateUUID(entity.ID); err != nil {
+		log.C(ctx).Errorf("invalid entity id: %v", err)
+		return "", fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	query := `
+		INSERT INTO reminders (id, todo_id, user_id, remind_at, offset_minutes, channel, target, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query,
+		entity.ID,
+		entity.TodoID,
+		entity.UserID,
+		entity.RemindAt,
+		entity.OffsetMinutes,
+		entity.Channel,
+		entity.Target,
+		entity.Status,
+		entity.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to insert reminder: %v", err)
+		return "", fmt.Errorf("failed to create reminder: %w", err)
+	}
+	return id, nil
+}
+
+func (r *SQLXReminderRepository) GetByTodoID(ctx context.Context, todoID string, userID string) ([]models.Reminder, error) {
+	log.C(ctx).Info("getting reminders of todo repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT id, todo_id, user_id, remind_at, offset_minutes, channel, target, status, attempts, last_error, sent_at, created_at
+		FROM reminders
+		WHERE todo_id = $1 AND user_id = $2
+		ORDER BY created_at, id
+	`
+
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, query, todoID, userID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get reminders: %v", err)
+		return nil, fmt.Errorf("failed to get reminders: %w", err)
+	}
+
+	result := make([]models.Reminder, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertReminderToModel(entity))
+	}
+	return result, nil
+}
+
+func (r *SQLXReminderRepository) Delete(ctx context.Context, id string, todoID string, userID string) error {
+	log.C(ctx).Info("deleting reminder repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `DELETE FROM reminders WHERE id = $1 AND todo_id = $2 AND user_id = $3`
+	result, err := tx.ExecContext(ctx, query, id, todoID, userID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to delete reminder: %v", err)
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.C(ctx).Errorf("failed to get deleted reminders: %v", err)
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("reminder not found: %w", sql.ErrNoRows)
+	}
+	return nil
+}
+
+// ClaimDue marks up to limit due reminders of open, untrashed todos as sending
+// until lockedUntil and returns them. Reminders whose previous claim expired without
+// being marked sent or failed are claimed again, so a crashed delivery is
+// retried rather than lost. Rows locked by another worker are skipped, and so
+// are reminders whose owner no longer has access to the todo's list.
+func (r *SQLXReminderRepository) ClaimDue(ctx context.Context, now time.Time, lockedUntil time.Time, limit int) ([]models.ReminderDelivery, error) {
+	log.C(ctx).Info("claiming due reminders repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		UPDATE reminders r
+		SET status = 'sending', attempts = r.attempts + 1, locked_until = $2
+		FROM todos t, users u
+		WHERE r.id IN (
+			SELECT d.id FROM reminders d
+			JOIN todos dt ON dt.id = d.todo_id
+			JOIN list_access a ON a.list_id = dt.list_id AND a.user_id = d.user_id AND a.status IN ('owner', 'accepted')
+			WHERE NOT dt.completed AND dt.deleted_at IS NULL
+			  AND (d.status = 'pending' OR (d.status = 'sending' AND d.locked_until <= $1))
+			  AND COALESCE(d.next_attempt_at, '-infinity') <= $1
+			  AND COALESCE(d.remind_at, dt.due_date - make_interval(mins => d.offset_minutes)) <= $1
+			ORDER BY d.created_at
+			LIMIT $3
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		AND t.id = r.todo_id AND u.id = r.user_id
+		RETURNING r.id AS reminder_id, r.channel, r.target, r.attempts, u.email, t.id AS todo_id, t.list_id, t.title AS todo_title, t.due_date,
+		          COALESCE(r.remind_at, t.due_date - make_interval(mins => r.offset_minutes)) AS fire_at
+	`
+
+	var entities []DeliveryEntity
+	err = tx.SelectContext(ctx, &entities, query, now, lockedUntil, limit)
+	if err != nil {
+		log.C(ctx).Errorf("failed to claim due reminders: %v", err)
+		return nil, fmt.Errorf("failed to claim due reminders: %w", err)
+	}
+
+	result := make([]models.ReminderDelivery, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertDeliveryToModel(entity))
+	}
+	return result, nil
+}
+
+func (r *SQLXReminderRepository) MarkSent(ctx context.Context, id string, sentAt time.Time) error {
+	log.C(ctx).Info("marking reminder sent repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `UPDATE reminders SET status = 'sent', sent_at = $2, locked_until = NULL, last_error = '' WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, query, id, sentAt); err != nil {
+		log.C(ctx).Errorf("failed to mark reminder sent: %v", err)
+		return fmt.Errorf("failed to mark reminder sent: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed delivery. The reminder is retried at
+// nextAttemptAt, or given up on when nextAttemptAt is nil.
+func (r *SQLXReminderRepository) MarkFailed(ctx context.Context, id string, lastError string, nextAttemptAt *time.Time) error {
+	log.C(ctx).Info("marking reminder failed repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `
+		UPDATE reminders
+		SET status = CASE WHEN $3::timestamp IS NULL THEN 'failed' ELSE 'pending' END,
+		    last_error = $2, next_attempt_at = $3, locked_until = NULL
+		WHERE id = $1
+	`
+	if _, err = tx.ExecContext(ctx, query, id, lastError, nextAttemptAt); err != nil {
+		log.C(ctx).Errorf("failed to mark reminder failed: %v", err)
+		return fmt.Errorf("failed to mark reminder failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package reminders_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/reminders"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXReminderRepositoryClaimDue(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := reminders.NewSQLXReminderRepository()
+
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	lockedUntil := now.Add(5 * time.Minute)
+	due := now.Add(time.Hour)
+
+	testCases := []struct {
+		name           string
+		setupMocks     func()
+		expectedResult []models.ReminderDelivery
+		expectedError  error
+	}{
+		{
+			name: "Successful claim of due reminders",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("UPDATE reminders r SET status = 'sending', attempts = r.attempts \\+ 1, locked_until = \\$2 (.+) LIMIT \\$3 FOR UPDATE OF d SKIP LOCKED").
+					WithArgs(now, lockedUntil, 10).
+					WillReturnRows(sqlxmock.NewRows([]string{"reminder_id", "channel", "target", "attempts", "email", "todo_id", "list_id", "todo_title", "due_date", "fire_at"}).
+						AddRow("1", "email", "", 1, "alice@example.com", "2", "3", "Pay rent", due, now))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: []models.ReminderDelivery{{
+				ReminderID: "1",
+				Channel:    constants.ReminderChannelEmail,
+				Email:      "alice@example.com",
+				TodoID:     "2",
+				ListID:     "3",
+				TodoTitle:  "Pay rent",
+				DueDate:    &due,
+				FireAt:     now,
+				Attempts:   1,
+			}},
+		},
+		{
+			name: "Reminders of users whose list access was revoked are not claimed",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("JOIN list_access a ON a.list_id = dt.list_id AND a.user_id = d.user_id AND a.status IN \\('owner', 'accepted'\\)").
+					WithArgs(now, lockedUntil, 10).
+					WillReturnRows(sqlxmock.NewRows([]string{"reminder_id", "channel", "target", "attempts", "email", "todo_id", "list_id", "todo_title", "due_date", "fire_at"}))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: []models.ReminderDelivery{},
+		},
+		{
+			name: "Failed claim due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("UPDATE reminders r").
+					WithArgs(now, lockedUntil, 10).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to claim due reminders: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.ClaimDue(ctx, now, lockedUntil, 10)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXReminderRepositoryMarkFailed(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := reminders.NewSQLXReminderRepository()
+	retryAt := time.Date(2026, time.October, 18, 9, 1, 0, 0, time.UTC)
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectExec("UPDATE reminders SET status = CASE WHEN \\$3::timestamp IS NULL THEN 'failed' ELSE 'pending' END").
+		WithArgs("1", "timeout", &retryAt).
+		WillReturnResult(sqlxmock.NewResult(0, 1))
+	mockDB.ExpectCommit()
+
+	ctx := context.Background()
+	tx, err := database.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+
+	err = repo.MarkFailed(db.SaveToContext(ctx, tx), "1", "timeout", &retryAt)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
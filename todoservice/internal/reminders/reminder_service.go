@@ -0,0 +1,111 @@
+package reminders
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/url"
+	"time"
+)
+
+var (
+	ErrInvalidReminder  = errors.New("invalid reminder")
+	ErrReminderNotFound = errors.New("reminder not found")
+)
+
+//go:generate mockery --name=ReminderService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type ReminderService interface {
+	CreateReminder(ctx context.Context, reminder models.Reminder) (models.Reminder, error)
+	ListReminders(ctx context.Context, todoID string, userID string) ([]models.Reminder, error)
+	DeleteReminder(ctx context.Context, id string, todoID string, userID string) error
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ ReminderService = &service{}
+
+type service struct {
+	repo        ReminderRepository
+	resolver    Resolver
+	uuidService UUIDService
+	timeService TimeService
+}
+
+func NewService(repo ReminderRepository, resolver Resolver, uuidService UUIDService, timeService TimeService) ReminderService {
+	return &service{repo: repo, resolver: resolver, uuidService: uuidService, timeService: timeService}
+}
+
+func (s *service) CreateReminder(ctx context.Context, reminder models.Reminder) (models.Reminder, error) {
+	log.C(ctx).Info("creating reminder service")
+	if err := validateReminder(reminder); err != nil {
+		return models.Reminder{}, err
+	}
+	if reminder.Channel == constants.ReminderChannelWebhook {
+		if err := checkWebhookTarget(ctx, s.resolver, reminder.Target); err != nil {
+			return models.Reminder{}, err
+		}
+	}
+	if reminder.Channel == constants.ReminderChannelEmail {
+		reminder.Target = ""
+	}
+
+	reminder.ID = s.uuidService.Generate()
+	reminder.Status = constants.ReminderPending
+	reminder.Attempts = 0
+	reminder.LastError = ""
+	reminder.SentAt = nil
+	reminder.CreatedAt = s.timeService.Now()
+
+	if _, err := s.repo.Create(ctx, reminder); err != nil {
+		log.C(ctx).Errorf("creating reminder for todo with id %s failed", reminder.TodoID)
+		return models.Reminder{}, err
+	}
+	return reminder, nil
+}
+
+func (s *service) ListReminders(ctx context.Context, todoID string, userID string) ([]models.Reminder, error) {
+	log.C(ctx).Info("listing reminders service")
+	return s.repo.GetByTodoID(ctx, todoID, userID)
+}
+
+func (s *service) DeleteReminder(ctx context.Context, id string, todoID string, userID string) error {
+	log.C(ctx).Info("deleting reminder service")
+	err := s.repo.Delete(ctx, id, todoID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrReminderNotFound
+	}
+	return err
+}
+
+func validateReminder(reminder models.Reminder) error {
+	if (reminder.RemindAt == nil) == (reminder.OffsetMinutes == nil) {
+		return fmt.Errorf("%w: exactly one of remind_at and offset_minutes is required", ErrInvalidReminder)
+	}
+	if reminder.OffsetMinutes != nil && *reminder.OffsetMinutes < 0 {
+		return fmt.Errorf("%w: offset_minutes must not be negative", ErrInvalidReminder)
+	}
+	switch reminder.Channel {
+	case constants.ReminderChannelEmail:
+		return nil
+	case constants.ReminderChannelWebhook:
+		target, err := url.Parse(reminder.Target)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return fmt.Errorf("%w: webhook target must be an http or https URL", ErrInvalidReminder)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown channel %q", ErrInvalidReminder, reminder.Channel)
+	}
+}
@@ -0,0 +1,162 @@
+package reminders_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/reminders"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/reminders/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestServiceCreateReminder(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	remindAt := now.Add(time.Hour)
+	offset := 30
+	negativeOffset := -5
+
+	created := models.Reminder{
+		ID:        "1",
+		TodoID:    "todo",
+		UserID:    "user",
+		RemindAt:  &remindAt,
+		Channel:   constants.ReminderChannelEmail,
+		Status:    constants.ReminderPending,
+		CreatedAt: now,
+	}
+
+	tests := []struct {
+		name          string
+		input         models.Reminder
+		repo          func() *automock.ReminderRepository
+		addresses     []net.IPAddr
+		expected      models.Reminder
+		expectedError error
+	}{
+		{
+			name:  "Create absolute email reminder",
+			input: models.Reminder{TodoID: "todo", UserID: "user", RemindAt: &remindAt, Channel: constants.ReminderChannelEmail, Target: "ignored"},
+			repo: func() *automock.ReminderRepository {
+				repo := &automock.ReminderRepository{}
+				repo.EXPECT().Create(ctx, created).Return(created.ID, nil).Once()
+				return repo
+			},
+			expected: created,
+		},
+		{
+			name:  "Error when both remind_at and offset are set",
+			input: models.Reminder{RemindAt: &remindAt, OffsetMinutes: &offset, Channel: constants.ReminderChannelEmail},
+			repo: func() *automock.ReminderRepository {
+				return &automock.ReminderRepository{}
+			},
+			expectedError: reminders.ErrInvalidReminder,
+		},
+		{
+			name:  "Error when the offset is negative",
+			input: models.Reminder{OffsetMinutes: &negativeOffset, Channel: constants.ReminderChannelEmail},
+			repo: func() *automock.ReminderRepository {
+				return &automock.ReminderRepository{}
+			},
+			expectedError: reminders.ErrInvalidReminder,
+		},
+		{
+			name:  "Error when the webhook target is not a URL",
+			input: models.Reminder{OffsetMinutes: &offset, Channel: constants.ReminderChannelWebhook, Target: "ftp://example.com"},
+			repo: func() *automock.ReminderRepository {
+				return &automock.ReminderRepository{}
+			},
+			expectedError: reminders.ErrInvalidReminder,
+		},
+		{
+			name:      "Create webhook reminder to a public address",
+			input:     models.Reminder{TodoID: "todo", UserID: "user", RemindAt: &remindAt, Channel: constants.ReminderChannelWebhook, Target: "https://hooks.example.com/remind"},
+			addresses: []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}},
+			repo: func() *automock.ReminderRepository {
+				repo := &automock.ReminderRepository{}
+				repo.EXPECT().Create(ctx, mock.Anything).Return(created.ID, nil).Once()
+				return repo
+			},
+			expected: models.Reminder{ID: created.ID, TodoID: "todo", UserID: "user", RemindAt: &remindAt, Channel: constants.ReminderChannelWebhook, Target: "https://hooks.example.com/remind", Status: constants.ReminderPending, CreatedAt: now},
+		},
+		{
+			name:      "Error when the webhook host resolves to the metadata address",
+			input:     models.Reminder{OffsetMinutes: &offset, Channel: constants.ReminderChannelWebhook, Target: "http://metadata.example.com/latest"},
+			addresses: []net.IPAddr{{IP: net.ParseIP("169.254.169.254")}},
+			repo: func() *automock.ReminderRepository {
+				return &automock.ReminderRepository{}
+			},
+			expectedError: reminders.ErrForbiddenTarget,
+		},
+		{
+			name:      "Error when any address of the webhook host is private",
+			input:     models.Reminder{OffsetMinutes: &offset, Channel: constants.ReminderChannelWebhook, Target: "http://internal.example.com"},
+			addresses: []net.IPAddr{{IP: net.ParseIP("93.184.216.34")}, {IP: net.ParseIP("10.0.0.7")}},
+			repo: func() *automock.ReminderRepository {
+				return &automock.ReminderRepository{}
+			},
+			expectedError: reminders.ErrForbiddenTarget,
+		},
+		{
+			name:      "Error when the webhook target is a loopback address",
+			input:     models.Reminder{OffsetMinutes: &offset, Channel: constants.ReminderChannelWebhook, Target: "http://127.0.0.1:8080"},
+			addresses: []net.IPAddr{{IP: net.ParseIP("127.0.0.1")}},
+			repo: func() *automock.ReminderRepository {
+				return &automock.ReminderRepository{}
+			},
+			expectedError: reminders.ErrInvalidReminder,
+		},
+		{
+			name:  "Error when creating fails",
+			input: models.Reminder{TodoID: "todo", UserID: "user", RemindAt: &remindAt, Channel: constants.ReminderChannelEmail},
+			repo: func() *automock.ReminderRepository {
+				repo := &automock.ReminderRepository{}
+				repo.EXPECT().Create(ctx, created).Return("", err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(created.ID).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			resolver := &automock.Resolver{}
+			resolver.EXPECT().LookupIPAddr(ctx, mock.Anything).Return(tt.addresses, nil).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := reminders.NewService(repo, resolver, uuidService, timeService)
+			result, err := svc.CreateReminder(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceDeleteReminder(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &automock.ReminderRepository{}
+	repo.EXPECT().Delete(ctx, "1", "todo", "user").Return(fmt.Errorf("reminder not found: %w", sql.ErrNoRows)).Once()
+	defer mock.AssertExpectationsForObjects(t, repo)
+
+	svc := reminders.NewService(repo, &automock.Resolver{}, &automock.UUIDService{}, &automock.TimeService{})
+	err := svc.DeleteReminder(ctx, "1", "todo", "user")
+	require.ErrorIs(t, err, reminders.ErrReminderNotFound)
+}
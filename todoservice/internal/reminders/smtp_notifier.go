@@ -0,0 +1,70 @@
+package reminders
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+type SMTPConfig struct {
+	Host     string `envconfig:"APP_SMTP_HOST"`
+	Port     string `envconfig:"APP_SMTP_PORT" default:"25"`
+	Username string `envconfig:"APP_SMTP_USERNAME"`
+	Password string `envconfig:"APP_SMTP_PASSWORD"`
+	From     string `envconfig:"APP_SMTP_FROM" default:"todos@localhost"`
+}
+
+// SMTPNotifier emails reminders to the address of the user who set them. The
+// Message-ID is derived from the reminder ID so a repeated delivery can be
+// recognised by the mail client.
+type SMTPNotifier struct {
+	config SMTPConfig
+}
+
+var _ Notifier = &SMTPNotifier{}
+
+func NewSMTPNotifier(config SMTPConfig) *SMTPNotifier {
+	return &SMTPNotifier{config: config}
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, delivery models.ReminderDelivery) error {
+	log.C(ctx).Infof("sending reminder %s by email", delivery.ReminderID)
+	if delivery.Email == "" {
+		return fmt.Errorf("reminder %s has no recipient", delivery.ReminderID)
+	}
+
+	var auth smtp.Auth
+	if n.config.Username != "" {
+		auth = smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+	}
+	address := net.JoinHostPort(n.config.Host, n.config.Port)
+	if err := smtp.SendMail(address, auth, n.config.From, []string{delivery.Email}, n.message(delivery)); err != nil {
+		return fmt.Errorf("failed to send reminder email: %w", err)
+	}
+	return nil
+}
+
+func (n *SMTPNotifier) message(delivery models.ReminderDelivery) []byte {
+	title := strings.NewReplacer("\r", " ", "\n", " ").Replace(delivery.TodoTitle)
+
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", n.config.From)
+	fmt.Fprintf(&message, "To: %s\r\n", delivery.Email)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", "Reminder: "+title))
+	fmt.Fprintf(&message, "Message-ID: <reminder-%s@todoservice>\r\n", delivery.ReminderID)
+	fmt.Fprintf(&message, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&message, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	if delivery.DueDate != nil {
+		fmt.Fprintf(&message, "%q is due %s.\r\n", title, delivery.DueDate.Format(constants.DateFormat))
+	} else {
+		fmt.Fprintf(&message, "This is your reminder for %q.\r\n", title)
+	}
+	return message.Bytes()
+}
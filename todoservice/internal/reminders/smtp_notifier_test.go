@@ -0,0 +1,111 @@
+package reminders_test
+
+import (
+	"bufio"
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/reminders"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+type receivedMail struct {
+	from string
+	to   []string
+	data string
+}
+
+// startSMTPServer runs a minimal SMTP stand-in that accepts every message and
+// sends it to the returned channel.
+func startSMTPServer(t *testing.T) (string, string, <-chan receivedMail) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	mails := make(chan receivedMail, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+		var mail receivedMail
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.TrimSpace(line)
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				mail.from = strings.Trim(strings.TrimPrefix(command, "MAIL FROM:"), "<>")
+				reply("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				mail.to = append(mail.to, strings.Trim(strings.TrimPrefix(command, "RCPT TO:"), "<>"))
+				reply("250 OK")
+			case command == "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil || dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				mail.data = data.String()
+				mails <- mail
+				reply("250 OK")
+			case command == "QUIT":
+				reply("221 Bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+	return host, port, mails
+}
+
+func TestSMTPNotifierNotify(t *testing.T) {
+	host, port, mails := startSMTPServer(t)
+	due := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	notifier := reminders.NewSMTPNotifier(reminders.SMTPConfig{Host: host, Port: port, From: "todos@example.com"})
+	err := notifier.Notify(context.Background(), models.ReminderDelivery{
+		ReminderID: "1",
+		Channel:    constants.ReminderChannelEmail,
+		Email:      "alice@example.com",
+		TodoTitle:  "Pay rent\r\nBcc: mallory@example.com",
+		DueDate:    &due,
+	})
+	require.NoError(t, err)
+
+	mail := <-mails
+	assert.Equal(t, "todos@example.com", mail.from)
+	assert.Equal(t, []string{"alice@example.com"}, mail.to)
+	assert.Contains(t, mail.data, "Subject: Reminder: Pay rent  Bcc: mallory@example.com\r\n")
+	assert.Contains(t, mail.data, "Message-ID: <reminder-1@todoservice>\r\n")
+	assert.Contains(t, mail.data, "is due 2026-11-01T00:00:00Z.")
+	assert.NotContains(t, mail.data, "\r\nBcc:")
+}
+
+func TestSMTPNotifierNotifyWithoutRecipient(t *testing.T) {
+	notifier := reminders.NewSMTPNotifier(reminders.SMTPConfig{Host: "127.0.0.1", Port: "1"})
+	err := notifier.Notify(context.Background(), models.ReminderDelivery{ReminderID: "1"})
+	require.Error(t, err)
+}
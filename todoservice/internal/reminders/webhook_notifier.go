@@ -0,0 +1,75 @@
+package reminders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net"
+	"net/http"
+	"time"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// AllowPrivateTargets lets webhooks reach loopback and private addresses. It
+// is meant for local development and tests only.
+type WebhookConfig struct {
+	Timeout             time.Duration `envconfig:"APP_WEBHOOK_TIMEOUT" default:"10s"`
+	AllowPrivateTargets bool          `envconfig:"APP_WEBHOOK_ALLOW_PRIVATE_TARGETS" default:"false"`
+}
+
+// WebhookNotifier posts the delivery as JSON to the reminder's target URL. The
+// reminder ID is sent in the Idempotency-Key header. Any response other than
+// 2xx counts as a failed delivery. Redirects are not followed and connections
+// to loopback, private, link-local and metadata addresses are refused.
+type WebhookNotifier struct {
+	client *http.Client
+}
+
+var _ Notifier = &WebhookNotifier{}
+
+func NewWebhookNotifier(config WebhookConfig) *WebhookNotifier {
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowPrivateTargets {
+		dialer.Control = refusePrivateAddresses
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &WebhookNotifier{client: &http.Client{
+		Timeout:   config.Timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, delivery models.ReminderDelivery) error {
+	log.C(ctx).Infof("sending reminder %s to webhook", delivery.ReminderID)
+	body, err := json.Marshal(delivery)
+	if err != nil {
+		return fmt.Errorf("failed to encode reminder: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Target, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", constants.ContentTypeJSON)
+	req.Header.Set(IdempotencyKeyHeader, delivery.ReminderID)
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package reminders_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/reminders"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWebhookNotifierNotify(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		expectError bool
+	}{
+		{name: "Successful delivery", statusCode: http.StatusNoContent},
+		{name: "Error when the webhook rejects the reminder", statusCode: http.StatusInternalServerError, expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received models.ReminderDelivery
+			var idempotencyKey string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				idempotencyKey = r.Header.Get(reminders.IdempotencyKeyHeader)
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			notifier := reminders.NewWebhookNotifier(reminders.WebhookConfig{Timeout: time.Second, AllowPrivateTargets: true})
+			err := notifier.Notify(context.Background(), models.ReminderDelivery{
+				ReminderID: "1",
+				Channel:    constants.ReminderChannelWebhook,
+				Target:     server.URL,
+				TodoID:     "2",
+				TodoTitle:  "Pay rent",
+			})
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, "1", idempotencyKey)
+			assert.Equal(t, "2", received.TodoID)
+			assert.Equal(t, "Pay rent", received.TodoTitle)
+		})
+	}
+}
+
+func TestWebhookNotifierRefusesRedirects(t *testing.T) {
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer server.Close()
+
+	notifier := reminders.NewWebhookNotifier(reminders.WebhookConfig{Timeout: time.Second, AllowPrivateTargets: true})
+	err := notifier.Notify(context.Background(), models.ReminderDelivery{ReminderID: "1", Channel: constants.ReminderChannelWebhook, Target: server.URL})
+	require.Error(t, err)
+	assert.False(t, redirected)
+}
+
+func TestWebhookNotifierRefusesPrivateAddresses(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	notifier := reminders.NewWebhookNotifier(reminders.WebhookConfig{Timeout: time.Second})
+	err := notifier.Notify(context.Background(), models.ReminderDelivery{ReminderID: "1", Channel: constants.ReminderChannelWebhook, Target: server.URL})
+	require.ErrorIs(t, err, reminders.ErrForbiddenTarget)
+	assert.False(t, called)
+}
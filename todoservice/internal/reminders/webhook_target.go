@@ -0,0 +1,74 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"syscall"
+)
+
+// ErrForbiddenTarget is returned for webhook targets that resolve to
+// addresses inside the cluster or the cloud provider, such as loopback,
+// private, link-local and metadata addresses. Posting reminders there would
+// let users reach internal services through the worker.
+var ErrForbiddenTarget = errors.New("webhook target is not a public address")
+
+//go:generate mockery --name=Resolver --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which net.IP does not
+// count as private.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// forbiddenIP reports whether webhooks must not be sent to ip. The metadata
+// endpoints of the cloud providers are link-local (169.254.169.254) or unique
+// local (fd00:ec2::254) addresses and are covered by those checks.
+func forbiddenIP(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified() ||
+		sharedAddressSpace.Contains(ip)
+}
+
+// checkWebhookTarget checks that target is an http or https URL whose host
+// resolves only to public addresses.
+func checkWebhookTarget(ctx context.Context, resolver Resolver, target string) error {
+	parsed, err := url.Parse(target)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return fmt.Errorf("%w: webhook target must be an http or https URL", ErrInvalidReminder)
+	}
+	addresses, err := resolver.LookupIPAddr(ctx, parsed.Hostname())
+	if err != nil {
+		return fmt.Errorf("%w: webhook host %q does not resolve", ErrInvalidReminder, parsed.Hostname())
+	}
+	for _, address := range addresses {
+		if forbiddenIP(address.IP) {
+			return fmt.Errorf("%w: %w", ErrInvalidReminder, ErrForbiddenTarget)
+		}
+	}
+	return nil
+}
+
+// refusePrivateAddresses is the dialer control function of the webhook
+// client. It checks the address actually dialled, so a host that resolved to
+// a public address when the reminder was created cannot be pointed at an
+// internal one later.
+func refusePrivateAddresses(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || forbiddenIP(ip) {
+		return fmt.Errorf("%w: %s", ErrForbiddenTarget, host)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package reminders
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+type WorkerConfig struct {
+	Interval    time.Duration `envconfig:"APP_REMINDER_INTERVAL" default:"30s"`
+	BatchSize   int           `envconfig:"APP_REMINDER_BATCH_SIZE" default:"50"`
+	Lease       time.Duration `envconfig:"APP_REMINDER_LEASE" default:"5m"`
+	RetryDelay  time.Duration `envconfig:"APP_REMINDER_RETRY_DELAY" default:"1m"`
+	MaxAttempts int           `envconfig:"APP_REMINDER_MAX_ATTEMPTS" default:"5"`
+}
+
+// Worker periodically delivers due reminders. Claiming a batch and recording
+// each outcome happen in separate transactions, so a reminder is only marked
+// sent after its notifier succeeded. If the process dies in between, the claim
+// expires after the lease and the reminder is delivered again.
+type Worker struct {
+	database    *sqlx.DB
+	repo        ReminderRepository
+	notifiers   map[constants.ReminderChannel]Notifier
+	timeService TimeService
+	config      WorkerConfig
+}
+
+func NewWorker(database *sqlx.DB, repo ReminderRepository, notifiers map[constants.ReminderChannel]Notifier, timeService TimeService, config WorkerConfig) *Worker {
+	return &Worker{database: database, repo: repo, notifiers: notifiers, timeService: timeService, config: config}
+}
+
+// Run processes due reminders every interval until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	log.C(ctx).Infof("starting reminder worker with interval %s", w.config.Interval)
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.ProcessDue(ctx); err != nil {
+			log.C(ctx).Errorf("processing due reminders failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("stopping reminder worker")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessDue delivers one batch of due reminders and returns how many were sent.
+func (w *Worker) ProcessDue(ctx context.Context) (int, error) {
+	now := w.timeService.Now()
+	var deliveries []models.ReminderDelivery
+	err := w.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		deliveries, err = w.repo.ClaimDue(ctx, now, now.Add(w.config.Lease), w.config.BatchSize)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, delivery := range deliveries {
+		if err = w.deliver(ctx, delivery); err != nil {
+			log.C(ctx).Errorf("recording delivery of reminder %s failed: %v", delivery.ReminderID, err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// deliver sends the reminder and records the outcome. It only returns an error
+// when the delivery could not be sent or recorded.
+func (w *Worker) deliver(ctx context.Context, delivery models.ReminderDelivery) error {
+	if delivery.Attempts > w.config.MaxAttempts {
+		return w.markFailed(ctx, delivery, fmt.Errorf("gave up after %d attempts", w.config.MaxAttempts))
+	}
+	notifier, ok := w.notifiers[delivery.Channel]
+	if !ok {
+		return w.markFailed(ctx, delivery, fmt.Errorf("no notifier configured for channel %s", delivery.Channel))
+	}
+
+	if err := notifier.Notify(ctx, delivery); err != nil {
+		log.C(ctx).Errorf("delivering reminder %s failed: %v", delivery.ReminderID, err)
+		return w.markFailed(ctx, delivery, err)
+	}
+	return w.inTx(ctx, func(ctx context.Context) error {
+		return w.repo.MarkSent(ctx, delivery.ReminderID, w.timeService.Now())
+	})
+}
+
+// markFailed schedules a retry with exponential backoff, or gives up once the
+// reminder used all its attempts. It returns the delivery error once recorded.
+func (w *Worker) markFailed(ctx context.Context, delivery models.ReminderDelivery, deliveryErr error) error {
+	var nextAttemptAt *time.Time
+	if delivery.Attempts < w.config.MaxAttempts {
+		next := w.timeService.Now().Add(w.config.RetryDelay << (delivery.Attempts - 1))
+		nextAttemptAt = &next
+	}
+	err := w.inTx(ctx, func(ctx context.Context) error {
+		return w.repo.MarkFailed(ctx, delivery.ReminderID, deliveryErr.Error(), nextAttemptAt)
+	})
+	if err != nil {
+		return err
+	}
+	return deliveryErr
+}
+
+func (w *Worker) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := w.database.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = fn(db.SaveToContext(ctx, tx)); err != nil {
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
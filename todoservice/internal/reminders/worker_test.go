@@ -0,0 +1,94 @@
+package reminders_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/reminders"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/reminders/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestWorkerProcessDue(t *testing.T) {
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	config := reminders.WorkerConfig{BatchSize: 10, Lease: 5 * time.Minute, RetryDelay: time.Minute, MaxAttempts: 3}
+	err := errors.New("error")
+
+	email := models.ReminderDelivery{ReminderID: "1", Channel: constants.ReminderChannelEmail, Email: "alice@example.com", Attempts: 1}
+	webhook := models.ReminderDelivery{ReminderID: "2", Channel: constants.ReminderChannelWebhook, Target: "http://example.com", Attempts: 2}
+	exhausted := models.ReminderDelivery{ReminderID: "3", Channel: constants.ReminderChannelWebhook, Target: "http://example.com", Attempts: 3}
+	retryAt := now.Add(2 * time.Minute)
+
+	tests := []struct {
+		name          string
+		setup         func(mockDB sqlxmock.Sqlmock, repo *automock.ReminderRepository, emailNotifier, webhookNotifier *automock.Notifier)
+		expectedSent  int
+		expectedError error
+	}{
+		{
+			name: "Deliver reminders and record the outcome",
+			setup: func(mockDB sqlxmock.Sqlmock, repo *automock.ReminderRepository, emailNotifier, webhookNotifier *automock.Notifier) {
+				mockDB.ExpectBegin()
+				repo.EXPECT().ClaimDue(mock.Anything, now, now.Add(config.Lease), config.BatchSize).Return([]models.ReminderDelivery{email, webhook, exhausted}, nil).Once()
+				mockDB.ExpectCommit()
+
+				emailNotifier.EXPECT().Notify(mock.Anything, email).Return(nil).Once()
+				mockDB.ExpectBegin()
+				repo.EXPECT().MarkSent(mock.Anything, "1", now).Return(nil).Once()
+				mockDB.ExpectCommit()
+
+				webhookNotifier.EXPECT().Notify(mock.Anything, webhook).Return(err).Once()
+				mockDB.ExpectBegin()
+				repo.EXPECT().MarkFailed(mock.Anything, "2", "error", &retryAt).Return(nil).Once()
+				mockDB.ExpectCommit()
+
+				webhookNotifier.EXPECT().Notify(mock.Anything, exhausted).Return(err).Once()
+				mockDB.ExpectBegin()
+				repo.EXPECT().MarkFailed(mock.Anything, "3", "error", (*time.Time)(nil)).Return(nil).Once()
+				mockDB.ExpectCommit()
+			},
+			expectedSent: 1,
+		},
+		{
+			name: "Error when claiming fails",
+			setup: func(mockDB sqlxmock.Sqlmock, repo *automock.ReminderRepository, emailNotifier, webhookNotifier *automock.Notifier) {
+				mockDB.ExpectBegin()
+				repo.EXPECT().ClaimDue(mock.Anything, now, now.Add(config.Lease), config.BatchSize).Return(nil, err).Once()
+				mockDB.ExpectRollback()
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, mockDB, dbErr := sqlxmock.Newx()
+			require.NoError(t, dbErr)
+			repo := &automock.ReminderRepository{}
+			emailNotifier := &automock.Notifier{}
+			webhookNotifier := &automock.Notifier{}
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now)
+			tt.setup(mockDB, repo, emailNotifier, webhookNotifier)
+			defer mock.AssertExpectationsForObjects(t, repo, emailNotifier, webhookNotifier)
+
+			worker := reminders.NewWorker(database, repo, map[constants.ReminderChannel]reminders.Notifier{
+				constants.ReminderChannelEmail:   emailNotifier,
+				constants.ReminderChannelWebhook: webhookNotifier,
+			}, timeService, config)
+			sent, err := worker.ProcessDue(context.Background())
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedSent, sent)
+			}
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
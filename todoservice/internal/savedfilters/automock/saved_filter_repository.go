@@ -0,0 +1,364 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SavedFilterRepository is an autogenerated mock type for the SavedFilterRepository type
+type SavedFilterRepository struct {
+	mock.Mock
+}
+
+type SavedFilterRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SavedFilterRepository) EXPECT() *SavedFilterRepository_Expecter {
+	return &SavedFilterRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, filter
+func (_m *SavedFilterRepository) Create(ctx context.Context, filter models.SavedFilter) (string, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SavedFilter) (string, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SavedFilter) string); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SavedFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SavedFilterRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.SavedFilter
+func (_e *SavedFilterRepository_Expecter) Create(ctx interface{}, filter interface{}) *SavedFilterRepository_Create_Call {
+	return &SavedFilterRepository_Create_Call{Call: _e.mock.On("Create", ctx, filter)}
+}
+
+func (_c *SavedFilterRepository_Create_Call) Run(run func(ctx context.Context, filter models.SavedFilter)) *SavedFilterRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.SavedFilter))
+	})
+	return _c
+}
+
+func (_c *SavedFilterRepository_Create_Call) Return(_a0 string, _a1 error) *SavedFilterRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterRepository_Create_Call) RunAndReturn(run func(context.Context, models.SavedFilter) (string, error)) *SavedFilterRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *SavedFilterRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SavedFilterRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SavedFilterRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *SavedFilterRepository_Expecter) Delete(ctx interface{}, id interface{}) *SavedFilterRepository_Delete_Call {
+	return &SavedFilterRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *SavedFilterRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *SavedFilterRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SavedFilterRepository_Delete_Call) Return(_a0 error) *SavedFilterRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SavedFilterRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *SavedFilterRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByName provides a mock function with given fields: ctx, userID, name
+func (_m *SavedFilterRepository) FindByName(ctx context.Context, userID string, name string) ([]models.SavedFilter, error) {
+	ret := _m.Called(ctx, userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByName")
+	}
+
+	var r0 []models.SavedFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.SavedFilter, error)); ok {
+		return rf(ctx, userID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.SavedFilter); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SavedFilter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterRepository_FindByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByName'
+type SavedFilterRepository_FindByName_Call struct {
+	*mock.Call
+}
+
+// FindByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - name string
+func (_e *SavedFilterRepository_Expecter) FindByName(ctx interface{}, userID interface{}, name interface{}) *SavedFilterRepository_FindByName_Call {
+	return &SavedFilterRepository_FindByName_Call{Call: _e.mock.On("FindByName", ctx, userID, name)}
+}
+
+func (_c *SavedFilterRepository_FindByName_Call) Run(run func(ctx context.Context, userID string, name string)) *SavedFilterRepository_FindByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SavedFilterRepository_FindByName_Call) Return(_a0 []models.SavedFilter, _a1 error) *SavedFilterRepository_FindByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterRepository_FindByName_Call) RunAndReturn(run func(context.Context, string, string) ([]models.SavedFilter, error)) *SavedFilterRepository_FindByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *SavedFilterRepository) Get(ctx context.Context, id string) (models.SavedFilter, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 models.SavedFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.SavedFilter, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.SavedFilter); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.SavedFilter)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type SavedFilterRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *SavedFilterRepository_Expecter) Get(ctx interface{}, id interface{}) *SavedFilterRepository_Get_Call {
+	return &SavedFilterRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *SavedFilterRepository_Get_Call) Run(run func(ctx context.Context, id string)) *SavedFilterRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SavedFilterRepository_Get_Call) Return(_a0 models.SavedFilter, _a1 error) *SavedFilterRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterRepository_Get_Call) RunAndReturn(run func(context.Context, string) (models.SavedFilter, error)) *SavedFilterRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userID
+func (_m *SavedFilterRepository) GetByUserID(ctx context.Context, userID string) ([]models.SavedFilter, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []models.SavedFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.SavedFilter, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.SavedFilter); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SavedFilter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterRepository_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type SavedFilterRepository_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *SavedFilterRepository_Expecter) GetByUserID(ctx interface{}, userID interface{}) *SavedFilterRepository_GetByUserID_Call {
+	return &SavedFilterRepository_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userID)}
+}
+
+func (_c *SavedFilterRepository_GetByUserID_Call) Run(run func(ctx context.Context, userID string)) *SavedFilterRepository_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SavedFilterRepository_GetByUserID_Call) Return(_a0 []models.SavedFilter, _a1 error) *SavedFilterRepository_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterRepository_GetByUserID_Call) RunAndReturn(run func(context.Context, string) ([]models.SavedFilter, error)) *SavedFilterRepository_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, filter
+func (_m *SavedFilterRepository) Update(ctx context.Context, filter models.SavedFilter) error {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SavedFilter) error); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SavedFilterRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type SavedFilterRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.SavedFilter
+func (_e *SavedFilterRepository_Expecter) Update(ctx interface{}, filter interface{}) *SavedFilterRepository_Update_Call {
+	return &SavedFilterRepository_Update_Call{Call: _e.mock.On("Update", ctx, filter)}
+}
+
+func (_c *SavedFilterRepository_Update_Call) Run(run func(ctx context.Context, filter models.SavedFilter)) *SavedFilterRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.SavedFilter))
+	})
+	return _c
+}
+
+func (_c *SavedFilterRepository_Update_Call) Return(_a0 error) *SavedFilterRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SavedFilterRepository_Update_Call) RunAndReturn(run func(context.Context, models.SavedFilter) error) *SavedFilterRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSavedFilterRepository creates a new instance of SavedFilterRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSavedFilterRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SavedFilterRepository {
+	mock := &SavedFilterRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
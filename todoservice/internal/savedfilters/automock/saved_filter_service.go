@@ -0,0 +1,385 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SavedFilterService is an autogenerated mock type for the SavedFilterService type
+type SavedFilterService struct {
+	mock.Mock
+}
+
+type SavedFilterService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SavedFilterService) EXPECT() *SavedFilterService_Expecter {
+	return &SavedFilterService_Expecter{mock: &_m.Mock}
+}
+
+// CreateFilter provides a mock function with given fields: ctx, filter
+func (_m *SavedFilterService) CreateFilter(ctx context.Context, filter models.SavedFilter) (models.SavedFilter, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFilter")
+	}
+
+	var r0 models.SavedFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SavedFilter) (models.SavedFilter, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SavedFilter) models.SavedFilter); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(models.SavedFilter)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SavedFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterService_CreateFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFilter'
+type SavedFilterService_CreateFilter_Call struct {
+	*mock.Call
+}
+
+// CreateFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.SavedFilter
+func (_e *SavedFilterService_Expecter) CreateFilter(ctx interface{}, filter interface{}) *SavedFilterService_CreateFilter_Call {
+	return &SavedFilterService_CreateFilter_Call{Call: _e.mock.On("CreateFilter", ctx, filter)}
+}
+
+func (_c *SavedFilterService_CreateFilter_Call) Run(run func(ctx context.Context, filter models.SavedFilter)) *SavedFilterService_CreateFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.SavedFilter))
+	})
+	return _c
+}
+
+func (_c *SavedFilterService_CreateFilter_Call) Return(_a0 models.SavedFilter, _a1 error) *SavedFilterService_CreateFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterService_CreateFilter_Call) RunAndReturn(run func(context.Context, models.SavedFilter) (models.SavedFilter, error)) *SavedFilterService_CreateFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFilter provides a mock function with given fields: ctx, id, userID
+func (_m *SavedFilterService) DeleteFilter(ctx context.Context, id string, userID string) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFilter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SavedFilterService_DeleteFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFilter'
+type SavedFilterService_DeleteFilter_Call struct {
+	*mock.Call
+}
+
+// DeleteFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *SavedFilterService_Expecter) DeleteFilter(ctx interface{}, id interface{}, userID interface{}) *SavedFilterService_DeleteFilter_Call {
+	return &SavedFilterService_DeleteFilter_Call{Call: _e.mock.On("DeleteFilter", ctx, id, userID)}
+}
+
+func (_c *SavedFilterService_DeleteFilter_Call) Run(run func(ctx context.Context, id string, userID string)) *SavedFilterService_DeleteFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SavedFilterService_DeleteFilter_Call) Return(_a0 error) *SavedFilterService_DeleteFilter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SavedFilterService_DeleteFilter_Call) RunAndReturn(run func(context.Context, string, string) error) *SavedFilterService_DeleteFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterTodos provides a mock function with given fields: ctx, id, userID, timeZone, filter
+func (_m *SavedFilterService) FilterTodos(ctx context.Context, id string, userID string, timeZone string, filter models.TodoFilter) ([]models.Todo, string, error) {
+	ret := _m.Called(ctx, id, userID, timeZone, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterTodos")
+	}
+
+	var r0 []models.Todo
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, models.TodoFilter) ([]models.Todo, string, error)); ok {
+		return rf(ctx, id, userID, timeZone, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, models.TodoFilter) []models.Todo); ok {
+		r0 = rf(ctx, id, userID, timeZone, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, models.TodoFilter) string); ok {
+		r1 = rf(ctx, id, userID, timeZone, filter)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, string, models.TodoFilter) error); ok {
+		r2 = rf(ctx, id, userID, timeZone, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SavedFilterService_FilterTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterTodos'
+type SavedFilterService_FilterTodos_Call struct {
+	*mock.Call
+}
+
+// FilterTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+//   - timeZone string
+//   - filter models.TodoFilter
+func (_e *SavedFilterService_Expecter) FilterTodos(ctx interface{}, id interface{}, userID interface{}, timeZone interface{}, filter interface{}) *SavedFilterService_FilterTodos_Call {
+	return &SavedFilterService_FilterTodos_Call{Call: _e.mock.On("FilterTodos", ctx, id, userID, timeZone, filter)}
+}
+
+func (_c *SavedFilterService_FilterTodos_Call) Run(run func(ctx context.Context, id string, userID string, timeZone string, filter models.TodoFilter)) *SavedFilterService_FilterTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(models.TodoFilter))
+	})
+	return _c
+}
+
+func (_c *SavedFilterService_FilterTodos_Call) Return(_a0 []models.Todo, _a1 string, _a2 error) *SavedFilterService_FilterTodos_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *SavedFilterService_FilterTodos_Call) RunAndReturn(run func(context.Context, string, string, string, models.TodoFilter) ([]models.Todo, string, error)) *SavedFilterService_FilterTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFilter provides a mock function with given fields: ctx, id, userID
+func (_m *SavedFilterService) GetFilter(ctx context.Context, id string, userID string) (models.SavedFilter, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFilter")
+	}
+
+	var r0 models.SavedFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.SavedFilter, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.SavedFilter); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Get(0).(models.SavedFilter)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterService_GetFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFilter'
+type SavedFilterService_GetFilter_Call struct {
+	*mock.Call
+}
+
+// GetFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *SavedFilterService_Expecter) GetFilter(ctx interface{}, id interface{}, userID interface{}) *SavedFilterService_GetFilter_Call {
+	return &SavedFilterService_GetFilter_Call{Call: _e.mock.On("GetFilter", ctx, id, userID)}
+}
+
+func (_c *SavedFilterService_GetFilter_Call) Run(run func(ctx context.Context, id string, userID string)) *SavedFilterService_GetFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SavedFilterService_GetFilter_Call) Return(_a0 models.SavedFilter, _a1 error) *SavedFilterService_GetFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterService_GetFilter_Call) RunAndReturn(run func(context.Context, string, string) (models.SavedFilter, error)) *SavedFilterService_GetFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFilters provides a mock function with given fields: ctx, userID
+func (_m *SavedFilterService) ListFilters(ctx context.Context, userID string) ([]models.SavedFilter, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFilters")
+	}
+
+	var r0 []models.SavedFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.SavedFilter, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.SavedFilter); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SavedFilter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterService_ListFilters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFilters'
+type SavedFilterService_ListFilters_Call struct {
+	*mock.Call
+}
+
+// ListFilters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *SavedFilterService_Expecter) ListFilters(ctx interface{}, userID interface{}) *SavedFilterService_ListFilters_Call {
+	return &SavedFilterService_ListFilters_Call{Call: _e.mock.On("ListFilters", ctx, userID)}
+}
+
+func (_c *SavedFilterService_ListFilters_Call) Run(run func(ctx context.Context, userID string)) *SavedFilterService_ListFilters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SavedFilterService_ListFilters_Call) Return(_a0 []models.SavedFilter, _a1 error) *SavedFilterService_ListFilters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterService_ListFilters_Call) RunAndReturn(run func(context.Context, string) ([]models.SavedFilter, error)) *SavedFilterService_ListFilters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFilter provides a mock function with given fields: ctx, filter
+func (_m *SavedFilterService) UpdateFilter(ctx context.Context, filter models.SavedFilter) (models.SavedFilter, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFilter")
+	}
+
+	var r0 models.SavedFilter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SavedFilter) (models.SavedFilter, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SavedFilter) models.SavedFilter); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(models.SavedFilter)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SavedFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavedFilterService_UpdateFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFilter'
+type SavedFilterService_UpdateFilter_Call struct {
+	*mock.Call
+}
+
+// UpdateFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.SavedFilter
+func (_e *SavedFilterService_Expecter) UpdateFilter(ctx interface{}, filter interface{}) *SavedFilterService_UpdateFilter_Call {
+	return &SavedFilterService_UpdateFilter_Call{Call: _e.mock.On("UpdateFilter", ctx, filter)}
+}
+
+func (_c *SavedFilterService_UpdateFilter_Call) Run(run func(ctx context.Context, filter models.SavedFilter)) *SavedFilterService_UpdateFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.SavedFilter))
+	})
+	return _c
+}
+
+func (_c *SavedFilterService_UpdateFilter_Call) Return(_a0 models.SavedFilter, _a1 error) *SavedFilterService_UpdateFilter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SavedFilterService_UpdateFilter_Call) RunAndReturn(run func(context.Context, models.SavedFilter) (models.SavedFilter, error)) *SavedFilterService_UpdateFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSavedFilterService creates a new instance of SavedFilterService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSavedFilterService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SavedFilterService {
+	mock := &SavedFilterService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,31 @@
+package savedfilters
+
+import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertSavedFilterToModel(entity Entity) models.SavedFilter {
+	return models.SavedFilter{
+		ID:         entity.ID,
+		UserID:     entity.UserID,
+		Name:       entity.Name,
+		Expression: entity.Expression,
+		CreatedAt:  entity.CreatedAt,
+		UpdatedAt:  entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ConvertSavedFilterToEntity(filter models.SavedFilter) Entity {
+	return Entity{
+		ID:         filter.ID,
+		UserID:     filter.UserID,
+		Name:       filter.Name,
+		Expression: filter.Expression,
+		CreatedAt:  filter.CreatedAt,
+		UpdatedAt:  filter.UpdatedAt,
+	}
+}
@@ -0,0 +1,12 @@
+package savedfilters
+
+import "time"
+
+type Entity struct {
+	ID         string    `db:"id"`
+	UserID     string    `db:"user_id"`
+	Name       string    `db:"name"`
+	Expression string    `db:"expression"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
@@ -0,0 +1,490 @@
+// Package savedfilters keeps named todo filters that users use as their own
+// smart lists. A filter is an expression that combines comparisons of todo
+// fields with and, or, not and parentheses, where not binds tighter than and,
+// and and tighter than or:
+//
+//	priority = high and (due <= today+3d or tag = urgent) and completed = false
+//
+// A comparison is a field, an operator and a value. Values are single words or
+// double-quoted strings. The fields and the operators they support are
+//
+//	title, description   = != ~ (contains)        any text
+//	priority             = !=                     low, medium, high
+//	completed            = !=                     true, false
+//	due, start, created  = != < <= > >=           a date, or none with = and !=
+//	tag, status          = !=                     a tag or status name
+//	assignee             = !=                     me, none or a user ID
+//	list                 = !=                     a list ID
+//
+// Dates are either YYYY-MM-DD or today, tomorrow or yesterday optionally
+// followed by an offset in days or weeks such as today+3d or today-2w. They
+// are whole days in the time zone the filter is evaluated in. Keywords, field
+// names and text comparisons are case-insensitive, and != matches everything
+// = does not, including todos without a value for the field.
+package savedfilters
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxExpressionLength = 1000
+
+var (
+	errEmptyExpression  = errors.New("the expression is empty")
+	relativeDatePattern = regexp.MustCompile(`^(today|tomorrow|yesterday)(?:([+-])(\d{1,4})([dw]))?$`)
+)
+
+type fieldKind int
+
+const (
+	textField fieldKind = iota
+	priorityField
+	boolField
+	dateField
+	tagField
+	statusField
+	userField
+	listField
+)
+
+type field struct {
+	column string
+	kind   fieldKind
+}
+
+var fields = map[string]field{
+	"title":       {column: "title", kind: textField},
+	"description": {column: "description", kind: textField},
+	"priority":    {column: "priority", kind: priorityField},
+	"completed":   {column: "completed", kind: boolField},
+	"due":         {column: "due_date", kind: dateField},
+	"start":       {column: "start_date", kind: dateField},
+	"created":     {column: "created_at", kind: dateField},
+	"tag":         {kind: tagField},
+	"status":      {kind: statusField},
+	"assignee":    {column: "assigned_to", kind: userField},
+	"list":        {column: "list_id", kind: listField},
+}
+
+var operators = map[fieldKind][]string{
+	textField:     {"=", "!=", "~"},
+	priorityField: {"=", "!="},
+	boolField:     {"=", "!="},
+	dateField:     {"=", "!=", "<", "<=", ">", ">="},
+	tagField:      {"=", "!="},
+	statusField:   {"=", "!="},
+	userField:     {"=", "!="},
+	listField:     {"=", "!="},
+}
+
+var dayOffsets = map[string]int{"yesterday": -1, "today": 0, "tomorrow": 1}
+
+// Expression is a parsed filter expression. Compile turns it into a condition
+// on todos.
+type Expression struct {
+	root node
+}
+
+// Env holds what an expression needs to be evaluated: the user "me" stands
+// for and the current time in the time zone dates are resolved in.
+type Env struct {
+	UserID string
+	Now    time.Time
+}
+
+// Parse checks the expression and returns it parsed. Errors describe what is
+// wrong and where.
+func Parse(expression string) (*Expression, error) {
+	if len(expression) > maxExpressionLength {
+		return nil, fmt.Errorf("the expression is longer than %d characters", maxExpressionLength)
+	}
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, errEmptyExpression
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.tokens[p.pos].text, p.tokens[p.pos].offset+1)
+	}
+	return &Expression{root: root}, nil
+}
+
+// Compile returns the expression as a condition on todos for the given
+// environment.
+func (e *Expression) Compile(env Env) models.Condition {
+	return &condition{root: e.root, env: env}
+}
+
+type condition struct {
+	root node
+	env  Env
+}
+
+func (c *condition) SQL(arg func(value interface{}) string) string {
+	return c.root.sql(c.env, arg)
+}
+
+type node interface {
+	sql(env Env, arg func(value interface{}) string) string
+}
+
+type andNode struct{ left, right node }
+
+func (n *andNode) sql(env Env, arg func(value interface{}) string) string {
+	return "(" + n.left.sql(env, arg) + " AND " + n.right.sql(env, arg) + ")"
+}
+
+type orNode struct{ left, right node }
+
+func (n *orNode) sql(env Env, arg func(value interface{}) string) string {
+	return "(" + n.left.sql(env, arg) + " OR " + n.right.sql(env, arg) + ")"
+}
+
+// notNode treats comparisons with missing values as false, so that negating
+// them matches the todos without a value.
+type notNode struct{ operand node }
+
+func (n *notNode) sql(env Env, arg func(value interface{}) string) string {
+	return "NOT COALESCE(" + n.operand.sql(env, arg) + ", false)"
+}
+
+type comparisonNode struct {
+	field    field
+	operator string
+	value    string
+	date     *dateValue
+}
+
+type dateValue struct {
+	relative string
+	days     int
+	absolute time.Time
+}
+
+func (n *comparisonNode) sql(env Env, arg func(value interface{}) string) string {
+	column := n.field.column
+	switch n.field.kind {
+	case textField:
+		if n.operator == "~" {
+			return "strpos(lower(" + column + "), lower(" + arg(n.value) + ")) > 0"
+		}
+		return "lower(" + column + ") = lower(" + arg(n.value) + ")"
+	case priorityField:
+		return column + " = " + arg(n.value)
+	case boolField:
+		return column + " = " + arg(n.value == "true")
+	case dateField:
+		if n.date == nil {
+			return column + " IS NULL"
+		}
+		start := n.date.start(env.Now)
+		end := start.AddDate(0, 0, 1)
+		switch n.operator {
+		case "<":
+			return column + " < " + arg(start)
+		case "<=":
+			return column + " < " + arg(end)
+		case ">":
+			return column + " >= " + arg(end)
+		case ">=":
+			return column + " >= " + arg(start)
+		default:
+			return "(" + column + " >= " + arg(start) + " AND " + column + " < " + arg(end) + ")"
+		}
+	case tagField:
+		return "id IN (SELECT tt.todo_id FROM todo_tags tt JOIN tags g ON g.id = tt.tag_id WHERE lower(g.name) = lower(" +
+			arg(n.value) + "))"
+	case statusField:
+		return "status_id IN (SELECT id FROM list_statuses WHERE lower(name) = lower(" + arg(n.value) + "))"
+	case userField:
+		switch strings.ToLower(n.value) {
+		case "none":
+			return column + " IS NULL"
+		case "me":
+			return column + " = " + arg(env.UserID)
+		}
+		return column + " = " + arg(n.value)
+	default:
+		return column + " = " + arg(n.value)
+	}
+}
+
+// start returns the beginning of the day in the time zone of now.
+func (d *dateValue) start(now time.Time) time.Time {
+	if d.relative == "" {
+		return time.Date(d.absolute.Year(), d.absolute.Month(), d.absolute.Day(), 0, 0, 0, 0, now.Location())
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return today.AddDate(0, 0, dayOffsets[d.relative]+d.days)
+}
+
+type tokenKind int
+
+const (
+	wordToken tokenKind = iota
+	stringToken
+	operatorToken
+	openToken
+	closeToken
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: openToken, text: "(", offset: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: closeToken, text: ")", offset: i})
+			i++
+		case c == '=' || c == '~':
+			tokens = append(tokens, token{kind: operatorToken, text: string(c), offset: i})
+			i++
+		case c == '!' || c == '<' || c == '>':
+			if i+1 < len(expression) && expression[i+1] == '=' {
+				tokens = append(tokens, token{kind: operatorToken, text: expression[i : i+2], offset: i})
+				i += 2
+				continue
+			}
+			if c == '!' {
+				return nil, fmt.Errorf("unexpected \"!\" at position %d", i+1)
+			}
+			tokens = append(tokens, token{kind: operatorToken, text: string(c), offset: i})
+			i++
+		case c == '"':
+			text, n, err := readString(expression[i:])
+			if err != nil {
+				return nil, fmt.Errorf("%v at position %d", err, i+1)
+			}
+			tokens = append(tokens, token{kind: stringToken, text: text, offset: i})
+			i += n
+		default:
+			start := i
+			for i < len(expression) && !strings.ContainsRune(" \t\n\r()=~!<>\"", rune(expression[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: wordToken, text: expression[start:i], offset: start})
+		}
+	}
+	return tokens, nil
+}
+
+// readString reads the double-quoted string at the start of s, in which \" and
+// \\ stand for a quote and a backslash, and returns it with the number of
+// bytes it takes up.
+func readString(s string) (string, int, error) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return b.String(), i + 1, nil
+		case '\\':
+			if i+1 < len(s) && (s[i+1] == '"' || s[i+1] == '\\') {
+				i++
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return "", 0, errors.New("unterminated string")
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peekKeyword(keyword string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == wordToken && strings.EqualFold(p.tokens[p.pos].text, keyword)
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.peekKeyword("not") {
+		p.pos++
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand: operand}, nil
+	}
+	if p.pos < len(p.tokens) && p.tokens[p.pos].kind == openToken {
+		p.pos++
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != closeToken {
+			return nil, errors.New("missing closing parenthesis")
+		}
+		p.pos++
+		return inner, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	if p.pos+3 > len(p.tokens) {
+		return nil, errors.New("the expression ends in the middle of a comparison")
+	}
+	name, operator, value := p.tokens[p.pos], p.tokens[p.pos+1], p.tokens[p.pos+2]
+	if name.kind != wordToken {
+		return nil, fmt.Errorf("expected a field at position %d", name.offset+1)
+	}
+	f, ok := fields[strings.ToLower(name.text)]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q at position %d", name.text, name.offset+1)
+	}
+	if operator.kind != operatorToken {
+		return nil, fmt.Errorf("expected an operator at position %d", operator.offset+1)
+	}
+	if !supports(f.kind, operator.text) {
+		return nil, fmt.Errorf("field %s does not support %s", strings.ToLower(name.text), operator.text)
+	}
+	if value.kind != wordToken && value.kind != stringToken {
+		return nil, fmt.Errorf("expected a value at position %d", value.offset+1)
+	}
+	p.pos += 3
+
+	comparison, err := newComparison(f, operator.text, value.text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q for %s: %w", value.text, strings.ToLower(name.text), err)
+	}
+	if operator.text == "!=" {
+		comparison.operator = "="
+		return &notNode{operand: comparison}, nil
+	}
+	return comparison, nil
+}
+
+func supports(kind fieldKind, operator string) bool {
+	for _, supported := range operators[kind] {
+		if supported == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func newComparison(f field, operator string, value string) (*comparisonNode, error) {
+	comparison := &comparisonNode{field: f, operator: operator, value: value}
+	switch f.kind {
+	case priorityField:
+		priority := constants.PriorityLevel(strings.ToLower(value))
+		if priority != constants.PriorityLow && priority != constants.PriorityMedium && priority != constants.PriorityHigh {
+			return nil, errors.New("expected low, medium or high")
+		}
+		comparison.value = string(priority)
+	case boolField:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.New("expected true or false")
+		}
+		comparison.value = strconv.FormatBool(parsed)
+	case dateField:
+		if strings.EqualFold(value, "none") {
+			if operator != "=" && operator != "!=" {
+				return nil, errors.New("none can only be compared with = and !=")
+			}
+			return comparison, nil
+		}
+		date, err := parseDate(value)
+		if err != nil {
+			return nil, err
+		}
+		comparison.date = date
+	case tagField, statusField:
+		if strings.TrimSpace(value) == "" {
+			return nil, errors.New("expected a name")
+		}
+	case userField:
+		if lower := strings.ToLower(value); lower == "me" || lower == "none" {
+			return comparison, nil
+		}
+		if err := pkg.ValidateUUID(value); err != nil {
+			return nil, errors.New("expected me, none or a user ID")
+		}
+	case listField:
+		if err := pkg.ValidateUUID(value); err != nil {
+			return nil, errors.New("expected a list ID")
+		}
+	}
+	return comparison, nil
+}
+
+func parseDate(value string) (*dateValue, error) {
+	if match := relativeDatePattern.FindStringSubmatch(strings.ToLower(value)); match != nil {
+		date := &dateValue{relative: match[1]}
+		if match[2] != "" {
+			days, _ := strconv.Atoi(match[3])
+			if match[4] == "w" {
+				days *= 7
+			}
+			if match[2] == "-" {
+				days = -days
+			}
+			date.days = days
+		}
+		return date, nil
+	}
+	absolute, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, errors.New("expected YYYY-MM-DD, today, tomorrow or yesterday with an optional offset such as +3d")
+	}
+	return &dateValue{absolute: absolute}, nil
+}
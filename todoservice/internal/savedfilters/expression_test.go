@@ -0,0 +1,124 @@
+package savedfilters_test
+
+import (
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/savedfilters"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestExpressionCompile(t *testing.T) {
+	sofia, err := time.LoadLocation("Europe/Sofia")
+	require.NoError(t, err)
+	// 23:30 in UTC is already Monday in Sofia.
+	now := time.Date(2026, time.October, 18, 23, 30, 0, 0, time.UTC).In(sofia)
+	env := savedfilters.Env{UserID: "user", Now: now}
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2026, month, d, 0, 0, 0, 0, sofia)
+	}
+	listID := "8f4c2a50-1d2e-4f6a-9b3c-7e8d9f0a1b2c"
+
+	tests := []struct {
+		name         string
+		input        string
+		expectedSQL  string
+		expectedArgs []interface{}
+	}{
+		{
+			name:         "Priority and a tag",
+			input:        "priority = HIGH and tag = \"Deep work\"",
+			expectedSQL:  "(priority = $1 AND id IN (SELECT tt.todo_id FROM todo_tags tt JOIN tags g ON g.id = tt.tag_id WHERE lower(g.name) = lower($2)))",
+			expectedArgs: []interface{}{"high", "Deep work"},
+		},
+		{
+			name:         "Or binds looser than and",
+			input:        "completed = false and due <= today+3d or assignee = me",
+			expectedSQL:  "((completed = $1 AND due_date < $2) OR assigned_to = $3)",
+			expectedArgs: []interface{}{false, day(time.October, 23), "user"},
+		},
+		{
+			name:         "Parentheses group",
+			input:        "completed = false AND (due < today OR start >= tomorrow)",
+			expectedSQL:  "(completed = $1 AND (due_date < $2 OR start_date >= $3))",
+			expectedArgs: []interface{}{false, day(time.October, 19), day(time.October, 20)},
+		},
+		{
+			name:         "A day is a range in the time zone",
+			input:        "due = 2026-11-02",
+			expectedSQL:  "(due_date >= $1 AND due_date < $2)",
+			expectedArgs: []interface{}{day(time.November, 2), day(time.November, 3)},
+		},
+		{
+			name:         "Not equal matches missing values",
+			input:        "title != chores",
+			expectedSQL:  "NOT COALESCE(lower(title) = lower($1), false)",
+			expectedArgs: []interface{}{"chores"},
+		},
+		{
+			name:         "Not of contains with escaped quotes",
+			input:        `not description ~ "say \"hi\""`,
+			expectedSQL:  "NOT COALESCE(strpos(lower(description), lower($1)) > 0, false)",
+			expectedArgs: []interface{}{`say "hi"`},
+		},
+		{
+			name:        "Missing dates and assignees",
+			input:       "due = none or assignee = none or created > yesterday-1w",
+			expectedSQL: "((due_date IS NULL OR assigned_to IS NULL) OR created_at >= $1)",
+			expectedArgs: []interface{}{
+				day(time.October, 12),
+			},
+		},
+		{
+			name:         "Status and list",
+			input:        "status = Review and list != " + listID,
+			expectedSQL:  "(status_id IN (SELECT id FROM list_statuses WHERE lower(name) = lower($1)) AND NOT COALESCE(list_id = $2, false))",
+			expectedArgs: []interface{}{"Review", listID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := savedfilters.Parse(tt.input)
+			require.NoError(t, err)
+
+			var args []interface{}
+			sql := expression.Compile(env).SQL(func(value interface{}) string {
+				args = append(args, value)
+				return fmt.Sprintf("$%d", len(args))
+			})
+			assert.Equal(t, tt.expectedSQL, sql)
+			assert.Equal(t, tt.expectedArgs, args)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedError string
+	}{
+		{name: "Empty", input: "  ", expectedError: "the expression is empty"},
+		{name: "Unknown field", input: "colour = red", expectedError: `unknown field "colour" at position 1`},
+		{name: "Unsupported operator", input: "priority < high", expectedError: "field priority does not support <"},
+		{name: "Invalid priority", input: "priority = urgent", expectedError: `invalid value "urgent" for priority: expected low, medium or high`},
+		{name: "Invalid date", input: "due = someday", expectedError: `invalid value "someday" for due: expected YYYY-MM-DD, today, tomorrow or yesterday with an optional offset such as +3d`},
+		{name: "Ordering with none", input: "due < none", expectedError: `invalid value "none" for due: none can only be compared with = and !=`},
+		{name: "Invalid assignee", input: "assignee = bob", expectedError: `invalid value "bob" for assignee: expected me, none or a user ID`},
+		{name: "Missing parenthesis", input: "(tag = a or tag = b", expectedError: "missing closing parenthesis"},
+		{name: "Trailing input", input: "tag = a tag = b", expectedError: `unexpected "tag" at position 9`},
+		{name: "Incomplete comparison", input: "tag = a and tag", expectedError: "the expression ends in the middle of a comparison"},
+		{name: "Unterminated string", input: `title = "open`, expectedError: "unterminated string at position 9"},
+		{name: "Lone exclamation mark", input: "title ! x", expectedError: `unexpected "!" at position 7`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := savedfilters.Parse(tt.input)
+			require.Error(t, err)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
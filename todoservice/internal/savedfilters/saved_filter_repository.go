@@ -0,0 +1,154 @@
+package savedfilters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+//go:generate mockery --name=SavedFilterRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type SavedFilterRepository interface {
+	Create(ctx context.Context, filter models.SavedFilter) (string, error)
+	Get(ctx context.Context, id string) (models.SavedFilter, error)
+	GetByUserID(ctx context.Context, userID string) ([]models.SavedFilter, error)
+	FindByName(ctx context.Context, userID string, name string) ([]models.SavedFilter, error)
+	Update(ctx context.Context, filter models.SavedFilter) error
+	Delete(ctx context.Context, id string) error
+}
+
+const selectSavedFilters = `SELECT id, user_id, name, expression, created_at, updated_at FROM saved_filters `
+
+type SQLXSavedFilterRepository struct {
+	converter *Converter
+}
+
+var _ SavedFilterRepository = &SQLXSavedFilterRepository{}
+
+func NewSQLXSavedFilterRepository() SavedFilterRepository {
+	return &SQLXSavedFilterRepository{converter: NewConverter()}
+}
+
+func (r *SQLXSavedFilterRepository) Create(ctx context.Context, filter models.SavedFilter) (string, error) {
+	log.C(ctx).Info("creating saved filter repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertSavedFilterToEntity(filter)
+	if err = pkg.ValidateUUID(entity.ID); err != nil {
+		log.C(ctx).Errorf("invalid entity id: %v", err)
+		return "", fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	query := `
+		INSERT INTO saved_filters (id, user_id, name, expression, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query,
+		entity.ID,
+		entity.UserID,
+		entity.Name,
+		entity.Expression,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to insert saved filter: %v", err)
+		return "", fmt.Errorf("failed to create saved filter: %w", err)
+	}
+	return id, nil
+}
+
+func (r *SQLXSavedFilterRepository) Get(ctx context.Context, id string) (models.SavedFilter, error) {
+	log.C(ctx).Info("getting saved filter repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.SavedFilter{}, err
+	}
+
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, selectSavedFilters+`WHERE id = $1`, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get saved filter: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.SavedFilter{}, fmt.Errorf("saved filter not found: %w", err)
+		}
+		return models.SavedFilter{}, fmt.Errorf("failed to get saved filter: %w", err)
+	}
+	return r.converter.ConvertSavedFilterToModel(entity), nil
+}
+
+func (r *SQLXSavedFilterRepository) GetByUserID(ctx context.Context, userID string) ([]models.SavedFilter, error) {
+	log.C(ctx).Info("getting saved filters of user repository")
+	return r.selectSavedFilters(ctx, selectSavedFilters+`WHERE user_id = $1 ORDER BY lower(name), id`, userID)
+}
+
+// FindByName returns the saved filters of the user with the given name,
+// compared case-insensitively.
+func (r *SQLXSavedFilterRepository) FindByName(ctx context.Context, userID string, name string) ([]models.SavedFilter, error) {
+	log.C(ctx).Info("finding saved filters by name repository")
+	return r.selectSavedFilters(ctx, selectSavedFilters+`WHERE user_id = $1 AND lower(name) = lower($2)`, userID, name)
+}
+
+func (r *SQLXSavedFilterRepository) Update(ctx context.Context, filter models.SavedFilter) error {
+	log.C(ctx).Info("updating saved filter repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	entity := r.converter.ConvertSavedFilterToEntity(filter)
+	query := `UPDATE saved_filters SET name = $2, expression = $3, updated_at = $4 WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, query, entity.ID, entity.Name, entity.Expression, entity.UpdatedAt); err != nil {
+		log.C(ctx).Errorf("failed to update saved filter: %v", err)
+		return fmt.Errorf("failed to update saved filter: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXSavedFilterRepository) Delete(ctx context.Context, id string) error {
+	log.C(ctx).Info("deleting saved filter repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM saved_filters WHERE id = $1`, id); err != nil {
+		log.C(ctx).Errorf("failed to delete saved filter: %v", err)
+		return fmt.Errorf("failed to delete saved filter: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXSavedFilterRepository) selectSavedFilters(ctx context.Context, query string, args ...interface{}) ([]models.SavedFilter, error) {
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	var entities []Entity
+	if err = tx.SelectContext(ctx, &entities, query, args...); err != nil {
+		log.C(ctx).Errorf("failed to get saved filters: %v", err)
+		return nil, fmt.Errorf("failed to get saved filters: %w", err)
+	}
+
+	result := make([]models.SavedFilter, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertSavedFilterToModel(entity))
+	}
+	return result, nil
+}
@@ -0,0 +1,225 @@
+package savedfilters_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/savedfilters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXSavedFilterRepositoryGet(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := savedfilters.NewSQLXSavedFilterRepository()
+
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		setupMocks     func()
+		expectedResult models.SavedFilter
+		expectedError  error
+	}{
+		{
+			name: "Successful get",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, user_id, name, expression, created_at, updated_at FROM saved_filters WHERE id = \\$1").
+					WithArgs("1").
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "user_id", "name", "expression", "created_at", "updated_at"}).
+						AddRow("1", "user", "Urgent", "priority = high", now, now))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: models.SavedFilter{ID: "1", UserID: "user", Name: "Urgent", Expression: "priority = high", CreatedAt: now, UpdatedAt: now},
+		},
+		{
+			name: "Failed get of a missing filter",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("FROM saved_filters WHERE id = \\$1").
+					WithArgs("1").
+					WillReturnError(sql.ErrNoRows)
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("saved filter not found: %w", sql.ErrNoRows),
+		},
+		{
+			name: "Failed get due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("FROM saved_filters WHERE id = \\$1").
+					WithArgs("1").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get saved filter: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.Get(ctx, "1")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXSavedFilterRepositoryFindByName(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := savedfilters.NewSQLXSavedFilterRepository()
+
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		setupMocks     func()
+		expectedResult []models.SavedFilter
+		expectedError  error
+	}{
+		{
+			name: "Successful find",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("FROM saved_filters WHERE user_id = \\$1 AND lower\\(name\\) = lower\\(\\$2\\)").
+					WithArgs("user", "urgent").
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "user_id", "name", "expression", "created_at", "updated_at"}).
+						AddRow("1", "user", "Urgent", "priority = high", now, now))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: []models.SavedFilter{{ID: "1", UserID: "user", Name: "Urgent", Expression: "priority = high", CreatedAt: now, UpdatedAt: now}},
+		},
+		{
+			name: "Failed find due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("FROM saved_filters").
+					WithArgs("user", "urgent").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get saved filters: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.FindByName(ctx, "user", "urgent")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXSavedFilterRepositoryUpdate(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := savedfilters.NewSQLXSavedFilterRepository()
+
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	filter := models.SavedFilter{ID: "1", UserID: "user", Name: "Urgent", Expression: "priority = high", UpdatedAt: now}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful update",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE saved_filters SET name = \\$2, expression = \\$3, updated_at = \\$4 WHERE id = \\$1").
+					WithArgs("1", "Urgent", "priority = high", now).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed update due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE saved_filters").
+					WithArgs("1", "Urgent", "priority = high", now).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to update saved filter: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.Update(ctx, filter)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package savedfilters
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"strings"
+	"time"
+)
+
+const maxFilterNameLength = 100
+
+var (
+	ErrInvalidFilter   = errors.New("invalid saved filter")
+	ErrDuplicateFilter = errors.New("a saved filter with this name already exists")
+	ErrFilterNotFound  = errors.New("saved filter not found")
+)
+
+//go:generate mockery --name=SavedFilterService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type SavedFilterService interface {
+	CreateFilter(ctx context.Context, filter models.SavedFilter) (models.SavedFilter, error)
+	GetFilter(ctx context.Context, id string, userID string) (models.SavedFilter, error)
+	ListFilters(ctx context.Context, userID string) ([]models.SavedFilter, error)
+	UpdateFilter(ctx context.Context, filter models.SavedFilter) (models.SavedFilter, error)
+	DeleteFilter(ctx context.Context, id string, userID string) error
+	FilterTodos(ctx context.Context, id string, userID string, timeZone string, filter models.TodoFilter) ([]models.Todo, string, error)
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ SavedFilterService = &service{}
+
+type service struct {
+	repo        SavedFilterRepository
+	todoService todos.TodoService
+	uuidService UUIDService
+	timeService TimeService
+}
+
+func NewService(repo SavedFilterRepository, todoService todos.TodoService, uuidService UUIDService, timeService TimeService) SavedFilterService {
+	return &service{repo: repo, todoService: todoService, uuidService: uuidService, timeService: timeService}
+}
+
+// CreateFilter saves the filter for its user after checking its expression.
+func (s *service) CreateFilter(ctx context.Context, filter models.SavedFilter) (models.SavedFilter, error) {
+	log.C(ctx).Info("creating saved filter service")
+	filter.Name = strings.TrimSpace(filter.Name)
+	if err := validateFilter(filter); err != nil {
+		return models.SavedFilter{}, err
+	}
+	if err := s.ensureUniqueName(ctx, filter); err != nil {
+		return models.SavedFilter{}, err
+	}
+
+	filter.ID = s.uuidService.Generate()
+	filter.CreatedAt = s.timeService.Now()
+	filter.UpdatedAt = filter.CreatedAt
+	if _, err := s.repo.Create(ctx, filter); err != nil {
+		log.C(ctx).Errorf("creating saved filter %q failed", filter.Name)
+		return models.SavedFilter{}, err
+	}
+	return s.repo.Get(ctx, filter.ID)
+}
+
+// GetFilter returns the saved filter if it belongs to the user. Saved filters
+// of other users are reported as missing.
+func (s *service) GetFilter(ctx context.Context, id string, userID string) (models.SavedFilter, error) {
+	log.C(ctx).Info("getting saved filter service")
+	filter, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.SavedFilter{}, ErrFilterNotFound
+	}
+	if err != nil {
+		log.C(ctx).Errorf("getting saved filter with id %s failed", id)
+		return models.SavedFilter{}, err
+	}
+	if filter.UserID != userID {
+		return models.SavedFilter{}, ErrFilterNotFound
+	}
+	return filter, nil
+}
+
+func (s *service) ListFilters(ctx context.Context, userID string) ([]models.SavedFilter, error) {
+	log.C(ctx).Info("listing saved filters service")
+	return s.repo.GetByUserID(ctx, userID)
+}
+
+// UpdateFilter renames the filter and replaces its expression. The filter's
+// UserID must be set to the user making the change.
+func (s *service) UpdateFilter(ctx context.Context, filter models.SavedFilter) (models.SavedFilter, error) {
+	log.C(ctx).Info("updating saved filter service")
+	existing, err := s.GetFilter(ctx, filter.ID, filter.UserID)
+	if err != nil {
+		return models.SavedFilter{}, err
+	}
+
+	existing.Name = strings.TrimSpace(filter.Name)
+	existing.Expression = filter.Expression
+	if err = validateFilter(existing); err != nil {
+		return models.SavedFilter{}, err
+	}
+	if err = s.ensureUniqueName(ctx, existing); err != nil {
+		return models.SavedFilter{}, err
+	}
+	existing.UpdatedAt = s.timeService.Now()
+	if err = s.repo.Update(ctx, existing); err != nil {
+		log.C(ctx).Errorf("updating saved filter with id %s failed", existing.ID)
+		return models.SavedFilter{}, err
+	}
+	return s.repo.Get(ctx, existing.ID)
+}
+
+func (s *service) DeleteFilter(ctx context.Context, id string, userID string) error {
+	log.C(ctx).Info("deleting saved filter service")
+	if _, err := s.GetFilter(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// FilterTodos returns the todos of the lists the user owns or has accepted
+// that match the saved filter and the given filter, one page at a time. Dates
+// in the expression are resolved in the time zone, which is an IANA name and
+// defaults to UTC.
+func (s *service) FilterTodos(ctx context.Context, id string, userID string, timeZone string, filter models.TodoFilter) ([]models.Todo, string, error) {
+	log.C(ctx).Info("filtering todos by saved filter service")
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: unknown time zone %q", ErrInvalidFilter, timeZone)
+	}
+	saved, err := s.GetFilter(ctx, id, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	expression, err := Parse(saved.Expression)
+	if err != nil {
+		log.C(ctx).Errorf("parsing saved filter with id %s failed: %v", id, err)
+		return nil, "", fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+
+	filter.ListID = ""
+	filter.AccessibleBy = userID
+	filter.Condition = expression.Compile(Env{UserID: userID, Now: s.timeService.Now().In(location)})
+	return s.todoService.FilterTodos(ctx, filter)
+}
+
+func (s *service) ensureUniqueName(ctx context.Context, filter models.SavedFilter) error {
+	others, err := s.repo.FindByName(ctx, filter.UserID, filter.Name)
+	if err != nil {
+		log.C(ctx).Errorf("finding saved filters named %q failed", filter.Name)
+		return err
+	}
+	for _, other := range others {
+		if other.ID != filter.ID {
+			return ErrDuplicateFilter
+		}
+	}
+	return nil
+}
+
+func validateFilter(filter models.SavedFilter) error {
+	if filter.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidFilter)
+	}
+	if len([]rune(filter.Name)) > maxFilterNameLength {
+		return fmt.Errorf("%w: name must be at most %d characters", ErrInvalidFilter, maxFilterNameLength)
+	}
+	if _, err := Parse(filter.Expression); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package savedfilters_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/savedfilters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/savedfilters/automock"
+	todoautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/todos/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestServiceCreateFilter(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	userID := "user"
+
+	created := models.SavedFilter{ID: "1", UserID: userID, Name: "Urgent", Expression: "priority = high", CreatedAt: now, UpdatedAt: now}
+
+	tests := []struct {
+		name          string
+		input         models.SavedFilter
+		repo          func() *automock.SavedFilterRepository
+		expected      models.SavedFilter
+		expectedError error
+	}{
+		{
+			name:  "Create a filter with a trimmed name",
+			input: models.SavedFilter{UserID: userID, Name: " Urgent ", Expression: "priority = high"},
+			repo: func() *automock.SavedFilterRepository {
+				repo := &automock.SavedFilterRepository{}
+				repo.EXPECT().FindByName(ctx, userID, "Urgent").Return(nil, nil).Once()
+				repo.EXPECT().Create(ctx, created).Return(created.ID, nil).Once()
+				repo.EXPECT().Get(ctx, created.ID).Return(created, nil).Once()
+				return repo
+			},
+			expected: created,
+		},
+		{
+			name:  "Error when the user already has a filter with the name",
+			input: models.SavedFilter{UserID: userID, Name: "Urgent", Expression: "priority = high"},
+			repo: func() *automock.SavedFilterRepository {
+				repo := &automock.SavedFilterRepository{}
+				repo.EXPECT().FindByName(ctx, userID, "Urgent").Return([]models.SavedFilter{{ID: "2", UserID: userID, Name: "urgent"}}, nil).Once()
+				return repo
+			},
+			expectedError: savedfilters.ErrDuplicateFilter,
+		},
+		{
+			name:          "Error when the name is blank",
+			input:         models.SavedFilter{UserID: userID, Name: "  ", Expression: "priority = high"},
+			repo:          func() *automock.SavedFilterRepository { return &automock.SavedFilterRepository{} },
+			expectedError: savedfilters.ErrInvalidFilter,
+		},
+		{
+			name:          "Error when the expression does not parse",
+			input:         models.SavedFilter{UserID: userID, Name: "Urgent", Expression: "priority = urgent"},
+			repo:          func() *automock.SavedFilterRepository { return &automock.SavedFilterRepository{} },
+			expectedError: savedfilters.ErrInvalidFilter,
+		},
+		{
+			name:  "Error when creating fails",
+			input: models.SavedFilter{UserID: userID, Name: "Urgent", Expression: "priority = high"},
+			repo: func() *automock.SavedFilterRepository {
+				repo := &automock.SavedFilterRepository{}
+				repo.EXPECT().FindByName(ctx, userID, "Urgent").Return(nil, nil).Once()
+				repo.EXPECT().Create(ctx, mock.Anything).Return("", err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(created.ID).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := savedfilters.NewService(repo, &todoautomock.TodoService{}, uuidService, timeService)
+			result, err := svc.CreateFilter(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateFilter(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	userID := "user"
+
+	existing := models.SavedFilter{ID: "1", UserID: userID, Name: "Urgent", Expression: "priority = high", CreatedAt: created, UpdatedAt: created}
+	updated := models.SavedFilter{ID: "1", UserID: userID, Name: "Soon", Expression: "due <= today+3d", CreatedAt: created, UpdatedAt: now}
+
+	tests := []struct {
+		name          string
+		input         models.SavedFilter
+		repo          func() *automock.SavedFilterRepository
+		expected      models.SavedFilter
+		expectedError error
+	}{
+		{
+			name:  "Rename and replace the expression",
+			input: models.SavedFilter{ID: "1", UserID: userID, Name: "Soon", Expression: "due <= today+3d"},
+			repo: func() *automock.SavedFilterRepository {
+				repo := &automock.SavedFilterRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(existing, nil).Once()
+				repo.EXPECT().FindByName(ctx, userID, "Soon").Return(nil, nil).Once()
+				repo.EXPECT().Update(ctx, updated).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(updated, nil).Once()
+				return repo
+			},
+			expected: updated,
+		},
+		{
+			name:  "Keeping the name is not a duplicate",
+			input: models.SavedFilter{ID: "1", UserID: userID, Name: "urgent", Expression: "priority = high"},
+			repo: func() *automock.SavedFilterRepository {
+				repo := &automock.SavedFilterRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(existing, nil).Once()
+				repo.EXPECT().FindByName(ctx, userID, "urgent").Return([]models.SavedFilter{existing}, nil).Once()
+				repo.EXPECT().Update(ctx, mock.Anything).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(existing, nil).Once()
+				return repo
+			},
+			expected: existing,
+		},
+		{
+			name:  "Error when the filter belongs to another user",
+			input: models.SavedFilter{ID: "1", UserID: "other", Name: "Soon", Expression: "due <= today"},
+			repo: func() *automock.SavedFilterRepository {
+				repo := &automock.SavedFilterRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(existing, nil).Once()
+				return repo
+			},
+			expectedError: savedfilters.ErrFilterNotFound,
+		},
+		{
+			name:  "Error when the filter does not exist",
+			input: models.SavedFilter{ID: "1", UserID: userID, Name: "Soon", Expression: "due <= today"},
+			repo: func() *automock.SavedFilterRepository {
+				repo := &automock.SavedFilterRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(models.SavedFilter{}, fmt.Errorf("saved filter not found: %w", sql.ErrNoRows)).Once()
+				return repo
+			},
+			expectedError: savedfilters.ErrFilterNotFound,
+		},
+		{
+			name:  "Error when the expression does not parse",
+			input: models.SavedFilter{ID: "1", UserID: userID, Name: "Soon", Expression: "due <="},
+			repo: func() *automock.SavedFilterRepository {
+				repo := &automock.SavedFilterRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(existing, nil).Once()
+				return repo
+			},
+			expectedError: savedfilters.ErrInvalidFilter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := savedfilters.NewService(repo, &todoautomock.TodoService{}, &automock.UUIDService{}, timeService)
+			result, err := svc.UpdateFilter(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceFilterTodos(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	userID := "user"
+
+	saved := models.SavedFilter{ID: "1", UserID: userID, Name: "Urgent", Expression: "priority = high"}
+	todos := []models.Todo{{ID: "todo", Title: "Ship it"}}
+
+	tests := []struct {
+		name          string
+		timeZone      string
+		input         models.TodoFilter
+		repo          func() *automock.SavedFilterRepository
+		todoService   func() *todoautomock.TodoService
+		expected      []models.Todo
+		expectedNext  string
+		expectedError error
+	}{
+		{
+			name:  "Scope the filter to accessible lists",
+			input: models.TodoFilter{ListID: "list", Page: models.Page{Limit: 10}},
+			repo: func() *automock.SavedFilterRepository {
+				repo := &automock.SavedFilterRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(saved, nil).Once()
+				return repo
+			},
+			todoService: func() *todoautomock.TodoService {
+				todoService := &todoautomock.TodoService{}
+				todoService.EXPECT().FilterTodos(ctx, mock.MatchedBy(func(filter models.TodoFilter) bool {
+					return filter.ListID == "" && filter.AccessibleBy == userID && filter.Condition != nil && filter.Limit == 10
+				})).Return(todos, "next", nil).Once()
+				return todoService
+			},
+			expected:     todos,
+			expectedNext: "next",
+		},
+		{
+			name:     "Error when the time zone is unknown",
+			timeZone: "Mars/Olympus",
+			repo:     func() *automock.SavedFilterRepository { return &automock.SavedFilterRepository{} },
+			todoService: func() *todoautomock.TodoService {
+				return &todoautomock.TodoService{}
+			},
+			expectedError: savedfilters.ErrInvalidFilter,
+		},
+		{
+			name: "Error when the filter belongs to another user",
+			repo: func() *automock.SavedFilterRepository {
+				repo := &automock.SavedFilterRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(models.SavedFilter{ID: "1", UserID: "other", Expression: "priority = high"}, nil).Once()
+				return repo
+			},
+			todoService: func() *todoautomock.TodoService {
+				return &todoautomock.TodoService{}
+			},
+			expectedError: savedfilters.ErrFilterNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			todoService := tt.todoService()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo, todoService)
+
+			svc := savedfilters.NewService(repo, todoService, &automock.UUIDService{}, timeService)
+			result, next, err := svc.FilterTodos(ctx, "1", userID, tt.timeZone, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+				assert.Equal(t, tt.expectedNext, next)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchRepository is an autogenerated mock type for the SearchRepository type
+type SearchRepository struct {
+	mock.Mock
+}
+
+type SearchRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SearchRepository) EXPECT() *SearchRepository_Expecter {
+	return &SearchRepository_Expecter{mock: &_m.Mock}
+}
+
+// Search provides a mock function with given fields: ctx, userID, query, limit
+func (_m *SearchRepository) Search(ctx context.Context, userID string, query string, limit int) ([]models.SearchResult, error) {
+	ret := _m.Called(ctx, userID, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []models.SearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) ([]models.SearchResult, error)); ok {
+		return rf(ctx, userID, query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) []models.SearchResult); ok {
+		r0 = rf(ctx, userID, query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.SearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, userID, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type SearchRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - query string
+//   - limit int
+func (_e *SearchRepository_Expecter) Search(ctx interface{}, userID interface{}, query interface{}, limit interface{}) *SearchRepository_Search_Call {
+	return &SearchRepository_Search_Call{Call: _e.mock.On("Search", ctx, userID, query, limit)}
+}
+
+func (_c *SearchRepository_Search_Call) Run(run func(ctx context.Context, userID string, query string, limit int)) *SearchRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SearchRepository_Search_Call) Return(_a0 []models.SearchResult, _a1 error) *SearchRepository_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SearchRepository_Search_Call) RunAndReturn(run func(context.Context, string, string, int) ([]models.SearchResult, error)) *SearchRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSearchRepository creates a new instance of SearchRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSearchRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SearchRepository {
+	mock := &SearchRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,40 @@
+package search
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"html"
+	"strings"
+)
+
+// snippetStart and snippetStop are the markers ts_headline puts around matched
+// words. They are control characters stripped from the text beforehand, so they
+// can only come from ts_headline itself.
+const (
+	snippetStart = "\x02"
+	snippetStop  = "\x03"
+)
+
+var highlighter = strings.NewReplacer(snippetStart, "<b>", snippetStop, "</b>")
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertResultToModel(entity ResultEntity) models.SearchResult {
+	return models.SearchResult{
+		Type:    entity.Type,
+		ID:      entity.ID,
+		ListID:  entity.ListID,
+		Title:   entity.Title,
+		Snippet: highlightSnippet(entity.Snippet),
+		Rank:    entity.Rank,
+	}
+}
+
+// highlightSnippet HTML-escapes the snippet and then wraps the matched words in
+// <b> tags, so user-written text can't inject markup.
+func highlightSnippet(snippet string) string {
+	return highlighter.Replace(html.EscapeString(snippet))
+}
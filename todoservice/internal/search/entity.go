@@ -0,0 +1,12 @@
+package search
+
+import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+
+type ResultEntity struct {
+	Type    constants.SearchResultType `db:"type"`
+	ID      string                     `db:"id"`
+	ListID  string                     `db:"list_id"`
+	Title   string                     `db:"title"`
+	Snippet string                     `db:"snippet"`
+	Rank    float64                    `db:"rank"`
+}
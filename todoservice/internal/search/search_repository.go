@@ -0,0 +1,76 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+//go:generate mockery --name=SearchRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type SearchRepository interface {
+	Search(ctx context.Context, userID string, query string, limit int) ([]models.SearchResult, error)
+}
+
+type SQLXSearchRepository struct {
+	converter *Converter
+}
+
+var _ SearchRepository = &SQLXSearchRepository{}
+
+func NewSQLXSearchRepository() SearchRepository {
+	return &SQLXSearchRepository{converter: NewConverter()}
+}
+
+// Search matches the query against the search vectors of todos and lists and
+// returns the best ranked results. Only lists the user owns or has accepted
+// access to, and the todos in them, are searched. Matches in the snippet are
+// marked with control characters rather than HTML so the converter can escape
+// the user's text before highlighting them.
+func (r *SQLXSearchRepository) Search(ctx context.Context, userID string, query string, limit int) ([]models.SearchResult, error) {
+	log.C(ctx).Info("searching repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	searchQuery := `
+		WITH accessible AS (
+			SELECT list_id FROM list_access
+			WHERE user_id = $1 AND status IN ('owner', 'accepted')
+		), q AS (
+			SELECT websearch_to_tsquery('english', $2) AS query
+		)
+		SELECT 'todo' AS type, t.id, t.list_id, t.title,
+		       ts_headline('english', translate(t.title || ' ' || COALESCE(t.description, ''), chr(2) || chr(3), ''), q.query,
+		                   'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=2') AS snippet,
+		       ts_rank(t.search_vector, q.query) AS rank
+		FROM todos t, q
+		WHERE t.list_id IN (SELECT list_id FROM accessible) AND t.deleted_at IS NULL AND t.search_vector @@ q.query
+		UNION ALL
+		SELECT 'list' AS type, l.id, l.id AS list_id, l.name AS title,
+		       ts_headline('english', translate(l.name || ' ' || COALESCE(l.description, ''), chr(2) || chr(3), ''), q.query,
+		                   'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=2') AS snippet,
+		       ts_rank(l.search_vector, q.query) AS rank
+		FROM lists l, q
+		WHERE l.id IN (SELECT list_id FROM accessible) AND l.deleted_at IS NULL AND l.search_vector @@ q.query
+		ORDER BY rank DESC, title
+		LIMIT $3
+	`
+
+	var entities []ResultEntity
+	err = tx.SelectContext(ctx, &entities, searchQuery, userID, query, limit)
+	if err != nil {
+		log.C(ctx).Errorf("failed to search: %v", err)
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+
+	results := make([]models.SearchResult, 0, len(entities))
+	for _, entity := range entities {
+		results = append(results, r.converter.ConvertResultToModel(entity))
+	}
+	log.C(ctx).Debugf("found %d search results", len(results))
+	return results, nil
+}
@@ -0,0 +1,98 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/search"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+)
+
+func TestSQLXSearchRepositorySearch(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := search.NewSQLXSearchRepository()
+
+	testCases := []struct {
+		name            string
+		setupMocks      func()
+		expectedResults []models.SearchResult
+		expectedError   error
+	}{
+		{
+			name: "Successful search",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("WITH accessible AS (.+) FROM todos t, q (.+) FROM lists l, q (.+) LIMIT \\$3").
+					WithArgs("user", "groceries", 20).
+					WillReturnRows(sqlxmock.NewRows([]string{"type", "id", "list_id", "title", "snippet", "rank"}).
+						AddRow("list", "2", "2", "Groceries", "\x02Groceries\x03", 0.6).
+						AddRow("todo", "1", "2", "Buy milk", "Buy milk for \x02groceries\x03", 0.3))
+				mockDB.ExpectCommit()
+			},
+			expectedResults: []models.SearchResult{
+				{Type: constants.SearchResultList, ID: "2", ListID: "2", Title: "Groceries", Snippet: "<b>Groceries</b>", Rank: 0.6},
+				{Type: constants.SearchResultTodo, ID: "1", ListID: "2", Title: "Buy milk", Snippet: "Buy milk for <b>groceries</b>", Rank: 0.3},
+			},
+		},
+		{
+			name: "Successful search escapes user text in snippets",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("WITH accessible AS (.+)").
+					WithArgs("user", "groceries", 20).
+					WillReturnRows(sqlxmock.NewRows([]string{"type", "id", "list_id", "title", "snippet", "rank"}).
+						AddRow("todo", "1", "2", "<script>", "<script>alert(1)</script> \x02groceries\x03 & more", 0.3))
+				mockDB.ExpectCommit()
+			},
+			expectedResults: []models.SearchResult{
+				{Type: constants.SearchResultTodo, ID: "1", ListID: "2", Title: "<script>", Snippet: "&lt;script&gt;alert(1)&lt;/script&gt; <b>groceries</b> &amp; more", Rank: 0.3},
+			},
+		},
+		{
+			name: "Failed search due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("WITH accessible AS (.+)").
+					WithArgs("user", "groceries", 20).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to search: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			results, err := repo.Search(ctx, "user", "groceries", 20)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedResults, results)
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
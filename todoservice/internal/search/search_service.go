@@ -0,0 +1,46 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"strings"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var ErrEmptyQuery = errors.New("search query must not be empty")
+
+//go:generate mockery --name=SearchService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type SearchService interface {
+	Search(ctx context.Context, userID string, query string, limit int) ([]models.SearchResult, error)
+}
+
+var _ SearchService = &service{}
+
+type service struct {
+	repo SearchRepository
+}
+
+func NewService(repo SearchRepository) SearchService {
+	return &service{repo: repo}
+}
+
+func (s *service) Search(ctx context.Context, userID string, query string, limit int) ([]models.SearchResult, error) {
+	log.C(ctx).Info("searching service")
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ErrEmptyQuery
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	return s.repo.Search(ctx, userID, query, limit)
+}
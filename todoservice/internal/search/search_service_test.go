@@ -0,0 +1,84 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/search"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/search/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestServiceSearch(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	results := []models.SearchResult{{ID: "1", Title: "Buy milk"}}
+
+	tests := []struct {
+		name            string
+		query           string
+		limit           int
+		repo            func() *automock.SearchRepository
+		expectedResults []models.SearchResult
+		expectedError   error
+	}{
+		{
+			name:  "Search with the default limit",
+			query: "  milk ",
+			repo: func() *automock.SearchRepository {
+				repo := &automock.SearchRepository{}
+				repo.EXPECT().Search(ctx, "user", "milk", search.DefaultLimit).Return(results, nil).Once()
+				return repo
+			},
+			expectedResults: results,
+		},
+		{
+			name:  "Limit is capped",
+			query: "milk",
+			limit: 1000,
+			repo: func() *automock.SearchRepository {
+				repo := &automock.SearchRepository{}
+				repo.EXPECT().Search(ctx, "user", "milk", search.MaxLimit).Return(results, nil).Once()
+				return repo
+			},
+			expectedResults: results,
+		},
+		{
+			name:  "Error for empty query",
+			query: "   ",
+			repo: func() *automock.SearchRepository {
+				return &automock.SearchRepository{}
+			},
+			expectedError: search.ErrEmptyQuery,
+		},
+		{
+			name:  "Error from the repository",
+			query: "milk",
+			limit: 5,
+			repo: func() *automock.SearchRepository {
+				repo := &automock.SearchRepository{}
+				repo.EXPECT().Search(ctx, "user", "milk", 5).Return(nil, err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := search.NewService(repo)
+			result, err := svc.Search(ctx, "user", tt.query, tt.limit)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResults, result)
+			}
+		})
+	}
+}
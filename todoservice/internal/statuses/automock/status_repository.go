@@ -0,0 +1,399 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatusRepository is an autogenerated mock type for the StatusRepository type
+type StatusRepository struct {
+	mock.Mock
+}
+
+type StatusRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatusRepository) EXPECT() *StatusRepository_Expecter {
+	return &StatusRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, status
+func (_m *StatusRepository) Create(ctx context.Context, status models.WorkflowStatus) (string, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.WorkflowStatus) (string, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.WorkflowStatus) string); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.WorkflowStatus) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type StatusRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status models.WorkflowStatus
+func (_e *StatusRepository_Expecter) Create(ctx interface{}, status interface{}) *StatusRepository_Create_Call {
+	return &StatusRepository_Create_Call{Call: _e.mock.On("Create", ctx, status)}
+}
+
+func (_c *StatusRepository_Create_Call) Run(run func(ctx context.Context, status models.WorkflowStatus)) *StatusRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.WorkflowStatus))
+	})
+	return _c
+}
+
+func (_c *StatusRepository_Create_Call) Return(_a0 string, _a1 error) *StatusRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusRepository_Create_Call) RunAndReturn(run func(context.Context, models.WorkflowStatus) (string, error)) *StatusRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *StatusRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type StatusRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *StatusRepository_Expecter) Delete(ctx interface{}, id interface{}) *StatusRepository_Delete_Call {
+	return &StatusRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *StatusRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *StatusRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StatusRepository_Delete_Call) Return(_a0 error) *StatusRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *StatusRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *StatusRepository) Get(ctx context.Context, id string) (models.WorkflowStatus, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 models.WorkflowStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.WorkflowStatus, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.WorkflowStatus); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.WorkflowStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type StatusRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *StatusRepository_Expecter) Get(ctx interface{}, id interface{}) *StatusRepository_Get_Call {
+	return &StatusRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *StatusRepository_Get_Call) Run(run func(ctx context.Context, id string)) *StatusRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StatusRepository_Get_Call) Return(_a0 models.WorkflowStatus, _a1 error) *StatusRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusRepository_Get_Call) RunAndReturn(run func(context.Context, string) (models.WorkflowStatus, error)) *StatusRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByListID provides a mock function with given fields: ctx, listID
+func (_m *StatusRepository) GetByListID(ctx context.Context, listID string) ([]models.WorkflowStatus, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByListID")
+	}
+
+	var r0 []models.WorkflowStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.WorkflowStatus, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.WorkflowStatus); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WorkflowStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusRepository_GetByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByListID'
+type StatusRepository_GetByListID_Call struct {
+	*mock.Call
+}
+
+// GetByListID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *StatusRepository_Expecter) GetByListID(ctx interface{}, listID interface{}) *StatusRepository_GetByListID_Call {
+	return &StatusRepository_GetByListID_Call{Call: _e.mock.On("GetByListID", ctx, listID)}
+}
+
+func (_c *StatusRepository_GetByListID_Call) Run(run func(ctx context.Context, listID string)) *StatusRepository_GetByListID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StatusRepository_GetByListID_Call) Return(_a0 []models.WorkflowStatus, _a1 error) *StatusRepository_GetByListID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusRepository_GetByListID_Call) RunAndReturn(run func(context.Context, string) ([]models.WorkflowStatus, error)) *StatusRepository_GetByListID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveTodos provides a mock function with given fields: ctx, fromID, toID
+func (_m *StatusRepository) MoveTodos(ctx context.Context, fromID string, toID string) error {
+	ret := _m.Called(ctx, fromID, toID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTodos")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, fromID, toID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusRepository_MoveTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTodos'
+type StatusRepository_MoveTodos_Call struct {
+	*mock.Call
+}
+
+// MoveTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromID string
+//   - toID string
+func (_e *StatusRepository_Expecter) MoveTodos(ctx interface{}, fromID interface{}, toID interface{}) *StatusRepository_MoveTodos_Call {
+	return &StatusRepository_MoveTodos_Call{Call: _e.mock.On("MoveTodos", ctx, fromID, toID)}
+}
+
+func (_c *StatusRepository_MoveTodos_Call) Run(run func(ctx context.Context, fromID string, toID string)) *StatusRepository_MoveTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *StatusRepository_MoveTodos_Call) Return(_a0 error) *StatusRepository_MoveTodos_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusRepository_MoveTodos_Call) RunAndReturn(run func(context.Context, string, string) error) *StatusRepository_MoveTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, status
+func (_m *StatusRepository) Update(ctx context.Context, status models.WorkflowStatus) error {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.WorkflowStatus) error); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type StatusRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status models.WorkflowStatus
+func (_e *StatusRepository_Expecter) Update(ctx interface{}, status interface{}) *StatusRepository_Update_Call {
+	return &StatusRepository_Update_Call{Call: _e.mock.On("Update", ctx, status)}
+}
+
+func (_c *StatusRepository_Update_Call) Run(run func(ctx context.Context, status models.WorkflowStatus)) *StatusRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.WorkflowStatus))
+	})
+	return _c
+}
+
+func (_c *StatusRepository_Update_Call) Return(_a0 error) *StatusRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusRepository_Update_Call) RunAndReturn(run func(context.Context, models.WorkflowStatus) error) *StatusRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePositions provides a mock function with given fields: ctx, ids
+func (_m *StatusRepository) UpdatePositions(ctx context.Context, ids []string) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusRepository_UpdatePositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePositions'
+type StatusRepository_UpdatePositions_Call struct {
+	*mock.Call
+}
+
+// UpdatePositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *StatusRepository_Expecter) UpdatePositions(ctx interface{}, ids interface{}) *StatusRepository_UpdatePositions_Call {
+	return &StatusRepository_UpdatePositions_Call{Call: _e.mock.On("UpdatePositions", ctx, ids)}
+}
+
+func (_c *StatusRepository_UpdatePositions_Call) Run(run func(ctx context.Context, ids []string)) *StatusRepository_UpdatePositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *StatusRepository_UpdatePositions_Call) Return(_a0 error) *StatusRepository_UpdatePositions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusRepository_UpdatePositions_Call) RunAndReturn(run func(context.Context, []string) error) *StatusRepository_UpdatePositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatusRepository creates a new instance of StatusRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatusRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatusRepository {
+	mock := &StatusRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
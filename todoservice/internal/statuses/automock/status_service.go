@@ -0,0 +1,424 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatusService is an autogenerated mock type for the StatusService type
+type StatusService struct {
+	mock.Mock
+}
+
+type StatusService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatusService) EXPECT() *StatusService_Expecter {
+	return &StatusService_Expecter{mock: &_m.Mock}
+}
+
+// CreateDefaultStatuses provides a mock function with given fields: ctx, listID
+func (_m *StatusService) CreateDefaultStatuses(ctx context.Context, listID string) error {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDefaultStatuses")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusService_CreateDefaultStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDefaultStatuses'
+type StatusService_CreateDefaultStatuses_Call struct {
+	*mock.Call
+}
+
+// CreateDefaultStatuses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *StatusService_Expecter) CreateDefaultStatuses(ctx interface{}, listID interface{}) *StatusService_CreateDefaultStatuses_Call {
+	return &StatusService_CreateDefaultStatuses_Call{Call: _e.mock.On("CreateDefaultStatuses", ctx, listID)}
+}
+
+func (_c *StatusService_CreateDefaultStatuses_Call) Run(run func(ctx context.Context, listID string)) *StatusService_CreateDefaultStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StatusService_CreateDefaultStatuses_Call) Return(_a0 error) *StatusService_CreateDefaultStatuses_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusService_CreateDefaultStatuses_Call) RunAndReturn(run func(context.Context, string) error) *StatusService_CreateDefaultStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStatus provides a mock function with given fields: ctx, status
+func (_m *StatusService) CreateStatus(ctx context.Context, status models.WorkflowStatus) (models.WorkflowStatus, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStatus")
+	}
+
+	var r0 models.WorkflowStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.WorkflowStatus) (models.WorkflowStatus, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.WorkflowStatus) models.WorkflowStatus); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Get(0).(models.WorkflowStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.WorkflowStatus) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusService_CreateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStatus'
+type StatusService_CreateStatus_Call struct {
+	*mock.Call
+}
+
+// CreateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status models.WorkflowStatus
+func (_e *StatusService_Expecter) CreateStatus(ctx interface{}, status interface{}) *StatusService_CreateStatus_Call {
+	return &StatusService_CreateStatus_Call{Call: _e.mock.On("CreateStatus", ctx, status)}
+}
+
+func (_c *StatusService_CreateStatus_Call) Run(run func(ctx context.Context, status models.WorkflowStatus)) *StatusService_CreateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.WorkflowStatus))
+	})
+	return _c
+}
+
+func (_c *StatusService_CreateStatus_Call) Return(_a0 models.WorkflowStatus, _a1 error) *StatusService_CreateStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusService_CreateStatus_Call) RunAndReturn(run func(context.Context, models.WorkflowStatus) (models.WorkflowStatus, error)) *StatusService_CreateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStatus provides a mock function with given fields: ctx, id, listID, moveTo
+func (_m *StatusService) DeleteStatus(ctx context.Context, id string, listID string, moveTo string) error {
+	ret := _m.Called(ctx, id, listID, moveTo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, id, listID, moveTo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatusService_DeleteStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStatus'
+type StatusService_DeleteStatus_Call struct {
+	*mock.Call
+}
+
+// DeleteStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - listID string
+//   - moveTo string
+func (_e *StatusService_Expecter) DeleteStatus(ctx interface{}, id interface{}, listID interface{}, moveTo interface{}) *StatusService_DeleteStatus_Call {
+	return &StatusService_DeleteStatus_Call{Call: _e.mock.On("DeleteStatus", ctx, id, listID, moveTo)}
+}
+
+func (_c *StatusService_DeleteStatus_Call) Run(run func(ctx context.Context, id string, listID string, moveTo string)) *StatusService_DeleteStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *StatusService_DeleteStatus_Call) Return(_a0 error) *StatusService_DeleteStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatusService_DeleteStatus_Call) RunAndReturn(run func(context.Context, string, string, string) error) *StatusService_DeleteStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatus provides a mock function with given fields: ctx, id, listID
+func (_m *StatusService) GetStatus(ctx context.Context, id string, listID string) (models.WorkflowStatus, error) {
+	ret := _m.Called(ctx, id, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 models.WorkflowStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.WorkflowStatus, error)); ok {
+		return rf(ctx, id, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.WorkflowStatus); ok {
+		r0 = rf(ctx, id, listID)
+	} else {
+		r0 = ret.Get(0).(models.WorkflowStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusService_GetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatus'
+type StatusService_GetStatus_Call struct {
+	*mock.Call
+}
+
+// GetStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - listID string
+func (_e *StatusService_Expecter) GetStatus(ctx interface{}, id interface{}, listID interface{}) *StatusService_GetStatus_Call {
+	return &StatusService_GetStatus_Call{Call: _e.mock.On("GetStatus", ctx, id, listID)}
+}
+
+func (_c *StatusService_GetStatus_Call) Run(run func(ctx context.Context, id string, listID string)) *StatusService_GetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *StatusService_GetStatus_Call) Return(_a0 models.WorkflowStatus, _a1 error) *StatusService_GetStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusService_GetStatus_Call) RunAndReturn(run func(context.Context, string, string) (models.WorkflowStatus, error)) *StatusService_GetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStatuses provides a mock function with given fields: ctx, listID
+func (_m *StatusService) ListStatuses(ctx context.Context, listID string) ([]models.WorkflowStatus, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatuses")
+	}
+
+	var r0 []models.WorkflowStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.WorkflowStatus, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.WorkflowStatus); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WorkflowStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusService_ListStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStatuses'
+type StatusService_ListStatuses_Call struct {
+	*mock.Call
+}
+
+// ListStatuses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *StatusService_Expecter) ListStatuses(ctx interface{}, listID interface{}) *StatusService_ListStatuses_Call {
+	return &StatusService_ListStatuses_Call{Call: _e.mock.On("ListStatuses", ctx, listID)}
+}
+
+func (_c *StatusService_ListStatuses_Call) Run(run func(ctx context.Context, listID string)) *StatusService_ListStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StatusService_ListStatuses_Call) Return(_a0 []models.WorkflowStatus, _a1 error) *StatusService_ListStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusService_ListStatuses_Call) RunAndReturn(run func(context.Context, string) ([]models.WorkflowStatus, error)) *StatusService_ListStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderStatuses provides a mock function with given fields: ctx, listID, ids
+func (_m *StatusService) ReorderStatuses(ctx context.Context, listID string, ids []string) ([]models.WorkflowStatus, error) {
+	ret := _m.Called(ctx, listID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderStatuses")
+	}
+
+	var r0 []models.WorkflowStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]models.WorkflowStatus, error)); ok {
+		return rf(ctx, listID, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []models.WorkflowStatus); ok {
+		r0 = rf(ctx, listID, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WorkflowStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, listID, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusService_ReorderStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderStatuses'
+type StatusService_ReorderStatuses_Call struct {
+	*mock.Call
+}
+
+// ReorderStatuses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - ids []string
+func (_e *StatusService_Expecter) ReorderStatuses(ctx interface{}, listID interface{}, ids interface{}) *StatusService_ReorderStatuses_Call {
+	return &StatusService_ReorderStatuses_Call{Call: _e.mock.On("ReorderStatuses", ctx, listID, ids)}
+}
+
+func (_c *StatusService_ReorderStatuses_Call) Run(run func(ctx context.Context, listID string, ids []string)) *StatusService_ReorderStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *StatusService_ReorderStatuses_Call) Return(_a0 []models.WorkflowStatus, _a1 error) *StatusService_ReorderStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusService_ReorderStatuses_Call) RunAndReturn(run func(context.Context, string, []string) ([]models.WorkflowStatus, error)) *StatusService_ReorderStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, status
+func (_m *StatusService) UpdateStatus(ctx context.Context, status models.WorkflowStatus) (models.WorkflowStatus, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 models.WorkflowStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.WorkflowStatus) (models.WorkflowStatus, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.WorkflowStatus) models.WorkflowStatus); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Get(0).(models.WorkflowStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.WorkflowStatus) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusService_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type StatusService_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status models.WorkflowStatus
+func (_e *StatusService_Expecter) UpdateStatus(ctx interface{}, status interface{}) *StatusService_UpdateStatus_Call {
+	return &StatusService_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, status)}
+}
+
+func (_c *StatusService_UpdateStatus_Call) Run(run func(ctx context.Context, status models.WorkflowStatus)) *StatusService_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.WorkflowStatus))
+	})
+	return _c
+}
+
+func (_c *StatusService_UpdateStatus_Call) Return(_a0 models.WorkflowStatus, _a1 error) *StatusService_UpdateStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatusService_UpdateStatus_Call) RunAndReturn(run func(context.Context, models.WorkflowStatus) (models.WorkflowStatus, error)) *StatusService_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatusService creates a new instance of StatusService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatusService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatusService {
+	mock := &StatusService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
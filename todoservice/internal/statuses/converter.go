@@ -0,0 +1,31 @@
+package statuses
+
+import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertStatusToModel(entity Entity) models.WorkflowStatus {
+	return models.WorkflowStatus{
+		ID:        entity.ID,
+		ListID:    entity.ListID,
+		Name:      entity.Name,
+		Category:  entity.Category,
+		Position:  entity.Position,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func (c *Converter) ConvertStatusToEntity(status models.WorkflowStatus) Entity {
+	return Entity{
+		ID:        status.ID,
+		ListID:    status.ListID,
+		Name:      status.Name,
+		Category:  status.Category,
+		Position:  status.Position,
+		CreatedAt: status.CreatedAt,
+	}
+}
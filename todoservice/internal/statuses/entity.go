@@ -0,0 +1,15 @@
+package statuses
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+type Entity struct {
+	ID        string                   `db:"id"`
+	ListID    string                   `db:"list_id"`
+	Name      string                   `db:"name"`
+	Category  constants.StatusCategory `db:"category"`
+	Position  int                      `db:"position"`
+	CreatedAt time.Time                `db:"created_at"`
+}
@@ -0,0 +1,188 @@
+package statuses
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+)
+
+//go:generate mockery --name=StatusRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type StatusRepository interface {
+	Create(ctx context.Context, status models.WorkflowStatus) (string, error)
+	Get(ctx context.Context, id string) (models.WorkflowStatus, error)
+	GetByListID(ctx context.Context, listID string) ([]models.WorkflowStatus, error)
+	Update(ctx context.Context, status models.WorkflowStatus) error
+	Delete(ctx context.Context, id string) error
+	UpdatePositions(ctx context.Context, ids []string) error
+	MoveTodos(ctx context.Context, fromID string, toID string) error
+}
+
+const selectStatuses = `
+	SELECT id, list_id, name, category, position, created_at
+	FROM list_statuses
+`
+
+type SQLXStatusRepository struct {
+	converter *Converter
+}
+
+var _ StatusRepository = &SQLXStatusRepository{}
+
+func NewSQLXStatusRepository() StatusRepository {
+	return &SQLXStatusRepository{converter: NewConverter()}
+}
+
+func (r *SQLXStatusRepository) Create(ctx context.Context, status models.WorkflowStatus) (string, error) {
+	log.C(ctx).Info("creating status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertStatusToEntity(status)
+	if err = pkg.ValidateUUID(entity.ID); err != nil {
+		log.C(ctx).Errorf("invalid entity id: %v", err)
+		return "", fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	query := `
+		INSERT INTO list_statuses (id, list_id, name, category, position, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query,
+		entity.ID,
+		entity.ListID,
+		entity.Name,
+		entity.Category,
+		entity.Position,
+		entity.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to insert status: %v", err)
+		return "", fmt.Errorf("failed to create status: %w", err)
+	}
+	return id, nil
+}
+
+func (r *SQLXStatusRepository) Get(ctx context.Context, id string) (models.WorkflowStatus, error) {
+	log.C(ctx).Info("getting status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.WorkflowStatus{}, err
+	}
+
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, selectStatuses+`WHERE id = $1`, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get status: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.WorkflowStatus{}, fmt.Errorf("status not found: %w", err)
+		}
+		return models.WorkflowStatus{}, fmt.Errorf("failed to get status: %w", err)
+	}
+	return r.converter.ConvertStatusToModel(entity), nil
+}
+
+func (r *SQLXStatusRepository) GetByListID(ctx context.Context, listID string) ([]models.WorkflowStatus, error) {
+	log.C(ctx).Info("getting statuses of list repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, selectStatuses+`WHERE list_id = $1 ORDER BY position, id`, listID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get statuses: %v", err)
+		return nil, fmt.Errorf("failed to get statuses: %w", err)
+	}
+
+	result := make([]models.WorkflowStatus, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertStatusToModel(entity))
+	}
+	return result, nil
+}
+
+// Update renames the status. The category of a status never changes.
+func (r *SQLXStatusRepository) Update(ctx context.Context, status models.WorkflowStatus) error {
+	log.C(ctx).Info("updating status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `UPDATE list_statuses SET name = $2 WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, query, status.ID, status.Name); err != nil {
+		log.C(ctx).Errorf("failed to update status: %v", err)
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXStatusRepository) Delete(ctx context.Context, id string) error {
+	log.C(ctx).Info("deleting status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM list_statuses WHERE id = $1`, id); err != nil {
+		log.C(ctx).Errorf("failed to delete status: %v", err)
+		return fmt.Errorf("failed to delete status: %w", err)
+	}
+	return nil
+}
+
+// UpdatePositions numbers the statuses in the order of the given IDs.
+func (r *SQLXStatusRepository) UpdatePositions(ctx context.Context, ids []string) error {
+	log.C(ctx).Info("updating status positions repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `
+		UPDATE list_statuses s
+		SET position = o.ordinality - 1
+		FROM unnest($1::uuid[]) WITH ORDINALITY AS o(id, ordinality)
+		WHERE s.id = o.id
+	`
+	if _, err = tx.ExecContext(ctx, query, pq.Array(ids)); err != nil {
+		log.C(ctx).Errorf("failed to update status positions: %v", err)
+		return fmt.Errorf("failed to update status positions: %w", err)
+	}
+	return nil
+}
+
+// MoveTodos puts every todo in the first status, trashed ones included, into
+// the second one.
+func (r *SQLXStatusRepository) MoveTodos(ctx context.Context, fromID string, toID string) error {
+	log.C(ctx).Info("moving todos between statuses repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `UPDATE todos SET status_id = $2 WHERE status_id = $1`, fromID, toID); err != nil {
+		log.C(ctx).Errorf("failed to move todos between statuses: %v", err)
+		return fmt.Errorf("failed to move todos between statuses: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package statuses_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXStatusRepositoryGetByListID(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := statuses.NewSQLXStatusRepository()
+
+	listID := "list"
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		setupMocks     func()
+		expectedResult []models.WorkflowStatus
+		expectedError  error
+	}{
+		{
+			name: "Successful get of the statuses in order",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, list_id, name, category, position, created_at FROM list_statuses WHERE list_id = \\$1 ORDER BY position, id").
+					WithArgs(listID).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "list_id", "name", "category", "position", "created_at"}).
+						AddRow("1", listID, "To do", "open", 0, now).
+						AddRow("2", listID, "Done", "done", 1, now))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: []models.WorkflowStatus{
+				{ID: "1", ListID: listID, Name: "To do", Category: constants.StatusCategoryOpen, Position: 0, CreatedAt: now},
+				{ID: "2", ListID: listID, Name: "Done", Category: constants.StatusCategoryDone, Position: 1, CreatedAt: now},
+			},
+		},
+		{
+			name: "Failed get due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("FROM list_statuses").
+					WithArgs(listID).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get statuses: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.GetByListID(ctx, listID)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXStatusRepositoryUpdatePositions(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := statuses.NewSQLXStatusRepository()
+
+	ids := []string{"2", "1"}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful renumbering of the statuses",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE list_statuses s SET position = o.ordinality - 1 FROM unnest\\(\\$1::uuid\\[\\]\\) WITH ORDINALITY AS o\\(id, ordinality\\) WHERE s.id = o.id").
+					WithArgs(pq.Array(ids)).
+					WillReturnResult(sqlxmock.NewResult(0, 2))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed renumbering due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE list_statuses").
+					WithArgs(pq.Array(ids)).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to update status positions: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.UpdatePositions(ctx, ids)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package statuses
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"strings"
+	"time"
+)
+
+const maxStatusNameLength = 64
+
+var (
+	ErrInvalidStatus   = errors.New("invalid status")
+	ErrDuplicateStatus = errors.New("a status with this name already exists in the list")
+	ErrStatusNotFound  = errors.New("status not found")
+	ErrLastStatus      = errors.New("a list needs at least one open and one done status")
+)
+
+// defaultStatuses are the statuses every new list starts with, in order.
+var defaultStatuses = []models.WorkflowStatus{
+	{Name: "To do", Category: constants.StatusCategoryOpen},
+	{Name: "In progress", Category: constants.StatusCategoryOpen},
+	{Name: "Done", Category: constants.StatusCategoryDone},
+}
+
+//go:generate mockery --name=StatusService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type StatusService interface {
+	CreateStatus(ctx context.Context, status models.WorkflowStatus) (models.WorkflowStatus, error)
+	GetStatus(ctx context.Context, id string, listID string) (models.WorkflowStatus, error)
+	ListStatuses(ctx context.Context, listID string) ([]models.WorkflowStatus, error)
+	UpdateStatus(ctx context.Context, status models.WorkflowStatus) (models.WorkflowStatus, error)
+	DeleteStatus(ctx context.Context, id string, listID string, moveTo string) error
+	ReorderStatuses(ctx context.Context, listID string, ids []string) ([]models.WorkflowStatus, error)
+	CreateDefaultStatuses(ctx context.Context, listID string) error
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ StatusService = &service{}
+
+type service struct {
+	repo        StatusRepository
+	uuidService UUIDService
+	timeService TimeService
+}
+
+func NewService(repo StatusRepository, uuidService UUIDService, timeService TimeService) StatusService {
+	return &service{repo: repo, uuidService: uuidService, timeService: timeService}
+}
+
+// CreateStatus adds the status after the last status of the list.
+func (s *service) CreateStatus(ctx context.Context, status models.WorkflowStatus) (models.WorkflowStatus, error) {
+	log.C(ctx).Info("creating status service")
+	status.Name = strings.TrimSpace(status.Name)
+	if err := validateStatus(status); err != nil {
+		return models.WorkflowStatus{}, err
+	}
+	existing, err := s.repo.GetByListID(ctx, status.ListID)
+	if err != nil {
+		log.C(ctx).Errorf("getting statuses of list with id %s failed", status.ListID)
+		return models.WorkflowStatus{}, err
+	}
+	if err = ensureUniqueName(existing, status); err != nil {
+		return models.WorkflowStatus{}, err
+	}
+
+	status.Position = 0
+	for _, other := range existing {
+		if other.Position >= status.Position {
+			status.Position = other.Position + 1
+		}
+	}
+	id, err := s.create(ctx, status)
+	if err != nil {
+		return models.WorkflowStatus{}, err
+	}
+	return s.repo.Get(ctx, id)
+}
+
+func (s *service) GetStatus(ctx context.Context, id string, listID string) (models.WorkflowStatus, error) {
+	log.C(ctx).Info("getting status service")
+	status, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.WorkflowStatus{}, ErrStatusNotFound
+	}
+	if err != nil {
+		log.C(ctx).Errorf("getting status with id %s failed", id)
+		return models.WorkflowStatus{}, err
+	}
+	if status.ListID != listID {
+		return models.WorkflowStatus{}, ErrStatusNotFound
+	}
+	return status, nil
+}
+
+func (s *service) ListStatuses(ctx context.Context, listID string) ([]models.WorkflowStatus, error) {
+	log.C(ctx).Info("listing statuses service")
+	return s.repo.GetByListID(ctx, listID)
+}
+
+// UpdateStatus renames the status. The category given is ignored since a
+// status keeps its category; todos in it would otherwise change their
+// completed flag behind the back of their owners.
+func (s *service) UpdateStatus(ctx context.Context, status models.WorkflowStatus) (models.WorkflowStatus, error) {
+	log.C(ctx).Info("updating status service")
+	existing, err := s.GetStatus(ctx, status.ID, status.ListID)
+	if err != nil {
+		return models.WorkflowStatus{}, err
+	}
+
+	existing.Name = strings.TrimSpace(status.Name)
+	if err = validateStatus(existing); err != nil {
+		return models.WorkflowStatus{}, err
+	}
+	others, err := s.repo.GetByListID(ctx, existing.ListID)
+	if err != nil {
+		log.C(ctx).Errorf("getting statuses of list with id %s failed", existing.ListID)
+		return models.WorkflowStatus{}, err
+	}
+	if err = ensureUniqueName(others, existing); err != nil {
+		return models.WorkflowStatus{}, err
+	}
+
+	if err = s.repo.Update(ctx, existing); err != nil {
+		log.C(ctx).Errorf("updating status with id %s failed", existing.ID)
+		return models.WorkflowStatus{}, err
+	}
+	return s.repo.Get(ctx, existing.ID)
+}
+
+// DeleteStatus removes the status after moving its todos to the status
+// moveTo, which must be another status of the list in the same category.
+// Without moveTo the todos go to the first other status of that category.
+func (s *service) DeleteStatus(ctx context.Context, id string, listID string, moveTo string) error {
+	log.C(ctx).Info("deleting status service")
+	status, err := s.GetStatus(ctx, id, listID)
+	if err != nil {
+		return err
+	}
+	others, err := s.repo.GetByListID(ctx, listID)
+	if err != nil {
+		log.C(ctx).Errorf("getting statuses of list with id %s failed", listID)
+		return err
+	}
+
+	var target *models.WorkflowStatus
+	for i := range others {
+		other := others[i]
+		if other.ID == status.ID || other.Category != status.Category {
+			continue
+		}
+		if moveTo == other.ID || (moveTo == "" && target == nil) {
+			target = &other
+		}
+	}
+	if target == nil && moveTo != "" {
+		return fmt.Errorf("%w: todos can only move to another %s status of the list", ErrInvalidStatus, status.Category)
+	}
+	if target == nil {
+		return ErrLastStatus
+	}
+
+	if err = s.repo.MoveTodos(ctx, status.ID, target.ID); err != nil {
+		log.C(ctx).Errorf("moving todos from status %s to %s failed", status.ID, target.ID)
+		return err
+	}
+	return s.repo.Delete(ctx, status.ID)
+}
+
+// ReorderStatuses puts the statuses of the list in the order of the given
+// IDs, which must name every status of the list exactly once.
+func (s *service) ReorderStatuses(ctx context.Context, listID string, ids []string) ([]models.WorkflowStatus, error) {
+	log.C(ctx).Info("reordering statuses service")
+	existing, err := s.repo.GetByListID(ctx, listID)
+	if err != nil {
+		log.C(ctx).Errorf("getting statuses of list with id %s failed", listID)
+		return nil, err
+	}
+
+	remaining := make(map[string]bool, len(existing))
+	for _, status := range existing {
+		remaining[status.ID] = true
+	}
+	for _, id := range ids {
+		if !remaining[id] {
+			return nil, fmt.Errorf("%w: %s is not a status of the list or is given twice", ErrInvalidStatus, id)
+		}
+		delete(remaining, id)
+	}
+	if len(remaining) > 0 {
+		return nil, fmt.Errorf("%w: every status of the list must be given", ErrInvalidStatus)
+	}
+
+	if err = s.repo.UpdatePositions(ctx, ids); err != nil {
+		log.C(ctx).Errorf("updating status positions of list with id %s failed", listID)
+		return nil, err
+	}
+	return s.repo.GetByListID(ctx, listID)
+}
+
+func (s *service) CreateDefaultStatuses(ctx context.Context, listID string) error {
+	log.C(ctx).Info("creating default statuses service")
+	for position, status := range defaultStatuses {
+		status.ListID = listID
+		status.Position = position
+		if _, err := s.create(ctx, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *service) create(ctx context.Context, status models.WorkflowStatus) (string, error) {
+	status.ID = s.uuidService.Generate()
+	status.CreatedAt = s.timeService.Now()
+	id, err := s.repo.Create(ctx, status)
+	if err != nil {
+		log.C(ctx).Errorf("creating status in list with id %s failed", status.ListID)
+		return "", err
+	}
+	return id, nil
+}
+
+func ensureUniqueName(existing []models.WorkflowStatus, status models.WorkflowStatus) error {
+	for _, other := range existing {
+		if other.ID != status.ID && strings.EqualFold(other.Name, status.Name) {
+			return ErrDuplicateStatus
+		}
+	}
+	return nil
+}
+
+func validateStatus(status models.WorkflowStatus) error {
+	if status.Name == "" || len(status.Name) > maxStatusNameLength {
+		return fmt.Errorf("%w: name must be between 1 and %d characters", ErrInvalidStatus, maxStatusNameLength)
+	}
+	switch status.Category {
+	case constants.StatusCategoryOpen, constants.StatusCategoryDone:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown category %q", ErrInvalidStatus, status.Category)
+	}
+}
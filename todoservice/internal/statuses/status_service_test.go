@@ -0,0 +1,273 @@
+package statuses_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func boardStatuses(listID string) []models.WorkflowStatus {
+	return []models.WorkflowStatus{
+		{ID: "todo", ListID: listID, Name: "To do", Category: constants.StatusCategoryOpen, Position: 0},
+		{ID: "doing", ListID: listID, Name: "In progress", Category: constants.StatusCategoryOpen, Position: 1},
+		{ID: "done", ListID: listID, Name: "Done", Category: constants.StatusCategoryDone, Position: 2},
+	}
+}
+
+func TestServiceCreateStatus(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	listID := "list"
+
+	created := models.WorkflowStatus{ID: "1", ListID: listID, Name: "Review", Category: constants.StatusCategoryOpen, Position: 3, CreatedAt: now}
+
+	tests := []struct {
+		name          string
+		input         models.WorkflowStatus
+		repo          func() *automock.StatusRepository
+		expected      models.WorkflowStatus
+		expectedError error
+	}{
+		{
+			name:  "Create a status after the last one of the list",
+			input: models.WorkflowStatus{ListID: listID, Name: " Review ", Category: constants.StatusCategoryOpen, Position: 7},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetByListID(ctx, listID).Return(boardStatuses(listID), nil).Once()
+				repo.EXPECT().Create(ctx, created).Return(created.ID, nil).Once()
+				repo.EXPECT().Get(ctx, created.ID).Return(created, nil).Once()
+				return repo
+			},
+			expected: created,
+		},
+		{
+			name:  "Error when the list already has a status with the name",
+			input: models.WorkflowStatus{ListID: listID, Name: "DONE", Category: constants.StatusCategoryDone},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetByListID(ctx, listID).Return(boardStatuses(listID), nil).Once()
+				return repo
+			},
+			expectedError: statuses.ErrDuplicateStatus,
+		},
+		{
+			name:          "Error when the name is blank",
+			input:         models.WorkflowStatus{ListID: listID, Name: "  ", Category: constants.StatusCategoryOpen},
+			repo:          func() *automock.StatusRepository { return &automock.StatusRepository{} },
+			expectedError: statuses.ErrInvalidStatus,
+		},
+		{
+			name:          "Error when the category is unknown",
+			input:         models.WorkflowStatus{ListID: listID, Name: "Review", Category: "blocked"},
+			repo:          func() *automock.StatusRepository { return &automock.StatusRepository{} },
+			expectedError: statuses.ErrInvalidStatus,
+		},
+		{
+			name:  "Error when creating fails",
+			input: models.WorkflowStatus{ListID: listID, Name: "Review", Category: constants.StatusCategoryOpen},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetByListID(ctx, listID).Return(nil, nil).Once()
+				repo.EXPECT().Create(ctx, mock.Anything).Return("", err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(created.ID).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := statuses.NewService(repo, uuidService, timeService)
+			result, err := svc.CreateStatus(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceDeleteStatus(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	listID := "list"
+	current := boardStatuses(listID)
+
+	tests := []struct {
+		name          string
+		id            string
+		moveTo        string
+		repo          func() *automock.StatusRepository
+		expectedError error
+	}{
+		{
+			name: "Delete a status moving its todos to the first other status of the category",
+			id:   "doing",
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().Get(ctx, "doing").Return(current[1], nil).Once()
+				repo.EXPECT().GetByListID(ctx, listID).Return(current, nil).Once()
+				repo.EXPECT().MoveTodos(ctx, "doing", "todo").Return(nil).Once()
+				repo.EXPECT().Delete(ctx, "doing").Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:   "Delete a status moving its todos to the given status",
+			id:     "todo",
+			moveTo: "doing",
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().Get(ctx, "todo").Return(current[0], nil).Once()
+				repo.EXPECT().GetByListID(ctx, listID).Return(current, nil).Once()
+				repo.EXPECT().MoveTodos(ctx, "todo", "doing").Return(nil).Once()
+				repo.EXPECT().Delete(ctx, "todo").Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:   "Error when the todos would move to a status of another category",
+			id:     "doing",
+			moveTo: "done",
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().Get(ctx, "doing").Return(current[1], nil).Once()
+				repo.EXPECT().GetByListID(ctx, listID).Return(current, nil).Once()
+				return repo
+			},
+			expectedError: statuses.ErrInvalidStatus,
+		},
+		{
+			name: "Error when deleting the last done status",
+			id:   "done",
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().Get(ctx, "done").Return(current[2], nil).Once()
+				repo.EXPECT().GetByListID(ctx, listID).Return(current, nil).Once()
+				return repo
+			},
+			expectedError: statuses.ErrLastStatus,
+		},
+		{
+			name: "Error when the status belongs to another list",
+			id:   "other",
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().Get(ctx, "other").Return(models.WorkflowStatus{ID: "other", ListID: "another"}, nil).Once()
+				return repo
+			},
+			expectedError: statuses.ErrStatusNotFound,
+		},
+		{
+			name: "Error when moving the todos fails",
+			id:   "doing",
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().Get(ctx, "doing").Return(current[1], nil).Once()
+				repo.EXPECT().GetByListID(ctx, listID).Return(current, nil).Once()
+				repo.EXPECT().MoveTodos(ctx, "doing", "todo").Return(err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := statuses.NewService(repo, &automock.UUIDService{}, &automock.TimeService{})
+			err := svc.DeleteStatus(ctx, tt.id, listID, tt.moveTo)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServiceReorderStatuses(t *testing.T) {
+	ctx := context.Background()
+	listID := "list"
+	current := boardStatuses(listID)
+
+	tests := []struct {
+		name          string
+		ids           []string
+		repo          func() *automock.StatusRepository
+		expectedError error
+	}{
+		{
+			name: "Reorder every status of the list",
+			ids:  []string{"doing", "todo", "done"},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetByListID(ctx, listID).Return(current, nil).Twice()
+				repo.EXPECT().UpdatePositions(ctx, []string{"doing", "todo", "done"}).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name: "Error when a status is missing",
+			ids:  []string{"doing", "todo"},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetByListID(ctx, listID).Return(current, nil).Once()
+				return repo
+			},
+			expectedError: statuses.ErrInvalidStatus,
+		},
+		{
+			name: "Error when a status is given twice",
+			ids:  []string{"doing", "doing", "todo", "done"},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetByListID(ctx, listID).Return(current, nil).Once()
+				return repo
+			},
+			expectedError: statuses.ErrInvalidStatus,
+		},
+		{
+			name: "Error when a status belongs to another list",
+			ids:  []string{"doing", "todo", "done", "other"},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetByListID(ctx, listID).Return(current, nil).Once()
+				return repo
+			},
+			expectedError: statuses.ErrInvalidStatus,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := statuses.NewService(repo, &automock.UUIDService{}, &automock.TimeService{})
+			_, err := svc.ReorderStatuses(ctx, listID, tt.ids)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
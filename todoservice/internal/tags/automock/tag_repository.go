@@ -0,0 +1,685 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagRepository is an autogenerated mock type for the TagRepository type
+type TagRepository struct {
+	mock.Mock
+}
+
+type TagRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagRepository) EXPECT() *TagRepository_Expecter {
+	return &TagRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, tag
+func (_m *TagRepository) Create(ctx context.Context, tag models.Tag) (string, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Tag) (string, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Tag) string); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Tag) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TagRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag models.Tag
+func (_e *TagRepository_Expecter) Create(ctx interface{}, tag interface{}) *TagRepository_Create_Call {
+	return &TagRepository_Create_Call{Call: _e.mock.On("Create", ctx, tag)}
+}
+
+func (_c *TagRepository_Create_Call) Run(run func(ctx context.Context, tag models.Tag)) *TagRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Tag))
+	})
+	return _c
+}
+
+func (_c *TagRepository_Create_Call) Return(_a0 string, _a1 error) *TagRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_Create_Call) RunAndReturn(run func(context.Context, models.Tag) (string, error)) *TagRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *TagRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TagRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TagRepository_Expecter) Delete(ctx interface{}, id interface{}) *TagRepository_Delete_Call {
+	return &TagRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *TagRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *TagRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_Delete_Call) Return(_a0 error) *TagRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *TagRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindForList provides a mock function with given fields: ctx, listID, names
+func (_m *TagRepository) FindForList(ctx context.Context, listID string, names []string) ([]models.Tag, error) {
+	ret := _m.Called(ctx, listID, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindForList")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]models.Tag, error)); ok {
+		return rf(ctx, listID, names)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []models.Tag); ok {
+		r0 = rf(ctx, listID, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, listID, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_FindForList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindForList'
+type TagRepository_FindForList_Call struct {
+	*mock.Call
+}
+
+// FindForList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - names []string
+func (_e *TagRepository_Expecter) FindForList(ctx interface{}, listID interface{}, names interface{}) *TagRepository_FindForList_Call {
+	return &TagRepository_FindForList_Call{Call: _e.mock.On("FindForList", ctx, listID, names)}
+}
+
+func (_c *TagRepository_FindForList_Call) Run(run func(ctx context.Context, listID string, names []string)) *TagRepository_FindForList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_FindForList_Call) Return(_a0 []models.Tag, _a1 error) *TagRepository_FindForList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_FindForList_Call) RunAndReturn(run func(context.Context, string, []string) ([]models.Tag, error)) *TagRepository_FindForList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindForUser provides a mock function with given fields: ctx, userID, names
+func (_m *TagRepository) FindForUser(ctx context.Context, userID string, names []string) ([]models.Tag, error) {
+	ret := _m.Called(ctx, userID, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindForUser")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) ([]models.Tag, error)); ok {
+		return rf(ctx, userID, names)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []models.Tag); ok {
+		r0 = rf(ctx, userID, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, userID, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_FindForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindForUser'
+type TagRepository_FindForUser_Call struct {
+	*mock.Call
+}
+
+// FindForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - names []string
+func (_e *TagRepository_Expecter) FindForUser(ctx interface{}, userID interface{}, names interface{}) *TagRepository_FindForUser_Call {
+	return &TagRepository_FindForUser_Call{Call: _e.mock.On("FindForUser", ctx, userID, names)}
+}
+
+func (_c *TagRepository_FindForUser_Call) Run(run func(ctx context.Context, userID string, names []string)) *TagRepository_FindForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_FindForUser_Call) Return(_a0 []models.Tag, _a1 error) *TagRepository_FindForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_FindForUser_Call) RunAndReturn(run func(context.Context, string, []string) ([]models.Tag, error)) *TagRepository_FindForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *TagRepository) Get(ctx context.Context, id string) (models.Tag, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Tag, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Tag); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TagRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TagRepository_Expecter) Get(ctx interface{}, id interface{}) *TagRepository_Get_Call {
+	return &TagRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *TagRepository_Get_Call) Run(run func(ctx context.Context, id string)) *TagRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_Get_Call) Return(_a0 models.Tag, _a1 error) *TagRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_Get_Call) RunAndReturn(run func(context.Context, string) (models.Tag, error)) *TagRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccessible provides a mock function with given fields: ctx, userID
+func (_m *TagRepository) GetAccessible(ctx context.Context, userID string) ([]models.Tag, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccessible")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Tag, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Tag); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_GetAccessible_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccessible'
+type TagRepository_GetAccessible_Call struct {
+	*mock.Call
+}
+
+// GetAccessible is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *TagRepository_Expecter) GetAccessible(ctx interface{}, userID interface{}) *TagRepository_GetAccessible_Call {
+	return &TagRepository_GetAccessible_Call{Call: _e.mock.On("GetAccessible", ctx, userID)}
+}
+
+func (_c *TagRepository_GetAccessible_Call) Run(run func(ctx context.Context, userID string)) *TagRepository_GetAccessible_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_GetAccessible_Call) Return(_a0 []models.Tag, _a1 error) *TagRepository_GetAccessible_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_GetAccessible_Call) RunAndReturn(run func(context.Context, string) ([]models.Tag, error)) *TagRepository_GetAccessible_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByListID provides a mock function with given fields: ctx, listID
+func (_m *TagRepository) GetByListID(ctx context.Context, listID string) ([]models.Tag, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByListID")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Tag, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Tag); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_GetByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByListID'
+type TagRepository_GetByListID_Call struct {
+	*mock.Call
+}
+
+// GetByListID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *TagRepository_Expecter) GetByListID(ctx interface{}, listID interface{}) *TagRepository_GetByListID_Call {
+	return &TagRepository_GetByListID_Call{Call: _e.mock.On("GetByListID", ctx, listID)}
+}
+
+func (_c *TagRepository_GetByListID_Call) Run(run func(ctx context.Context, listID string)) *TagRepository_GetByListID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_GetByListID_Call) Return(_a0 []models.Tag, _a1 error) *TagRepository_GetByListID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_GetByListID_Call) RunAndReturn(run func(context.Context, string) ([]models.Tag, error)) *TagRepository_GetByListID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListStatus provides a mock function with given fields: ctx, listID, userID
+func (_m *TagRepository) GetListStatus(ctx context.Context, listID string, userID string) (string, error) {
+	ret := _m.Called(ctx, listID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListStatus")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, listID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, listID, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, listID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_GetListStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListStatus'
+type TagRepository_GetListStatus_Call struct {
+	*mock.Call
+}
+
+// GetListStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - userID string
+func (_e *TagRepository_Expecter) GetListStatus(ctx interface{}, listID interface{}, userID interface{}) *TagRepository_GetListStatus_Call {
+	return &TagRepository_GetListStatus_Call{Call: _e.mock.On("GetListStatus", ctx, listID, userID)}
+}
+
+func (_c *TagRepository_GetListStatus_Call) Run(run func(ctx context.Context, listID string, userID string)) *TagRepository_GetListStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_GetListStatus_Call) Return(_a0 string, _a1 error) *TagRepository_GetListStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_GetListStatus_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *TagRepository_GetListStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reassign provides a mock function with given fields: ctx, sourceID, targetID
+func (_m *TagRepository) Reassign(ctx context.Context, sourceID string, targetID string) error {
+	ret := _m.Called(ctx, sourceID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reassign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, sourceID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagRepository_Reassign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reassign'
+type TagRepository_Reassign_Call struct {
+	*mock.Call
+}
+
+// Reassign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceID string
+//   - targetID string
+func (_e *TagRepository_Expecter) Reassign(ctx interface{}, sourceID interface{}, targetID interface{}) *TagRepository_Reassign_Call {
+	return &TagRepository_Reassign_Call{Call: _e.mock.On("Reassign", ctx, sourceID, targetID)}
+}
+
+func (_c *TagRepository_Reassign_Call) Run(run func(ctx context.Context, sourceID string, targetID string)) *TagRepository_Reassign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_Reassign_Call) Return(_a0 error) *TagRepository_Reassign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagRepository_Reassign_Call) RunAndReturn(run func(context.Context, string, string) error) *TagRepository_Reassign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetListTags provides a mock function with given fields: ctx, listID, tagIDs
+func (_m *TagRepository) SetListTags(ctx context.Context, listID string, tagIDs []string) error {
+	ret := _m.Called(ctx, listID, tagIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetListTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, listID, tagIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagRepository_SetListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetListTags'
+type TagRepository_SetListTags_Call struct {
+	*mock.Call
+}
+
+// SetListTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - tagIDs []string
+func (_e *TagRepository_Expecter) SetListTags(ctx interface{}, listID interface{}, tagIDs interface{}) *TagRepository_SetListTags_Call {
+	return &TagRepository_SetListTags_Call{Call: _e.mock.On("SetListTags", ctx, listID, tagIDs)}
+}
+
+func (_c *TagRepository_SetListTags_Call) Run(run func(ctx context.Context, listID string, tagIDs []string)) *TagRepository_SetListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_SetListTags_Call) Return(_a0 error) *TagRepository_SetListTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagRepository_SetListTags_Call) RunAndReturn(run func(context.Context, string, []string) error) *TagRepository_SetListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTodoTags provides a mock function with given fields: ctx, todoID, tagIDs
+func (_m *TagRepository) SetTodoTags(ctx context.Context, todoID string, tagIDs []string) error {
+	ret := _m.Called(ctx, todoID, tagIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTodoTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, todoID, tagIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagRepository_SetTodoTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTodoTags'
+type TagRepository_SetTodoTags_Call struct {
+	*mock.Call
+}
+
+// SetTodoTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - tagIDs []string
+func (_e *TagRepository_Expecter) SetTodoTags(ctx interface{}, todoID interface{}, tagIDs interface{}) *TagRepository_SetTodoTags_Call {
+	return &TagRepository_SetTodoTags_Call{Call: _e.mock.On("SetTodoTags", ctx, todoID, tagIDs)}
+}
+
+func (_c *TagRepository_SetTodoTags_Call) Run(run func(ctx context.Context, todoID string, tagIDs []string)) *TagRepository_SetTodoTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_SetTodoTags_Call) Return(_a0 error) *TagRepository_SetTodoTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagRepository_SetTodoTags_Call) RunAndReturn(run func(context.Context, string, []string) error) *TagRepository_SetTodoTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, tag
+func (_m *TagRepository) Update(ctx context.Context, tag models.Tag) error {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Tag) error); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type TagRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag models.Tag
+func (_e *TagRepository_Expecter) Update(ctx interface{}, tag interface{}) *TagRepository_Update_Call {
+	return &TagRepository_Update_Call{Call: _e.mock.On("Update", ctx, tag)}
+}
+
+func (_c *TagRepository_Update_Call) Run(run func(ctx context.Context, tag models.Tag)) *TagRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Tag))
+	})
+	return _c
+}
+
+func (_c *TagRepository_Update_Call) Return(_a0 error) *TagRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagRepository_Update_Call) RunAndReturn(run func(context.Context, models.Tag) error) *TagRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagRepository creates a new instance of TagRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagRepository {
+	mock := &TagRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
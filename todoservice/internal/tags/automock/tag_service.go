@@ -0,0 +1,533 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagService is an autogenerated mock type for the TagService type
+type TagService struct {
+	mock.Mock
+}
+
+type TagService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagService) EXPECT() *TagService_Expecter {
+	return &TagService_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: ctx, tag
+func (_m *TagService) CreateTag(ctx context.Context, tag models.Tag) (models.Tag, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Tag) (models.Tag, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Tag) models.Tag); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Tag) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type TagService_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag models.Tag
+func (_e *TagService_Expecter) CreateTag(ctx interface{}, tag interface{}) *TagService_CreateTag_Call {
+	return &TagService_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, tag)}
+}
+
+func (_c *TagService_CreateTag_Call) Run(run func(ctx context.Context, tag models.Tag)) *TagService_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Tag))
+	})
+	return _c
+}
+
+func (_c *TagService_CreateTag_Call) Return(_a0 models.Tag, _a1 error) *TagService_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_CreateTag_Call) RunAndReturn(run func(context.Context, models.Tag) (models.Tag, error)) *TagService_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, id, userID
+func (_m *TagService) DeleteTag(ctx context.Context, id string, userID string) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagService_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type TagService_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *TagService_Expecter) DeleteTag(ctx interface{}, id interface{}, userID interface{}) *TagService_DeleteTag_Call {
+	return &TagService_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, id, userID)}
+}
+
+func (_c *TagService_DeleteTag_Call) Run(run func(ctx context.Context, id string, userID string)) *TagService_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TagService_DeleteTag_Call) Return(_a0 error) *TagService_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagService_DeleteTag_Call) RunAndReturn(run func(context.Context, string, string) error) *TagService_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTag provides a mock function with given fields: ctx, id, userID
+func (_m *TagService) GetTag(ctx context.Context, id string, userID string) (models.Tag, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Tag, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Tag); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_GetTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTag'
+type TagService_GetTag_Call struct {
+	*mock.Call
+}
+
+// GetTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *TagService_Expecter) GetTag(ctx interface{}, id interface{}, userID interface{}) *TagService_GetTag_Call {
+	return &TagService_GetTag_Call{Call: _e.mock.On("GetTag", ctx, id, userID)}
+}
+
+func (_c *TagService_GetTag_Call) Run(run func(ctx context.Context, id string, userID string)) *TagService_GetTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TagService_GetTag_Call) Return(_a0 models.Tag, _a1 error) *TagService_GetTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_GetTag_Call) RunAndReturn(run func(context.Context, string, string) (models.Tag, error)) *TagService_GetTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListListTags provides a mock function with given fields: ctx, listID
+func (_m *TagService) ListListTags(ctx context.Context, listID string) ([]models.Tag, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListListTags")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Tag, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Tag); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_ListListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListListTags'
+type TagService_ListListTags_Call struct {
+	*mock.Call
+}
+
+// ListListTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *TagService_Expecter) ListListTags(ctx interface{}, listID interface{}) *TagService_ListListTags_Call {
+	return &TagService_ListListTags_Call{Call: _e.mock.On("ListListTags", ctx, listID)}
+}
+
+func (_c *TagService_ListListTags_Call) Run(run func(ctx context.Context, listID string)) *TagService_ListListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TagService_ListListTags_Call) Return(_a0 []models.Tag, _a1 error) *TagService_ListListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_ListListTags_Call) RunAndReturn(run func(context.Context, string) ([]models.Tag, error)) *TagService_ListListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function with given fields: ctx, userID
+func (_m *TagService) ListTags(ctx context.Context, userID string) ([]models.Tag, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Tag, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Tag); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type TagService_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *TagService_Expecter) ListTags(ctx interface{}, userID interface{}) *TagService_ListTags_Call {
+	return &TagService_ListTags_Call{Call: _e.mock.On("ListTags", ctx, userID)}
+}
+
+func (_c *TagService_ListTags_Call) Run(run func(ctx context.Context, userID string)) *TagService_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TagService_ListTags_Call) Return(_a0 []models.Tag, _a1 error) *TagService_ListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_ListTags_Call) RunAndReturn(run func(context.Context, string) ([]models.Tag, error)) *TagService_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeTags provides a mock function with given fields: ctx, sourceID, targetID, userID
+func (_m *TagService) MergeTags(ctx context.Context, sourceID string, targetID string, userID string) (models.Tag, error) {
+	ret := _m.Called(ctx, sourceID, targetID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTags")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (models.Tag, error)); ok {
+		return rf(ctx, sourceID, targetID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) models.Tag); ok {
+		r0 = rf(ctx, sourceID, targetID, userID)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, sourceID, targetID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_MergeTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTags'
+type TagService_MergeTags_Call struct {
+	*mock.Call
+}
+
+// MergeTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceID string
+//   - targetID string
+//   - userID string
+func (_e *TagService_Expecter) MergeTags(ctx interface{}, sourceID interface{}, targetID interface{}, userID interface{}) *TagService_MergeTags_Call {
+	return &TagService_MergeTags_Call{Call: _e.mock.On("MergeTags", ctx, sourceID, targetID, userID)}
+}
+
+func (_c *TagService_MergeTags_Call) Run(run func(ctx context.Context, sourceID string, targetID string, userID string)) *TagService_MergeTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TagService_MergeTags_Call) Return(_a0 models.Tag, _a1 error) *TagService_MergeTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_MergeTags_Call) RunAndReturn(run func(context.Context, string, string, string) (models.Tag, error)) *TagService_MergeTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetListTags provides a mock function with given fields: ctx, listID, ownerID, names
+func (_m *TagService) SetListTags(ctx context.Context, listID string, ownerID string, names []string) error {
+	ret := _m.Called(ctx, listID, ownerID, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetListTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) error); ok {
+		r0 = rf(ctx, listID, ownerID, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagService_SetListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetListTags'
+type TagService_SetListTags_Call struct {
+	*mock.Call
+}
+
+// SetListTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - ownerID string
+//   - names []string
+func (_e *TagService_Expecter) SetListTags(ctx interface{}, listID interface{}, ownerID interface{}, names interface{}) *TagService_SetListTags_Call {
+	return &TagService_SetListTags_Call{Call: _e.mock.On("SetListTags", ctx, listID, ownerID, names)}
+}
+
+func (_c *TagService_SetListTags_Call) Run(run func(ctx context.Context, listID string, ownerID string, names []string)) *TagService_SetListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *TagService_SetListTags_Call) Return(_a0 error) *TagService_SetListTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagService_SetListTags_Call) RunAndReturn(run func(context.Context, string, string, []string) error) *TagService_SetListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTodoTags provides a mock function with given fields: ctx, todoID, listID, names
+func (_m *TagService) SetTodoTags(ctx context.Context, todoID string, listID string, names []string) error {
+	ret := _m.Called(ctx, todoID, listID, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTodoTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) error); ok {
+		r0 = rf(ctx, todoID, listID, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagService_SetTodoTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTodoTags'
+type TagService_SetTodoTags_Call struct {
+	*mock.Call
+}
+
+// SetTodoTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - listID string
+//   - names []string
+func (_e *TagService_Expecter) SetTodoTags(ctx interface{}, todoID interface{}, listID interface{}, names interface{}) *TagService_SetTodoTags_Call {
+	return &TagService_SetTodoTags_Call{Call: _e.mock.On("SetTodoTags", ctx, todoID, listID, names)}
+}
+
+func (_c *TagService_SetTodoTags_Call) Run(run func(ctx context.Context, todoID string, listID string, names []string)) *TagService_SetTodoTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *TagService_SetTodoTags_Call) Return(_a0 error) *TagService_SetTodoTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagService_SetTodoTags_Call) RunAndReturn(run func(context.Context, string, string, []string) error) *TagService_SetTodoTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: ctx, tag, userID
+func (_m *TagService) UpdateTag(ctx context.Context, tag models.Tag, userID string) (models.Tag, error) {
+	ret := _m.Called(ctx, tag, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Tag, string) (models.Tag, error)); ok {
+		return rf(ctx, tag, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Tag, string) models.Tag); ok {
+		r0 = rf(ctx, tag, userID)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Tag, string) error); ok {
+		r1 = rf(ctx, tag, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type TagService_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag models.Tag
+//   - userID string
+func (_e *TagService_Expecter) UpdateTag(ctx interface{}, tag interface{}, userID interface{}) *TagService_UpdateTag_Call {
+	return &TagService_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, tag, userID)}
+}
+
+func (_c *TagService_UpdateTag_Call) Run(run func(ctx context.Context, tag models.Tag, userID string)) *TagService_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Tag), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TagService_UpdateTag_Call) Return(_a0 models.Tag, _a1 error) *TagService_UpdateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_UpdateTag_Call) RunAndReturn(run func(context.Context, models.Tag, string) (models.Tag, error)) *TagService_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagService creates a new instance of TagService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagService {
+	mock := &TagService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,56 @@
+package tags
+
+import (
+	"database/sql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertTagToModel(entity Entity) models.Tag {
+	return models.Tag{
+		ID:          entity.ID,
+		Name:        entity.Name,
+		Color:       entity.Color.String,
+		Description: entity.Description.String,
+		UserID:      convertNullStringToPointer(entity.UserID),
+		ListID:      convertNullStringToPointer(entity.ListID),
+		UsageCount:  entity.UsageCount,
+		CreatedAt:   entity.CreatedAt,
+	}
+}
+
+func (c *Converter) ConvertTagToEntity(tag models.Tag) Entity {
+	return Entity{
+		ID:          tag.ID,
+		Name:        tag.Name,
+		Color:       convertStringToNullString(tag.Color),
+		Description: convertStringToNullString(tag.Description),
+		UserID:      convertPointerToNullString(tag.UserID),
+		ListID:      convertPointerToNullString(tag.ListID),
+		UsageCount:  tag.UsageCount,
+		CreatedAt:   tag.CreatedAt,
+	}
+}
+
+func convertNullStringToPointer(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	return &value.String
+}
+
+func convertPointerToNullString(value *string) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+	return convertStringToNullString(*value)
+}
+
+func convertStringToNullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
@@ -0,0 +1,17 @@
+package tags
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Entity struct {
+	ID          string         `db:"id"`
+	Name        string         `db:"name"`
+	Color       sql.NullString `db:"color"`
+	Description sql.NullString `db:"description"`
+	UserID      sql.NullString `db:"user_id"`
+	ListID      sql.NullString `db:"list_id"`
+	UsageCount  int            `db:"usage_count"`
+	CreatedAt   time.Time      `db:"created_at"`
+}
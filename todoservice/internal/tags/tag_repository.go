@@ -0,0 +1,294 @@
+package tags
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+)
+
+//go:generate mockery --name=TagRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TagRepository interface {
+	Create(ctx context.Context, tag models.Tag) (string, error)
+	Get(ctx context.Context, id string) (models.Tag, error)
+	GetAccessible(ctx context.Context, userID string) ([]models.Tag, error)
+	GetByListID(ctx context.Context, listID string) ([]models.Tag, error)
+	FindForList(ctx context.Context, listID string, names []string) ([]models.Tag, error)
+	FindForUser(ctx context.Context, userID string, names []string) ([]models.Tag, error)
+	Update(ctx context.Context, tag models.Tag) error
+	Delete(ctx context.Context, id string) error
+	Reassign(ctx context.Context, sourceID string, targetID string) error
+	SetTodoTags(ctx context.Context, todoID string, tagIDs []string) error
+	SetListTags(ctx context.Context, listID string, tagIDs []string) error
+	GetListStatus(ctx context.Context, listID string, userID string) (string, error)
+}
+
+const selectTags = `
+	SELECT g.id, g.name, g.color, g.description, g.user_id, g.list_id, g.created_at,
+	       (SELECT COUNT(*) FROM todo_tags tt JOIN todos t ON t.id = tt.todo_id WHERE tt.tag_id = g.id AND t.deleted_at IS NULL) +
+	       (SELECT COUNT(*) FROM list_tags lt JOIN lists l ON l.id = lt.list_id WHERE lt.tag_id = g.id AND l.deleted_at IS NULL) AS usage_count
+	FROM tags g
+`
+
+type SQLXTagRepository struct {
+	converter *Converter
+}
+
+var _ TagRepository = &SQLXTagRepository{}
+
+func NewSQLXTagRepository() TagRepository {
+	return &SQLXTagRepository{converter: NewConverter()}
+}
+
+func (r *SQLXTagRepository) Create(ctx context.Context, tag models.Tag) (string, error) {
+	log.C(ctx).Info("creating tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertTagToEntity(tag)
+	if err = pkg.ValidateUUID(entity.ID); err != nil {
+		log.C(ctx).Errorf("invalid entity id: %v", err)
+		return "", fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	query := `
+		INSERT INTO tags (id, name, color, description, user_id, list_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query,
+		entity.ID,
+		entity.Name,
+		entity.Color,
+		entity.Description,
+		entity.UserID,
+		entity.ListID,
+		entity.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to insert tag: %v", err)
+		return "", fmt.Errorf("failed to create tag: %w", err)
+	}
+	return id, nil
+}
+
+func (r *SQLXTagRepository) Get(ctx context.Context, id string) (models.Tag, error) {
+	log.C(ctx).Info("getting tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Tag{}, err
+	}
+
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, selectTags+`WHERE g.id = $1`, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get tag: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Tag{}, fmt.Errorf("tag not found: %w", err)
+		}
+		return models.Tag{}, fmt.Errorf("failed to get tag: %w", err)
+	}
+	return r.converter.ConvertTagToModel(entity), nil
+}
+
+// GetAccessible returns the personal tags of the user together with the tags
+// of the lists the user owns or has accepted.
+func (r *SQLXTagRepository) GetAccessible(ctx context.Context, userID string) ([]models.Tag, error) {
+	log.C(ctx).Info("getting accessible tags repository")
+	query := selectTags + `
+		WHERE g.user_id = $1
+		   OR g.list_id IN (
+		       SELECT a.list_id FROM list_access a JOIN lists l ON l.id = a.list_id
+		       WHERE a.user_id = $1 AND a.status IN ('owner', 'accepted') AND l.deleted_at IS NULL
+		   )
+		ORDER BY lower(g.name), g.id
+	`
+	return r.selectTags(ctx, query, userID)
+}
+
+func (r *SQLXTagRepository) GetByListID(ctx context.Context, listID string) ([]models.Tag, error) {
+	log.C(ctx).Info("getting tags of list repository")
+	return r.selectTags(ctx, selectTags+`WHERE g.list_id = $1 ORDER BY lower(g.name), g.id`, listID)
+}
+
+// FindForList returns the tags with the given lowercase names that can be put
+// on todos of the list: the tags of the list and the personal tags of its
+// owner.
+func (r *SQLXTagRepository) FindForList(ctx context.Context, listID string, names []string) ([]models.Tag, error) {
+	log.C(ctx).Info("finding tags for list repository")
+	query := selectTags + `
+		WHERE (g.list_id = $1 OR g.user_id = (SELECT owner_id FROM lists WHERE id = $1))
+		  AND lower(g.name) = ANY($2)
+		ORDER BY g.created_at, g.id
+	`
+	return r.selectTags(ctx, query, listID, pq.Array(names))
+}
+
+// FindForUser returns the personal tags of the user with the given lowercase
+// names.
+func (r *SQLXTagRepository) FindForUser(ctx context.Context, userID string, names []string) ([]models.Tag, error) {
+	log.C(ctx).Info("finding tags for user repository")
+	query := selectTags + `WHERE g.user_id = $1 AND lower(g.name) = ANY($2) ORDER BY g.created_at, g.id`
+	return r.selectTags(ctx, query, userID, pq.Array(names))
+}
+
+// Update renames the tag and changes its colour and description. The scope of
+// a tag never changes.
+func (r *SQLXTagRepository) Update(ctx context.Context, tag models.Tag) error {
+	log.C(ctx).Info("updating tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	entity := r.converter.ConvertTagToEntity(tag)
+	query := `UPDATE tags SET name = $2, color = $3, description = $4 WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, query, entity.ID, entity.Name, entity.Color, entity.Description); err != nil {
+		log.C(ctx).Errorf("failed to update tag: %v", err)
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXTagRepository) Delete(ctx context.Context, id string) error {
+	log.C(ctx).Info("deleting tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM tags WHERE id = $1`, id); err != nil {
+		log.C(ctx).Errorf("failed to delete tag: %v", err)
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	return nil
+}
+
+// Reassign puts the target tag on every todo and list carrying the source tag.
+// The source tag itself is left in place.
+func (r *SQLXTagRepository) Reassign(ctx context.Context, sourceID string, targetID string) error {
+	log.C(ctx).Info("reassigning tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	todoQuery := `
+		INSERT INTO todo_tags (todo_id, tag_id)
+		SELECT todo_id, $2 FROM todo_tags WHERE tag_id = $1
+		ON CONFLICT DO NOTHING
+	`
+	if _, err = tx.ExecContext(ctx, todoQuery, sourceID, targetID); err != nil {
+		log.C(ctx).Errorf("failed to reassign todo tags: %v", err)
+		return fmt.Errorf("failed to reassign todo tags: %w", err)
+	}
+
+	listQuery := `
+		INSERT INTO list_tags (list_id, tag_id)
+		SELECT list_id, $2 FROM list_tags WHERE tag_id = $1
+		ON CONFLICT DO NOTHING
+	`
+	if _, err = tx.ExecContext(ctx, listQuery, sourceID, targetID); err != nil {
+		log.C(ctx).Errorf("failed to reassign list tags: %v", err)
+		return fmt.Errorf("failed to reassign list tags: %w", err)
+	}
+	return nil
+}
+
+// SetTodoTags replaces the tags of the todo with the given ones.
+func (r *SQLXTagRepository) SetTodoTags(ctx context.Context, todoID string, tagIDs []string) error {
+	log.C(ctx).Info("setting todo tags repository")
+	return r.setTags(ctx, "todo_tags", "todo_id", todoID, tagIDs)
+}
+
+// SetListTags replaces the tags of the list with the given ones.
+func (r *SQLXTagRepository) SetListTags(ctx context.Context, listID string, tagIDs []string) error {
+	log.C(ctx).Info("setting list tags repository")
+	return r.setTags(ctx, "list_tags", "list_id", listID, tagIDs)
+}
+
+// GetListStatus returns the status the user has on the list, or an empty
+// string when the list is not shared with the user.
+func (r *SQLXTagRepository) GetListStatus(ctx context.Context, listID string, userID string) (string, error) {
+	log.C(ctx).Info("getting list status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	query := `
+		SELECT a.status
+		FROM list_access a
+		JOIN lists l ON l.id = a.list_id
+		WHERE a.list_id = $1 AND a.user_id = $2 AND l.deleted_at IS NULL
+	`
+	var status string
+	err = tx.GetContext(ctx, &status, query, listID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		log.C(ctx).Errorf("failed to get list status: %v", err)
+		return "", fmt.Errorf("failed to get list status: %w", err)
+	}
+	return status, nil
+}
+
+func (r *SQLXTagRepository) setTags(ctx context.Context, table string, column string, id string, tagIDs []string) error {
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	deleteQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s = $1`, table, column)
+	if _, err = tx.ExecContext(ctx, deleteQuery, id); err != nil {
+		log.C(ctx).Errorf("failed to remove tags from %s: %v", table, err)
+		return fmt.Errorf("failed to remove tags: %w", err)
+	}
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	insertQuery := fmt.Sprintf(`INSERT INTO %s (%s, tag_id) SELECT $1, unnest($2::uuid[])`, table, column)
+	if _, err = tx.ExecContext(ctx, insertQuery, id, pq.Array(tagIDs)); err != nil {
+		log.C(ctx).Errorf("failed to add tags to %s: %v", table, err)
+		return fmt.Errorf("failed to add tags: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXTagRepository) selectTags(ctx context.Context, query string, args ...interface{}) ([]models.Tag, error) {
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	var entities []Entity
+	if err = tx.SelectContext(ctx, &entities, query, args...); err != nil {
+		log.C(ctx).Errorf("failed to get tags: %v", err)
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	result := make([]models.Tag, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertTagToModel(entity))
+	}
+	return result, nil
+}
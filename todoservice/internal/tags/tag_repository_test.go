@@ -0,0 +1,234 @@
+package tags_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXTagRepositoryGetAccessible(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := tags.NewSQLXTagRepository()
+
+	userID := "user"
+	listID := "list"
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		setupMocks     func()
+		expectedResult []models.Tag
+		expectedError  error
+	}{
+		{
+			name: "Successful get of personal and list tags",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT g.id, g.name, g.color, g.description, g.user_id, g.list_id, g.created_at, (.+) AS usage_count FROM tags g " +
+					"WHERE g.user_id = \\$1 OR g.list_id IN \\((.+)\\) ORDER BY lower\\(g.name\\), g.id").
+					WithArgs(userID).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "name", "color", "description", "user_id", "list_id", "created_at", "usage_count"}).
+						AddRow("1", "Home", "#00ff00", nil, userID, nil, now, 2).
+						AddRow("2", "Work", nil, "Office things", nil, listID, now, 0))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: []models.Tag{
+				{ID: "1", Name: "Home", Color: "#00ff00", UserID: &userID, UsageCount: 2, CreatedAt: now},
+				{ID: "2", Name: "Work", Description: "Office things", ListID: &listID, CreatedAt: now},
+			},
+		},
+		{
+			name: "Failed get due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("FROM tags g").
+					WithArgs(userID).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get tags: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.GetAccessible(ctx, userID)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTagRepositorySetTodoTags(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := tags.NewSQLXTagRepository()
+
+	testCases := []struct {
+		name          string
+		tagIDs        []string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name:   "Successful replacement of the tags",
+			tagIDs: []string{"1", "2"},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("DELETE FROM todo_tags WHERE todo_id = \\$1").
+					WithArgs("todo").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectExec("INSERT INTO todo_tags \\(todo_id, tag_id\\) SELECT \\$1, unnest\\(\\$2::uuid\\[\\]\\)").
+					WithArgs("todo", pq.Array([]string{"1", "2"})).
+					WillReturnResult(sqlxmock.NewResult(0, 2))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Successful removal of every tag",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("DELETE FROM todo_tags WHERE todo_id = \\$1").
+					WithArgs("todo").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name:   "Failed replacement due to database error",
+			tagIDs: []string{"1"},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("DELETE FROM todo_tags WHERE todo_id = \\$1").
+					WithArgs("todo").
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectExec("INSERT INTO todo_tags").
+					WithArgs("todo", pq.Array([]string{"1"})).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to add tags: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.SetTodoTags(ctx, "todo", tc.tagIDs)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTagRepositoryReassign(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := tags.NewSQLXTagRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful reassignment of todos and lists",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("INSERT INTO todo_tags \\(todo_id, tag_id\\) SELECT todo_id, \\$2 FROM todo_tags WHERE tag_id = \\$1 ON CONFLICT DO NOTHING").
+					WithArgs("source", "target").
+					WillReturnResult(sqlxmock.NewResult(0, 3))
+				mockDB.ExpectExec("INSERT INTO list_tags \\(list_id, tag_id\\) SELECT list_id, \\$2 FROM list_tags WHERE tag_id = \\$1 ON CONFLICT DO NOTHING").
+					WithArgs("source", "target").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed reassignment due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("INSERT INTO todo_tags").
+					WithArgs("source", "target").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to reassign todo tags: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.Reassign(ctx, "source", "target")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,387 @@
+package tags
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const maxTagNameLength = 64
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+var (
+	ErrInvalidTag       = errors.New("invalid tag")
+	ErrDuplicateTag     = errors.New("a tag with this name already exists")
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrTagReadOnly      = errors.New("only the owner of the tag can change it")
+	ErrIncompatibleTags = errors.New("tags cannot be merged")
+)
+
+//go:generate mockery --name=TagService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TagService interface {
+	CreateTag(ctx context.Context, tag models.Tag) (models.Tag, error)
+	GetTag(ctx context.Context, id string, userID string) (models.Tag, error)
+	ListTags(ctx context.Context, userID string) ([]models.Tag, error)
+	ListListTags(ctx context.Context, listID string) ([]models.Tag, error)
+	UpdateTag(ctx context.Context, tag models.Tag, userID string) (models.Tag, error)
+	DeleteTag(ctx context.Context, id string, userID string) error
+	MergeTags(ctx context.Context, sourceID string, targetID string, userID string) (models.Tag, error)
+	SetTodoTags(ctx context.Context, todoID string, listID string, names []string) error
+	SetListTags(ctx context.Context, listID string, ownerID string, names []string) error
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ TagService = &service{}
+
+type service struct {
+	repo        TagRepository
+	uuidService UUIDService
+	timeService TimeService
+}
+
+func NewService(repo TagRepository, uuidService UUIDService, timeService TimeService) TagService {
+	return &service{repo: repo, uuidService: uuidService, timeService: timeService}
+}
+
+// CreateTag creates a personal tag when the tag's UserID is set and a tag of
+// a list when its ListID is set.
+func (s *service) CreateTag(ctx context.Context, tag models.Tag) (models.Tag, error) {
+	log.C(ctx).Info("creating tag service")
+	tag = normalizeTag(tag)
+	if (tag.UserID == nil) == (tag.ListID == nil) {
+		return models.Tag{}, fmt.Errorf("%w: a tag belongs to either a user or a list", ErrInvalidTag)
+	}
+	if err := validateTag(tag); err != nil {
+		return models.Tag{}, err
+	}
+	if err := s.ensureUniqueName(ctx, tag); err != nil {
+		return models.Tag{}, err
+	}
+
+	tag.ID = s.uuidService.Generate()
+	tag.CreatedAt = s.timeService.Now()
+	if _, err := s.repo.Create(ctx, tag); err != nil {
+		log.C(ctx).Errorf("creating tag %q failed", tag.Name)
+		return models.Tag{}, err
+	}
+	return s.repo.Get(ctx, tag.ID)
+}
+
+// GetTag returns the tag if it is a personal tag of the user or a tag of a
+// list the user owns or has accepted.
+func (s *service) GetTag(ctx context.Context, id string, userID string) (models.Tag, error) {
+	log.C(ctx).Info("getting tag service")
+	tag, err := s.getTag(ctx, id)
+	if err != nil {
+		return models.Tag{}, err
+	}
+	if tag.UserID != nil {
+		if *tag.UserID != userID {
+			return models.Tag{}, ErrTagNotFound
+		}
+		return tag, nil
+	}
+	status, err := s.repo.GetListStatus(ctx, *tag.ListID, userID)
+	if err != nil {
+		log.C(ctx).Errorf("getting status of user %s on list with id %s failed", userID, *tag.ListID)
+		return models.Tag{}, err
+	}
+	if status != constants.StatusOwner && status != constants.StatusAccepted {
+		return models.Tag{}, ErrTagNotFound
+	}
+	return tag, nil
+}
+
+func (s *service) ListTags(ctx context.Context, userID string) ([]models.Tag, error) {
+	log.C(ctx).Info("listing tags service")
+	return s.repo.GetAccessible(ctx, userID)
+}
+
+func (s *service) ListListTags(ctx context.Context, listID string) ([]models.Tag, error) {
+	log.C(ctx).Info("listing tags of list service")
+	return s.repo.GetByListID(ctx, listID)
+}
+
+// UpdateTag renames the tag and changes its colour and description. Todos and
+// lists refer to the tag, so all of them show the new name.
+func (s *service) UpdateTag(ctx context.Context, tag models.Tag, userID string) (models.Tag, error) {
+	log.C(ctx).Info("updating tag service")
+	existing, err := s.getOwnTag(ctx, tag.ID, userID)
+	if err != nil {
+		return models.Tag{}, err
+	}
+
+	existing.Name = tag.Name
+	existing.Color = tag.Color
+	existing.Description = tag.Description
+	existing = normalizeTag(existing)
+	if err = validateTag(existing); err != nil {
+		return models.Tag{}, err
+	}
+	if err = s.ensureUniqueName(ctx, existing); err != nil {
+		return models.Tag{}, err
+	}
+	if err = s.repo.Update(ctx, existing); err != nil {
+		log.C(ctx).Errorf("updating tag with id %s failed", tag.ID)
+		return models.Tag{}, err
+	}
+	return s.repo.Get(ctx, existing.ID)
+}
+
+// DeleteTag removes the tag from every todo and list carrying it.
+func (s *service) DeleteTag(ctx context.Context, id string, userID string) error {
+	log.C(ctx).Info("deleting tag service")
+	if _, err := s.getOwnTag(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// MergeTags replaces the source tag with the target tag on every todo and list
+// and deletes the source tag. A tag of a list can only absorb tags of the same
+// list, while a personal tag can absorb any tag its owner manages.
+func (s *service) MergeTags(ctx context.Context, sourceID string, targetID string, userID string) (models.Tag, error) {
+	log.C(ctx).Info("merging tags service")
+	if sourceID == targetID {
+		return models.Tag{}, fmt.Errorf("%w: a tag cannot be merged into itself", ErrIncompatibleTags)
+	}
+	source, err := s.getOwnTag(ctx, sourceID, userID)
+	if err != nil {
+		return models.Tag{}, err
+	}
+	target, err := s.getOwnTag(ctx, targetID, userID)
+	if err != nil {
+		return models.Tag{}, err
+	}
+	if target.ListID != nil && (source.ListID == nil || *source.ListID != *target.ListID) {
+		return models.Tag{}, fmt.Errorf("%w: a tag of a list can only absorb tags of the same list", ErrIncompatibleTags)
+	}
+
+	if err = s.repo.Reassign(ctx, source.ID, target.ID); err != nil {
+		log.C(ctx).Errorf("reassigning tag %s to tag %s failed", source.ID, target.ID)
+		return models.Tag{}, err
+	}
+	if err = s.repo.Delete(ctx, source.ID); err != nil {
+		log.C(ctx).Errorf("deleting merged tag with id %s failed", source.ID)
+		return models.Tag{}, err
+	}
+	return s.repo.Get(ctx, target.ID)
+}
+
+// SetTodoTags replaces the tags of the todo with the tags of the given names.
+// A name is resolved to the tag of the list first and to a personal tag of the
+// list owner second; unknown names become new tags of the list.
+func (s *service) SetTodoTags(ctx context.Context, todoID string, listID string, names []string) error {
+	log.C(ctx).Info("setting todo tags service")
+	names, err := normalizeNames(names)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return s.repo.SetTodoTags(ctx, todoID, nil)
+	}
+	found, err := s.repo.FindForList(ctx, listID, lowerNames(names))
+	if err != nil {
+		log.C(ctx).Errorf("finding tags for list with id %s failed", listID)
+		return err
+	}
+	ids, err := s.resolveNames(ctx, names, found, func(tag *models.Tag) { tag.ListID = &listID })
+	if err != nil {
+		return err
+	}
+	return s.repo.SetTodoTags(ctx, todoID, ids)
+}
+
+// SetListTags replaces the tags of the list with the personal tags of its
+// owner with the given names, creating the ones the owner does not have yet.
+func (s *service) SetListTags(ctx context.Context, listID string, ownerID string, names []string) error {
+	log.C(ctx).Info("setting list tags service")
+	names, err := normalizeNames(names)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return s.repo.SetListTags(ctx, listID, nil)
+	}
+	found, err := s.repo.FindForUser(ctx, ownerID, lowerNames(names))
+	if err != nil {
+		log.C(ctx).Errorf("finding tags of user with id %s failed", ownerID)
+		return err
+	}
+	ids, err := s.resolveNames(ctx, names, found, func(tag *models.Tag) { tag.UserID = &ownerID })
+	if err != nil {
+		return err
+	}
+	return s.repo.SetListTags(ctx, listID, ids)
+}
+
+// Names decodes the JSON array of tag names todos and lists are sent with. A
+// missing or null array means no tags.
+func Names(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var names []string
+	if err := json.Unmarshal(raw, &names); err != nil {
+		return nil, fmt.Errorf("%w: tags must be an array of names", ErrInvalidTag)
+	}
+	return names, nil
+}
+
+// resolveNames returns the IDs of the tags with the given names, preferring
+// tags of a list over personal tags. Missing tags are created in the scope
+// set by scope.
+func (s *service) resolveNames(ctx context.Context, names []string, found []models.Tag, scope func(tag *models.Tag)) ([]string, error) {
+	byName := make(map[string]models.Tag, len(found))
+	for _, tag := range found {
+		key := strings.ToLower(tag.Name)
+		if existing, ok := byName[key]; ok && existing.ListID != nil {
+			continue
+		}
+		byName[key] = tag
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if tag, ok := byName[strings.ToLower(name)]; ok {
+			ids = append(ids, tag.ID)
+			continue
+		}
+		tag := models.Tag{ID: s.uuidService.Generate(), Name: name, CreatedAt: s.timeService.Now()}
+		scope(&tag)
+		if _, err := s.repo.Create(ctx, tag); err != nil {
+			log.C(ctx).Errorf("creating tag %q failed", name)
+			return nil, err
+		}
+		ids = append(ids, tag.ID)
+	}
+	return ids, nil
+}
+
+func (s *service) getTag(ctx context.Context, id string) (models.Tag, error) {
+	tag, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Tag{}, ErrTagNotFound
+	}
+	if err != nil {
+		log.C(ctx).Errorf("getting tag with id %s failed", id)
+		return models.Tag{}, err
+	}
+	return tag, nil
+}
+
+// getOwnTag returns the tag if the user may change it: personal tags can only
+// be changed by their user and tags of a list only by the list owner.
+func (s *service) getOwnTag(ctx context.Context, id string, userID string) (models.Tag, error) {
+	tag, err := s.GetTag(ctx, id, userID)
+	if err != nil {
+		return models.Tag{}, err
+	}
+	if tag.ListID == nil {
+		return tag, nil
+	}
+	status, err := s.repo.GetListStatus(ctx, *tag.ListID, userID)
+	if err != nil {
+		log.C(ctx).Errorf("getting status of user %s on list with id %s failed", userID, *tag.ListID)
+		return models.Tag{}, err
+	}
+	if status != constants.StatusOwner {
+		return models.Tag{}, ErrTagReadOnly
+	}
+	return tag, nil
+}
+
+func (s *service) ensureUniqueName(ctx context.Context, tag models.Tag) error {
+	var (
+		others []models.Tag
+		err    error
+	)
+	if tag.ListID != nil {
+		others, err = s.repo.FindForList(ctx, *tag.ListID, []string{strings.ToLower(tag.Name)})
+	} else {
+		others, err = s.repo.FindForUser(ctx, *tag.UserID, []string{strings.ToLower(tag.Name)})
+	}
+	if err != nil {
+		log.C(ctx).Errorf("finding tags named %q failed", tag.Name)
+		return err
+	}
+	for _, other := range others {
+		if other.ID != tag.ID && sameScope(other, tag) {
+			return ErrDuplicateTag
+		}
+	}
+	return nil
+}
+
+func sameScope(a, b models.Tag) bool {
+	if a.ListID != nil && b.ListID != nil {
+		return *a.ListID == *b.ListID
+	}
+	if a.UserID != nil && b.UserID != nil {
+		return *a.UserID == *b.UserID
+	}
+	return false
+}
+
+func normalizeTag(tag models.Tag) models.Tag {
+	tag.Name = strings.TrimSpace(tag.Name)
+	tag.Color = strings.ToLower(strings.TrimSpace(tag.Color))
+	tag.Description = strings.TrimSpace(tag.Description)
+	return tag
+}
+
+func validateTag(tag models.Tag) error {
+	if tag.Name == "" || len(tag.Name) > maxTagNameLength {
+		return fmt.Errorf("%w: name must be between 1 and %d characters", ErrInvalidTag, maxTagNameLength)
+	}
+	if tag.Color != "" && !colorPattern.MatchString(tag.Color) {
+		return fmt.Errorf("%w: colour must look like #1a2b3c", ErrInvalidTag)
+	}
+	return nil
+}
+
+// normalizeNames trims the names and drops blank ones and names that only
+// differ in case from an earlier one.
+func normalizeNames(names []string) ([]string, error) {
+	result := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		if len(name) > maxTagNameLength {
+			return nil, fmt.Errorf("%w: %q is longer than %d characters", ErrInvalidTag, name, maxTagNameLength)
+		}
+		seen[key] = true
+		result = append(result, name)
+	}
+	return result, nil
+}
+
+func lowerNames(names []string) []string {
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		result = append(result, strings.ToLower(name))
+	}
+	return result
+}
@@ -0,0 +1,377 @@
+package tags_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestServiceCreateTag(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	userID := "user"
+	listID := "list"
+	otherListID := "other"
+
+	created := models.Tag{ID: "1", Name: "Work", Color: "#aabbcc", UserID: &userID, CreatedAt: now}
+
+	tests := []struct {
+		name          string
+		input         models.Tag
+		repo          func() *automock.TagRepository
+		expected      models.Tag
+		expectedError error
+	}{
+		{
+			name:  "Create a personal tag with a normalized colour",
+			input: models.Tag{Name: " Work ", Color: "#AABBCC", UserID: &userID},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().FindForUser(ctx, userID, []string{"work"}).Return(nil, nil).Once()
+				repo.EXPECT().Create(ctx, created).Return(created.ID, nil).Once()
+				repo.EXPECT().Get(ctx, created.ID).Return(created, nil).Once()
+				return repo
+			},
+			expected: created,
+		},
+		{
+			name:  "A list tag may share its name with a personal tag",
+			input: models.Tag{Name: "Work", ListID: &listID},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().FindForList(ctx, listID, []string{"work"}).Return([]models.Tag{{ID: "2", Name: "work", UserID: &userID}}, nil).Once()
+				repo.EXPECT().Create(ctx, mock.Anything).Return("1", nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(models.Tag{ID: "1", Name: "Work", ListID: &listID}, nil).Once()
+				return repo
+			},
+			expected: models.Tag{ID: "1", Name: "Work", ListID: &listID},
+		},
+		{
+			name:  "Error when the list already has the tag",
+			input: models.Tag{Name: "Work", ListID: &listID},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().FindForList(ctx, listID, []string{"work"}).Return([]models.Tag{{ID: "2", Name: "WORK", ListID: &listID}}, nil).Once()
+				return repo
+			},
+			expectedError: tags.ErrDuplicateTag,
+		},
+		{
+			name:          "Error when the tag has no scope",
+			input:         models.Tag{Name: "Work"},
+			repo:          func() *automock.TagRepository { return &automock.TagRepository{} },
+			expectedError: tags.ErrInvalidTag,
+		},
+		{
+			name:          "Error when the tag has two scopes",
+			input:         models.Tag{Name: "Work", UserID: &userID, ListID: &otherListID},
+			repo:          func() *automock.TagRepository { return &automock.TagRepository{} },
+			expectedError: tags.ErrInvalidTag,
+		},
+		{
+			name:          "Error when the name is blank",
+			input:         models.Tag{Name: "  ", UserID: &userID},
+			repo:          func() *automock.TagRepository { return &automock.TagRepository{} },
+			expectedError: tags.ErrInvalidTag,
+		},
+		{
+			name:          "Error when the colour is not a hex colour",
+			input:         models.Tag{Name: "Work", Color: "red", UserID: &userID},
+			repo:          func() *automock.TagRepository { return &automock.TagRepository{} },
+			expectedError: tags.ErrInvalidTag,
+		},
+		{
+			name:  "Error when creating fails",
+			input: models.Tag{Name: "Work", UserID: &userID},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().FindForUser(ctx, userID, []string{"work"}).Return(nil, nil).Once()
+				repo.EXPECT().Create(ctx, mock.Anything).Return("", err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(created.ID).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := tags.NewService(repo, uuidService, timeService)
+			result, err := svc.CreateTag(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateTag(t *testing.T) {
+	ctx := context.Background()
+	userID := "user"
+	otherUserID := "other"
+	listID := "list"
+
+	personal := models.Tag{ID: "1", Name: "Work", UserID: &userID}
+	listTag := models.Tag{ID: "2", Name: "Stage", ListID: &listID}
+
+	tests := []struct {
+		name          string
+		input         models.Tag
+		repo          func() *automock.TagRepository
+		expected      models.Tag
+		expectedError error
+	}{
+		{
+			name:  "Rename a personal tag",
+			input: models.Tag{ID: "1", Name: "Office", Color: "#112233"},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				renamed := models.Tag{ID: "1", Name: "Office", Color: "#112233", UserID: &userID}
+				repo.EXPECT().Get(ctx, "1").Return(personal, nil).Once()
+				repo.EXPECT().FindForUser(ctx, userID, []string{"office"}).Return(nil, nil).Once()
+				repo.EXPECT().Update(ctx, renamed).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(renamed, nil).Once()
+				return repo
+			},
+			expected: models.Tag{ID: "1", Name: "Office", Color: "#112233", UserID: &userID},
+		},
+		{
+			name:  "Change the case of a tag's name",
+			input: models.Tag{ID: "1", Name: "WORK"},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				renamed := models.Tag{ID: "1", Name: "WORK", UserID: &userID}
+				repo.EXPECT().Get(ctx, "1").Return(personal, nil).Once()
+				repo.EXPECT().FindForUser(ctx, userID, []string{"work"}).Return([]models.Tag{personal}, nil).Once()
+				repo.EXPECT().Update(ctx, renamed).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(renamed, nil).Once()
+				return repo
+			},
+			expected: models.Tag{ID: "1", Name: "WORK", UserID: &userID},
+		},
+		{
+			name:  "Error when another tag has the name",
+			input: models.Tag{ID: "1", Name: "Home"},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(personal, nil).Once()
+				repo.EXPECT().FindForUser(ctx, userID, []string{"home"}).Return([]models.Tag{{ID: "3", Name: "home", UserID: &userID}}, nil).Once()
+				return repo
+			},
+			expectedError: tags.ErrDuplicateTag,
+		},
+		{
+			name:  "Error when the tag belongs to another user",
+			input: models.Tag{ID: "1", Name: "Office"},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(models.Tag{ID: "1", Name: "Work", UserID: &otherUserID}, nil).Once()
+				return repo
+			},
+			expectedError: tags.ErrTagNotFound,
+		},
+		{
+			name:  "Error when a collaborator renames a tag of the list",
+			input: models.Tag{ID: "2", Name: "Phase"},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().Get(ctx, "2").Return(listTag, nil).Once()
+				repo.EXPECT().GetListStatus(ctx, listID, userID).Return(constants.StatusAccepted, nil).Twice()
+				return repo
+			},
+			expectedError: tags.ErrTagReadOnly,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := tags.NewService(repo, &automock.UUIDService{}, &automock.TimeService{})
+			result, err := svc.UpdateTag(ctx, tt.input, userID)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceMergeTags(t *testing.T) {
+	ctx := context.Background()
+	userID := "user"
+	listID := "list"
+	otherListID := "other"
+
+	personal := models.Tag{ID: "1", Name: "Work", UserID: &userID}
+	listTag := models.Tag{ID: "2", Name: "work", ListID: &listID}
+	otherListTag := models.Tag{ID: "3", Name: "Job", ListID: &otherListID}
+
+	tests := []struct {
+		name          string
+		sourceID      string
+		targetID      string
+		repo          func() *automock.TagRepository
+		expected      models.Tag
+		expectedError error
+	}{
+		{
+			name:     "Merge a tag of an owned list into a personal tag",
+			sourceID: "2",
+			targetID: "1",
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().Get(ctx, "2").Return(listTag, nil).Once()
+				repo.EXPECT().GetListStatus(ctx, listID, userID).Return(constants.StatusOwner, nil).Twice()
+				repo.EXPECT().Get(ctx, "1").Return(personal, nil).Once()
+				repo.EXPECT().Reassign(ctx, "2", "1").Return(nil).Once()
+				repo.EXPECT().Delete(ctx, "2").Return(nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(models.Tag{ID: "1", Name: "Work", UserID: &userID, UsageCount: 4}, nil).Once()
+				return repo
+			},
+			expected: models.Tag{ID: "1", Name: "Work", UserID: &userID, UsageCount: 4},
+		},
+		{
+			name:     "Error when merging into a tag of another list",
+			sourceID: "2",
+			targetID: "3",
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().Get(ctx, "2").Return(listTag, nil).Once()
+				repo.EXPECT().GetListStatus(ctx, listID, userID).Return(constants.StatusOwner, nil).Twice()
+				repo.EXPECT().Get(ctx, "3").Return(otherListTag, nil).Once()
+				repo.EXPECT().GetListStatus(ctx, otherListID, userID).Return(constants.StatusOwner, nil).Twice()
+				return repo
+			},
+			expectedError: tags.ErrIncompatibleTags,
+		},
+		{
+			name:     "Error when merging a personal tag into a tag of a list",
+			sourceID: "1",
+			targetID: "2",
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(personal, nil).Once()
+				repo.EXPECT().Get(ctx, "2").Return(listTag, nil).Once()
+				repo.EXPECT().GetListStatus(ctx, listID, userID).Return(constants.StatusOwner, nil).Twice()
+				return repo
+			},
+			expectedError: tags.ErrIncompatibleTags,
+		},
+		{
+			name:          "Error when merging a tag into itself",
+			sourceID:      "1",
+			targetID:      "1",
+			repo:          func() *automock.TagRepository { return &automock.TagRepository{} },
+			expectedError: tags.ErrIncompatibleTags,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := tags.NewService(repo, &automock.UUIDService{}, &automock.TimeService{})
+			result, err := svc.MergeTags(ctx, tt.sourceID, tt.targetID, userID)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceSetTodoTags(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	ownerID := "owner"
+	listID := "list"
+
+	tests := []struct {
+		name          string
+		names         []string
+		repo          func() *automock.TagRepository
+		expectedError error
+	}{
+		{
+			name:  "Tags of the list win over personal tags and unknown names become tags of the list",
+			names: []string{"Work", " home ", "work", "", "New"},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().FindForList(ctx, listID, []string{"work", "home", "new"}).Return([]models.Tag{
+					{ID: "1", Name: "work", UserID: &ownerID},
+					{ID: "2", Name: "Work", ListID: &listID},
+					{ID: "3", Name: "Home", UserID: &ownerID},
+				}, nil).Once()
+				repo.EXPECT().Create(ctx, models.Tag{ID: "4", Name: "New", ListID: &listID, CreatedAt: now}).Return("4", nil).Once()
+				repo.EXPECT().SetTodoTags(ctx, "todo", []string{"2", "3", "4"}).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:  "No names remove every tag",
+			names: []string{" "},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().SetTodoTags(ctx, "todo", []string(nil)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:          "Error when a name is too long",
+			names:         []string{"this tag name is far too long to be accepted as the name of a tag"},
+			repo:          func() *automock.TagRepository { return &automock.TagRepository{} },
+			expectedError: tags.ErrInvalidTag,
+		},
+		{
+			name:  "Error when finding the tags fails",
+			names: []string{"work"},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().FindForList(ctx, listID, []string{"work"}).Return(nil, err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return("4").Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := tags.NewService(repo, uuidService, timeService)
+			err := svc.SetTodoTags(ctx, "todo", listID, tt.names)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
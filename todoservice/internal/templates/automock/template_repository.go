@@ -0,0 +1,304 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TemplateRepository is an autogenerated mock type for the TemplateRepository type
+type TemplateRepository struct {
+	mock.Mock
+}
+
+type TemplateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TemplateRepository) EXPECT() *TemplateRepository_Expecter {
+	return &TemplateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, template
+func (_m *TemplateRepository) Create(ctx context.Context, template models.ListTemplate) (string, error) {
+	ret := _m.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListTemplate) (string, error)); ok {
+		return rf(ctx, template)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListTemplate) string); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ListTemplate) error); ok {
+		r1 = rf(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TemplateRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template models.ListTemplate
+func (_e *TemplateRepository_Expecter) Create(ctx interface{}, template interface{}) *TemplateRepository_Create_Call {
+	return &TemplateRepository_Create_Call{Call: _e.mock.On("Create", ctx, template)}
+}
+
+func (_c *TemplateRepository_Create_Call) Run(run func(ctx context.Context, template models.ListTemplate)) *TemplateRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ListTemplate))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_Create_Call) Return(_a0 string, _a1 error) *TemplateRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateRepository_Create_Call) RunAndReturn(run func(context.Context, models.ListTemplate) (string, error)) *TemplateRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *TemplateRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TemplateRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TemplateRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TemplateRepository_Expecter) Delete(ctx interface{}, id interface{}) *TemplateRepository_Delete_Call {
+	return &TemplateRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *TemplateRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *TemplateRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_Delete_Call) Return(_a0 error) *TemplateRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TemplateRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *TemplateRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *TemplateRepository) Get(ctx context.Context, id string) (models.ListTemplate, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 models.ListTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.ListTemplate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.ListTemplate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.ListTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TemplateRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TemplateRepository_Expecter) Get(ctx interface{}, id interface{}) *TemplateRepository_Get_Call {
+	return &TemplateRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *TemplateRepository_Get_Call) Run(run func(ctx context.Context, id string)) *TemplateRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_Get_Call) Return(_a0 models.ListTemplate, _a1 error) *TemplateRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateRepository_Get_Call) RunAndReturn(run func(context.Context, string) (models.ListTemplate, error)) *TemplateRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByOwnerID provides a mock function with given fields: ctx, ownerID
+func (_m *TemplateRepository) GetByOwnerID(ctx context.Context, ownerID string) ([]models.ListTemplate, error) {
+	ret := _m.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByOwnerID")
+	}
+
+	var r0 []models.ListTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.ListTemplate, error)); ok {
+		return rf(ctx, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.ListTemplate); ok {
+		r0 = rf(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ListTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateRepository_GetByOwnerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByOwnerID'
+type TemplateRepository_GetByOwnerID_Call struct {
+	*mock.Call
+}
+
+// GetByOwnerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID string
+func (_e *TemplateRepository_Expecter) GetByOwnerID(ctx interface{}, ownerID interface{}) *TemplateRepository_GetByOwnerID_Call {
+	return &TemplateRepository_GetByOwnerID_Call{Call: _e.mock.On("GetByOwnerID", ctx, ownerID)}
+}
+
+func (_c *TemplateRepository_GetByOwnerID_Call) Run(run func(ctx context.Context, ownerID string)) *TemplateRepository_GetByOwnerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_GetByOwnerID_Call) Return(_a0 []models.ListTemplate, _a1 error) *TemplateRepository_GetByOwnerID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateRepository_GetByOwnerID_Call) RunAndReturn(run func(context.Context, string) ([]models.ListTemplate, error)) *TemplateRepository_GetByOwnerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, template
+func (_m *TemplateRepository) Update(ctx context.Context, template models.ListTemplate) error {
+	ret := _m.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListTemplate) error); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TemplateRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type TemplateRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template models.ListTemplate
+func (_e *TemplateRepository_Expecter) Update(ctx interface{}, template interface{}) *TemplateRepository_Update_Call {
+	return &TemplateRepository_Update_Call{Call: _e.mock.On("Update", ctx, template)}
+}
+
+func (_c *TemplateRepository_Update_Call) Run(run func(ctx context.Context, template models.ListTemplate)) *TemplateRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ListTemplate))
+	})
+	return _c
+}
+
+func (_c *TemplateRepository_Update_Call) Return(_a0 error) *TemplateRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TemplateRepository_Update_Call) RunAndReturn(run func(context.Context, models.ListTemplate) error) *TemplateRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTemplateRepository creates a new instance of TemplateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTemplateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TemplateRepository {
+	mock := &TemplateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,434 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TemplateService is an autogenerated mock type for the TemplateService type
+type TemplateService struct {
+	mock.Mock
+}
+
+type TemplateService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TemplateService) EXPECT() *TemplateService_Expecter {
+	return &TemplateService_Expecter{mock: &_m.Mock}
+}
+
+// CreateTemplate provides a mock function with given fields: ctx, template
+func (_m *TemplateService) CreateTemplate(ctx context.Context, template models.ListTemplate) (models.ListTemplate, error) {
+	ret := _m.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTemplate")
+	}
+
+	var r0 models.ListTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListTemplate) (models.ListTemplate, error)); ok {
+		return rf(ctx, template)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListTemplate) models.ListTemplate); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Get(0).(models.ListTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ListTemplate) error); ok {
+		r1 = rf(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateService_CreateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTemplate'
+type TemplateService_CreateTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template models.ListTemplate
+func (_e *TemplateService_Expecter) CreateTemplate(ctx interface{}, template interface{}) *TemplateService_CreateTemplate_Call {
+	return &TemplateService_CreateTemplate_Call{Call: _e.mock.On("CreateTemplate", ctx, template)}
+}
+
+func (_c *TemplateService_CreateTemplate_Call) Run(run func(ctx context.Context, template models.ListTemplate)) *TemplateService_CreateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ListTemplate))
+	})
+	return _c
+}
+
+func (_c *TemplateService_CreateTemplate_Call) Return(_a0 models.ListTemplate, _a1 error) *TemplateService_CreateTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateService_CreateTemplate_Call) RunAndReturn(run func(context.Context, models.ListTemplate) (models.ListTemplate, error)) *TemplateService_CreateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTemplate provides a mock function with given fields: ctx, id, userID
+func (_m *TemplateService) DeleteTemplate(ctx context.Context, id string, userID string) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TemplateService_DeleteTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTemplate'
+type TemplateService_DeleteTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *TemplateService_Expecter) DeleteTemplate(ctx interface{}, id interface{}, userID interface{}) *TemplateService_DeleteTemplate_Call {
+	return &TemplateService_DeleteTemplate_Call{Call: _e.mock.On("DeleteTemplate", ctx, id, userID)}
+}
+
+func (_c *TemplateService_DeleteTemplate_Call) Run(run func(ctx context.Context, id string, userID string)) *TemplateService_DeleteTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TemplateService_DeleteTemplate_Call) Return(_a0 error) *TemplateService_DeleteTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TemplateService_DeleteTemplate_Call) RunAndReturn(run func(context.Context, string, string) error) *TemplateService_DeleteTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemplate provides a mock function with given fields: ctx, id, userID
+func (_m *TemplateService) GetTemplate(ctx context.Context, id string, userID string) (models.ListTemplate, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplate")
+	}
+
+	var r0 models.ListTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.ListTemplate, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.ListTemplate); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Get(0).(models.ListTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateService_GetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemplate'
+type TemplateService_GetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *TemplateService_Expecter) GetTemplate(ctx interface{}, id interface{}, userID interface{}) *TemplateService_GetTemplate_Call {
+	return &TemplateService_GetTemplate_Call{Call: _e.mock.On("GetTemplate", ctx, id, userID)}
+}
+
+func (_c *TemplateService_GetTemplate_Call) Run(run func(ctx context.Context, id string, userID string)) *TemplateService_GetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TemplateService_GetTemplate_Call) Return(_a0 models.ListTemplate, _a1 error) *TemplateService_GetTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateService_GetTemplate_Call) RunAndReturn(run func(context.Context, string, string) (models.ListTemplate, error)) *TemplateService_GetTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InstantiateTemplate provides a mock function with given fields: ctx, id, userID, request
+func (_m *TemplateService) InstantiateTemplate(ctx context.Context, id string, userID string, request models.TemplateAnchor) (models.List, error) {
+	ret := _m.Called(ctx, id, userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstantiateTemplate")
+	}
+
+	var r0 models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.TemplateAnchor) (models.List, error)); ok {
+		return rf(ctx, id, userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.TemplateAnchor) models.List); ok {
+		r0 = rf(ctx, id, userID, request)
+	} else {
+		r0 = ret.Get(0).(models.List)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, models.TemplateAnchor) error); ok {
+		r1 = rf(ctx, id, userID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateService_InstantiateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstantiateTemplate'
+type TemplateService_InstantiateTemplate_Call struct {
+	*mock.Call
+}
+
+// InstantiateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+//   - request models.TemplateAnchor
+func (_e *TemplateService_Expecter) InstantiateTemplate(ctx interface{}, id interface{}, userID interface{}, request interface{}) *TemplateService_InstantiateTemplate_Call {
+	return &TemplateService_InstantiateTemplate_Call{Call: _e.mock.On("InstantiateTemplate", ctx, id, userID, request)}
+}
+
+func (_c *TemplateService_InstantiateTemplate_Call) Run(run func(ctx context.Context, id string, userID string, request models.TemplateAnchor)) *TemplateService_InstantiateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(models.TemplateAnchor))
+	})
+	return _c
+}
+
+func (_c *TemplateService_InstantiateTemplate_Call) Return(_a0 models.List, _a1 error) *TemplateService_InstantiateTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateService_InstantiateTemplate_Call) RunAndReturn(run func(context.Context, string, string, models.TemplateAnchor) (models.List, error)) *TemplateService_InstantiateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTemplates provides a mock function with given fields: ctx, userID
+func (_m *TemplateService) ListTemplates(ctx context.Context, userID string) ([]models.ListTemplate, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTemplates")
+	}
+
+	var r0 []models.ListTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.ListTemplate, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.ListTemplate); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ListTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateService_ListTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTemplates'
+type TemplateService_ListTemplates_Call struct {
+	*mock.Call
+}
+
+// ListTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *TemplateService_Expecter) ListTemplates(ctx interface{}, userID interface{}) *TemplateService_ListTemplates_Call {
+	return &TemplateService_ListTemplates_Call{Call: _e.mock.On("ListTemplates", ctx, userID)}
+}
+
+func (_c *TemplateService_ListTemplates_Call) Run(run func(ctx context.Context, userID string)) *TemplateService_ListTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TemplateService_ListTemplates_Call) Return(_a0 []models.ListTemplate, _a1 error) *TemplateService_ListTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateService_ListTemplates_Call) RunAndReturn(run func(context.Context, string) ([]models.ListTemplate, error)) *TemplateService_ListTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveListAsTemplate provides a mock function with given fields: ctx, listID, userID, request
+func (_m *TemplateService) SaveListAsTemplate(ctx context.Context, listID string, userID string, request models.TemplateAnchor) (models.ListTemplate, error) {
+	ret := _m.Called(ctx, listID, userID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveListAsTemplate")
+	}
+
+	var r0 models.ListTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.TemplateAnchor) (models.ListTemplate, error)); ok {
+		return rf(ctx, listID, userID, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, models.TemplateAnchor) models.ListTemplate); ok {
+		r0 = rf(ctx, listID, userID, request)
+	} else {
+		r0 = ret.Get(0).(models.ListTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, models.TemplateAnchor) error); ok {
+		r1 = rf(ctx, listID, userID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateService_SaveListAsTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveListAsTemplate'
+type TemplateService_SaveListAsTemplate_Call struct {
+	*mock.Call
+}
+
+// SaveListAsTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - userID string
+//   - request models.TemplateAnchor
+func (_e *TemplateService_Expecter) SaveListAsTemplate(ctx interface{}, listID interface{}, userID interface{}, request interface{}) *TemplateService_SaveListAsTemplate_Call {
+	return &TemplateService_SaveListAsTemplate_Call{Call: _e.mock.On("SaveListAsTemplate", ctx, listID, userID, request)}
+}
+
+func (_c *TemplateService_SaveListAsTemplate_Call) Run(run func(ctx context.Context, listID string, userID string, request models.TemplateAnchor)) *TemplateService_SaveListAsTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(models.TemplateAnchor))
+	})
+	return _c
+}
+
+func (_c *TemplateService_SaveListAsTemplate_Call) Return(_a0 models.ListTemplate, _a1 error) *TemplateService_SaveListAsTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateService_SaveListAsTemplate_Call) RunAndReturn(run func(context.Context, string, string, models.TemplateAnchor) (models.ListTemplate, error)) *TemplateService_SaveListAsTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTemplate provides a mock function with given fields: ctx, template
+func (_m *TemplateService) UpdateTemplate(ctx context.Context, template models.ListTemplate) (models.ListTemplate, error) {
+	ret := _m.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTemplate")
+	}
+
+	var r0 models.ListTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListTemplate) (models.ListTemplate, error)); ok {
+		return rf(ctx, template)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ListTemplate) models.ListTemplate); ok {
+		r0 = rf(ctx, template)
+	} else {
+		r0 = ret.Get(0).(models.ListTemplate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ListTemplate) error); ok {
+		r1 = rf(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateService_UpdateTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTemplate'
+type TemplateService_UpdateTemplate_Call struct {
+	*mock.Call
+}
+
+// UpdateTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template models.ListTemplate
+func (_e *TemplateService_Expecter) UpdateTemplate(ctx interface{}, template interface{}) *TemplateService_UpdateTemplate_Call {
+	return &TemplateService_UpdateTemplate_Call{Call: _e.mock.On("UpdateTemplate", ctx, template)}
+}
+
+func (_c *TemplateService_UpdateTemplate_Call) Run(run func(ctx context.Context, template models.ListTemplate)) *TemplateService_UpdateTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ListTemplate))
+	})
+	return _c
+}
+
+func (_c *TemplateService_UpdateTemplate_Call) Return(_a0 models.ListTemplate, _a1 error) *TemplateService_UpdateTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TemplateService_UpdateTemplate_Call) RunAndReturn(run func(context.Context, models.ListTemplate) (models.ListTemplate, error)) *TemplateService_UpdateTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTemplateService creates a new instance of TemplateService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTemplateService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TemplateService {
+	mock := &TemplateService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
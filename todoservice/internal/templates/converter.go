@@ -0,0 +1,52 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertTemplateToModel(entity Entity) (models.ListTemplate, error) {
+	todos := []models.TemplateTodo{}
+	if err := json.Unmarshal([]byte(entity.Todos), &todos); err != nil {
+		return models.ListTemplate{}, fmt.Errorf("failed to unmarshal template todos: %w", err)
+	}
+	return models.ListTemplate{
+		ID:          entity.ID,
+		OwnerID:     entity.OwnerID,
+		Name:        entity.Name,
+		Description: entity.Description,
+		Tags:        pkg.JSONRawMessageFromNullableString(entity.Tags),
+		Todos:       todos,
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}, nil
+}
+
+func (c *Converter) ConvertTemplateToEntity(template models.ListTemplate) (Entity, error) {
+	todos := template.Todos
+	if todos == nil {
+		todos = []models.TemplateTodo{}
+	}
+	data, err := json.Marshal(todos)
+	if err != nil {
+		return Entity{}, fmt.Errorf("failed to marshal template todos: %w", err)
+	}
+	return Entity{
+		ID:          template.ID,
+		OwnerID:     template.OwnerID,
+		Name:        template.Name,
+		Description: template.Description,
+		Tags:        pkg.NewNullableStringFromJSONRawMessage(template.Tags),
+		Todos:       string(data),
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package templates
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Entity struct {
+	ID          string         `db:"id"`
+	OwnerID     string         `db:"owner_id"`
+	Name        string         `db:"name"`
+	Description string         `db:"description"`
+	Tags        sql.NullString `db:"tags"`
+	Todos       string         `db:"todos"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
@@ -0,0 +1,154 @@
+package templates
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+//go:generate mockery --name=TemplateRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TemplateRepository interface {
+	Create(ctx context.Context, template models.ListTemplate) (string, error)
+	Get(ctx context.Context, id string) (models.ListTemplate, error)
+	GetByOwnerID(ctx context.Context, ownerID string) ([]models.ListTemplate, error)
+	Update(ctx context.Context, template models.ListTemplate) error
+	Delete(ctx context.Context, id string) error
+}
+
+const selectTemplates = `SELECT id, owner_id, name, description, tags, todos, created_at, updated_at FROM list_templates `
+
+type SQLXTemplateRepository struct {
+	converter *Converter
+}
+
+var _ TemplateRepository = &SQLXTemplateRepository{}
+
+func NewSQLXTemplateRepository() TemplateRepository {
+	return &SQLXTemplateRepository{converter: NewConverter()}
+}
+
+func (r *SQLXTemplateRepository) Create(ctx context.Context, template models.ListTemplate) (string, error) {
+	log.C(ctx).Info("creating template repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity, err := r.converter.ConvertTemplateToEntity(template)
+	if err != nil {
+		return "", err
+	}
+	if err = pkg.ValidateUUID(entity.ID); err != nil {
+		log.C(ctx).Errorf("invalid entity id: %v", err)
+		return "", fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	query := `
+		INSERT INTO list_templates (id, owner_id, name, description, tags, todos, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query,
+		entity.ID,
+		entity.OwnerID,
+		entity.Name,
+		entity.Description,
+		entity.Tags,
+		entity.Todos,
+		entity.CreatedAt,
+		entity.UpdatedAt,
+	).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to insert template: %v", err)
+		return "", fmt.Errorf("failed to create template: %w", err)
+	}
+	return id, nil
+}
+
+func (r *SQLXTemplateRepository) Get(ctx context.Context, id string) (models.ListTemplate, error) {
+	log.C(ctx).Info("getting template repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.ListTemplate{}, err
+	}
+
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, selectTemplates+`WHERE id = $1`, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get template: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ListTemplate{}, fmt.Errorf("template not found: %w", err)
+		}
+		return models.ListTemplate{}, fmt.Errorf("failed to get template: %w", err)
+	}
+	return r.converter.ConvertTemplateToModel(entity)
+}
+
+func (r *SQLXTemplateRepository) GetByOwnerID(ctx context.Context, ownerID string) ([]models.ListTemplate, error) {
+	log.C(ctx).Info("getting templates of user repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	var entities []Entity
+	if err = tx.SelectContext(ctx, &entities, selectTemplates+`WHERE owner_id = $1 ORDER BY lower(name), id`, ownerID); err != nil {
+		log.C(ctx).Errorf("failed to get templates: %v", err)
+		return nil, fmt.Errorf("failed to get templates: %w", err)
+	}
+
+	result := make([]models.ListTemplate, 0, len(entities))
+	for _, entity := range entities {
+		template, err := r.converter.ConvertTemplateToModel(entity)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, template)
+	}
+	return result, nil
+}
+
+func (r *SQLXTemplateRepository) Update(ctx context.Context, template models.ListTemplate) error {
+	log.C(ctx).Info("updating template repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	entity, err := r.converter.ConvertTemplateToEntity(template)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE list_templates SET name = $2, description = $3, tags = $4, todos = $5, updated_at = $6 WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, query, entity.ID, entity.Name, entity.Description, entity.Tags, entity.Todos, entity.UpdatedAt); err != nil {
+		log.C(ctx).Errorf("failed to update template: %v", err)
+		return fmt.Errorf("failed to update template: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXTemplateRepository) Delete(ctx context.Context, id string) error {
+	log.C(ctx).Info("deleting template repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM list_templates WHERE id = $1`, id); err != nil {
+		log.C(ctx).Errorf("failed to delete template: %v", err)
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package templates_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/templates"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXTemplateRepositoryCreate(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := templates.NewSQLXTemplateRepository()
+
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	id := "2b0dbd44-0f0e-4b4c-9c1c-9a6f7a6f4f10"
+	due := 3
+	template := models.ListTemplate{
+		ID:        id,
+		OwnerID:   "user",
+		Name:      "Release",
+		Tags:      json.RawMessage(`["work"]`),
+		Todos:     []models.TemplateTodo{{Title: "Tag the release", Priority: "high", DueOffsetDays: &due}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	todos := `[{"title":"Tag the release","description":"","priority":"high","tags":null,"start_offset_days":null,"due_offset_days":3}]`
+
+	testCases := []struct {
+		name          string
+		input         models.ListTemplate
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name:  "Successful create",
+			input: template,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("INSERT INTO list_templates").
+					WithArgs(id, "user", "Release", "", `["work"]`, todos, now, now).
+					WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow(id))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name:  "Failed create due to database error",
+			input: template,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("INSERT INTO list_templates").
+					WithArgs(id, "user", "Release", "", `["work"]`, todos, now, now).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to create template: %w", errors.New("db error")),
+		},
+		{
+			name:  "Failed create due to invalid id",
+			input: models.ListTemplate{ID: "1", OwnerID: "user", Name: "Release"},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectRollback()
+			},
+			expectedError: errors.New("invalid ID format: invalid UUID format: invalid UUID length: 1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.Create(ctx, tc.input)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, id, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTemplateRepositoryGet(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := templates.NewSQLXTemplateRepository()
+
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	start, due := 0, 2
+	columns := []string{"id", "owner_id", "name", "description", "tags", "todos", "created_at", "updated_at"}
+
+	testCases := []struct {
+		name           string
+		setupMocks     func()
+		expectedResult models.ListTemplate
+		expectedError  error
+	}{
+		{
+			name: "Successful get",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, owner_id, name, description, tags, todos, created_at, updated_at FROM list_templates WHERE id = \\$1").
+					WithArgs("1").
+					WillReturnRows(sqlxmock.NewRows(columns).
+						AddRow("1", "user", "Onboarding", "", nil, `[{"title":"Meet the team","priority":"low","start_offset_days":0,"due_offset_days":2,"subtasks":[{"title":"Book a room","priority":"low"}]}]`, now, now))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: models.ListTemplate{
+				ID:      "1",
+				OwnerID: "user",
+				Name:    "Onboarding",
+				Todos: []models.TemplateTodo{{
+					Title:           "Meet the team",
+					Priority:        "low",
+					StartOffsetDays: &start,
+					DueOffsetDays:   &due,
+					Subtasks:        []models.TemplateTodo{{Title: "Book a room", Priority: "low"}},
+				}},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+		{
+			name: "Failed get of a missing template",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("FROM list_templates WHERE id = \\$1").
+					WithArgs("1").
+					WillReturnError(sql.ErrNoRows)
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("template not found: %w", sql.ErrNoRows),
+		},
+		{
+			name: "Failed get due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("FROM list_templates WHERE id = \\$1").
+					WithArgs("1").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get template: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.Get(ctx, "1")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
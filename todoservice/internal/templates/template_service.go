@@ -0,0 +1,293 @@
+package templates
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
+	"math"
+	"strings"
+	"time"
+)
+
+var ErrTemplateNotFound = errors.New("template not found")
+
+//go:generate mockery --name=TemplateService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TemplateService interface {
+	CreateTemplate(ctx context.Context, template models.ListTemplate) (models.ListTemplate, error)
+	SaveListAsTemplate(ctx context.Context, listID string, userID string, request models.TemplateAnchor) (models.ListTemplate, error)
+	GetTemplate(ctx context.Context, id string, userID string) (models.ListTemplate, error)
+	ListTemplates(ctx context.Context, userID string) ([]models.ListTemplate, error)
+	UpdateTemplate(ctx context.Context, template models.ListTemplate) (models.ListTemplate, error)
+	DeleteTemplate(ctx context.Context, id string, userID string) error
+	InstantiateTemplate(ctx context.Context, id string, userID string, request models.TemplateAnchor) (models.List, error)
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ TemplateService = &service{}
+
+type service struct {
+	repo        TemplateRepository
+	listService lists.ListService
+	todoService todos.TodoService
+	uuidService UUIDService
+	timeService TimeService
+}
+
+func NewService(repo TemplateRepository, listService lists.ListService, todoService todos.TodoService, uuidService UUIDService, timeService TimeService) TemplateService {
+	return &service{repo: repo, listService: listService, todoService: todoService, uuidService: uuidService, timeService: timeService}
+}
+
+// CreateTemplate saves the template for its owner.
+func (s *service) CreateTemplate(ctx context.Context, template models.ListTemplate) (models.ListTemplate, error) {
+	log.C(ctx).Info("creating template service")
+	template.Name = strings.TrimSpace(template.Name)
+	template.Todos = withDefaultPriority(template.Todos)
+	if err := validation.Template(template).Err(); err != nil {
+		return models.ListTemplate{}, err
+	}
+
+	template.ID = s.uuidService.Generate()
+	template.CreatedAt = s.timeService.Now()
+	template.UpdatedAt = template.CreatedAt
+	if _, err := s.repo.Create(ctx, template); err != nil {
+		log.C(ctx).Errorf("creating template %q failed", template.Name)
+		return models.ListTemplate{}, err
+	}
+	return s.repo.Get(ctx, template.ID)
+}
+
+// SaveListAsTemplate saves the list and its todos as a template of the user.
+// The dates of the todos are kept as days from the anchor, which defaults to
+// the day the list was created, and the template is named after the list
+// unless the request names it.
+func (s *service) SaveListAsTemplate(ctx context.Context, listID string, userID string, request models.TemplateAnchor) (models.ListTemplate, error) {
+	log.C(ctx).Info("saving list as template service")
+	list, err := s.listService.GetList(ctx, listID)
+	if err != nil {
+		log.C(ctx).Errorf("getting list with id %s failed", listID)
+		return models.ListTemplate{}, err
+	}
+	listTodos, err := s.todoService.ListTodosByListID(ctx, listID)
+	if err != nil {
+		log.C(ctx).Errorf("listing todos of list with id %s failed", listID)
+		return models.ListTemplate{}, err
+	}
+
+	anchor := list.CreatedAt
+	if request.Anchor != nil {
+		anchor = *request.Anchor
+	}
+	name := list.Name
+	if strings.TrimSpace(request.Name) != "" {
+		name = request.Name
+	}
+	return s.CreateTemplate(ctx, models.ListTemplate{
+		OwnerID:     userID,
+		Name:        name,
+		Description: list.Description,
+		Tags:        list.Tags,
+		Todos:       templateTodos(listTodos, day(anchor)),
+	})
+}
+
+// GetTemplate returns the template if it belongs to the user. Templates of
+// other users are reported as missing.
+func (s *service) GetTemplate(ctx context.Context, id string, userID string) (models.ListTemplate, error) {
+	log.C(ctx).Info("getting template service")
+	template, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ListTemplate{}, ErrTemplateNotFound
+	}
+	if err != nil {
+		log.C(ctx).Errorf("getting template with id %s failed", id)
+		return models.ListTemplate{}, err
+	}
+	if template.OwnerID != userID {
+		return models.ListTemplate{}, ErrTemplateNotFound
+	}
+	return template, nil
+}
+
+func (s *service) ListTemplates(ctx context.Context, userID string) ([]models.ListTemplate, error) {
+	log.C(ctx).Info("listing templates service")
+	return s.repo.GetByOwnerID(ctx, userID)
+}
+
+// UpdateTemplate replaces the name, description, tags and todos of the
+// template. The template's OwnerID must be set to the user making the change.
+func (s *service) UpdateTemplate(ctx context.Context, template models.ListTemplate) (models.ListTemplate, error) {
+	log.C(ctx).Info("updating template service")
+	existing, err := s.GetTemplate(ctx, template.ID, template.OwnerID)
+	if err != nil {
+		return models.ListTemplate{}, err
+	}
+
+	existing.Name = strings.TrimSpace(template.Name)
+	existing.Description = template.Description
+	existing.Tags = template.Tags
+	existing.Todos = withDefaultPriority(template.Todos)
+	if err = validation.Template(existing).Err(); err != nil {
+		return models.ListTemplate{}, err
+	}
+	existing.UpdatedAt = s.timeService.Now()
+	if err = s.repo.Update(ctx, existing); err != nil {
+		log.C(ctx).Errorf("updating template with id %s failed", existing.ID)
+		return models.ListTemplate{}, err
+	}
+	return s.repo.Get(ctx, existing.ID)
+}
+
+func (s *service) DeleteTemplate(ctx context.Context, id string, userID string) error {
+	log.C(ctx).Info("deleting template service")
+	if _, err := s.GetTemplate(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// InstantiateTemplate creates a private list owned by the user from the
+// template and returns it. The dates of the todos are resolved against the
+// anchor, which defaults to today in UTC, and the list is named after the
+// template unless the request names it.
+func (s *service) InstantiateTemplate(ctx context.Context, id string, userID string, request models.TemplateAnchor) (models.List, error) {
+	log.C(ctx).Info("instantiating template service")
+	template, err := s.GetTemplate(ctx, id, userID)
+	if err != nil {
+		return models.List{}, err
+	}
+
+	anchor := s.timeService.Now()
+	if request.Anchor != nil {
+		anchor = *request.Anchor
+	}
+	name := template.Name
+	if strings.TrimSpace(request.Name) != "" {
+		name = strings.TrimSpace(request.Name)
+	}
+	listID, err := s.listService.CreateList(ctx, models.List{
+		Name:        name,
+		Description: template.Description,
+		OwnerID:     userID,
+		Tags:        template.Tags,
+		Visibility:  constants.VisibilityPrivate,
+	})
+	if err != nil {
+		log.C(ctx).Errorf("creating list from template with id %s failed", id)
+		return models.List{}, err
+	}
+	if err = s.createTodos(ctx, listID, nil, template.Todos, day(anchor)); err != nil {
+		return models.List{}, err
+	}
+	return s.listService.GetList(ctx, listID)
+}
+
+func (s *service) createTodos(ctx context.Context, listID string, parentID *string, templateTodos []models.TemplateTodo, anchor time.Time) error {
+	for _, templateTodo := range templateTodos {
+		todoID, err := s.todoService.CreateTodo(ctx, models.Todo{
+			ListID:      listID,
+			Title:       templateTodo.Title,
+			Description: templateTodo.Description,
+			Priority:    templateTodo.Priority,
+			Tags:        templateTodo.Tags,
+			StartDate:   resolveOffset(anchor, templateTodo.StartOffsetDays),
+			DueDate:     resolveOffset(anchor, templateTodo.DueOffsetDays),
+			ParentID:    parentID,
+		})
+		if err != nil {
+			log.C(ctx).Errorf("creating todo %q from template failed", templateTodo.Title)
+			return err
+		}
+		if err = s.createTodos(ctx, listID, &todoID, templateTodo.Subtasks, anchor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// templateTodos turns the todos of a list into template todos, nesting the
+// subtasks under their parents and keeping the order of the list.
+func templateTodos(listTodos []models.Todo, anchor time.Time) []models.TemplateTodo {
+	ids := make(map[string]bool, len(listTodos))
+	for _, todo := range listTodos {
+		ids[todo.ID] = true
+	}
+	children := make(map[string][]models.Todo)
+	var roots []models.Todo
+	for _, todo := range listTodos {
+		if todo.ParentID != nil && ids[*todo.ParentID] {
+			children[*todo.ParentID] = append(children[*todo.ParentID], todo)
+			continue
+		}
+		roots = append(roots, todo)
+	}
+
+	var convert func(todos []models.Todo) []models.TemplateTodo
+	convert = func(todos []models.Todo) []models.TemplateTodo {
+		if len(todos) == 0 {
+			return nil
+		}
+		result := make([]models.TemplateTodo, 0, len(todos))
+		for _, todo := range todos {
+			result = append(result, models.TemplateTodo{
+				Title:           todo.Title,
+				Description:     todo.Description,
+				Priority:        todo.Priority,
+				Tags:            todo.Tags,
+				StartOffsetDays: offsetFrom(anchor, todo.StartDate),
+				DueOffsetDays:   offsetFrom(anchor, todo.DueDate),
+				Subtasks:        convert(children[todo.ID]),
+			})
+		}
+		return result
+	}
+	return convert(roots)
+}
+
+func withDefaultPriority(todos []models.TemplateTodo) []models.TemplateTodo {
+	for i := range todos {
+		if todos[i].Priority == "" {
+			todos[i].Priority = constants.PriorityLow
+		}
+		todos[i].Subtasks = withDefaultPriority(todos[i].Subtasks)
+	}
+	return todos
+}
+
+// offsetFrom returns the number of calendar days from the anchor to the date
+// in UTC, or nil when there is no date.
+func offsetFrom(anchor time.Time, date *time.Time) *int {
+	if date == nil {
+		return nil
+	}
+	days := int(math.Round(day(*date).Sub(anchor).Hours() / 24))
+	return &days
+}
+
+func resolveOffset(anchor time.Time, offset *int) *time.Time {
+	if offset == nil {
+		return nil
+	}
+	date := anchor.AddDate(0, 0, *offset)
+	return &date
+}
+
+// day returns midnight in UTC of the day of t.
+func day(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,320 @@
+package templates_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	listautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/lists/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/templates"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/templates/automock"
+	todoautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/todos/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestServiceCreateTemplate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	userID := "user"
+
+	created := models.ListTemplate{
+		ID:        "1",
+		OwnerID:   userID,
+		Name:      "Release",
+		Todos:     []models.TemplateTodo{{Title: "Tag", Priority: constants.PriorityLow, DueOffsetDays: intPtr(1)}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	tests := []struct {
+		name           string
+		input          models.ListTemplate
+		repo           func() *automock.TemplateRepository
+		expected       models.ListTemplate
+		expectedFields []validation.FieldError
+	}{
+		{
+			name:  "Create a template with a trimmed name and default priorities",
+			input: models.ListTemplate{OwnerID: userID, Name: " Release ", Todos: []models.TemplateTodo{{Title: "Tag", DueOffsetDays: intPtr(1)}}},
+			repo: func() *automock.TemplateRepository {
+				repo := &automock.TemplateRepository{}
+				repo.EXPECT().Create(ctx, created).Return(created.ID, nil).Once()
+				repo.EXPECT().Get(ctx, created.ID).Return(created, nil).Once()
+				return repo
+			},
+			expected: created,
+		},
+		{
+			name: "Error for every invalid field of the template and its todos",
+			input: models.ListTemplate{OwnerID: userID, Name: " ", Todos: []models.TemplateTodo{
+				{Title: "Tag", Priority: "urgent", StartOffsetDays: intPtr(2), DueOffsetDays: intPtr(1)},
+				{Title: "Ship", Subtasks: []models.TemplateTodo{{Title: ""}}},
+			}},
+			repo: func() *automock.TemplateRepository { return &automock.TemplateRepository{} },
+			expectedFields: []validation.FieldError{
+				{Field: "name", Message: "is required"},
+				{Field: "todos[0].priority", Message: "must be one of low, medium, high"},
+				{Field: "todos[0].start_offset_days", Message: "must not be after todos[0].due_offset_days"},
+				{Field: "todos[1].subtasks[0].title", Message: "is required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(created.ID).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := templates.NewService(repo, &listautomock.ListService{}, &todoautomock.TodoService{}, uuidService, timeService)
+			result, err := svc.CreateTemplate(ctx, tt.input)
+			if tt.expectedFields != nil {
+				var validationErr *validation.Errors
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.expectedFields, validationErr.Fields)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceSaveListAsTemplate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	userID := "user"
+	listID := "list"
+	parentID := "todo-1"
+
+	list := models.List{ID: listID, Name: "Release 1.0", Description: "Steps", Tags: json.RawMessage(`["work"]`), CreatedAt: time.Date(2026, time.October, 1, 15, 30, 0, 0, time.UTC)}
+	listTodos := []models.Todo{
+		{ID: parentID, ListID: listID, Title: "Freeze", Priority: constants.PriorityHigh, StartDate: timePtr(time.Date(2026, time.October, 1, 8, 0, 0, 0, time.UTC)), DueDate: timePtr(time.Date(2026, time.October, 3, 23, 0, 0, 0, time.UTC))},
+		{ID: "todo-2", ListID: listID, Title: "Announce", Priority: constants.PriorityLow, Tags: json.RawMessage(`["comms"]`)},
+		{ID: "todo-3", ListID: listID, Title: "Branch", Priority: constants.PriorityMedium, ParentID: &parentID, DueDate: timePtr(time.Date(2026, time.September, 30, 12, 0, 0, 0, time.UTC))},
+	}
+	saved := models.ListTemplate{
+		ID:          "1",
+		OwnerID:     userID,
+		Description: "Steps",
+		Tags:        json.RawMessage(`["work"]`),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	tests := []struct {
+		name     string
+		request  models.TemplateAnchor
+		expected models.ListTemplate
+	}{
+		{
+			name: "Offsets are counted from the day the list was created",
+			expected: func() models.ListTemplate {
+				template := saved
+				template.Name = "Release 1.0"
+				template.Todos = []models.TemplateTodo{
+					{Title: "Freeze", Priority: constants.PriorityHigh, StartOffsetDays: intPtr(0), DueOffsetDays: intPtr(2), Subtasks: []models.TemplateTodo{
+						{Title: "Branch", Priority: constants.PriorityMedium, DueOffsetDays: intPtr(-1)},
+					}},
+					{Title: "Announce", Priority: constants.PriorityLow, Tags: json.RawMessage(`["comms"]`)},
+				}
+				return template
+			}(),
+		},
+		{
+			name:    "Offsets are counted from the anchor of the request",
+			request: models.TemplateAnchor{Name: "Release", Anchor: timePtr(time.Date(2026, time.October, 3, 0, 0, 0, 0, time.UTC))},
+			expected: func() models.ListTemplate {
+				template := saved
+				template.Name = "Release"
+				template.Todos = []models.TemplateTodo{
+					{Title: "Freeze", Priority: constants.PriorityHigh, StartOffsetDays: intPtr(-2), DueOffsetDays: intPtr(0), Subtasks: []models.TemplateTodo{
+						{Title: "Branch", Priority: constants.PriorityMedium, DueOffsetDays: intPtr(-3)},
+					}},
+					{Title: "Announce", Priority: constants.PriorityLow, Tags: json.RawMessage(`["comms"]`)},
+				}
+				return template
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &automock.TemplateRepository{}
+			repo.EXPECT().Create(ctx, tt.expected).Return(tt.expected.ID, nil).Once()
+			repo.EXPECT().Get(ctx, tt.expected.ID).Return(tt.expected, nil).Once()
+			listService := &listautomock.ListService{}
+			listService.EXPECT().GetList(ctx, listID).Return(list, nil).Once()
+			todoService := &todoautomock.TodoService{}
+			todoService.EXPECT().ListTodosByListID(ctx, listID).Return(listTodos, nil).Once()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return("1")
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now)
+			defer mock.AssertExpectationsForObjects(t, repo, listService, todoService)
+
+			svc := templates.NewService(repo, listService, todoService, uuidService, timeService)
+			result, err := svc.SaveListAsTemplate(ctx, listID, userID, tt.request)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestServiceInstantiateTemplate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	userID := "user"
+	err := errors.New("error")
+
+	template := models.ListTemplate{
+		ID:          "1",
+		OwnerID:     userID,
+		Name:        "Onboarding",
+		Description: "First week",
+		Tags:        json.RawMessage(`["people"]`),
+		Todos: []models.TemplateTodo{
+			{Title: "Laptop", Priority: constants.PriorityHigh, DueOffsetDays: intPtr(-1), Subtasks: []models.TemplateTodo{
+				{Title: "Accounts", Priority: constants.PriorityLow, StartOffsetDays: intPtr(0), DueOffsetDays: intPtr(2)},
+			}},
+		},
+	}
+	parentID := "todo-1"
+
+	tests := []struct {
+		name          string
+		request       models.TemplateAnchor
+		repo          func() *automock.TemplateRepository
+		listService   func() *listautomock.ListService
+		todoService   func() *todoautomock.TodoService
+		expected      models.List
+		expectedError error
+	}{
+		{
+			name:    "Create a list with the dates resolved against the anchor",
+			request: models.TemplateAnchor{Name: " Alice ", Anchor: timePtr(time.Date(2026, time.November, 2, 18, 0, 0, 0, time.UTC))},
+			repo: func() *automock.TemplateRepository {
+				repo := &automock.TemplateRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(template, nil).Once()
+				return repo
+			},
+			listService: func() *listautomock.ListService {
+				listService := &listautomock.ListService{}
+				listService.EXPECT().CreateList(ctx, models.List{Name: "Alice", Description: "First week", OwnerID: userID, Tags: json.RawMessage(`["people"]`), Visibility: constants.VisibilityPrivate}).Return("list", nil).Once()
+				listService.EXPECT().GetList(ctx, "list").Return(models.List{ID: "list", Name: "Alice"}, nil).Once()
+				return listService
+			},
+			todoService: func() *todoautomock.TodoService {
+				todoService := &todoautomock.TodoService{}
+				todoService.EXPECT().CreateTodo(ctx, models.Todo{ListID: "list", Title: "Laptop", Priority: constants.PriorityHigh, DueDate: timePtr(time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC))}).Return(parentID, nil).Once()
+				todoService.EXPECT().CreateTodo(ctx, models.Todo{ListID: "list", Title: "Accounts", Priority: constants.PriorityLow, StartDate: timePtr(time.Date(2026, time.November, 2, 0, 0, 0, 0, time.UTC)), DueDate: timePtr(time.Date(2026, time.November, 4, 0, 0, 0, 0, time.UTC)), ParentID: &parentID}).Return("todo-2", nil).Once()
+				return todoService
+			},
+			expected: models.List{ID: "list", Name: "Alice"},
+		},
+		{
+			name: "Create a list named after the template anchored today",
+			repo: func() *automock.TemplateRepository {
+				repo := &automock.TemplateRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(template, nil).Once()
+				return repo
+			},
+			listService: func() *listautomock.ListService {
+				listService := &listautomock.ListService{}
+				listService.EXPECT().CreateList(ctx, mock.MatchedBy(func(list models.List) bool { return list.Name == "Onboarding" })).Return("list", nil).Once()
+				listService.EXPECT().GetList(ctx, "list").Return(models.List{ID: "list", Name: "Onboarding"}, nil).Once()
+				return listService
+			},
+			todoService: func() *todoautomock.TodoService {
+				todoService := &todoautomock.TodoService{}
+				todoService.EXPECT().CreateTodo(ctx, mock.MatchedBy(func(todo models.Todo) bool {
+					return todo.DueDate.Equal(time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC))
+				})).Return(parentID, nil).Once()
+				todoService.EXPECT().CreateTodo(ctx, mock.MatchedBy(func(todo models.Todo) bool {
+					return todo.StartDate.Equal(time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC))
+				})).Return("todo-2", nil).Once()
+				return todoService
+			},
+			expected: models.List{ID: "list", Name: "Onboarding"},
+		},
+		{
+			name: "Error when the template belongs to another user",
+			repo: func() *automock.TemplateRepository {
+				repo := &automock.TemplateRepository{}
+				other := template
+				other.OwnerID = "other"
+				repo.EXPECT().Get(ctx, "1").Return(other, nil).Once()
+				return repo
+			},
+			listService:   func() *listautomock.ListService { return &listautomock.ListService{} },
+			todoService:   func() *todoautomock.TodoService { return &todoautomock.TodoService{} },
+			expectedError: templates.ErrTemplateNotFound,
+		},
+		{
+			name: "Error when the template does not exist",
+			repo: func() *automock.TemplateRepository {
+				repo := &automock.TemplateRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(models.ListTemplate{}, fmt.Errorf("template not found: %w", sql.ErrNoRows)).Once()
+				return repo
+			},
+			listService:   func() *listautomock.ListService { return &listautomock.ListService{} },
+			todoService:   func() *todoautomock.TodoService { return &todoautomock.TodoService{} },
+			expectedError: templates.ErrTemplateNotFound,
+		},
+		{
+			name: "Error when creating a todo fails",
+			repo: func() *automock.TemplateRepository {
+				repo := &automock.TemplateRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(template, nil).Once()
+				return repo
+			},
+			listService: func() *listautomock.ListService {
+				listService := &listautomock.ListService{}
+				listService.EXPECT().CreateList(ctx, mock.Anything).Return("list", nil).Once()
+				return listService
+			},
+			todoService: func() *todoautomock.TodoService {
+				todoService := &todoautomock.TodoService{}
+				todoService.EXPECT().CreateTodo(ctx, mock.Anything).Return("", err).Once()
+				return todoService
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			listService := tt.listService()
+			todoService := tt.todoService()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo, listService, todoService)
+
+			svc := templates.NewService(repo, listService, todoService, &automock.UUIDService{}, timeService)
+			result, err := svc.InstantiateTemplate(ctx, "1", userID, tt.request)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
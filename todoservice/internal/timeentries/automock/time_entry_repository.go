@@ -0,0 +1,422 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// TimeEntryRepository is an autogenerated mock type for the TimeEntryRepository type
+type TimeEntryRepository struct {
+	mock.Mock
+}
+
+type TimeEntryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TimeEntryRepository) EXPECT() *TimeEntryRepository_Expecter {
+	return &TimeEntryRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, entry
+func (_m *TimeEntryRepository) Create(ctx context.Context, entry models.TimeEntry) (string, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.TimeEntry) (string, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.TimeEntry) string); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.TimeEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TimeEntryRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry models.TimeEntry
+func (_e *TimeEntryRepository_Expecter) Create(ctx interface{}, entry interface{}) *TimeEntryRepository_Create_Call {
+	return &TimeEntryRepository_Create_Call{Call: _e.mock.On("Create", ctx, entry)}
+}
+
+func (_c *TimeEntryRepository_Create_Call) Run(run func(ctx context.Context, entry models.TimeEntry)) *TimeEntryRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.TimeEntry))
+	})
+	return _c
+}
+
+func (_c *TimeEntryRepository_Create_Call) Return(_a0 string, _a1 error) *TimeEntryRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryRepository_Create_Call) RunAndReturn(run func(context.Context, models.TimeEntry) (string, error)) *TimeEntryRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *TimeEntryRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimeEntryRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TimeEntryRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TimeEntryRepository_Expecter) Delete(ctx interface{}, id interface{}) *TimeEntryRepository_Delete_Call {
+	return &TimeEntryRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *TimeEntryRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *TimeEntryRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TimeEntryRepository_Delete_Call) Return(_a0 error) *TimeEntryRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimeEntryRepository_Delete_Call) RunAndReturn(run func(context.Context, string) error) *TimeEntryRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *TimeEntryRepository) Get(ctx context.Context, id string) (models.TimeEntry, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.TimeEntry, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.TimeEntry); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TimeEntryRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TimeEntryRepository_Expecter) Get(ctx interface{}, id interface{}) *TimeEntryRepository_Get_Call {
+	return &TimeEntryRepository_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *TimeEntryRepository_Get_Call) Run(run func(ctx context.Context, id string)) *TimeEntryRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TimeEntryRepository_Get_Call) Return(_a0 models.TimeEntry, _a1 error) *TimeEntryRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryRepository_Get_Call) RunAndReturn(run func(context.Context, string) (models.TimeEntry, error)) *TimeEntryRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTodoID provides a mock function with given fields: ctx, todoID
+func (_m *TimeEntryRepository) GetByTodoID(ctx context.Context, todoID string) ([]models.TimeEntry, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTodoID")
+	}
+
+	var r0 []models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.TimeEntry, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.TimeEntry); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryRepository_GetByTodoID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTodoID'
+type TimeEntryRepository_GetByTodoID_Call struct {
+	*mock.Call
+}
+
+// GetByTodoID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+func (_e *TimeEntryRepository_Expecter) GetByTodoID(ctx interface{}, todoID interface{}) *TimeEntryRepository_GetByTodoID_Call {
+	return &TimeEntryRepository_GetByTodoID_Call{Call: _e.mock.On("GetByTodoID", ctx, todoID)}
+}
+
+func (_c *TimeEntryRepository_GetByTodoID_Call) Run(run func(ctx context.Context, todoID string)) *TimeEntryRepository_GetByTodoID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TimeEntryRepository_GetByTodoID_Call) Return(_a0 []models.TimeEntry, _a1 error) *TimeEntryRepository_GetByTodoID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryRepository_GetByTodoID_Call) RunAndReturn(run func(context.Context, string) ([]models.TimeEntry, error)) *TimeEntryRepository_GetByTodoID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunning provides a mock function with given fields: ctx, userID
+func (_m *TimeEntryRepository) GetRunning(ctx context.Context, userID string) (models.TimeEntry, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunning")
+	}
+
+	var r0 models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.TimeEntry, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.TimeEntry); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(models.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryRepository_GetRunning_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunning'
+type TimeEntryRepository_GetRunning_Call struct {
+	*mock.Call
+}
+
+// GetRunning is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *TimeEntryRepository_Expecter) GetRunning(ctx interface{}, userID interface{}) *TimeEntryRepository_GetRunning_Call {
+	return &TimeEntryRepository_GetRunning_Call{Call: _e.mock.On("GetRunning", ctx, userID)}
+}
+
+func (_c *TimeEntryRepository_GetRunning_Call) Run(run func(ctx context.Context, userID string)) *TimeEntryRepository_GetRunning_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TimeEntryRepository_GetRunning_Call) Return(_a0 models.TimeEntry, _a1 error) *TimeEntryRepository_GetRunning_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryRepository_GetRunning_Call) RunAndReturn(run func(context.Context, string) (models.TimeEntry, error)) *TimeEntryRepository_GetRunning_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTotal provides a mock function with given fields: ctx, filter, now
+func (_m *TimeEntryRepository) GetTotal(ctx context.Context, filter models.TimeEntryFilter, now time.Time) (models.TimeTotal, error) {
+	ret := _m.Called(ctx, filter, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotal")
+	}
+
+	var r0 models.TimeTotal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.TimeEntryFilter, time.Time) (models.TimeTotal, error)); ok {
+		return rf(ctx, filter, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.TimeEntryFilter, time.Time) models.TimeTotal); ok {
+		r0 = rf(ctx, filter, now)
+	} else {
+		r0 = ret.Get(0).(models.TimeTotal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.TimeEntryFilter, time.Time) error); ok {
+		r1 = rf(ctx, filter, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryRepository_GetTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotal'
+type TimeEntryRepository_GetTotal_Call struct {
+	*mock.Call
+}
+
+// GetTotal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.TimeEntryFilter
+//   - now time.Time
+func (_e *TimeEntryRepository_Expecter) GetTotal(ctx interface{}, filter interface{}, now interface{}) *TimeEntryRepository_GetTotal_Call {
+	return &TimeEntryRepository_GetTotal_Call{Call: _e.mock.On("GetTotal", ctx, filter, now)}
+}
+
+func (_c *TimeEntryRepository_GetTotal_Call) Run(run func(ctx context.Context, filter models.TimeEntryFilter, now time.Time)) *TimeEntryRepository_GetTotal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.TimeEntryFilter), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TimeEntryRepository_GetTotal_Call) Return(_a0 models.TimeTotal, _a1 error) *TimeEntryRepository_GetTotal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryRepository_GetTotal_Call) RunAndReturn(run func(context.Context, models.TimeEntryFilter, time.Time) (models.TimeTotal, error)) *TimeEntryRepository_GetTotal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: ctx, id, endedAt
+func (_m *TimeEntryRepository) Stop(ctx context.Context, id string, endedAt time.Time) error {
+	ret := _m.Called(ctx, id, endedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, endedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimeEntryRepository_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type TimeEntryRepository_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - endedAt time.Time
+func (_e *TimeEntryRepository_Expecter) Stop(ctx interface{}, id interface{}, endedAt interface{}) *TimeEntryRepository_Stop_Call {
+	return &TimeEntryRepository_Stop_Call{Call: _e.mock.On("Stop", ctx, id, endedAt)}
+}
+
+func (_c *TimeEntryRepository_Stop_Call) Run(run func(ctx context.Context, id string, endedAt time.Time)) *TimeEntryRepository_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TimeEntryRepository_Stop_Call) Return(_a0 error) *TimeEntryRepository_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimeEntryRepository_Stop_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *TimeEntryRepository_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTimeEntryRepository creates a new instance of TimeEntryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTimeEntryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TimeEntryRepository {
+	mock := &TimeEntryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
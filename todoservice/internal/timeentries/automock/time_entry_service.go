@@ -0,0 +1,432 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TimeEntryService is an autogenerated mock type for the TimeEntryService type
+type TimeEntryService struct {
+	mock.Mock
+}
+
+type TimeEntryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TimeEntryService) EXPECT() *TimeEntryService_Expecter {
+	return &TimeEntryService_Expecter{mock: &_m.Mock}
+}
+
+// CreateTimeEntry provides a mock function with given fields: ctx, entry
+func (_m *TimeEntryService) CreateTimeEntry(ctx context.Context, entry models.TimeEntry) (models.TimeEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTimeEntry")
+	}
+
+	var r0 models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.TimeEntry) (models.TimeEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.TimeEntry) models.TimeEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(models.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.TimeEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryService_CreateTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTimeEntry'
+type TimeEntryService_CreateTimeEntry_Call struct {
+	*mock.Call
+}
+
+// CreateTimeEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry models.TimeEntry
+func (_e *TimeEntryService_Expecter) CreateTimeEntry(ctx interface{}, entry interface{}) *TimeEntryService_CreateTimeEntry_Call {
+	return &TimeEntryService_CreateTimeEntry_Call{Call: _e.mock.On("CreateTimeEntry", ctx, entry)}
+}
+
+func (_c *TimeEntryService_CreateTimeEntry_Call) Run(run func(ctx context.Context, entry models.TimeEntry)) *TimeEntryService_CreateTimeEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.TimeEntry))
+	})
+	return _c
+}
+
+func (_c *TimeEntryService_CreateTimeEntry_Call) Return(_a0 models.TimeEntry, _a1 error) *TimeEntryService_CreateTimeEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryService_CreateTimeEntry_Call) RunAndReturn(run func(context.Context, models.TimeEntry) (models.TimeEntry, error)) *TimeEntryService_CreateTimeEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTimeEntry provides a mock function with given fields: ctx, id, todoID, userID
+func (_m *TimeEntryService) DeleteTimeEntry(ctx context.Context, id string, todoID string, userID string) error {
+	ret := _m.Called(ctx, id, todoID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTimeEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, id, todoID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TimeEntryService_DeleteTimeEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTimeEntry'
+type TimeEntryService_DeleteTimeEntry_Call struct {
+	*mock.Call
+}
+
+// DeleteTimeEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - todoID string
+//   - userID string
+func (_e *TimeEntryService_Expecter) DeleteTimeEntry(ctx interface{}, id interface{}, todoID interface{}, userID interface{}) *TimeEntryService_DeleteTimeEntry_Call {
+	return &TimeEntryService_DeleteTimeEntry_Call{Call: _e.mock.On("DeleteTimeEntry", ctx, id, todoID, userID)}
+}
+
+func (_c *TimeEntryService_DeleteTimeEntry_Call) Run(run func(ctx context.Context, id string, todoID string, userID string)) *TimeEntryService_DeleteTimeEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TimeEntryService_DeleteTimeEntry_Call) Return(_a0 error) *TimeEntryService_DeleteTimeEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TimeEntryService_DeleteTimeEntry_Call) RunAndReturn(run func(context.Context, string, string, string) error) *TimeEntryService_DeleteTimeEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunningTimer provides a mock function with given fields: ctx, userID
+func (_m *TimeEntryService) GetRunningTimer(ctx context.Context, userID string) (models.TimeEntry, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunningTimer")
+	}
+
+	var r0 models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.TimeEntry, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.TimeEntry); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(models.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryService_GetRunningTimer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunningTimer'
+type TimeEntryService_GetRunningTimer_Call struct {
+	*mock.Call
+}
+
+// GetRunningTimer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *TimeEntryService_Expecter) GetRunningTimer(ctx interface{}, userID interface{}) *TimeEntryService_GetRunningTimer_Call {
+	return &TimeEntryService_GetRunningTimer_Call{Call: _e.mock.On("GetRunningTimer", ctx, userID)}
+}
+
+func (_c *TimeEntryService_GetRunningTimer_Call) Run(run func(ctx context.Context, userID string)) *TimeEntryService_GetRunningTimer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TimeEntryService_GetRunningTimer_Call) Return(_a0 models.TimeEntry, _a1 error) *TimeEntryService_GetRunningTimer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryService_GetRunningTimer_Call) RunAndReturn(run func(context.Context, string) (models.TimeEntry, error)) *TimeEntryService_GetRunningTimer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTotal provides a mock function with given fields: ctx, filter
+func (_m *TimeEntryService) GetTotal(ctx context.Context, filter models.TimeEntryFilter) (models.TimeTotal, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTotal")
+	}
+
+	var r0 models.TimeTotal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.TimeEntryFilter) (models.TimeTotal, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.TimeEntryFilter) models.TimeTotal); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(models.TimeTotal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.TimeEntryFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryService_GetTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTotal'
+type TimeEntryService_GetTotal_Call struct {
+	*mock.Call
+}
+
+// GetTotal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.TimeEntryFilter
+func (_e *TimeEntryService_Expecter) GetTotal(ctx interface{}, filter interface{}) *TimeEntryService_GetTotal_Call {
+	return &TimeEntryService_GetTotal_Call{Call: _e.mock.On("GetTotal", ctx, filter)}
+}
+
+func (_c *TimeEntryService_GetTotal_Call) Run(run func(ctx context.Context, filter models.TimeEntryFilter)) *TimeEntryService_GetTotal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.TimeEntryFilter))
+	})
+	return _c
+}
+
+func (_c *TimeEntryService_GetTotal_Call) Return(_a0 models.TimeTotal, _a1 error) *TimeEntryService_GetTotal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryService_GetTotal_Call) RunAndReturn(run func(context.Context, models.TimeEntryFilter) (models.TimeTotal, error)) *TimeEntryService_GetTotal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTimeEntries provides a mock function with given fields: ctx, todoID
+func (_m *TimeEntryService) ListTimeEntries(ctx context.Context, todoID string) ([]models.TimeEntry, error) {
+	ret := _m.Called(ctx, todoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTimeEntries")
+	}
+
+	var r0 []models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.TimeEntry, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.TimeEntry); ok {
+		r0 = rf(ctx, todoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryService_ListTimeEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTimeEntries'
+type TimeEntryService_ListTimeEntries_Call struct {
+	*mock.Call
+}
+
+// ListTimeEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+func (_e *TimeEntryService_Expecter) ListTimeEntries(ctx interface{}, todoID interface{}) *TimeEntryService_ListTimeEntries_Call {
+	return &TimeEntryService_ListTimeEntries_Call{Call: _e.mock.On("ListTimeEntries", ctx, todoID)}
+}
+
+func (_c *TimeEntryService_ListTimeEntries_Call) Run(run func(ctx context.Context, todoID string)) *TimeEntryService_ListTimeEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TimeEntryService_ListTimeEntries_Call) Return(_a0 []models.TimeEntry, _a1 error) *TimeEntryService_ListTimeEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryService_ListTimeEntries_Call) RunAndReturn(run func(context.Context, string) ([]models.TimeEntry, error)) *TimeEntryService_ListTimeEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartTimer provides a mock function with given fields: ctx, todoID, userID, note
+func (_m *TimeEntryService) StartTimer(ctx context.Context, todoID string, userID string, note string) (models.TimeEntry, error) {
+	ret := _m.Called(ctx, todoID, userID, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartTimer")
+	}
+
+	var r0 models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (models.TimeEntry, error)); ok {
+		return rf(ctx, todoID, userID, note)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) models.TimeEntry); ok {
+		r0 = rf(ctx, todoID, userID, note)
+	} else {
+		r0 = ret.Get(0).(models.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, todoID, userID, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryService_StartTimer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartTimer'
+type TimeEntryService_StartTimer_Call struct {
+	*mock.Call
+}
+
+// StartTimer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - userID string
+//   - note string
+func (_e *TimeEntryService_Expecter) StartTimer(ctx interface{}, todoID interface{}, userID interface{}, note interface{}) *TimeEntryService_StartTimer_Call {
+	return &TimeEntryService_StartTimer_Call{Call: _e.mock.On("StartTimer", ctx, todoID, userID, note)}
+}
+
+func (_c *TimeEntryService_StartTimer_Call) Run(run func(ctx context.Context, todoID string, userID string, note string)) *TimeEntryService_StartTimer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TimeEntryService_StartTimer_Call) Return(_a0 models.TimeEntry, _a1 error) *TimeEntryService_StartTimer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryService_StartTimer_Call) RunAndReturn(run func(context.Context, string, string, string) (models.TimeEntry, error)) *TimeEntryService_StartTimer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopTimer provides a mock function with given fields: ctx, userID
+func (_m *TimeEntryService) StopTimer(ctx context.Context, userID string) (models.TimeEntry, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopTimer")
+	}
+
+	var r0 models.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.TimeEntry, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.TimeEntry); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(models.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TimeEntryService_StopTimer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopTimer'
+type TimeEntryService_StopTimer_Call struct {
+	*mock.Call
+}
+
+// StopTimer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *TimeEntryService_Expecter) StopTimer(ctx interface{}, userID interface{}) *TimeEntryService_StopTimer_Call {
+	return &TimeEntryService_StopTimer_Call{Call: _e.mock.On("StopTimer", ctx, userID)}
+}
+
+func (_c *TimeEntryService_StopTimer_Call) Run(run func(ctx context.Context, userID string)) *TimeEntryService_StopTimer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TimeEntryService_StopTimer_Call) Return(_a0 models.TimeEntry, _a1 error) *TimeEntryService_StopTimer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TimeEntryService_StopTimer_Call) RunAndReturn(run func(context.Context, string) (models.TimeEntry, error)) *TimeEntryService_StopTimer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTimeEntryService creates a new instance of TimeEntryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTimeEntryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TimeEntryService {
+	mock := &TimeEntryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,49 @@
+package timeentries
+
+import (
+	"database/sql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertTimeEntryToModel(entity Entity) models.TimeEntry {
+	entry := models.TimeEntry{
+		ID:        entity.ID,
+		TodoID:    entity.TodoID,
+		UserID:    entity.UserID,
+		StartedAt: entity.StartedAt,
+		Note:      entity.Note,
+		CreatedAt: entity.CreatedAt,
+	}
+	if entity.EndedAt.Valid {
+		entry.EndedAt = &entity.EndedAt.Time
+	}
+	return entry
+}
+
+func (c *Converter) ConvertTimeEntryToEntity(entry models.TimeEntry) Entity {
+	entity := Entity{
+		ID:        entry.ID,
+		TodoID:    entry.TodoID,
+		UserID:    entry.UserID,
+		StartedAt: entry.StartedAt,
+		Note:      entry.Note,
+		CreatedAt: entry.CreatedAt,
+	}
+	if entry.EndedAt != nil {
+		entity.EndedAt = sql.NullTime{Time: *entry.EndedAt, Valid: true}
+	}
+	return entity
+}
+
+func (c *Converter) ConvertTotalToModel(entity TotalEntity) models.TimeTotal {
+	return models.TimeTotal{
+		Seconds: entity.Seconds,
+		Entries: entity.Entries,
+	}
+}
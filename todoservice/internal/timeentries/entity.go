@@ -0,0 +1,21 @@
+package timeentries
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Entity struct {
+	ID        string       `db:"id"`
+	TodoID    string       `db:"todo_id"`
+	UserID    string       `db:"user_id"`
+	StartedAt time.Time    `db:"started_at"`
+	EndedAt   sql.NullTime `db:"ended_at"`
+	Note      string       `db:"note"`
+	CreatedAt time.Time    `db:"created_at"`
+}
+
+type TotalEntity struct {
+	Seconds int64 `db:"seconds"`
+	Entries int   `db:"entries"`
+}
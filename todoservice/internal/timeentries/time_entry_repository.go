@@ -0,0 +1,230 @@
+package timeentries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"strings"
+	"time"
+)
+
+//go:generate mockery --name=TimeEntryRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeEntryRepository interface {
+	Create(ctx context.Context, entry models.TimeEntry) (string, error)
+	Get(ctx context.Context, id string) (models.TimeEntry, error)
+	GetRunning(ctx context.Context, userID string) (models.TimeEntry, error)
+	GetByTodoID(ctx context.Context, todoID string) ([]models.TimeEntry, error)
+	Stop(ctx context.Context, id string, endedAt time.Time) error
+	Delete(ctx context.Context, id string) error
+	GetTotal(ctx context.Context, filter models.TimeEntryFilter, now time.Time) (models.TimeTotal, error)
+}
+
+const selectTimeEntries = `
+	SELECT id, todo_id, user_id, started_at, ended_at, note, created_at
+	FROM time_entries
+`
+
+type SQLXTimeEntryRepository struct {
+	converter *Converter
+}
+
+var _ TimeEntryRepository = &SQLXTimeEntryRepository{}
+
+func NewSQLXTimeEntryRepository() TimeEntryRepository {
+	return &SQLXTimeEntryRepository{converter: NewConverter()}
+}
+
+func (r *SQLXTimeEntryRepository) Create(ctx context.Context, entry models.TimeEntry) (string, error) {
+	log.C(ctx).Info("creating time entry repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertTimeEntryToEntity(entry)
+	if err = pkg.ValidateUUID(entity.ID); err != nil {
+		log.C(ctx).Errorf("invalid entity id: %v", err)
+		return "", fmt.Errorf("invalid ID format: %w", err)
+	}
+
+	query := `
+		INSERT INTO time_entries (id, todo_id, user_id, started_at, ended_at, note, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query,
+		entity.ID,
+		entity.TodoID,
+		entity.UserID,
+		entity.StartedAt,
+		entity.EndedAt,
+		entity.Note,
+		entity.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to insert time entry: %v", err)
+		return "", fmt.Errorf("failed to create time entry: %w", err)
+	}
+	return id, nil
+}
+
+func (r *SQLXTimeEntryRepository) Get(ctx context.Context, id string) (models.TimeEntry, error) {
+	log.C(ctx).Info("getting time entry repository")
+	return r.getTimeEntry(ctx, selectTimeEntries+`WHERE id = $1`, id)
+}
+
+// GetRunning returns the running timer of the user. It fails with
+// sql.ErrNoRows when the user has no running timer.
+func (r *SQLXTimeEntryRepository) GetRunning(ctx context.Context, userID string) (models.TimeEntry, error) {
+	log.C(ctx).Info("getting running timer repository")
+	return r.getTimeEntry(ctx, selectTimeEntries+`WHERE user_id = $1 AND ended_at IS NULL`, userID)
+}
+
+func (r *SQLXTimeEntryRepository) getTimeEntry(ctx context.Context, query string, args ...interface{}) (models.TimeEntry, error) {
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.TimeEntry{}, err
+	}
+
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, query, args...)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get time entry: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.TimeEntry{}, fmt.Errorf("time entry not found: %w", err)
+		}
+		return models.TimeEntry{}, fmt.Errorf("failed to get time entry: %w", err)
+	}
+	return r.converter.ConvertTimeEntryToModel(entity), nil
+}
+
+func (r *SQLXTimeEntryRepository) GetByTodoID(ctx context.Context, todoID string) ([]models.TimeEntry, error) {
+	log.C(ctx).Info("getting time entries of todo repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, selectTimeEntries+`WHERE todo_id = $1 ORDER BY started_at DESC, id`, todoID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get time entries: %v", err)
+		return nil, fmt.Errorf("failed to get time entries: %w", err)
+	}
+
+	result := make([]models.TimeEntry, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertTimeEntryToModel(entity))
+	}
+	return result, nil
+}
+
+func (r *SQLXTimeEntryRepository) Stop(ctx context.Context, id string, endedAt time.Time) error {
+	log.C(ctx).Info("stopping timer repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `UPDATE time_entries SET ended_at = $2 WHERE id = $1 AND ended_at IS NULL`
+	if _, err = tx.ExecContext(ctx, query, id, endedAt); err != nil {
+		log.C(ctx).Errorf("failed to stop timer: %v", err)
+		return fmt.Errorf("failed to stop timer: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXTimeEntryRepository) Delete(ctx context.Context, id string) error {
+	log.C(ctx).Info("deleting time entry repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `DELETE FROM time_entries WHERE id = $1`
+	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+		log.C(ctx).Errorf("failed to delete time entry: %v", err)
+		return fmt.Errorf("failed to delete time entry: %w", err)
+	}
+	return nil
+}
+
+// GetTotal sums the time logged on untrashed todos by the entries matching
+// the filter. Running timers count up to now.
+func (r *SQLXTimeEntryRepository) GetTotal(ctx context.Context, filter models.TimeEntryFilter, now time.Time) (models.TimeTotal, error) {
+	log.C(ctx).Info("getting time total repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.TimeTotal{}, err
+	}
+
+	where, start, end, args := buildTotal(filter, now)
+	query := `
+		SELECT COUNT(*) AS entries,
+		       COALESCE(SUM(EXTRACT(EPOCH FROM (` + end + ` - ` + start + `))), 0)::BIGINT AS seconds
+		FROM time_entries e
+		JOIN todos t ON t.id = e.todo_id AND t.deleted_at IS NULL
+		` + where
+
+	var entity TotalEntity
+	err = tx.GetContext(ctx, &entity, query, args...)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get time total: %v", err)
+		return models.TimeTotal{}, fmt.Errorf("failed to get time total: %w", err)
+	}
+	return r.converter.ConvertTotalToModel(entity), nil
+}
+
+// buildTotal returns the WHERE clause of a time total together with the
+// expressions for the start and end of the counted part of each entry, which
+// are clipped to the From/To range of the filter.
+func buildTotal(filter models.TimeEntryFilter, now time.Time) (string, string, string, []interface{}) {
+	args := []interface{}{now}
+	start := "e.started_at"
+	end := "COALESCE(e.ended_at, $1)"
+
+	var conditions []string
+	addArg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.TodoID != "" {
+		conditions = append(conditions, "e.todo_id = "+addArg(filter.TodoID))
+	}
+	if filter.ListID != "" {
+		conditions = append(conditions, "t.list_id = "+addArg(filter.ListID))
+	}
+	if filter.UserID != "" {
+		conditions = append(conditions, "e.user_id = "+addArg(filter.UserID))
+	}
+	if filter.From != nil {
+		from := addArg(*filter.From)
+		conditions = append(conditions, "COALESCE(e.ended_at, $1) > "+from)
+		start = "GREATEST(e.started_at, " + from + ")"
+	}
+	if filter.To != nil {
+		to := addArg(*filter.To)
+		conditions = append(conditions, "e.started_at < "+to)
+		end = "LEAST(COALESCE(e.ended_at, $1), " + to + ")"
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	return where, start, end, args
+}
@@ -0,0 +1,158 @@
+package timeentries_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/timeentries"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXTimeEntryRepositoryGetTotal(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := timeentries.NewSQLXTimeEntryRepository()
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	from := now.Add(-24 * time.Hour)
+
+	testCases := []struct {
+		name           string
+		filter         models.TimeEntryFilter
+		setupMocks     func()
+		expectedResult models.TimeTotal
+		expectedError  error
+	}{
+		{
+			name:   "Successful total of a list clipped to the range",
+			filter: models.TimeEntryFilter{ListID: "list", From: &from, To: &now},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT COUNT\\(\\*\\) AS entries, COALESCE\\(SUM\\(EXTRACT\\(EPOCH FROM \\(LEAST\\(COALESCE\\(e.ended_at, \\$1\\), \\$4\\) - GREATEST\\(e.started_at, \\$3\\)\\)\\)\\), 0\\)::BIGINT AS seconds FROM time_entries e JOIN todos t ON t.id = e.todo_id AND t.deleted_at IS NULL WHERE t.list_id = \\$2 AND COALESCE\\(e.ended_at, \\$1\\) > \\$3 AND e.started_at < \\$4").
+					WithArgs(now, "list", from, now).
+					WillReturnRows(sqlxmock.NewRows([]string{"entries", "seconds"}).AddRow(2, 5400))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: models.TimeTotal{Seconds: 5400, Entries: 2},
+		},
+		{
+			name:   "Successful total of a user without a range",
+			filter: models.TimeEntryFilter{UserID: "user"},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("EXTRACT\\(EPOCH FROM \\(COALESCE\\(e.ended_at, \\$1\\) - e.started_at\\)(.+) WHERE e.user_id = \\$2").
+					WithArgs(now, "user").
+					WillReturnRows(sqlxmock.NewRows([]string{"entries", "seconds"}).AddRow(0, 0))
+				mockDB.ExpectCommit()
+			},
+			expectedResult: models.TimeTotal{},
+		},
+		{
+			name:   "Failed total due to database error",
+			filter: models.TimeEntryFilter{TodoID: "todo"},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("FROM time_entries e (.+) WHERE e.todo_id = \\$2").
+					WithArgs(now, "todo").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get time total: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.GetTotal(ctx, tc.filter, now)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTimeEntryRepositoryStop(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := timeentries.NewSQLXTimeEntryRepository()
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful stop of a running timer",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE time_entries SET ended_at = \\$2 WHERE id = \\$1 AND ended_at IS NULL").
+					WithArgs("1", now).
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed stop due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE time_entries SET ended_at").
+					WithArgs("1", now).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to stop timer: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.Stop(ctx, "1", now)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
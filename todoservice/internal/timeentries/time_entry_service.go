@@ -0,0 +1,162 @@
+package timeentries
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"strings"
+	"time"
+)
+
+var (
+	ErrTimerRunning      = errors.New("a timer is already running")
+	ErrNoRunningTimer    = errors.New("there is no running timer")
+	ErrInvalidTimeEntry  = errors.New("time entry must end after it starts")
+	ErrInvalidTimeRange  = errors.New("time range must end after it starts")
+	ErrTimeEntryNotFound = errors.New("time entry not found")
+	ErrNotTimeEntryOwner = errors.New("only the owner can delete a time entry")
+)
+
+//go:generate mockery --name=TimeEntryService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeEntryService interface {
+	StartTimer(ctx context.Context, todoID string, userID string, note string) (models.TimeEntry, error)
+	StopTimer(ctx context.Context, userID string) (models.TimeEntry, error)
+	GetRunningTimer(ctx context.Context, userID string) (models.TimeEntry, error)
+	CreateTimeEntry(ctx context.Context, entry models.TimeEntry) (models.TimeEntry, error)
+	ListTimeEntries(ctx context.Context, todoID string) ([]models.TimeEntry, error)
+	DeleteTimeEntry(ctx context.Context, id string, todoID string, userID string) error
+	GetTotal(ctx context.Context, filter models.TimeEntryFilter) (models.TimeTotal, error)
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ TimeEntryService = &service{}
+
+type service struct {
+	repo        TimeEntryRepository
+	uuidService UUIDService
+	timeService TimeService
+}
+
+func NewService(repo TimeEntryRepository, uuidService UUIDService, timeService TimeService) TimeEntryService {
+	return &service{repo: repo, uuidService: uuidService, timeService: timeService}
+}
+
+// StartTimer starts a timer on the todo. A user can only have one running
+// timer, so the running one has to be stopped first.
+func (s *service) StartTimer(ctx context.Context, todoID string, userID string, note string) (models.TimeEntry, error) {
+	log.C(ctx).Info("starting timer service")
+	_, err := s.repo.GetRunning(ctx, userID)
+	if err == nil {
+		log.C(ctx).Errorf("user %s already has a running timer", userID)
+		return models.TimeEntry{}, ErrTimerRunning
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		log.C(ctx).Errorf("getting running timer of user %s failed", userID)
+		return models.TimeEntry{}, err
+	}
+
+	now := s.timeService.Now()
+	entry := models.TimeEntry{
+		ID:        s.uuidService.Generate(),
+		TodoID:    todoID,
+		UserID:    userID,
+		StartedAt: now,
+		Note:      strings.TrimSpace(note),
+		CreatedAt: now,
+	}
+	if _, err = s.repo.Create(ctx, entry); err != nil {
+		log.C(ctx).Errorf("starting timer on todo with id %s failed", todoID)
+		return models.TimeEntry{}, err
+	}
+	return s.repo.Get(ctx, entry.ID)
+}
+
+func (s *service) StopTimer(ctx context.Context, userID string) (models.TimeEntry, error) {
+	log.C(ctx).Info("stopping timer service")
+	running, err := s.GetRunningTimer(ctx, userID)
+	if err != nil {
+		return models.TimeEntry{}, err
+	}
+	if err = s.repo.Stop(ctx, running.ID, s.timeService.Now()); err != nil {
+		log.C(ctx).Errorf("stopping timer with id %s failed", running.ID)
+		return models.TimeEntry{}, err
+	}
+	return s.repo.Get(ctx, running.ID)
+}
+
+func (s *service) GetRunningTimer(ctx context.Context, userID string) (models.TimeEntry, error) {
+	log.C(ctx).Info("getting running timer service")
+	running, err := s.repo.GetRunning(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.TimeEntry{}, ErrNoRunningTimer
+	}
+	if err != nil {
+		log.C(ctx).Errorf("getting running timer of user %s failed", userID)
+		return models.TimeEntry{}, err
+	}
+	return running, nil
+}
+
+// CreateTimeEntry logs time spent on a todo without running a timer. The
+// entry must have both a start and an end.
+func (s *service) CreateTimeEntry(ctx context.Context, entry models.TimeEntry) (models.TimeEntry, error) {
+	log.C(ctx).Info("creating time entry service")
+	if entry.StartedAt.IsZero() || entry.EndedAt == nil || entry.EndedAt.Before(entry.StartedAt) {
+		return models.TimeEntry{}, ErrInvalidTimeEntry
+	}
+
+	entry.ID = s.uuidService.Generate()
+	entry.Note = strings.TrimSpace(entry.Note)
+	entry.CreatedAt = s.timeService.Now()
+	if _, err := s.repo.Create(ctx, entry); err != nil {
+		log.C(ctx).Errorf("creating time entry on todo with id %s failed", entry.TodoID)
+		return models.TimeEntry{}, err
+	}
+	return s.repo.Get(ctx, entry.ID)
+}
+
+func (s *service) ListTimeEntries(ctx context.Context, todoID string) ([]models.TimeEntry, error) {
+	log.C(ctx).Info("listing time entries service")
+	return s.repo.GetByTodoID(ctx, todoID)
+}
+
+// DeleteTimeEntry removes an entry of the todo. Users can only delete their
+// own entries.
+func (s *service) DeleteTimeEntry(ctx context.Context, id string, todoID string, userID string) error {
+	log.C(ctx).Info("deleting time entry service")
+	entry, err := s.repo.Get(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrTimeEntryNotFound
+	}
+	if err != nil {
+		log.C(ctx).Errorf("getting time entry with id %s failed", id)
+		return err
+	}
+	if entry.TodoID != todoID {
+		return ErrTimeEntryNotFound
+	}
+	if entry.UserID != userID {
+		log.C(ctx).Errorf("user %s does not own time entry %s", userID, id)
+		return ErrNotTimeEntryOwner
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *service) GetTotal(ctx context.Context, filter models.TimeEntryFilter) (models.TimeTotal, error) {
+	log.C(ctx).Info("getting time total service")
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return models.TimeTotal{}, ErrInvalidTimeRange
+	}
+	return s.repo.GetTotal(ctx, filter, s.timeService.Now())
+}
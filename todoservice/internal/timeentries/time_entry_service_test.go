@@ -0,0 +1,325 @@
+package timeentries_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/timeentries"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/timeentries/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestServiceStartTimer(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	notFound := fmt.Errorf("time entry not found: %w", sql.ErrNoRows)
+
+	started := models.TimeEntry{ID: "1", TodoID: "todo", UserID: "user", StartedAt: now, Note: "review", CreatedAt: now}
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.TimeEntryRepository
+		expected      models.TimeEntry
+		expectedError error
+	}{
+		{
+			name: "Start timer when none is running",
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().GetRunning(ctx, "user").Return(models.TimeEntry{}, notFound).Once()
+				repo.EXPECT().Create(ctx, started).Return(started.ID, nil).Once()
+				repo.EXPECT().Get(ctx, started.ID).Return(started, nil).Once()
+				return repo
+			},
+			expected: started,
+		},
+		{
+			name: "Error when a timer is already running",
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().GetRunning(ctx, "user").Return(models.TimeEntry{ID: "2"}, nil).Once()
+				return repo
+			},
+			expectedError: timeentries.ErrTimerRunning,
+		},
+		{
+			name: "Error when getting the running timer fails",
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().GetRunning(ctx, "user").Return(models.TimeEntry{}, err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(started.ID).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := timeentries.NewService(repo, uuidService, timeService)
+			result, err := svc.StartTimer(ctx, "todo", "user", " review ")
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceStopTimer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	notFound := fmt.Errorf("time entry not found: %w", sql.ErrNoRows)
+
+	running := models.TimeEntry{ID: "1", TodoID: "todo", UserID: "user", StartedAt: now.Add(-time.Hour)}
+	stopped := running
+	stopped.EndedAt = &now
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.TimeEntryRepository
+		expected      models.TimeEntry
+		expectedError error
+	}{
+		{
+			name: "Stop the running timer",
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().GetRunning(ctx, "user").Return(running, nil).Once()
+				repo.EXPECT().Stop(ctx, running.ID, now).Return(nil).Once()
+				repo.EXPECT().Get(ctx, running.ID).Return(stopped, nil).Once()
+				return repo
+			},
+			expected: stopped,
+		},
+		{
+			name: "Error when no timer is running",
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().GetRunning(ctx, "user").Return(models.TimeEntry{}, notFound).Once()
+				return repo
+			},
+			expectedError: timeentries.ErrNoRunningTimer,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := timeentries.NewService(repo, &automock.UUIDService{}, timeService)
+			result, err := svc.StopTimer(ctx, "user")
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceCreateTimeEntry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	start := now.Add(-2 * time.Hour)
+	end := now.Add(-time.Hour)
+	beforeStart := start.Add(-time.Minute)
+
+	stored := models.TimeEntry{ID: "1", TodoID: "todo", UserID: "user", StartedAt: start, EndedAt: &end, Note: "call", CreatedAt: now}
+
+	tests := []struct {
+		name          string
+		input         models.TimeEntry
+		repo          func() *automock.TimeEntryRepository
+		expected      models.TimeEntry
+		expectedError error
+	}{
+		{
+			name:  "Create a manual time entry",
+			input: models.TimeEntry{TodoID: "todo", UserID: "user", StartedAt: start, EndedAt: &end, Note: " call "},
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().Create(ctx, stored).Return(stored.ID, nil).Once()
+				repo.EXPECT().Get(ctx, stored.ID).Return(stored, nil).Once()
+				return repo
+			},
+			expected: stored,
+		},
+		{
+			name:  "Error when the entry has no end",
+			input: models.TimeEntry{TodoID: "todo", UserID: "user", StartedAt: start},
+			repo: func() *automock.TimeEntryRepository {
+				return &automock.TimeEntryRepository{}
+			},
+			expectedError: timeentries.ErrInvalidTimeEntry,
+		},
+		{
+			name:  "Error when the entry ends before it starts",
+			input: models.TimeEntry{TodoID: "todo", UserID: "user", StartedAt: start, EndedAt: &beforeStart},
+			repo: func() *automock.TimeEntryRepository {
+				return &automock.TimeEntryRepository{}
+			},
+			expectedError: timeentries.ErrInvalidTimeEntry,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(stored.ID).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := timeentries.NewService(repo, uuidService, timeService)
+			result, err := svc.CreateTimeEntry(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceDeleteTimeEntry(t *testing.T) {
+	ctx := context.Background()
+	notFound := fmt.Errorf("time entry not found: %w", sql.ErrNoRows)
+	entry := models.TimeEntry{ID: "1", TodoID: "todo", UserID: "user"}
+
+	tests := []struct {
+		name          string
+		todoID        string
+		userID        string
+		repo          func() *automock.TimeEntryRepository
+		expectedError error
+	}{
+		{
+			name:   "Delete own time entry",
+			todoID: "todo",
+			userID: "user",
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().Get(ctx, entry.ID).Return(entry, nil).Once()
+				repo.EXPECT().Delete(ctx, entry.ID).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:   "Error when the entry belongs to another user",
+			todoID: "todo",
+			userID: "other",
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().Get(ctx, entry.ID).Return(entry, nil).Once()
+				return repo
+			},
+			expectedError: timeentries.ErrNotTimeEntryOwner,
+		},
+		{
+			name:   "Error when the entry belongs to another todo",
+			todoID: "other",
+			userID: "user",
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().Get(ctx, entry.ID).Return(entry, nil).Once()
+				return repo
+			},
+			expectedError: timeentries.ErrTimeEntryNotFound,
+		},
+		{
+			name:   "Error when the entry does not exist",
+			todoID: "todo",
+			userID: "user",
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().Get(ctx, entry.ID).Return(models.TimeEntry{}, notFound).Once()
+				return repo
+			},
+			expectedError: timeentries.ErrTimeEntryNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := timeentries.NewService(repo, &automock.UUIDService{}, &automock.TimeService{})
+			err := svc.DeleteTimeEntry(ctx, entry.ID, tt.todoID, tt.userID)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServiceGetTotal(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	yesterday := now.Add(-24 * time.Hour)
+
+	tests := []struct {
+		name          string
+		filter        models.TimeEntryFilter
+		repo          func() *automock.TimeEntryRepository
+		expected      models.TimeTotal
+		expectedError error
+	}{
+		{
+			name:   "Get total counting running timers up to now",
+			filter: models.TimeEntryFilter{TodoID: "todo", From: &yesterday, To: &now},
+			repo: func() *automock.TimeEntryRepository {
+				repo := &automock.TimeEntryRepository{}
+				repo.EXPECT().GetTotal(ctx, models.TimeEntryFilter{TodoID: "todo", From: &yesterday, To: &now}, now).
+					Return(models.TimeTotal{Seconds: 60, Entries: 1}, nil).Once()
+				return repo
+			},
+			expected: models.TimeTotal{Seconds: 60, Entries: 1},
+		},
+		{
+			name:   "Error when the range ends before it starts",
+			filter: models.TimeEntryFilter{TodoID: "todo", From: &now, To: &yesterday},
+			repo: func() *automock.TimeEntryRepository {
+				return &automock.TimeEntryRepository{}
+			},
+			expectedError: timeentries.ErrInvalidTimeRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := timeentries.NewService(repo, &automock.UUIDService{}, timeService)
+			result, err := svc.GetTotal(ctx, tt.filter)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
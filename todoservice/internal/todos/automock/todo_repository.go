@@ -25,6 +25,54 @@ func (_m *TodoRepository) EXPECT() *TodoRepository_Expecter {
 	return &TodoRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddDependency provides a mock function with given fields: ctx, todoID, blockedByID
+func (_m *TodoRepository) AddDependency(ctx context.Context, todoID string, blockedByID string) error {
+	ret := _m.Called(ctx, todoID, blockedByID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, todoID, blockedByID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_AddDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDependency'
+type TodoRepository_AddDependency_Call struct {
+	*mock.Call
+}
+
+// AddDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - blockedByID string
+func (_e *TodoRepository_Expecter) AddDependency(ctx interface{}, todoID interface{}, blockedByID interface{}) *TodoRepository_AddDependency_Call {
+	return &TodoRepository_AddDependency_Call{Call: _e.mock.On("AddDependency", ctx, todoID, blockedByID)}
+}
+
+func (_c *TodoRepository_AddDependency_Call) Run(run func(ctx context.Context, todoID string, blockedByID string)) *TodoRepository_AddDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_AddDependency_Call) Return(_a0 error) *TodoRepository_AddDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_AddDependency_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepository_AddDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CompleteTodo provides a mock function with given fields: ctx, id
 func (_m *TodoRepository) CompleteTodo(ctx context.Context, id string) (models.Todo, error) {
 	ret := _m.Called(ctx, id)
@@ -186,6 +234,122 @@ func (_c *TodoRepository_Delete_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// DependsOn provides a mock function with given fields: ctx, todoID, blockedByID
+func (_m *TodoRepository) DependsOn(ctx context.Context, todoID string, blockedByID string) (bool, error) {
+	ret := _m.Called(ctx, todoID, blockedByID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DependsOn")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, todoID, blockedByID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, todoID, blockedByID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, todoID, blockedByID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_DependsOn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DependsOn'
+type TodoRepository_DependsOn_Call struct {
+	*mock.Call
+}
+
+// DependsOn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - blockedByID string
+func (_e *TodoRepository_Expecter) DependsOn(ctx interface{}, todoID interface{}, blockedByID interface{}) *TodoRepository_DependsOn_Call {
+	return &TodoRepository_DependsOn_Call{Call: _e.mock.On("DependsOn", ctx, todoID, blockedByID)}
+}
+
+func (_c *TodoRepository_DependsOn_Call) Run(run func(ctx context.Context, todoID string, blockedByID string)) *TodoRepository_DependsOn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_DependsOn_Call) Return(_a0 bool, _a1 error) *TodoRepository_DependsOn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_DependsOn_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *TodoRepository_DependsOn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCollaborator provides a mock function with given fields: ctx, listID, handle
+func (_m *TodoRepository) FindCollaborator(ctx context.Context, listID string, handle string) (string, error) {
+	ret := _m.Called(ctx, listID, handle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCollaborator")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, listID, handle)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, listID, handle)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, listID, handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_FindCollaborator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCollaborator'
+type TodoRepository_FindCollaborator_Call struct {
+	*mock.Call
+}
+
+// FindCollaborator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - handle string
+func (_e *TodoRepository_Expecter) FindCollaborator(ctx interface{}, listID interface{}, handle interface{}) *TodoRepository_FindCollaborator_Call {
+	return &TodoRepository_FindCollaborator_Call{Call: _e.mock.On("FindCollaborator", ctx, listID, handle)}
+}
+
+func (_c *TodoRepository_FindCollaborator_Call) Run(run func(ctx context.Context, listID string, handle string)) *TodoRepository_FindCollaborator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_FindCollaborator_Call) Return(_a0 string, _a1 error) *TodoRepository_FindCollaborator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_FindCollaborator_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *TodoRepository_FindCollaborator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *TodoRepository) Get(ctx context.Context, id string) (models.Todo, error) {
 	ret := _m.Called(ctx, id)
@@ -360,74 +524,88 @@ func (_c *TodoRepository_GetAllByListID_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, todo
-func (_m *TodoRepository) Update(ctx context.Context, todo models.Todo) error {
-	ret := _m.Called(ctx, todo)
+// GetBlockedBy provides a mock function with given fields: ctx, todoID
+func (_m *TodoRepository) GetBlockedBy(ctx context.Context, todoID string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, todoID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for GetBlockedBy")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Todo) error); ok {
-		r0 = rf(ctx, todo)
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, todoID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, todoID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// TodoRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type TodoRepository_Update_Call struct {
+// TodoRepository_GetBlockedBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockedBy'
+type TodoRepository_GetBlockedBy_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
+// GetBlockedBy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - todo models.Todo
-func (_e *TodoRepository_Expecter) Update(ctx interface{}, todo interface{}) *TodoRepository_Update_Call {
-	return &TodoRepository_Update_Call{Call: _e.mock.On("Update", ctx, todo)}
+//   - todoID string
+func (_e *TodoRepository_Expecter) GetBlockedBy(ctx interface{}, todoID interface{}) *TodoRepository_GetBlockedBy_Call {
+	return &TodoRepository_GetBlockedBy_Call{Call: _e.mock.On("GetBlockedBy", ctx, todoID)}
 }
 
-func (_c *TodoRepository_Update_Call) Run(run func(ctx context.Context, todo models.Todo)) *TodoRepository_Update_Call {
+func (_c *TodoRepository_GetBlockedBy_Call) Run(run func(ctx context.Context, todoID string)) *TodoRepository_GetBlockedBy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.Todo))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *TodoRepository_Update_Call) Return(_a0 error) *TodoRepository_Update_Call {
-	_c.Call.Return(_a0)
+func (_c *TodoRepository_GetBlockedBy_Call) Return(_a0 []models.Todo, _a1 error) *TodoRepository_GetBlockedBy_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoRepository_Update_Call) RunAndReturn(run func(context.Context, models.Todo) error) *TodoRepository_Update_Call {
+func (_c *TodoRepository_GetBlockedBy_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoRepository_GetBlockedBy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateAssignedTo provides a mock function with given fields: ctx, id, userID
-func (_m *TodoRepository) UpdateAssignedTo(ctx context.Context, id string, userID string) (models.Todo, error) {
-	ret := _m.Called(ctx, id, userID)
+// GetBlocking provides a mock function with given fields: ctx, todoID
+func (_m *TodoRepository) GetBlocking(ctx context.Context, todoID string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, todoID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAssignedTo")
+		panic("no return value specified for GetBlocking")
 	}
 
-	var r0 models.Todo
+	var r0 []models.Todo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
-		return rf(ctx, id, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, todoID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
-		r0 = rf(ctx, id, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, todoID)
 	} else {
-		r0 = ret.Get(0).(models.Todo)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, id, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, todoID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -435,57 +613,58 @@ func (_m *TodoRepository) UpdateAssignedTo(ctx context.Context, id string, userI
 	return r0, r1
 }
 
-// TodoRepository_UpdateAssignedTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAssignedTo'
-type TodoRepository_UpdateAssignedTo_Call struct {
+// TodoRepository_GetBlocking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlocking'
+type TodoRepository_GetBlocking_Call struct {
 	*mock.Call
 }
 
-// UpdateAssignedTo is a helper method to define mock.On call
+// GetBlocking is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-//   - userID string
-func (_e *TodoRepository_Expecter) UpdateAssignedTo(ctx interface{}, id interface{}, userID interface{}) *TodoRepository_UpdateAssignedTo_Call {
-	return &TodoRepository_UpdateAssignedTo_Call{Call: _e.mock.On("UpdateAssignedTo", ctx, id, userID)}
+//   - todoID string
+func (_e *TodoRepository_Expecter) GetBlocking(ctx interface{}, todoID interface{}) *TodoRepository_GetBlocking_Call {
+	return &TodoRepository_GetBlocking_Call{Call: _e.mock.On("GetBlocking", ctx, todoID)}
 }
 
-func (_c *TodoRepository_UpdateAssignedTo_Call) Run(run func(ctx context.Context, id string, userID string)) *TodoRepository_UpdateAssignedTo_Call {
+func (_c *TodoRepository_GetBlocking_Call) Run(run func(ctx context.Context, todoID string)) *TodoRepository_GetBlocking_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *TodoRepository_UpdateAssignedTo_Call) Return(_a0 models.Todo, _a1 error) *TodoRepository_UpdateAssignedTo_Call {
+func (_c *TodoRepository_GetBlocking_Call) Return(_a0 []models.Todo, _a1 error) *TodoRepository_GetBlocking_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoRepository_UpdateAssignedTo_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoRepository_UpdateAssignedTo_Call {
+func (_c *TodoRepository_GetBlocking_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoRepository_GetBlocking_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTodoDescription provides a mock function with given fields: ctx, id, description
-func (_m *TodoRepository) UpdateTodoDescription(ctx context.Context, id string, description string) (models.Todo, error) {
-	ret := _m.Called(ctx, id, description)
+// GetFiltered provides a mock function with given fields: ctx, filter
+func (_m *TodoRepository) GetFiltered(ctx context.Context, filter models.TodoFilter) ([]models.Todo, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTodoDescription")
+		panic("no return value specified for GetFiltered")
 	}
 
-	var r0 models.Todo
+	var r0 []models.Todo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
-		return rf(ctx, id, description)
+	if rf, ok := ret.Get(0).(func(context.Context, models.TodoFilter) ([]models.Todo, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
-		r0 = rf(ctx, id, description)
+	if rf, ok := ret.Get(0).(func(context.Context, models.TodoFilter) []models.Todo); ok {
+		r0 = rf(ctx, filter)
 	} else {
-		r0 = ret.Get(0).(models.Todo)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, id, description)
+	if rf, ok := ret.Get(1).(func(context.Context, models.TodoFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -493,57 +672,56 @@ func (_m *TodoRepository) UpdateTodoDescription(ctx context.Context, id string,
 	return r0, r1
 }
 
-// TodoRepository_UpdateTodoDescription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodoDescription'
-type TodoRepository_UpdateTodoDescription_Call struct {
+// TodoRepository_GetFiltered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFiltered'
+type TodoRepository_GetFiltered_Call struct {
 	*mock.Call
 }
 
-// UpdateTodoDescription is a helper method to define mock.On call
+// GetFiltered is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-//   - description string
-func (_e *TodoRepository_Expecter) UpdateTodoDescription(ctx interface{}, id interface{}, description interface{}) *TodoRepository_UpdateTodoDescription_Call {
-	return &TodoRepository_UpdateTodoDescription_Call{Call: _e.mock.On("UpdateTodoDescription", ctx, id, description)}
+//   - filter models.TodoFilter
+func (_e *TodoRepository_Expecter) GetFiltered(ctx interface{}, filter interface{}) *TodoRepository_GetFiltered_Call {
+	return &TodoRepository_GetFiltered_Call{Call: _e.mock.On("GetFiltered", ctx, filter)}
 }
 
-func (_c *TodoRepository_UpdateTodoDescription_Call) Run(run func(ctx context.Context, id string, description string)) *TodoRepository_UpdateTodoDescription_Call {
+func (_c *TodoRepository_GetFiltered_Call) Run(run func(ctx context.Context, filter models.TodoFilter)) *TodoRepository_GetFiltered_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(models.TodoFilter))
 	})
 	return _c
 }
 
-func (_c *TodoRepository_UpdateTodoDescription_Call) Return(_a0 models.Todo, _a1 error) *TodoRepository_UpdateTodoDescription_Call {
+func (_c *TodoRepository_GetFiltered_Call) Return(_a0 []models.Todo, _a1 error) *TodoRepository_GetFiltered_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoRepository_UpdateTodoDescription_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoRepository_UpdateTodoDescription_Call {
+func (_c *TodoRepository_GetFiltered_Call) RunAndReturn(run func(context.Context, models.TodoFilter) ([]models.Todo, error)) *TodoRepository_GetFiltered_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTodoPriority provides a mock function with given fields: ctx, id, priority
-func (_m *TodoRepository) UpdateTodoPriority(ctx context.Context, id string, priority constants.PriorityLevel) (models.Todo, error) {
-	ret := _m.Called(ctx, id, priority)
+// GetLastPosition provides a mock function with given fields: ctx, listID
+func (_m *TodoRepository) GetLastPosition(ctx context.Context, listID string) (string, error) {
+	ret := _m.Called(ctx, listID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTodoPriority")
+		panic("no return value specified for GetLastPosition")
 	}
 
-	var r0 models.Todo
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, constants.PriorityLevel) (models.Todo, error)); ok {
-		return rf(ctx, id, priority)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, listID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, constants.PriorityLevel) models.Todo); ok {
-		r0 = rf(ctx, id, priority)
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, listID)
 	} else {
-		r0 = ret.Get(0).(models.Todo)
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, constants.PriorityLevel) error); ok {
-		r1 = rf(ctx, id, priority)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -551,32 +729,803 @@ func (_m *TodoRepository) UpdateTodoPriority(ctx context.Context, id string, pri
 	return r0, r1
 }
 
-// TodoRepository_UpdateTodoPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodoPriority'
-type TodoRepository_UpdateTodoPriority_Call struct {
+// TodoRepository_GetLastPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastPosition'
+type TodoRepository_GetLastPosition_Call struct {
 	*mock.Call
 }
 
-// UpdateTodoPriority is a helper method to define mock.On call
+// GetLastPosition is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-//   - priority constants.PriorityLevel
-func (_e *TodoRepository_Expecter) UpdateTodoPriority(ctx interface{}, id interface{}, priority interface{}) *TodoRepository_UpdateTodoPriority_Call {
-	return &TodoRepository_UpdateTodoPriority_Call{Call: _e.mock.On("UpdateTodoPriority", ctx, id, priority)}
+//   - listID string
+func (_e *TodoRepository_Expecter) GetLastPosition(ctx interface{}, listID interface{}) *TodoRepository_GetLastPosition_Call {
+	return &TodoRepository_GetLastPosition_Call{Call: _e.mock.On("GetLastPosition", ctx, listID)}
 }
 
-func (_c *TodoRepository_UpdateTodoPriority_Call) Run(run func(ctx context.Context, id string, priority constants.PriorityLevel)) *TodoRepository_UpdateTodoPriority_Call {
+func (_c *TodoRepository_GetLastPosition_Call) Run(run func(ctx context.Context, listID string)) *TodoRepository_GetLastPosition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(constants.PriorityLevel))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *TodoRepository_UpdateTodoPriority_Call) Return(_a0 models.Todo, _a1 error) *TodoRepository_UpdateTodoPriority_Call {
+func (_c *TodoRepository_GetLastPosition_Call) Return(_a0 string, _a1 error) *TodoRepository_GetLastPosition_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoRepository_UpdateTodoPriority_Call) RunAndReturn(run func(context.Context, string, constants.PriorityLevel) (models.Todo, error)) *TodoRepository_UpdateTodoPriority_Call {
+func (_c *TodoRepository_GetLastPosition_Call) RunAndReturn(run func(context.Context, string) (string, error)) *TodoRepository_GetLastPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPositionAfter provides a mock function with given fields: ctx, listID, position
+func (_m *TodoRepository) GetPositionAfter(ctx context.Context, listID string, position string) (string, error) {
+	ret := _m.Called(ctx, listID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPositionAfter")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, listID, position)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, listID, position)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, listID, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_GetPositionAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPositionAfter'
+type TodoRepository_GetPositionAfter_Call struct {
+	*mock.Call
+}
+
+// GetPositionAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - position string
+func (_e *TodoRepository_Expecter) GetPositionAfter(ctx interface{}, listID interface{}, position interface{}) *TodoRepository_GetPositionAfter_Call {
+	return &TodoRepository_GetPositionAfter_Call{Call: _e.mock.On("GetPositionAfter", ctx, listID, position)}
+}
+
+func (_c *TodoRepository_GetPositionAfter_Call) Run(run func(ctx context.Context, listID string, position string)) *TodoRepository_GetPositionAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_GetPositionAfter_Call) Return(_a0 string, _a1 error) *TodoRepository_GetPositionAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_GetPositionAfter_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *TodoRepository_GetPositionAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPositionBefore provides a mock function with given fields: ctx, listID, position
+func (_m *TodoRepository) GetPositionBefore(ctx context.Context, listID string, position string) (string, error) {
+	ret := _m.Called(ctx, listID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPositionBefore")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, listID, position)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, listID, position)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, listID, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_GetPositionBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPositionBefore'
+type TodoRepository_GetPositionBefore_Call struct {
+	*mock.Call
+}
+
+// GetPositionBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - position string
+func (_e *TodoRepository_Expecter) GetPositionBefore(ctx interface{}, listID interface{}, position interface{}) *TodoRepository_GetPositionBefore_Call {
+	return &TodoRepository_GetPositionBefore_Call{Call: _e.mock.On("GetPositionBefore", ctx, listID, position)}
+}
+
+func (_c *TodoRepository_GetPositionBefore_Call) Run(run func(ctx context.Context, listID string, position string)) *TodoRepository_GetPositionBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_GetPositionBefore_Call) Return(_a0 string, _a1 error) *TodoRepository_GetPositionBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_GetPositionBefore_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *TodoRepository_GetPositionBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubtasks provides a mock function with given fields: ctx, parentID
+func (_m *TodoRepository) GetSubtasks(ctx context.Context, parentID string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubtasks")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, parentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, parentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_GetSubtasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubtasks'
+type TodoRepository_GetSubtasks_Call struct {
+	*mock.Call
+}
+
+// GetSubtasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentID string
+func (_e *TodoRepository_Expecter) GetSubtasks(ctx interface{}, parentID interface{}) *TodoRepository_GetSubtasks_Call {
+	return &TodoRepository_GetSubtasks_Call{Call: _e.mock.On("GetSubtasks", ctx, parentID)}
+}
+
+func (_c *TodoRepository_GetSubtasks_Call) Run(run func(ctx context.Context, parentID string)) *TodoRepository_GetSubtasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_GetSubtasks_Call) Return(_a0 []models.Todo, _a1 error) *TodoRepository_GetSubtasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_GetSubtasks_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoRepository_GetSubtasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsCollaborator provides a mock function with given fields: ctx, listID, userID
+func (_m *TodoRepository) IsCollaborator(ctx context.Context, listID string, userID string) (bool, error) {
+	ret := _m.Called(ctx, listID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCollaborator")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, listID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, listID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, listID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_IsCollaborator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsCollaborator'
+type TodoRepository_IsCollaborator_Call struct {
+	*mock.Call
+}
+
+// IsCollaborator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - userID string
+func (_e *TodoRepository_Expecter) IsCollaborator(ctx interface{}, listID interface{}, userID interface{}) *TodoRepository_IsCollaborator_Call {
+	return &TodoRepository_IsCollaborator_Call{Call: _e.mock.On("IsCollaborator", ctx, listID, userID)}
+}
+
+func (_c *TodoRepository_IsCollaborator_Call) Run(run func(ctx context.Context, listID string, userID string)) *TodoRepository_IsCollaborator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_IsCollaborator_Call) Return(_a0 bool, _a1 error) *TodoRepository_IsCollaborator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_IsCollaborator_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *TodoRepository_IsCollaborator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockVersion provides a mock function with given fields: ctx, id
+func (_m *TodoRepository) LockVersion(ctx context.Context, id string) (int, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockVersion")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_LockVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockVersion'
+type TodoRepository_LockVersion_Call struct {
+	*mock.Call
+}
+
+// LockVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoRepository_Expecter) LockVersion(ctx interface{}, id interface{}) *TodoRepository_LockVersion_Call {
+	return &TodoRepository_LockVersion_Call{Call: _e.mock.On("LockVersion", ctx, id)}
+}
+
+func (_c *TodoRepository_LockVersion_Call) Run(run func(ctx context.Context, id string)) *TodoRepository_LockVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_LockVersion_Call) Return(_a0 int, _a1 error) *TodoRepository_LockVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_LockVersion_Call) RunAndReturn(run func(context.Context, string) (int, error)) *TodoRepository_LockVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveToList provides a mock function with given fields: ctx, id, listID, position
+func (_m *TodoRepository) MoveToList(ctx context.Context, id string, listID string, position string) error {
+	ret := _m.Called(ctx, id, listID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveToList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, id, listID, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_MoveToList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveToList'
+type TodoRepository_MoveToList_Call struct {
+	*mock.Call
+}
+
+// MoveToList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - listID string
+//   - position string
+func (_e *TodoRepository_Expecter) MoveToList(ctx interface{}, id interface{}, listID interface{}, position interface{}) *TodoRepository_MoveToList_Call {
+	return &TodoRepository_MoveToList_Call{Call: _e.mock.On("MoveToList", ctx, id, listID, position)}
+}
+
+func (_c *TodoRepository_MoveToList_Call) Run(run func(ctx context.Context, id string, listID string, position string)) *TodoRepository_MoveToList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_MoveToList_Call) Return(_a0 error) *TodoRepository_MoveToList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_MoveToList_Call) RunAndReturn(run func(context.Context, string, string, string) error) *TodoRepository_MoveToList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveDependency provides a mock function with given fields: ctx, todoID, blockedByID
+func (_m *TodoRepository) RemoveDependency(ctx context.Context, todoID string, blockedByID string) error {
+	ret := _m.Called(ctx, todoID, blockedByID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, todoID, blockedByID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_RemoveDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDependency'
+type TodoRepository_RemoveDependency_Call struct {
+	*mock.Call
+}
+
+// RemoveDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - blockedByID string
+func (_e *TodoRepository_Expecter) RemoveDependency(ctx interface{}, todoID interface{}, blockedByID interface{}) *TodoRepository_RemoveDependency_Call {
+	return &TodoRepository_RemoveDependency_Call{Call: _e.mock.On("RemoveDependency", ctx, todoID, blockedByID)}
+}
+
+func (_c *TodoRepository_RemoveDependency_Call) Run(run func(ctx context.Context, todoID string, blockedByID string)) *TodoRepository_RemoveDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_RemoveDependency_Call) Return(_a0 error) *TodoRepository_RemoveDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_RemoveDependency_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepository_RemoveDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenTodo provides a mock function with given fields: ctx, id
+func (_m *TodoRepository) ReopenTodo(ctx context.Context, id string) (models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenTodo")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_ReopenTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenTodo'
+type TodoRepository_ReopenTodo_Call struct {
+	*mock.Call
+}
+
+// ReopenTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoRepository_Expecter) ReopenTodo(ctx interface{}, id interface{}) *TodoRepository_ReopenTodo_Call {
+	return &TodoRepository_ReopenTodo_Call{Call: _e.mock.On("ReopenTodo", ctx, id)}
+}
+
+func (_c *TodoRepository_ReopenTodo_Call) Run(run func(ctx context.Context, id string)) *TodoRepository_ReopenTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_ReopenTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoRepository_ReopenTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_ReopenTodo_Call) RunAndReturn(run func(context.Context, string) (models.Todo, error)) *TodoRepository_ReopenTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, todo
+func (_m *TodoRepository) Update(ctx context.Context, todo models.Todo) error {
+	ret := _m.Called(ctx, todo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Todo) error); ok {
+		r0 = rf(ctx, todo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type TodoRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todo models.Todo
+func (_e *TodoRepository_Expecter) Update(ctx interface{}, todo interface{}) *TodoRepository_Update_Call {
+	return &TodoRepository_Update_Call{Call: _e.mock.On("Update", ctx, todo)}
+}
+
+func (_c *TodoRepository_Update_Call) Run(run func(ctx context.Context, todo models.Todo)) *TodoRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Todo))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_Update_Call) Return(_a0 error) *TodoRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_Update_Call) RunAndReturn(run func(context.Context, models.Todo) error) *TodoRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAssignedTo provides a mock function with given fields: ctx, id, userID
+func (_m *TodoRepository) UpdateAssignedTo(ctx context.Context, id string, userID string) (models.Todo, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAssignedTo")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_UpdateAssignedTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAssignedTo'
+type TodoRepository_UpdateAssignedTo_Call struct {
+	*mock.Call
+}
+
+// UpdateAssignedTo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *TodoRepository_Expecter) UpdateAssignedTo(ctx interface{}, id interface{}, userID interface{}) *TodoRepository_UpdateAssignedTo_Call {
+	return &TodoRepository_UpdateAssignedTo_Call{Call: _e.mock.On("UpdateAssignedTo", ctx, id, userID)}
+}
+
+func (_c *TodoRepository_UpdateAssignedTo_Call) Run(run func(ctx context.Context, id string, userID string)) *TodoRepository_UpdateAssignedTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_UpdateAssignedTo_Call) Return(_a0 models.Todo, _a1 error) *TodoRepository_UpdateAssignedTo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_UpdateAssignedTo_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoRepository_UpdateAssignedTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePosition provides a mock function with given fields: ctx, id, position
+func (_m *TodoRepository) UpdatePosition(ctx context.Context, id string, position string) error {
+	ret := _m.Called(ctx, id, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_UpdatePosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePosition'
+type TodoRepository_UpdatePosition_Call struct {
+	*mock.Call
+}
+
+// UpdatePosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - position string
+func (_e *TodoRepository_Expecter) UpdatePosition(ctx interface{}, id interface{}, position interface{}) *TodoRepository_UpdatePosition_Call {
+	return &TodoRepository_UpdatePosition_Call{Call: _e.mock.On("UpdatePosition", ctx, id, position)}
+}
+
+func (_c *TodoRepository_UpdatePosition_Call) Run(run func(ctx context.Context, id string, position string)) *TodoRepository_UpdatePosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_UpdatePosition_Call) Return(_a0 error) *TodoRepository_UpdatePosition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_UpdatePosition_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepository_UpdatePosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTodoDescription provides a mock function with given fields: ctx, id, description
+func (_m *TodoRepository) UpdateTodoDescription(ctx context.Context, id string, description string) (models.Todo, error) {
+	ret := _m.Called(ctx, id, description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTodoDescription")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
+		return rf(ctx, id, description)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
+		r0 = rf(ctx, id, description)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, description)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_UpdateTodoDescription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodoDescription'
+type TodoRepository_UpdateTodoDescription_Call struct {
+	*mock.Call
+}
+
+// UpdateTodoDescription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - description string
+func (_e *TodoRepository_Expecter) UpdateTodoDescription(ctx interface{}, id interface{}, description interface{}) *TodoRepository_UpdateTodoDescription_Call {
+	return &TodoRepository_UpdateTodoDescription_Call{Call: _e.mock.On("UpdateTodoDescription", ctx, id, description)}
+}
+
+func (_c *TodoRepository_UpdateTodoDescription_Call) Run(run func(ctx context.Context, id string, description string)) *TodoRepository_UpdateTodoDescription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_UpdateTodoDescription_Call) Return(_a0 models.Todo, _a1 error) *TodoRepository_UpdateTodoDescription_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_UpdateTodoDescription_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoRepository_UpdateTodoDescription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTodoPriority provides a mock function with given fields: ctx, id, priority
+func (_m *TodoRepository) UpdateTodoPriority(ctx context.Context, id string, priority constants.PriorityLevel) (models.Todo, error) {
+	ret := _m.Called(ctx, id, priority)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTodoPriority")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, constants.PriorityLevel) (models.Todo, error)); ok {
+		return rf(ctx, id, priority)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, constants.PriorityLevel) models.Todo); ok {
+		r0 = rf(ctx, id, priority)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, constants.PriorityLevel) error); ok {
+		r1 = rf(ctx, id, priority)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_UpdateTodoPriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodoPriority'
+type TodoRepository_UpdateTodoPriority_Call struct {
+	*mock.Call
+}
+
+// UpdateTodoPriority is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - priority constants.PriorityLevel
+func (_e *TodoRepository_Expecter) UpdateTodoPriority(ctx interface{}, id interface{}, priority interface{}) *TodoRepository_UpdateTodoPriority_Call {
+	return &TodoRepository_UpdateTodoPriority_Call{Call: _e.mock.On("UpdateTodoPriority", ctx, id, priority)}
+}
+
+func (_c *TodoRepository_UpdateTodoPriority_Call) Run(run func(ctx context.Context, id string, priority constants.PriorityLevel)) *TodoRepository_UpdateTodoPriority_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(constants.PriorityLevel))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_UpdateTodoPriority_Call) Return(_a0 models.Todo, _a1 error) *TodoRepository_UpdateTodoPriority_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_UpdateTodoPriority_Call) RunAndReturn(run func(context.Context, string, constants.PriorityLevel) (models.Todo, error)) *TodoRepository_UpdateTodoPriority_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTodoStatus provides a mock function with given fields: ctx, id, statusID, completed
+func (_m *TodoRepository) UpdateTodoStatus(ctx context.Context, id string, statusID string, completed bool) (models.Todo, error) {
+	ret := _m.Called(ctx, id, statusID, completed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTodoStatus")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) (models.Todo, error)); ok {
+		return rf(ctx, id, statusID, completed)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) models.Todo); ok {
+		r0 = rf(ctx, id, statusID, completed)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = rf(ctx, id, statusID, completed)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_UpdateTodoStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodoStatus'
+type TodoRepository_UpdateTodoStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateTodoStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - statusID string
+//   - completed bool
+func (_e *TodoRepository_Expecter) UpdateTodoStatus(ctx interface{}, id interface{}, statusID interface{}, completed interface{}) *TodoRepository_UpdateTodoStatus_Call {
+	return &TodoRepository_UpdateTodoStatus_Call{Call: _e.mock.On("UpdateTodoStatus", ctx, id, statusID, completed)}
+}
+
+func (_c *TodoRepository_UpdateTodoStatus_Call) Run(run func(ctx context.Context, id string, statusID string, completed bool)) *TodoRepository_UpdateTodoStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_UpdateTodoStatus_Call) Return(_a0 models.Todo, _a1 error) *TodoRepository_UpdateTodoStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_UpdateTodoStatus_Call) RunAndReturn(run func(context.Context, string, string, bool) (models.Todo, error)) *TodoRepository_UpdateTodoStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -25,6 +25,161 @@ func (_m *TodoService) EXPECT() *TodoService_Expecter {
 	return &TodoService_Expecter{mock: &_m.Mock}
 }
 
+// AddDependency provides a mock function with given fields: ctx, todoID, blockedByID
+func (_m *TodoService) AddDependency(ctx context.Context, todoID string, blockedByID string) error {
+	ret := _m.Called(ctx, todoID, blockedByID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, todoID, blockedByID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoService_AddDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDependency'
+type TodoService_AddDependency_Call struct {
+	*mock.Call
+}
+
+// AddDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - blockedByID string
+func (_e *TodoService_Expecter) AddDependency(ctx interface{}, todoID interface{}, blockedByID interface{}) *TodoService_AddDependency_Call {
+	return &TodoService_AddDependency_Call{Call: _e.mock.On("AddDependency", ctx, todoID, blockedByID)}
+}
+
+func (_c *TodoService_AddDependency_Call) Run(run func(ctx context.Context, todoID string, blockedByID string)) *TodoService_AddDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_AddDependency_Call) Return(_a0 error) *TodoService_AddDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoService_AddDependency_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoService_AddDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpdateTodos provides a mock function with given fields: ctx, operation
+func (_m *TodoService) BulkUpdateTodos(ctx context.Context, operation models.BulkOperation) ([]models.Todo, error) {
+	ret := _m.Called(ctx, operation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateTodos")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.BulkOperation) ([]models.Todo, error)); ok {
+		return rf(ctx, operation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.BulkOperation) []models.Todo); ok {
+		r0 = rf(ctx, operation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.BulkOperation) error); ok {
+		r1 = rf(ctx, operation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_BulkUpdateTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateTodos'
+type TodoService_BulkUpdateTodos_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - operation models.BulkOperation
+func (_e *TodoService_Expecter) BulkUpdateTodos(ctx interface{}, operation interface{}) *TodoService_BulkUpdateTodos_Call {
+	return &TodoService_BulkUpdateTodos_Call{Call: _e.mock.On("BulkUpdateTodos", ctx, operation)}
+}
+
+func (_c *TodoService_BulkUpdateTodos_Call) Run(run func(ctx context.Context, operation models.BulkOperation)) *TodoService_BulkUpdateTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.BulkOperation))
+	})
+	return _c
+}
+
+func (_c *TodoService_BulkUpdateTodos_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_BulkUpdateTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_BulkUpdateTodos_Call) RunAndReturn(run func(context.Context, models.BulkOperation) ([]models.Todo, error)) *TodoService_BulkUpdateTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckVersion provides a mock function with given fields: ctx, id, version
+func (_m *TodoService) CheckVersion(ctx context.Context, id string, version int) error {
+	ret := _m.Called(ctx, id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, id, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoService_CheckVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckVersion'
+type TodoService_CheckVersion_Call struct {
+	*mock.Call
+}
+
+// CheckVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - version int
+func (_e *TodoService_Expecter) CheckVersion(ctx interface{}, id interface{}, version interface{}) *TodoService_CheckVersion_Call {
+	return &TodoService_CheckVersion_Call{Call: _e.mock.On("CheckVersion", ctx, id, version)}
+}
+
+func (_c *TodoService_CheckVersion_Call) Run(run func(ctx context.Context, id string, version int)) *TodoService_CheckVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *TodoService_CheckVersion_Call) Return(_a0 error) *TodoService_CheckVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoService_CheckVersion_Call) RunAndReturn(run func(context.Context, string, int) error) *TodoService_CheckVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CompleteTodo provides a mock function with given fields: ctx, id
 func (_m *TodoService) CompleteTodo(ctx context.Context, id string) (models.Todo, error) {
 	ret := _m.Called(ctx, id)
@@ -82,6 +237,63 @@ func (_c *TodoService_CompleteTodo_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CompleteTodoWithSubtasks provides a mock function with given fields: ctx, id
+func (_m *TodoService) CompleteTodoWithSubtasks(ctx context.Context, id string) (models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteTodoWithSubtasks")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_CompleteTodoWithSubtasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteTodoWithSubtasks'
+type TodoService_CompleteTodoWithSubtasks_Call struct {
+	*mock.Call
+}
+
+// CompleteTodoWithSubtasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoService_Expecter) CompleteTodoWithSubtasks(ctx interface{}, id interface{}) *TodoService_CompleteTodoWithSubtasks_Call {
+	return &TodoService_CompleteTodoWithSubtasks_Call{Call: _e.mock.On("CompleteTodoWithSubtasks", ctx, id)}
+}
+
+func (_c *TodoService_CompleteTodoWithSubtasks_Call) Run(run func(ctx context.Context, id string)) *TodoService_CompleteTodoWithSubtasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_CompleteTodoWithSubtasks_Call) Return(_a0 models.Todo, _a1 error) *TodoService_CompleteTodoWithSubtasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_CompleteTodoWithSubtasks_Call) RunAndReturn(run func(context.Context, string) (models.Todo, error)) *TodoService_CompleteTodoWithSubtasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTodo provides a mock function with given fields: ctx, todo
 func (_m *TodoService) CreateTodo(ctx context.Context, todo models.Todo) (string, error) {
 	ret := _m.Called(ctx, todo)
@@ -186,6 +398,72 @@ func (_c *TodoService_DeleteTodo_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// FilterTodos provides a mock function with given fields: ctx, filter
+func (_m *TodoService) FilterTodos(ctx context.Context, filter models.TodoFilter) ([]models.Todo, string, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterTodos")
+	}
+
+	var r0 []models.Todo
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.TodoFilter) ([]models.Todo, string, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.TodoFilter) []models.Todo); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.TodoFilter) string); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.TodoFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// TodoService_FilterTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterTodos'
+type TodoService_FilterTodos_Call struct {
+	*mock.Call
+}
+
+// FilterTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.TodoFilter
+func (_e *TodoService_Expecter) FilterTodos(ctx interface{}, filter interface{}) *TodoService_FilterTodos_Call {
+	return &TodoService_FilterTodos_Call{Call: _e.mock.On("FilterTodos", ctx, filter)}
+}
+
+func (_c *TodoService_FilterTodos_Call) Run(run func(ctx context.Context, filter models.TodoFilter)) *TodoService_FilterTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.TodoFilter))
+	})
+	return _c
+}
+
+func (_c *TodoService_FilterTodos_Call) Return(_a0 []models.Todo, _a1 string, _a2 error) *TodoService_FilterTodos_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *TodoService_FilterTodos_Call) RunAndReturn(run func(context.Context, models.TodoFilter) ([]models.Todo, string, error)) *TodoService_FilterTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllTodos provides a mock function with given fields: ctx
 func (_m *TodoService) GetAllTodos(ctx context.Context) ([]models.Todo, error) {
 	ret := _m.Called(ctx)
@@ -301,21 +579,21 @@ func (_c *TodoService_GetTodo_Call) RunAndReturn(run func(context.Context, strin
 	return _c
 }
 
-// ListTodosByListID provides a mock function with given fields: ctx, listID
-func (_m *TodoService) ListTodosByListID(ctx context.Context, listID string) ([]models.Todo, error) {
-	ret := _m.Called(ctx, listID)
+// ListBlockedBy provides a mock function with given fields: ctx, id
+func (_m *TodoService) ListBlockedBy(ctx context.Context, id string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTodosByListID")
+		panic("no return value specified for ListBlockedBy")
 	}
 
 	var r0 []models.Todo
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
-		return rf(ctx, listID)
+		return rf(ctx, id)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
-		r0 = rf(ctx, listID)
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Todo)
@@ -323,7 +601,7 @@ func (_m *TodoService) ListTodosByListID(ctx context.Context, listID string) ([]
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, listID)
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -331,31 +609,664 @@ func (_m *TodoService) ListTodosByListID(ctx context.Context, listID string) ([]
 	return r0, r1
 }
 
-// TodoService_ListTodosByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodosByListID'
-type TodoService_ListTodosByListID_Call struct {
+// TodoService_ListBlockedBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBlockedBy'
+type TodoService_ListBlockedBy_Call struct {
 	*mock.Call
 }
 
-// ListTodosByListID is a helper method to define mock.On call
+// ListBlockedBy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - listID string
-func (_e *TodoService_Expecter) ListTodosByListID(ctx interface{}, listID interface{}) *TodoService_ListTodosByListID_Call {
-	return &TodoService_ListTodosByListID_Call{Call: _e.mock.On("ListTodosByListID", ctx, listID)}
+//   - id string
+func (_e *TodoService_Expecter) ListBlockedBy(ctx interface{}, id interface{}) *TodoService_ListBlockedBy_Call {
+	return &TodoService_ListBlockedBy_Call{Call: _e.mock.On("ListBlockedBy", ctx, id)}
 }
 
-func (_c *TodoService_ListTodosByListID_Call) Run(run func(ctx context.Context, listID string)) *TodoService_ListTodosByListID_Call {
+func (_c *TodoService_ListBlockedBy_Call) Run(run func(ctx context.Context, id string)) *TodoService_ListBlockedBy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *TodoService_ListTodosByListID_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListTodosByListID_Call {
+func (_c *TodoService_ListBlockedBy_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListBlockedBy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoService_ListTodosByListID_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoService_ListTodosByListID_Call {
+func (_c *TodoService_ListBlockedBy_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoService_ListBlockedBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBlocking provides a mock function with given fields: ctx, id
+func (_m *TodoService) ListBlocking(ctx context.Context, id string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBlocking")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_ListBlocking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBlocking'
+type TodoService_ListBlocking_Call struct {
+	*mock.Call
+}
+
+// ListBlocking is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoService_Expecter) ListBlocking(ctx interface{}, id interface{}) *TodoService_ListBlocking_Call {
+	return &TodoService_ListBlocking_Call{Call: _e.mock.On("ListBlocking", ctx, id)}
+}
+
+func (_c *TodoService_ListBlocking_Call) Run(run func(ctx context.Context, id string)) *TodoService_ListBlocking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_ListBlocking_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListBlocking_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_ListBlocking_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoService_ListBlocking_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSubtasks provides a mock function with given fields: ctx, id
+func (_m *TodoService) ListSubtasks(ctx context.Context, id string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubtasks")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_ListSubtasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSubtasks'
+type TodoService_ListSubtasks_Call struct {
+	*mock.Call
+}
+
+// ListSubtasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoService_Expecter) ListSubtasks(ctx interface{}, id interface{}) *TodoService_ListSubtasks_Call {
+	return &TodoService_ListSubtasks_Call{Call: _e.mock.On("ListSubtasks", ctx, id)}
+}
+
+func (_c *TodoService_ListSubtasks_Call) Run(run func(ctx context.Context, id string)) *TodoService_ListSubtasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_ListSubtasks_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListSubtasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_ListSubtasks_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoService_ListSubtasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTodosByListID provides a mock function with given fields: ctx, listID
+func (_m *TodoService) ListTodosByListID(ctx context.Context, listID string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTodosByListID")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_ListTodosByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodosByListID'
+type TodoService_ListTodosByListID_Call struct {
+	*mock.Call
+}
+
+// ListTodosByListID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *TodoService_Expecter) ListTodosByListID(ctx interface{}, listID interface{}) *TodoService_ListTodosByListID_Call {
+	return &TodoService_ListTodosByListID_Call{Call: _e.mock.On("ListTodosByListID", ctx, listID)}
+}
+
+func (_c *TodoService_ListTodosByListID_Call) Run(run func(ctx context.Context, listID string)) *TodoService_ListTodosByListID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_ListTodosByListID_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListTodosByListID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_ListTodosByListID_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoService_ListTodosByListID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveTodoToList provides a mock function with given fields: ctx, id, listID
+func (_m *TodoService) MoveTodoToList(ctx context.Context, id string, listID string) (models.Todo, error) {
+	ret := _m.Called(ctx, id, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTodoToList")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
+		return rf(ctx, id, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
+		r0 = rf(ctx, id, listID)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_MoveTodoToList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTodoToList'
+type TodoService_MoveTodoToList_Call struct {
+	*mock.Call
+}
+
+// MoveTodoToList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - listID string
+func (_e *TodoService_Expecter) MoveTodoToList(ctx interface{}, id interface{}, listID interface{}) *TodoService_MoveTodoToList_Call {
+	return &TodoService_MoveTodoToList_Call{Call: _e.mock.On("MoveTodoToList", ctx, id, listID)}
+}
+
+func (_c *TodoService_MoveTodoToList_Call) Run(run func(ctx context.Context, id string, listID string)) *TodoService_MoveTodoToList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_MoveTodoToList_Call) Return(_a0 models.Todo, _a1 error) *TodoService_MoveTodoToList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_MoveTodoToList_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoService_MoveTodoToList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchTodo provides a mock function with given fields: ctx, id, patch
+func (_m *TodoService) PatchTodo(ctx context.Context, id string, patch []byte) (models.Todo, error) {
+	ret := _m.Called(ctx, id, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchTodo")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) (models.Todo, error)); ok {
+		return rf(ctx, id, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) models.Todo); ok {
+		r0 = rf(ctx, id, patch)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, id, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_PatchTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchTodo'
+type TodoService_PatchTodo_Call struct {
+	*mock.Call
+}
+
+// PatchTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - patch []byte
+func (_e *TodoService_Expecter) PatchTodo(ctx interface{}, id interface{}, patch interface{}) *TodoService_PatchTodo_Call {
+	return &TodoService_PatchTodo_Call{Call: _e.mock.On("PatchTodo", ctx, id, patch)}
+}
+
+func (_c *TodoService_PatchTodo_Call) Run(run func(ctx context.Context, id string, patch []byte)) *TodoService_PatchTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *TodoService_PatchTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_PatchTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_PatchTodo_Call) RunAndReturn(run func(context.Context, string, []byte) (models.Todo, error)) *TodoService_PatchTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QuickAddTodo provides a mock function with given fields: ctx, listID, text
+func (_m *TodoService) QuickAddTodo(ctx context.Context, listID string, text string) (models.Todo, error) {
+	ret := _m.Called(ctx, listID, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuickAddTodo")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
+		return rf(ctx, listID, text)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
+		r0 = rf(ctx, listID, text)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, listID, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_QuickAddTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuickAddTodo'
+type TodoService_QuickAddTodo_Call struct {
+	*mock.Call
+}
+
+// QuickAddTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - text string
+func (_e *TodoService_Expecter) QuickAddTodo(ctx interface{}, listID interface{}, text interface{}) *TodoService_QuickAddTodo_Call {
+	return &TodoService_QuickAddTodo_Call{Call: _e.mock.On("QuickAddTodo", ctx, listID, text)}
+}
+
+func (_c *TodoService_QuickAddTodo_Call) Run(run func(ctx context.Context, listID string, text string)) *TodoService_QuickAddTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_QuickAddTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_QuickAddTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_QuickAddTodo_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoService_QuickAddTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveDependency provides a mock function with given fields: ctx, todoID, blockedByID
+func (_m *TodoService) RemoveDependency(ctx context.Context, todoID string, blockedByID string) error {
+	ret := _m.Called(ctx, todoID, blockedByID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, todoID, blockedByID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoService_RemoveDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDependency'
+type TodoService_RemoveDependency_Call struct {
+	*mock.Call
+}
+
+// RemoveDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todoID string
+//   - blockedByID string
+func (_e *TodoService_Expecter) RemoveDependency(ctx interface{}, todoID interface{}, blockedByID interface{}) *TodoService_RemoveDependency_Call {
+	return &TodoService_RemoveDependency_Call{Call: _e.mock.On("RemoveDependency", ctx, todoID, blockedByID)}
+}
+
+func (_c *TodoService_RemoveDependency_Call) Run(run func(ctx context.Context, todoID string, blockedByID string)) *TodoService_RemoveDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_RemoveDependency_Call) Return(_a0 error) *TodoService_RemoveDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoService_RemoveDependency_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoService_RemoveDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenTodo provides a mock function with given fields: ctx, id
+func (_m *TodoService) ReopenTodo(ctx context.Context, id string) (models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenTodo")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_ReopenTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenTodo'
+type TodoService_ReopenTodo_Call struct {
+	*mock.Call
+}
+
+// ReopenTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoService_Expecter) ReopenTodo(ctx interface{}, id interface{}) *TodoService_ReopenTodo_Call {
+	return &TodoService_ReopenTodo_Call{Call: _e.mock.On("ReopenTodo", ctx, id)}
+}
+
+func (_c *TodoService_ReopenTodo_Call) Run(run func(ctx context.Context, id string)) *TodoService_ReopenTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_ReopenTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_ReopenTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_ReopenTodo_Call) RunAndReturn(run func(context.Context, string) (models.Todo, error)) *TodoService_ReopenTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderTodo provides a mock function with given fields: ctx, id, beforeID, afterID
+func (_m *TodoService) ReorderTodo(ctx context.Context, id string, beforeID string, afterID string) (models.Todo, error) {
+	ret := _m.Called(ctx, id, beforeID, afterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderTodo")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (models.Todo, error)); ok {
+		return rf(ctx, id, beforeID, afterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) models.Todo); ok {
+		r0 = rf(ctx, id, beforeID, afterID)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, id, beforeID, afterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_ReorderTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderTodo'
+type TodoService_ReorderTodo_Call struct {
+	*mock.Call
+}
+
+// ReorderTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - beforeID string
+//   - afterID string
+func (_e *TodoService_Expecter) ReorderTodo(ctx interface{}, id interface{}, beforeID interface{}, afterID interface{}) *TodoService_ReorderTodo_Call {
+	return &TodoService_ReorderTodo_Call{Call: _e.mock.On("ReorderTodo", ctx, id, beforeID, afterID)}
+}
+
+func (_c *TodoService_ReorderTodo_Call) Run(run func(ctx context.Context, id string, beforeID string, afterID string)) *TodoService_ReorderTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_ReorderTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_ReorderTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_ReorderTodo_Call) RunAndReturn(run func(context.Context, string, string, string) (models.Todo, error)) *TodoService_ReorderTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SmartView provides a mock function with given fields: ctx, userID, view, timeZone, days
+func (_m *TodoService) SmartView(ctx context.Context, userID string, view constants.SmartView, timeZone string, days int) (models.SmartView, error) {
+	ret := _m.Called(ctx, userID, view, timeZone, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SmartView")
+	}
+
+	var r0 models.SmartView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, constants.SmartView, string, int) (models.SmartView, error)); ok {
+		return rf(ctx, userID, view, timeZone, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, constants.SmartView, string, int) models.SmartView); ok {
+		r0 = rf(ctx, userID, view, timeZone, days)
+	} else {
+		r0 = ret.Get(0).(models.SmartView)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, constants.SmartView, string, int) error); ok {
+		r1 = rf(ctx, userID, view, timeZone, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_SmartView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SmartView'
+type TodoService_SmartView_Call struct {
+	*mock.Call
+}
+
+// SmartView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - view constants.SmartView
+//   - timeZone string
+//   - days int
+func (_e *TodoService_Expecter) SmartView(ctx interface{}, userID interface{}, view interface{}, timeZone interface{}, days interface{}) *TodoService_SmartView_Call {
+	return &TodoService_SmartView_Call{Call: _e.mock.On("SmartView", ctx, userID, view, timeZone, days)}
+}
+
+func (_c *TodoService_SmartView_Call) Run(run func(ctx context.Context, userID string, view constants.SmartView, timeZone string, days int)) *TodoService_SmartView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(constants.SmartView), args[3].(string), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *TodoService_SmartView_Call) Return(_a0 models.SmartView, _a1 error) *TodoService_SmartView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_SmartView_Call) RunAndReturn(run func(context.Context, string, constants.SmartView, string, int) (models.SmartView, error)) *TodoService_SmartView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionTodo provides a mock function with given fields: ctx, id, statusID
+func (_m *TodoService) TransitionTodo(ctx context.Context, id string, statusID string) (models.Todo, error) {
+	ret := _m.Called(ctx, id, statusID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionTodo")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
+		return rf(ctx, id, statusID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
+		r0 = rf(ctx, id, statusID)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, statusID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_TransitionTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionTodo'
+type TodoService_TransitionTodo_Call struct {
+	*mock.Call
+}
+
+// TransitionTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - statusID string
+func (_e *TodoService_Expecter) TransitionTodo(ctx interface{}, id interface{}, statusID interface{}) *TodoService_TransitionTodo_Call {
+	return &TodoService_TransitionTodo_Call{Call: _e.mock.On("TransitionTodo", ctx, id, statusID)}
+}
+
+func (_c *TodoService_TransitionTodo_Call) Run(run func(ctx context.Context, id string, statusID string)) *TodoService_TransitionTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_TransitionTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_TransitionTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_TransitionTodo_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoService_TransitionTodo_Call {
 	_c.Call.Return(run)
 	return _c
 }
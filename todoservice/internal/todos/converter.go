@@ -2,6 +2,7 @@ package todos
 
 import (
 	"database/sql"
+	"encoding/json"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	"time"
@@ -21,29 +22,48 @@ func (c *Converter) ConvertTodoToModel(entity Entity) models.Todo {
 		Description: entity.Description,
 		Tags:        pkg.JSONRawMessageFromNullableString(entity.Tags),
 		Completed:   entity.Completed,
+		CompletedAt: convertNullTimeToTime(entity.CompletedAt),
 		DueDate:     convertNullTimeToTime(entity.DueDate),
 		StartDate:   convertNullTimeToTime(entity.StartDate),
 		Priority:    entity.Priority,
 		CreatedAt:   entity.CreatedAt,
 		UpdatedAt:   entity.UpdatedAt,
 		AssignedTo:  entity.AssignedTo,
+		ParentID:    entity.ParentID,
+		Subtasks: models.SubtaskProgress{
+			Total:     entity.SubtasksTotal,
+			Completed: entity.SubtasksCompleted,
+		},
+		Recurrence:      convertNullStringToRecurrence(entity.Recurrence),
+		Position:        entity.Position,
+		EstimateMinutes: entity.EstimateMinutes,
+		CustomFields:    convertNullStringToCustomFields(entity.CustomFields),
+		StatusID:        entity.StatusID,
+		Version:         entity.Version,
 	}
 }
 
 func (c *Converter) ConvertTodoToEntity(todo models.Todo) Entity {
 	return Entity{
-		ID:          todo.ID,
-		ListID:      todo.ListID,
-		Title:       todo.Title,
-		Description: todo.Description,
-		Tags:        pkg.NewNullableStringFromJSONRawMessage(todo.Tags),
-		Completed:   todo.Completed,
-		DueDate:     convertTimeToNullTime(*todo.DueDate),
-		StartDate:   convertTimeToNullTime(*todo.StartDate),
-		Priority:    todo.Priority,
-		CreatedAt:   todo.CreatedAt,
-		UpdatedAt:   todo.UpdatedAt,
-		AssignedTo:  todo.AssignedTo,
+		ID:              todo.ID,
+		ListID:          todo.ListID,
+		Title:           todo.Title,
+		Description:     todo.Description,
+		Tags:            pkg.NewNullableStringFromJSONRawMessage(todo.Tags),
+		Completed:       todo.Completed,
+		DueDate:         convertTimeToNullTime(todo.DueDate),
+		StartDate:       convertTimeToNullTime(todo.StartDate),
+		Priority:        todo.Priority,
+		CreatedAt:       todo.CreatedAt,
+		UpdatedAt:       todo.UpdatedAt,
+		AssignedTo:      todo.AssignedTo,
+		ParentID:        todo.ParentID,
+		Recurrence:      convertRecurrenceToNullString(todo.Recurrence),
+		Position:        todo.Position,
+		EstimateMinutes: todo.EstimateMinutes,
+		CustomFields:    convertCustomFieldsToNullString(todo.CustomFields),
+		StatusID:        todo.StatusID,
+		Version:         todo.Version,
 	}
 }
 
@@ -55,15 +75,65 @@ func convertNullTimeToTime(nullTime sql.NullTime) *time.Time {
 	}
 }
 
-func convertTimeToNullTime(t time.Time) sql.NullTime {
-	if t.IsZero() {
+func convertTimeToNullTime(t *time.Time) sql.NullTime {
+	if t == nil || t.IsZero() {
 		return sql.NullTime{
 			Time:  time.Time{},
 			Valid: false,
 		}
 	}
 	return sql.NullTime{
-		Time:  t,
+		Time:  *t,
 		Valid: true,
 	}
 }
+
+func convertNullStringToRecurrence(nullString sql.NullString) *models.Recurrence {
+	if !nullString.Valid {
+		return nil
+	}
+	var recurrence models.Recurrence
+	if err := json.Unmarshal([]byte(nullString.String), &recurrence); err != nil {
+		return nil
+	}
+	return &recurrence
+}
+
+func convertRecurrenceToNullString(recurrence *models.Recurrence) sql.NullString {
+	if recurrence == nil {
+		return sql.NullString{}
+	}
+	data, err := json.Marshal(recurrence)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{
+		String: string(data),
+		Valid:  true,
+	}
+}
+
+func convertNullStringToCustomFields(nullString sql.NullString) map[string]interface{} {
+	if !nullString.Valid {
+		return nil
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(nullString.String), &values); err != nil {
+		return nil
+	}
+	return values
+}
+
+func convertCustomFieldsToNullString(values map[string]interface{}) sql.NullString {
+	if len(values) == 0 {
+		return sql.NullString{}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{
+		String: string(data),
+		Valid:  true,
+	}
+}
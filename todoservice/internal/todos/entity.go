@@ -7,16 +7,26 @@ import (
 )
 
 type Entity struct {
-	ID          string                  `db:"id"`
-	ListID      string                  `db:"list_id"`
-	Title       string                  `db:"title"`
-	Description string                  `db:"description"`
-	Tags        sql.NullString          `db:"tags"`
-	Completed   bool                    `db:"completed"`
-	DueDate     sql.NullTime            `db:"due_date"`
-	StartDate   sql.NullTime            `db:"start_date"`
-	Priority    constants.PriorityLevel `db:"priority"`
-	CreatedAt   time.Time               `db:"created_at"`
-	UpdatedAt   time.Time               `db:"updated_at"`
-	AssignedTo  *string                 `db:"assigned_to"`
+	ID                string                  `db:"id"`
+	ListID            string                  `db:"list_id"`
+	Title             string                  `db:"title"`
+	Description       string                  `db:"description"`
+	Tags              sql.NullString          `db:"tags"`
+	Completed         bool                    `db:"completed"`
+	DueDate           sql.NullTime            `db:"due_date"`
+	StartDate         sql.NullTime            `db:"start_date"`
+	Priority          constants.PriorityLevel `db:"priority"`
+	CreatedAt         time.Time               `db:"created_at"`
+	UpdatedAt         time.Time               `db:"updated_at"`
+	AssignedTo        *string                 `db:"assigned_to"`
+	ParentID          *string                 `db:"parent_id"`
+	SubtasksTotal     int                     `db:"subtasks_total"`
+	SubtasksCompleted int                     `db:"subtasks_completed"`
+	Recurrence        sql.NullString          `db:"recurrence"`
+	CompletedAt       sql.NullTime            `db:"completed_at"`
+	Position          string                  `db:"position"`
+	EstimateMinutes   *int                    `db:"estimate_minutes"`
+	CustomFields      sql.NullString          `db:"custom_fields"`
+	StatusID          *string                 `db:"status_id"`
+	Version           int                     `db:"version"`
 }
@@ -0,0 +1,140 @@
+package todos
+
+import (
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type sortColumn struct {
+	expression string
+	cast       string
+}
+
+// sortColumns maps the sort keys to the expressions the todos are ordered by.
+// Todos without a due date sort after all others and priorities sort from high
+// to low.
+var sortColumns = map[constants.SortKey]sortColumn{
+	constants.SortPosition:  {expression: `position COLLATE "C"`, cast: "text"},
+	constants.SortCreatedAt: {expression: "created_at", cast: "timestamp"},
+	constants.SortDueDate:   {expression: "COALESCE(due_date, 'infinity')", cast: "timestamp"},
+	constants.SortPriority:  {expression: "CASE priority WHEN 'high' THEN 0 WHEN 'medium' THEN 1 ELSE 2 END", cast: "int"},
+	constants.SortTitle:     {expression: "title", cast: "text"},
+}
+
+var priorityRanks = map[constants.PriorityLevel]int{
+	constants.PriorityHigh:   0,
+	constants.PriorityMedium: 1,
+	constants.PriorityLow:    2,
+}
+
+// buildFilter returns the WHERE and ORDER BY clauses for the filter together
+// with their arguments. Trashed rows never match. The filter's sort key must be
+// set.
+func buildFilter(filter models.TodoFilter) (string, string, []interface{}) {
+	conditions := []string{"deleted_at IS NULL"}
+	var args []interface{}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.ListID != "" {
+		conditions = append(conditions, "list_id = "+arg(filter.ListID))
+	}
+	if filter.AccessibleBy != "" {
+		conditions = append(conditions, "list_id IN (SELECT list_id FROM list_access WHERE user_id = "+
+			arg(filter.AccessibleBy)+" AND status IN ('owner', 'accepted'))")
+	}
+	if filter.Completed != nil {
+		conditions = append(conditions, "completed = "+arg(*filter.Completed))
+	}
+	if filter.Priority != "" {
+		conditions = append(conditions, "priority = "+arg(filter.Priority))
+	}
+	if filter.Tag != "" {
+		conditions = append(conditions, "id IN (SELECT tt.todo_id FROM todo_tags tt JOIN tags g ON g.id = tt.tag_id WHERE lower(g.name) = lower("+
+			arg(filter.Tag)+"))")
+	}
+	if filter.TagID != "" {
+		conditions = append(conditions, "id IN (SELECT todo_id FROM todo_tags WHERE tag_id = "+arg(filter.TagID)+")")
+	}
+	if filter.StatusID != "" {
+		conditions = append(conditions, "status_id = "+arg(filter.StatusID))
+	}
+	if filter.AssignedTo != "" {
+		conditions = append(conditions, "assigned_to = "+arg(filter.AssignedTo))
+	}
+	if filter.DueBefore != nil {
+		conditions = append(conditions, "due_date < "+arg(*filter.DueBefore))
+	}
+	if filter.DueAfter != nil {
+		conditions = append(conditions, "due_date > "+arg(*filter.DueAfter))
+	}
+	if filter.DueFrom != nil {
+		conditions = append(conditions, "due_date >= "+arg(*filter.DueFrom))
+	}
+	if filter.HasDueDate != nil {
+		if *filter.HasDueDate {
+			conditions = append(conditions, "due_date IS NOT NULL")
+		} else {
+			conditions = append(conditions, "due_date IS NULL")
+		}
+	}
+	fieldIDs := make([]string, 0, len(filter.CustomFields))
+	for id := range filter.CustomFields {
+		fieldIDs = append(fieldIDs, id)
+	}
+	sort.Strings(fieldIDs)
+	for _, id := range fieldIDs {
+		conditions = append(conditions, "custom_fields ->> "+arg(id)+"::text = "+arg(filter.CustomFields[id]))
+	}
+	if filter.Condition != nil {
+		conditions = append(conditions, "("+filter.Condition.SQL(arg)+")")
+	}
+
+	column := sortColumns[filter.Sort]
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+	if filter.After != nil {
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s (%s::%s, %s::uuid)",
+			column.expression, comparison, arg(filter.After.Value), column.cast, arg(filter.After.ID)))
+	}
+
+	where := "WHERE " + strings.Join(conditions, " AND ")
+	orderBy := fmt.Sprintf("ORDER BY %s %s, id %s", column.expression, direction, direction)
+	if filter.Limit > 0 {
+		orderBy += " LIMIT " + arg(filter.Limit)
+	}
+	return where, orderBy, args
+}
+
+// sortValue returns the value of the todo's sort key the way it is stored in a
+// cursor.
+func sortValue(todo models.Todo, key constants.SortKey) string {
+	switch key {
+	case constants.SortPosition:
+		return todo.Position
+	case constants.SortDueDate:
+		if todo.DueDate == nil {
+			return "infinity"
+		}
+		return todo.DueDate.Format(time.RFC3339Nano)
+	case constants.SortPriority:
+		rank, ok := priorityRanks[todo.Priority]
+		if !ok {
+			rank = priorityRanks[constants.PriorityLow]
+		}
+		return strconv.Itoa(rank)
+	case constants.SortTitle:
+		return todo.Title
+	default:
+		return todo.CreatedAt.Format(time.RFC3339Nano)
+	}
+}
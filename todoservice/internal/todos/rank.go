@@ -0,0 +1,39 @@
+package todos
+
+import "strings"
+
+// rankAlphabet lists the rank digits in ascending byte order, so ranks compare
+// correctly with a plain (COLLATE "C") string comparison.
+const rankAlphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// rankBetween returns a rank that sorts strictly after prev and strictly
+// before next. An empty prev means the start of the list and an empty next
+// means the end, so existing todos never have to be renumbered. Generated
+// ranks never end in the lowest digit, which keeps room in front of them.
+func rankBetween(prev, next string) string {
+	var rank strings.Builder
+	bounded := next != ""
+
+	for i := 0; ; i++ {
+		low := 0
+		if i < len(prev) {
+			low = strings.IndexByte(rankAlphabet, prev[i])
+		}
+		high := len(rankAlphabet)
+		if bounded && i < len(next) {
+			high = strings.IndexByte(rankAlphabet, next[i])
+		}
+
+		switch {
+		case !bounded && low+1 < high:
+			rank.WriteByte(rankAlphabet[low+1])
+			return rank.String()
+		case high-low > 1:
+			rank.WriteByte(rankAlphabet[(low+high)/2])
+			return rank.String()
+		case high-low == 1:
+			bounded = false
+		}
+		rank.WriteByte(rankAlphabet[low])
+	}
+}
@@ -0,0 +1,104 @@
+package todos
+
+import (
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"time"
+)
+
+const oneDay = 24 * time.Hour
+
+func validateRecurrence(rule *models.Recurrence) error {
+	if rule == nil {
+		return nil
+	}
+	if rule.Interval < 0 {
+		return fmt.Errorf("recurrence interval must not be negative")
+	}
+	switch rule.Frequency {
+	case constants.RecurrenceDaily, constants.RecurrenceAfterCompletion:
+		return nil
+	case constants.RecurrenceWeekly:
+		for _, day := range rule.Weekdays {
+			if day < time.Sunday || day > time.Saturday {
+				return fmt.Errorf("invalid recurrence weekday: %d", day)
+			}
+		}
+		return nil
+	case constants.RecurrenceMonthly:
+		if rule.DayOfMonth < 0 || rule.DayOfMonth > 31 {
+			return fmt.Errorf("invalid recurrence day of month: %d", rule.DayOfMonth)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid recurrence frequency: %v", rule.Frequency)
+	}
+}
+
+// nextOccurrence returns the date of the occurrence following anchor, which is
+// the due (or start) date of the occurrence being completed. Rules that repeat
+// after completion are counted from completedAt instead.
+func nextOccurrence(rule models.Recurrence, anchor, completedAt time.Time) time.Time {
+	interval := rule.Interval
+	if interval <= 0 {
+		interval = 1
+	}
+
+	switch rule.Frequency {
+	case constants.RecurrenceAfterCompletion:
+		return completedAt.AddDate(0, 0, interval)
+	case constants.RecurrenceWeekly:
+		return nextWeekly(anchor, interval, rule.Weekdays)
+	case constants.RecurrenceMonthly:
+		day := rule.DayOfMonth
+		if day == 0 {
+			day = anchor.Day()
+		}
+		return nextMonthly(anchor, interval, day)
+	default:
+		return anchor.AddDate(0, 0, interval)
+	}
+}
+
+func nextWeekly(anchor time.Time, interval int, weekdays []time.Weekday) time.Time {
+	if len(weekdays) == 0 {
+		return anchor.AddDate(0, 0, 7*interval)
+	}
+	days := make(map[time.Weekday]bool, len(weekdays))
+	for _, day := range weekdays {
+		days[day] = true
+	}
+
+	anchorWeek := startOfWeek(anchor)
+	for candidate := anchor.AddDate(0, 0, 1); ; candidate = candidate.AddDate(0, 0, 1) {
+		weeks := int(startOfWeek(candidate).Sub(anchorWeek).Round(oneDay) / (7 * oneDay))
+		if weeks%interval == 0 && days[candidate.Weekday()] {
+			return candidate
+		}
+	}
+}
+
+func nextMonthly(anchor time.Time, interval, day int) time.Time {
+	year, month, _ := anchor.Date()
+	candidate := monthDay(year, month, day, anchor)
+	if candidate.After(anchor) && interval == 1 {
+		return candidate
+	}
+	return monthDay(year, month+time.Month(interval), day, anchor)
+}
+
+// monthDay returns the given day of the month, clamped to the last day for
+// shorter months, keeping the clock time of reference.
+func monthDay(year int, month time.Month, day int, reference time.Time) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, reference.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month, day, reference.Hour(), reference.Minute(), reference.Second(), 0, reference.Location())
+}
+
+func startOfWeek(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day-int(t.Weekday()), 0, 0, 0, 0, t.Location())
+}
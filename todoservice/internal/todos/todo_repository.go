@@ -18,13 +18,30 @@ type TodoRepository interface {
 	Get(ctx context.Context, id string) (models.Todo, error)
 	GetAll(ctx context.Context) ([]models.Todo, error)
 	GetAllByListID(ctx context.Context, listID string) ([]models.Todo, error)
+	GetFiltered(ctx context.Context, filter models.TodoFilter) ([]models.Todo, error)
 	Delete(ctx context.Context, id string) error
 	Create(ctx context.Context, list models.Todo) (string, error)
 	CompleteTodo(ctx context.Context, id string) (models.Todo, error)
+	ReopenTodo(ctx context.Context, id string) (models.Todo, error)
 	UpdateTodoTitle(ctx context.Context, id string, title string) (models.Todo, error)
 	UpdateTodoDescription(ctx context.Context, id string, description string) (models.Todo, error)
 	UpdateTodoPriority(ctx context.Context, id string, priority constants.PriorityLevel) (models.Todo, error)
 	UpdateAssignedTo(ctx context.Context, id string, userID string) (models.Todo, error)
+	UpdateTodoStatus(ctx context.Context, id string, statusID string, completed bool) (models.Todo, error)
+	GetSubtasks(ctx context.Context, parentID string) ([]models.Todo, error)
+	AddDependency(ctx context.Context, todoID string, blockedByID string) error
+	RemoveDependency(ctx context.Context, todoID string, blockedByID string) error
+	GetBlockedBy(ctx context.Context, todoID string) ([]models.Todo, error)
+	GetBlocking(ctx context.Context, todoID string) ([]models.Todo, error)
+	DependsOn(ctx context.Context, todoID string, blockedByID string) (bool, error)
+	GetLastPosition(ctx context.Context, listID string) (string, error)
+	GetPositionBefore(ctx context.Context, listID string, position string) (string, error)
+	GetPositionAfter(ctx context.Context, listID string, position string) (string, error)
+	UpdatePosition(ctx context.Context, id string, position string) error
+	MoveToList(ctx context.Context, id string, listID string, position string) error
+	FindCollaborator(ctx context.Context, listID string, handle string) (string, error)
+	IsCollaborator(ctx context.Context, listID string, userID string) (bool, error)
+	LockVersion(ctx context.Context, id string) (int, error)
 }
 
 type SQLXTodoRepository struct {
@@ -58,8 +75,8 @@ func (r *SQLXTodoRepository) Create(ctx context.Context, todo models.Todo) (stri
 	}
 
 	insertTodoQuery := `
-		INSERT INTO todos (id, title, description, list_id, completed, tags, priority, due_date, start_date, assigned_to, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		INSERT INTO todos (id, title, description, list_id, completed, priority, due_date, start_date, assigned_to, parent_id, recurrence, position, estimate_minutes, custom_fields, created_at, updated_at, status_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, list_status_for($4, $17, CASE WHEN $5 THEN 'done' ELSE 'open' END))
 		RETURNING id
 	`
 
@@ -70,13 +87,18 @@ func (r *SQLXTodoRepository) Create(ctx context.Context, todo models.Todo) (stri
 		entity.Description,
 		entity.ListID,
 		entity.Completed,
-		entity.Tags,
 		entity.Priority,
 		entity.DueDate,
 		entity.StartDate,
-		pkg.NullIfEmpty(*entity.AssignedTo),
+		assignee(entity),
+		entity.ParentID,
+		entity.Recurrence,
+		entity.Position,
+		entity.EstimateMinutes,
+		entity.CustomFields,
 		entity.CreatedAt,
 		entity.UpdatedAt,
+		entity.StatusID,
 	).Scan(&id)
 	log.C(ctx).Debugf("created todo with ID: %v", id)
 	if err != nil {
@@ -95,9 +117,11 @@ func (r *SQLXTodoRepository) Get(ctx context.Context, id string) (models.Todo, e
 	}
 
 	query := `
-		SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at, assigned_to
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names(id) AS tags, created_at, updated_at, assigned_to, parent_id, recurrence, completed_at, position, estimate_minutes, custom_fields, status_id, version,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL) AS subtasks_total,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL AND s.completed) AS subtasks_completed
 		FROM todos
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 `
 	var entity Entity
 	err = tx.GetContext(ctx, &entity, query, id)
@@ -127,8 +151,10 @@ func (r *SQLXTodoRepository) Update(ctx context.Context, todo models.Todo) error
 	updateTodoQuery := `
 		UPDATE todos
 		SET title = $1, description = $2, 
-		    priority = $3, due_date = $4, start_date = $5, completed = $6, tags = $7, assigned_to = $8
-		WHERE id = $9
+		    priority = $3, due_date = $4, start_date = $5, completed = $6, assigned_to = $7, recurrence = $8,
+		    estimate_minutes = $9, custom_fields = $10, completed_at = CASE WHEN $6 THEN COALESCE(completed_at, NOW()) END,
+		    status_id = list_status_for(list_id, COALESCE($12, status_id), CASE WHEN $6 THEN 'done' ELSE 'open' END)
+		WHERE id = $11 AND deleted_at IS NULL
 	`
 
 	_, err = tx.ExecContext(ctx, updateTodoQuery,
@@ -138,9 +164,12 @@ func (r *SQLXTodoRepository) Update(ctx context.Context, todo models.Todo) error
 		entity.DueDate,
 		entity.StartDate,
 		entity.Completed,
-		entity.Tags,
-		pkg.NullIfEmpty(*entity.AssignedTo),
+		assignee(entity),
+		entity.Recurrence,
+		entity.EstimateMinutes,
+		entity.CustomFields,
 		entity.ID,
+		entity.StatusID,
 	)
 	if err != nil {
 		log.C(ctx).Errorf("failed to update todo: %v", err)
@@ -156,7 +185,16 @@ func (r *SQLXTodoRepository) Delete(ctx context.Context, id string) error {
 		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
 		return err
 	}
-	deleteQuery := `DELETE FROM todos WHERE id = $1`
+	deleteQuery := `
+		WITH RECURSIVE trashed AS (
+			SELECT id FROM todos WHERE id = $1 AND deleted_at IS NULL
+			UNION ALL
+			SELECT t.id FROM todos t JOIN trashed d ON t.parent_id = d.id WHERE t.deleted_at IS NULL
+		)
+		UPDATE todos
+		SET deleted_at = NOW()
+		WHERE id IN (SELECT id FROM trashed)
+	`
 	_, err = tx.ExecContext(ctx, deleteQuery, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to delete todo: %v", err)
@@ -174,9 +212,12 @@ func (r *SQLXTodoRepository) GetAllByListID(ctx context.Context, listID string)
 		return []models.Todo{}, err
 	}
 	query := `
-		SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names(id) AS tags, created_at, updated_at, parent_id, recurrence, completed_at, position, estimate_minutes, custom_fields, status_id, version,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL) AS subtasks_total,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL AND s.completed) AS subtasks_completed
 		FROM todos
-		WHERE list_id = $1
+		WHERE list_id = $1 AND deleted_at IS NULL
+		ORDER BY position COLLATE "C", created_at
 	`
 
 	var todos []Entity
@@ -207,8 +248,11 @@ func (r *SQLXTodoRepository) GetAll(ctx context.Context) ([]models.Todo, error)
 		return []models.Todo{}, err
 	}
 	query := `
-		SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names(id) AS tags, created_at, updated_at, parent_id, recurrence, completed_at, position, estimate_minutes, custom_fields, status_id, version,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL) AS subtasks_total,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL AND s.completed) AS subtasks_completed
 		FROM todos
+		WHERE deleted_at IS NULL
 	`
 
 	var todos []Entity
@@ -231,6 +275,37 @@ func (r *SQLXTodoRepository) GetAll(ctx context.Context) ([]models.Todo, error)
 	return result, nil
 }
 
+func (r *SQLXTodoRepository) GetFiltered(ctx context.Context, filter models.TodoFilter) ([]models.Todo, error) {
+	log.C(ctx).Info("getting filtered todos repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	where, orderBy, args := buildFilter(filter)
+	query := `
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names(id) AS tags, created_at, updated_at, assigned_to, parent_id, recurrence, completed_at, position, estimate_minutes, custom_fields, status_id, version,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL) AS subtasks_total,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL AND s.completed) AS subtasks_completed
+		FROM todos
+		` + where + `
+		` + orderBy
+
+	var todos []Entity
+	err = tx.SelectContext(ctx, &todos, query, args...)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get filtered todos: %v", err)
+		return nil, fmt.Errorf("failed to get filtered todos: %w", err)
+	}
+
+	result := make([]models.Todo, 0, len(todos))
+	for _, entity := range todos {
+		result = append(result, r.converter.ConvertTodoToModel(entity))
+	}
+	return result, nil
+}
+
 func (r *SQLXTodoRepository) CompleteTodo(ctx context.Context, id string) (models.Todo, error) {
 	log.C(ctx).Info("completing todo repository")
 	tx, err := db.FromContext(ctx)
@@ -241,8 +316,8 @@ func (r *SQLXTodoRepository) CompleteTodo(ctx context.Context, id string) (model
 
 	updateListQuery := `
 		UPDATE todos
-		SET completed = true
-		WHERE id = $1
+		SET completed = true, completed_at = COALESCE(completed_at, NOW()), status_id = list_status_for(list_id, status_id, 'done')
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 
 	_, err = tx.ExecContext(ctx, updateListQuery, id)
@@ -261,6 +336,36 @@ func (r *SQLXTodoRepository) CompleteTodo(ctx context.Context, id string) (model
 	return updatedTodo, nil
 }
 
+func (r *SQLXTodoRepository) ReopenTodo(ctx context.Context, id string) (models.Todo, error) {
+	log.C(ctx).Info("reopening todo repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Todo{}, err
+	}
+
+	reopenTodoQuery := `
+		UPDATE todos
+		SET completed = false, completed_at = NULL, status_id = list_status_for(list_id, status_id, 'open')
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	_, err = tx.ExecContext(ctx, reopenTodoQuery, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to reopen todo: %v", err)
+		return models.Todo{}, fmt.Errorf("failed to reopen todo: %w", err)
+	}
+
+	reopenedTodo, err := r.Get(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("error while fetching reopened todo: %v", err)
+		return models.Todo{}, err
+	}
+
+	log.C(ctx).Info("todo reopened successfully")
+	return reopenedTodo, nil
+}
+
 func (r *SQLXTodoRepository) UpdateTodoDescription(ctx context.Context, todoID string, description string) (models.Todo, error) {
 	log.C(ctx).Info("updating todo description repository")
 	tx, err := db.FromContext(ctx)
@@ -272,7 +377,7 @@ func (r *SQLXTodoRepository) UpdateTodoDescription(ctx context.Context, todoID s
 	updateTodoQuery := `
 		UPDATE todos
 		SET description = $1
-		WHERE id = $2
+		WHERE id = $2 AND deleted_at IS NULL
 	`
 
 	_, err = tx.ExecContext(ctx, updateTodoQuery, description, todoID)
@@ -302,7 +407,7 @@ func (r *SQLXTodoRepository) UpdateTodoTitle(ctx context.Context, todoID string,
 	updateTodoQuery := `
 		UPDATE todos
 		SET title = $1
-		WHERE id = $2
+		WHERE id = $2 AND deleted_at IS NULL
 	`
 
 	_, err = tx.ExecContext(ctx, updateTodoQuery, title, todoID)
@@ -332,7 +437,7 @@ func (r *SQLXTodoRepository) UpdateTodoPriority(ctx context.Context, todoID stri
 	updateTodoQuery := `
 		UPDATE todos
 		SET priority = $1
-		WHERE id = $2
+		WHERE id = $2 AND deleted_at IS NULL
 	`
 
 	_, err = tx.ExecContext(ctx, updateTodoQuery, priority, todoID)
@@ -362,7 +467,7 @@ func (r *SQLXTodoRepository) UpdateAssignedTo(ctx context.Context, todoID string
 	updateTodoQuery := `
 		UPDATE todos
 		SET assigned_to = $1
-		WHERE id = $2
+		WHERE id = $2 AND deleted_at IS NULL
 	`
 
 	_, err = tx.ExecContext(ctx, updateTodoQuery, userID, todoID)
@@ -380,3 +485,396 @@ func (r *SQLXTodoRepository) UpdateAssignedTo(ctx context.Context, todoID string
 	log.C(ctx).Info("todo assigned_to updated successfully")
 	return updatedTodo, nil
 }
+
+// UpdateTodoStatus puts the todo in the status and sets its completed flag,
+// which the caller derives from the category of the status.
+func (r *SQLXTodoRepository) UpdateTodoStatus(ctx context.Context, todoID string, statusID string, completed bool) (models.Todo, error) {
+	log.C(ctx).Info("updating todo status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Todo{}, err
+	}
+
+	updateTodoQuery := `
+		UPDATE todos
+		SET status_id = $1, completed = $2, completed_at = CASE WHEN $2 THEN COALESCE(completed_at, NOW()) END
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+
+	_, err = tx.ExecContext(ctx, updateTodoQuery, statusID, completed, todoID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update todo status: %v", err)
+		return models.Todo{}, fmt.Errorf("failed to update todo status: %w", err)
+	}
+
+	updatedTodo, err := r.Get(ctx, todoID)
+	if err != nil {
+		log.C(ctx).Errorf("error while fetching updated todo: %v", err)
+		return models.Todo{}, err
+	}
+
+	log.C(ctx).Info("todo status updated successfully")
+	return updatedTodo, nil
+}
+
+func (r *SQLXTodoRepository) GetSubtasks(ctx context.Context, parentID string) ([]models.Todo, error) {
+	log.C(ctx).Info("getting subtasks repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return []models.Todo{}, err
+	}
+	query := `
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names(id) AS tags, created_at, updated_at, assigned_to, parent_id, recurrence, completed_at, position, estimate_minutes, custom_fields, status_id, version,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL) AS subtasks_total,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = todos.id AND s.deleted_at IS NULL AND s.completed) AS subtasks_completed
+		FROM todos
+		WHERE parent_id = $1 AND deleted_at IS NULL
+		ORDER BY position COLLATE "C", created_at
+	`
+
+	var subtasks []Entity
+
+	err = tx.SelectContext(ctx, &subtasks, query, parentID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get subtasks: %v", err)
+		return nil, fmt.Errorf("failed to get subtasks: %w", err)
+	}
+
+	result := make([]models.Todo, 0)
+	for _, entity := range subtasks {
+		log.C(ctx).Debugf("got entity in repo layer: %v", entity)
+		result = append(result, r.converter.ConvertTodoToModel(entity))
+	}
+
+	return result, nil
+}
+
+func (r *SQLXTodoRepository) AddDependency(ctx context.Context, todoID string, blockedByID string) error {
+	log.C(ctx).Info("adding todo dependency repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	insertDependencyQuery := `
+		INSERT INTO todo_dependencies (todo_id, blocked_by_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+
+	_, err = tx.ExecContext(ctx, insertDependencyQuery, todoID, blockedByID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to add todo dependency: %v", err)
+		return fmt.Errorf("failed to add todo dependency: %w", err)
+	}
+
+	log.C(ctx).Debugf("todo %s is now blocked by todo %s", todoID, blockedByID)
+	return nil
+}
+
+func (r *SQLXTodoRepository) RemoveDependency(ctx context.Context, todoID string, blockedByID string) error {
+	log.C(ctx).Info("removing todo dependency repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	deleteDependencyQuery := `DELETE FROM todo_dependencies WHERE todo_id = $1 AND blocked_by_id = $2`
+	_, err = tx.ExecContext(ctx, deleteDependencyQuery, todoID, blockedByID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to remove todo dependency: %v", err)
+		return fmt.Errorf("failed to remove todo dependency: %w", err)
+	}
+
+	log.C(ctx).Debugf("todo %s is no longer blocked by todo %s", todoID, blockedByID)
+	return nil
+}
+
+func (r *SQLXTodoRepository) GetBlockedBy(ctx context.Context, todoID string) ([]models.Todo, error) {
+	log.C(ctx).Info("getting todo blockers repository")
+	query := `
+		SELECT t.id, t.title, t.description, t.list_id, t.priority, t.due_date, t.start_date, t.completed, todo_tag_names(t.id) AS tags, t.created_at, t.updated_at, t.assigned_to, t.parent_id, t.recurrence, t.completed_at, t.position, t.estimate_minutes, t.custom_fields, t.status_id, t.version,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = t.id AND s.deleted_at IS NULL) AS subtasks_total,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = t.id AND s.deleted_at IS NULL AND s.completed) AS subtasks_completed
+		FROM todos t
+		JOIN todo_dependencies d ON d.blocked_by_id = t.id
+		WHERE d.todo_id = $1 AND t.deleted_at IS NULL
+	`
+	return r.selectDependencies(ctx, query, todoID)
+}
+
+func (r *SQLXTodoRepository) GetBlocking(ctx context.Context, todoID string) ([]models.Todo, error) {
+	log.C(ctx).Info("getting todos blocked by todo repository")
+	query := `
+		SELECT t.id, t.title, t.description, t.list_id, t.priority, t.due_date, t.start_date, t.completed, todo_tag_names(t.id) AS tags, t.created_at, t.updated_at, t.assigned_to, t.parent_id, t.recurrence, t.completed_at, t.position, t.estimate_minutes, t.custom_fields, t.status_id, t.version,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = t.id AND s.deleted_at IS NULL) AS subtasks_total,
+		       (SELECT COUNT(*) FROM todos s WHERE s.parent_id = t.id AND s.deleted_at IS NULL AND s.completed) AS subtasks_completed
+		FROM todos t
+		JOIN todo_dependencies d ON d.todo_id = t.id
+		WHERE d.blocked_by_id = $1 AND t.deleted_at IS NULL
+	`
+	return r.selectDependencies(ctx, query, todoID)
+}
+
+// DependsOn reports whether todoID is blocked, directly or through a chain of
+// other todos, by blockedByID.
+func (r *SQLXTodoRepository) DependsOn(ctx context.Context, todoID string, blockedByID string) (bool, error) {
+	log.C(ctx).Info("checking todo dependency path repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return false, err
+	}
+
+	query := `
+		WITH RECURSIVE blockers AS (
+			SELECT blocked_by_id FROM todo_dependencies WHERE todo_id = $1
+			UNION
+			SELECT d.blocked_by_id FROM todo_dependencies d JOIN blockers b ON d.todo_id = b.blocked_by_id
+		)
+		SELECT EXISTS (SELECT 1 FROM blockers WHERE blocked_by_id = $2)
+	`
+
+	var exists bool
+	err = tx.GetContext(ctx, &exists, query, todoID, blockedByID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check todo dependency path: %v", err)
+		return false, fmt.Errorf("failed to check todo dependency path: %w", err)
+	}
+	return exists, nil
+}
+
+func (r *SQLXTodoRepository) selectDependencies(ctx context.Context, query string, todoID string) ([]models.Todo, error) {
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return []models.Todo{}, err
+	}
+
+	var dependencies []Entity
+
+	err = tx.SelectContext(ctx, &dependencies, query, todoID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todo dependencies: %v", err)
+		return nil, fmt.Errorf("failed to get todo dependencies: %w", err)
+	}
+
+	result := make([]models.Todo, 0)
+	for _, entity := range dependencies {
+		log.C(ctx).Debugf("got entity in repo layer: %v", entity)
+		result = append(result, r.converter.ConvertTodoToModel(entity))
+	}
+
+	return result, nil
+}
+
+func (r *SQLXTodoRepository) GetLastPosition(ctx context.Context, listID string) (string, error) {
+	log.C(ctx).Info("getting last todo position repository")
+	query := `SELECT COALESCE(MAX(position COLLATE "C"), '') FROM todos WHERE list_id = $1 AND deleted_at IS NULL`
+	return r.getPosition(ctx, query, listID)
+}
+
+func (r *SQLXTodoRepository) GetPositionBefore(ctx context.Context, listID string, position string) (string, error) {
+	log.C(ctx).Info("getting previous todo position repository")
+	query := `SELECT COALESCE(MAX(position COLLATE "C"), '') FROM todos WHERE list_id = $1 AND deleted_at IS NULL AND position COLLATE "C" < $2`
+	return r.getPosition(ctx, query, listID, position)
+}
+
+func (r *SQLXTodoRepository) GetPositionAfter(ctx context.Context, listID string, position string) (string, error) {
+	log.C(ctx).Info("getting next todo position repository")
+	query := `SELECT COALESCE(MIN(position COLLATE "C"), '') FROM todos WHERE list_id = $1 AND deleted_at IS NULL AND position COLLATE "C" > $2`
+	return r.getPosition(ctx, query, listID, position)
+}
+
+func (r *SQLXTodoRepository) UpdatePosition(ctx context.Context, id string, position string) error {
+	log.C(ctx).Info("updating todo position repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	updatePositionQuery := `UPDATE todos SET position = $1 WHERE id = $2 AND deleted_at IS NULL`
+	_, err = tx.ExecContext(ctx, updatePositionQuery, position, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update todo position: %v", err)
+		return fmt.Errorf("failed to update todo position: %w", err)
+	}
+
+	log.C(ctx).Debugf("moved todo %s to position %s", id, position)
+	return nil
+}
+
+func (r *SQLXTodoRepository) getPosition(ctx context.Context, query string, args ...interface{}) (string, error) {
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	var position string
+	err = tx.GetContext(ctx, &position, query, args...)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todo position: %v", err)
+		return "", fmt.Errorf("failed to get todo position: %w", err)
+	}
+	return position, nil
+}
+
+// MoveToList moves the todo together with all of its subtasks to another list.
+// The todo is detached from its parent, its subtasks keep their relative order
+// and assignees without access to the target list are cleared, as are custom
+// field values since fields belong to the source list. Tags that cannot be put
+// on todos of the target list are removed. Todos go to the status of the
+// target list with the same name and category as their current one, or else
+// to its first status of that category. Trashed subtasks move as well, so
+// restoring one puts it back under its parent.
+func (r *SQLXTodoRepository) MoveToList(ctx context.Context, id string, listID string, position string) error {
+	log.C(ctx).Info("moving todo to list repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	moveQuery := `
+		WITH RECURSIVE moved AS (
+			SELECT id FROM todos WHERE id = $1
+			UNION ALL
+			SELECT t.id FROM todos t JOIN moved m ON t.parent_id = m.id
+		)
+		UPDATE todos
+		SET list_id = $2,
+		    parent_id = CASE WHEN todos.id = $1 THEN NULL ELSE todos.parent_id END,
+		    position = CASE WHEN todos.id = $1 THEN $3 ELSE $3 || todos.position END,
+		    assigned_to = CASE WHEN EXISTS (
+		        SELECT 1 FROM list_access a
+		        WHERE a.list_id = $2 AND a.user_id = todos.assigned_to AND a.status IN ('owner', 'accepted')
+		    ) THEN todos.assigned_to END,
+		    custom_fields = NULL,
+		    status_id = COALESCE(
+		        (SELECT t.id FROM list_statuses s
+		         JOIN list_statuses t ON t.list_id = $2 AND t.category = s.category AND lower(t.name) = lower(s.name)
+		         WHERE s.id = todos.status_id),
+		        list_status_for($2, NULL, CASE WHEN todos.completed THEN 'done' ELSE 'open' END)
+		    )
+		WHERE id IN (SELECT id FROM moved)
+	`
+
+	_, err = tx.ExecContext(ctx, moveQuery, id, listID, position)
+	if err != nil {
+		log.C(ctx).Errorf("failed to move todo to list: %v", err)
+		return fmt.Errorf("failed to move todo to list: %w", err)
+	}
+
+	untagQuery := `
+		WITH RECURSIVE moved AS (
+			SELECT id FROM todos WHERE id = $1
+			UNION ALL
+			SELECT t.id FROM todos t JOIN moved m ON t.parent_id = m.id
+		)
+		DELETE FROM todo_tags tt
+		USING tags g
+		WHERE g.id = tt.tag_id
+		  AND tt.todo_id IN (SELECT id FROM moved)
+		  AND g.list_id IS DISTINCT FROM $2
+		  AND g.user_id IS DISTINCT FROM (SELECT owner_id FROM lists WHERE id = $2)
+	`
+	if _, err = tx.ExecContext(ctx, untagQuery, id, listID); err != nil {
+		log.C(ctx).Errorf("failed to remove tags of the source list: %v", err)
+		return fmt.Errorf("failed to remove tags of the source list: %w", err)
+	}
+
+	log.C(ctx).Debugf("moved todo %s to list %s", id, listID)
+	return nil
+}
+
+// FindCollaborator returns the ID of the user with access to the list whose
+// email or the part of the email before the @ matches the handle.
+func (r *SQLXTodoRepository) FindCollaborator(ctx context.Context, listID string, handle string) (string, error) {
+	log.C(ctx).Info("finding list collaborator repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	query := `
+		SELECT u.id FROM users u
+		JOIN list_access a ON a.user_id = u.id
+		WHERE a.list_id = $1 AND a.status IN ('owner', 'accepted')
+			AND (u.email = $2 OR split_part(u.email, '@', 1) = $2)
+		ORDER BY u.email = $2 DESC
+		LIMIT 1
+	`
+
+	var userID string
+	err = tx.GetContext(ctx, &userID, query, listID, handle)
+	if err != nil {
+		log.C(ctx).Errorf("failed to find list collaborator: %v", err)
+		return "", fmt.Errorf("failed to find list collaborator: %w", err)
+	}
+	return userID, nil
+}
+
+// IsCollaborator reports whether the user owns the list or has accepted it.
+func (r *SQLXTodoRepository) IsCollaborator(ctx context.Context, listID string, userID string) (bool, error) {
+	log.C(ctx).Info("checking list collaborator repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return false, err
+	}
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM list_access
+			WHERE list_id = $1 AND user_id = $2 AND status IN ('owner', 'accepted')
+		)
+	`
+
+	var exists bool
+	err = tx.GetContext(ctx, &exists, query, listID, userID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check list collaborator: %v", err)
+		return false, fmt.Errorf("failed to check list collaborator: %w", err)
+	}
+	return exists, nil
+}
+
+// LockVersion returns the version of the todo and locks its row until the
+// transaction ends, so the version cannot change before the caller writes.
+func (r *SQLXTodoRepository) LockVersion(ctx context.Context, id string) (int, error) {
+	log.C(ctx).Info("locking todo version repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return 0, err
+	}
+
+	query := `SELECT version FROM todos WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+
+	var version int
+	err = tx.GetContext(ctx, &version, query, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to lock todo version: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("todo not found: %w", err)
+		}
+		return 0, fmt.Errorf("failed to lock todo version: %w", err)
+	}
+	return version, nil
+}
+
+// assignee returns the user the todo is assigned to, where an empty id means
+// nobody.
+func assignee(entity Entity) *string {
+	if entity.AssignedTo == nil {
+		return nil
+	}
+	return pkg.NullIfEmpty(*entity.AssignedTo)
+}
@@ -47,7 +47,7 @@ func TestSQLXTodoRepositoryCreate(t *testing.T) {
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectQuery(`^INSERT INTO todos`).WithArgs(
-					"4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", false, "tag1, tag2", constants.PriorityLow, nil, nil, "someone", sqlxmock.AnyArg(), sqlxmock.AnyArg(),
+					"4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", false, constants.PriorityLow, nil, nil, "someone", nil, nil, "", nil, nil, sqlxmock.AnyArg(), sqlxmock.AnyArg(), nil,
 				).WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow("1"))
 
 				mockDB.ExpectCommit()
@@ -71,7 +71,7 @@ func TestSQLXTodoRepositoryCreate(t *testing.T) {
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectQuery(`^INSERT INTO todos`).WithArgs(
-					"4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", false, "tag1, tag2", constants.PriorityLow, nil, nil, "someone", sqlxmock.AnyArg(), sqlxmock.AnyArg(),
+					"4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", false, constants.PriorityLow, nil, nil, "someone", nil, nil, "", nil, nil, sqlxmock.AnyArg(), sqlxmock.AnyArg(), nil,
 				).WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
@@ -128,7 +128,7 @@ func TestSQLXTodoRepositoryGet(t *testing.T) {
 			id:   "4cfd7e64-7431-4690-a2a0-1268917cedf3",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at, assigned_to FROM todos").WithArgs(
+				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names\\(id\\) AS tags, created_at, updated_at, assigned_to, parent_id, (.+) FROM todos").WithArgs(
 					"4cfd7e64-7431-4690-a2a0-1268917cedf3").WillReturnRows(sqlxmock.NewRows([]string{"id", "title", "description", "list_id", "priority", "due_date", "start_date", "completed", "tags", "assigned_to", "created_at", "updated_at"}).
 					AddRow("4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", constants.PriorityLow, time.Time{}, time.Time{}, false, "tag1, tag2", "", time.Time{}, time.Time{}))
 
@@ -152,7 +152,7 @@ func TestSQLXTodoRepositoryGet(t *testing.T) {
 			id:   "4cfd7e64-7431-4690-a2a0-1268917cedf3",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at, assigned_to FROM todos").WithArgs(
+				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names\\(id\\) AS tags, created_at, updated_at, assigned_to, parent_id, (.+) FROM todos").WithArgs(
 					"4cfd7e64-7431-4690-a2a0-1268917cedf3").WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
@@ -211,7 +211,7 @@ func TestSQLXTodoRepositoryUpdate(t *testing.T) {
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectExec("UPDATE todos").
-					WithArgs("Test Todo", "Test Description", constants.PriorityLow, nil, nil, false, "tag1, tag2", "someone", "4cfd7e64-7431-4690-a2a0-1268917cedf3").
+					WithArgs("Test Todo", "Test Description", constants.PriorityLow, nil, nil, false, "someone", nil, nil, nil, "4cfd7e64-7431-4690-a2a0-1268917cedf3", nil).
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 
 				mockDB.ExpectCommit()
@@ -235,7 +235,7 @@ func TestSQLXTodoRepositoryUpdate(t *testing.T) {
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectExec("UPDATE todos").
-					WithArgs("Test Todo", "Test Description", constants.PriorityLow, nil, nil, false, "tag1, tag2", "someone", "4cfd7e64-7431-4690-a2a0-1268917cedf3").
+					WithArgs("Test Todo", "Test Description", constants.PriorityLow, nil, nil, false, "someone", nil, nil, nil, "4cfd7e64-7431-4690-a2a0-1268917cedf3", nil).
 					WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
@@ -299,7 +299,7 @@ func TestSQLXTodoRepositoryDelete(t *testing.T) {
 			id:   "1",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectExec(`^DELETE FROM todos`).
+				mockDB.ExpectExec("WITH RECURSIVE trashed AS (.+) UPDATE todos SET deleted_at = NOW\\(\\)").
 					WithArgs("1").
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 				mockDB.ExpectCommit()
@@ -311,7 +311,7 @@ func TestSQLXTodoRepositoryDelete(t *testing.T) {
 			id:   "1",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectExec(`^DELETE FROM todos`).WillReturnError(errors.New("db error"))
+				mockDB.ExpectExec("WITH RECURSIVE trashed AS (.+) UPDATE todos SET deleted_at = NOW\\(\\)").WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
 			expectedError: fmt.Errorf("failed to delete todo: %w", errors.New("db error")),
@@ -348,6 +348,70 @@ func TestSQLXTodoRepositoryDelete(t *testing.T) {
 	}
 }
 
+func TestSQLXTodoRepositoryLockVersion(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+
+	testCases := []struct {
+		name            string
+		setupMocks      func()
+		expectedVersion int
+		expectedError   error
+	}{
+		{
+			name: "Successful lock of a todo",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT version FROM todos WHERE id = \\$1 AND deleted_at IS NULL FOR UPDATE").
+					WithArgs("1").
+					WillReturnRows(sqlxmock.NewRows([]string{"version"}).AddRow(4))
+				mockDB.ExpectCommit()
+			},
+			expectedVersion: 4,
+		},
+		{
+			name: "Failed lock of a missing todo",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT version FROM todos").
+					WithArgs("1").
+					WillReturnError(sql.ErrNoRows)
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("todo not found: %w", sql.ErrNoRows),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			version, err := repo.LockVersion(ctx, "1")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedVersion, version)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestSQLXTodoRepositoryGetAllByListID(t *testing.T) {
 	database, mockDB, err := sqlxmock.Newx()
 	require.NoError(t, err)
@@ -365,7 +429,7 @@ func TestSQLXTodoRepositoryGetAllByListID(t *testing.T) {
 			userID: "owner_id",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at FROM todos").
+				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names\\(id\\) AS tags, created_at, updated_at, parent_id, (.+) FROM todos").
 					WithArgs("owner_id").
 					WillReturnRows(sqlxmock.NewRows([]string{"id", "title", "description", "list_id", "priority", "due_date", "start_date", "completed", "tags", "created_at", "updated_at"}).
 						AddRow("1", "Todo 1", "Desc 1", "owner_id", constants.PriorityLow, nil, nil, false, "tag1, tag2", time.Time{}, time.Time{}).
@@ -397,7 +461,7 @@ func TestSQLXTodoRepositoryGetAllByListID(t *testing.T) {
 			userID: "owner-id",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at FROM todos").
+				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names\\(id\\) AS tags, created_at, updated_at, parent_id, (.+) FROM todos").
 					WithArgs("owner-id").
 					WillReturnError(sql.ErrNoRows)
 				mockDB.ExpectRollback()
@@ -436,3 +500,460 @@ func TestSQLXTodoRepositoryGetAllByListID(t *testing.T) {
 		})
 	}
 }
+
+func TestSQLXTodoRepositoryGetSubtasks(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+	parentID := "parent_id"
+
+	testCases := []struct {
+		name             string
+		parentID         string
+		setupMocks       func()
+		expectedSubtasks []models.Todo
+		expectedError    error
+	}{
+		{
+			name:     "Successful fetch of subtasks",
+			parentID: parentID,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names\\(id\\) AS tags, created_at, updated_at, assigned_to, parent_id, (.+) FROM todos WHERE parent_id").
+					WithArgs(parentID).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "title", "description", "list_id", "priority", "completed", "parent_id", "subtasks_total", "subtasks_completed"}).
+						AddRow("1", "Subtask 1", "Desc 1", "list_id", constants.PriorityLow, true, parentID, 0, 0).
+						AddRow("2", "Subtask 2", "Desc 2", "list_id", constants.PriorityLow, false, parentID, 2, 1))
+				mockDB.ExpectCommit()
+			},
+			expectedSubtasks: []models.Todo{
+				{
+					ID:          "1",
+					Title:       "Subtask 1",
+					Description: "Desc 1",
+					ListID:      "list_id",
+					Priority:    constants.PriorityLow,
+					Completed:   true,
+					ParentID:    &parentID,
+				},
+				{
+					ID:          "2",
+					Title:       "Subtask 2",
+					Description: "Desc 2",
+					ListID:      "list_id",
+					Priority:    constants.PriorityLow,
+					ParentID:    &parentID,
+					Subtasks:    models.SubtaskProgress{Total: 2, Completed: 1},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name:     "Failed fetch of subtasks due to database error",
+			parentID: parentID,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, todo_tag_names\\(id\\) AS tags, created_at, updated_at, assigned_to, parent_id, (.+) FROM todos WHERE parent_id").
+					WithArgs(parentID).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedSubtasks: nil,
+			expectedError:    fmt.Errorf("failed to get subtasks: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			subtasks, err := repo.GetSubtasks(ctx, tc.parentID)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedSubtasks, subtasks)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTodoRepositoryDependsOn(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expected      bool
+		expectedError error
+	}{
+		{
+			name: "Todo transitively depends on another todo",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("WITH RECURSIVE blockers AS (.+) SELECT EXISTS").
+					WithArgs("1", "2").
+					WillReturnRows(sqlxmock.NewRows([]string{"exists"}).AddRow(true))
+				mockDB.ExpectCommit()
+			},
+			expected:      true,
+			expectedError: nil,
+		},
+		{
+			name: "Failed check due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("WITH RECURSIVE blockers AS (.+) SELECT EXISTS").
+					WithArgs("1", "2").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expected:      false,
+			expectedError: fmt.Errorf("failed to check todo dependency path: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			dependsOn, err := repo.DependsOn(ctx, "1", "2")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, dependsOn)
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTodoRepositoryReopenTodo(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+	assignedTo := ""
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedTodo  models.Todo
+		expectedError error
+	}{
+		{
+			name: "Successful reopening of a todo",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE todos SET completed = false, completed_at = NULL, status_id = list_status_for\\(list_id, status_id, 'open'\\) WHERE id = \\$1").
+					WithArgs("1").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectQuery("SELECT id, title, (.+) FROM todos").WithArgs("1").
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "title", "completed", "completed_at", "assigned_to"}).
+						AddRow("1", "Test Todo", false, nil, ""))
+				mockDB.ExpectCommit()
+			},
+			expectedTodo: models.Todo{
+				ID:         "1",
+				Title:      "Test Todo",
+				AssignedTo: &assignedTo,
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed reopening due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("UPDATE todos SET completed = false, completed_at = NULL, status_id = list_status_for\\(list_id, status_id, 'open'\\) WHERE id = \\$1").
+					WithArgs("1").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedTodo:  models.Todo{},
+			expectedError: fmt.Errorf("failed to reopen todo: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			todo, err := repo.ReopenTodo(ctx, "1")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedTodo, todo)
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTodoRepositoryMoveToList(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful move of a todo to another list",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("WITH RECURSIVE moved AS (.+) UPDATE todos SET list_id = \\$2").
+					WithArgs("1", "2", "5").
+					WillReturnResult(sqlxmock.NewResult(0, 2))
+				mockDB.ExpectExec("WITH RECURSIVE moved AS (.+) DELETE FROM todo_tags tt USING tags g (.+) tt.todo_id IN \\(SELECT id FROM moved\\)").
+					WithArgs("1", "2").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed move due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("WITH RECURSIVE moved AS (.+) UPDATE todos SET list_id = \\$2").
+					WithArgs("1", "2", "5").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to move todo to list: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.MoveToList(ctx, "1", "2", "5")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTodoRepositoryGetFiltered(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+	completed := true
+	dueBefore := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	dueFrom := time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)
+	open := false
+	hasDueDate := true
+	assignedTo := "user"
+
+	testCases := []struct {
+		name          string
+		filter        models.TodoFilter
+		setupMocks    func()
+		expectedTodos []models.Todo
+		expectedError error
+	}{
+		{
+			name: "Filter todos of a list with a cursor",
+			filter: models.TodoFilter{
+				ListID:    "list",
+				Completed: &completed,
+				Tag:       "work",
+				DueBefore: &dueBefore,
+				Page: models.Page{
+					Sort:       constants.SortDueDate,
+					Descending: true,
+					Limit:      3,
+					After:      &models.Cursor{Value: "infinity", ID: "5"},
+				},
+			},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT (.+) FROM todos WHERE deleted_at IS NULL AND list_id = \\$1 AND completed = \\$2 AND id IN \\(SELECT tt.todo_id FROM todo_tags tt JOIN tags g ON g.id = tt.tag_id WHERE lower\\(g.name\\) = lower\\(\\$3\\)\\) AND due_date < \\$4 "+
+					"AND \\(COALESCE\\(due_date, 'infinity'\\), id\\) < \\(\\$5::timestamp, \\$6::uuid\\) ORDER BY COALESCE\\(due_date, 'infinity'\\) DESC, id DESC LIMIT \\$7").
+					WithArgs("list", true, "work", dueBefore, "infinity", "5", 3).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "title", "completed", "assigned_to"}).
+						AddRow("1", "Test Todo", true, assignedTo))
+				mockDB.ExpectCommit()
+			},
+			expectedTodos: []models.Todo{{ID: "1", Title: "Test Todo", Completed: true, AssignedTo: &assignedTo}},
+		},
+		{
+			name: "Filter todos by custom field values",
+			filter: models.TodoFilter{
+				ListID:       "list",
+				CustomFields: map[string]string{"stage": "done", "points": "3"},
+				Page:         models.Page{Sort: constants.SortPosition},
+			},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT (.+) FROM todos WHERE deleted_at IS NULL AND list_id = \\$1 AND custom_fields ->> \\$2::text = \\$3 AND custom_fields ->> \\$4::text = \\$5 ORDER BY").
+					WithArgs("list", "points", "3", "stage", "done").
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "title"}).AddRow("1", "Test Todo"))
+				mockDB.ExpectCommit()
+			},
+			expectedTodos: []models.Todo{{ID: "1", Title: "Test Todo"}},
+		},
+		{
+			name:   "Todos accessible by a user",
+			filter: models.TodoFilter{AccessibleBy: "user", Page: models.Page{Sort: constants.SortCreatedAt}},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT (.+) FROM todos WHERE deleted_at IS NULL AND list_id IN \\(SELECT list_id FROM list_access WHERE user_id = \\$1 (.+)\\) ORDER BY created_at ASC, id ASC").
+					WithArgs("user").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get filtered todos: %w", errors.New("db error")),
+		},
+		{
+			name: "Open todos due on a day across accessible lists",
+			filter: models.TodoFilter{
+				AccessibleBy: "user",
+				Completed:    &open,
+				HasDueDate:   &hasDueDate,
+				DueFrom:      &dueFrom,
+				DueBefore:    &dueBefore,
+				Page:         models.Page{Sort: constants.SortDueDate},
+			},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT (.+) FROM todos WHERE deleted_at IS NULL AND list_id IN \\(SELECT list_id FROM list_access WHERE user_id = \\$1 (.+)\\) "+
+					"AND completed = \\$2 AND due_date < \\$3 AND due_date >= \\$4 AND due_date IS NOT NULL ORDER BY COALESCE\\(due_date, 'infinity'\\) ASC, id ASC").
+					WithArgs("user", false, dueBefore, dueFrom).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "title"}).AddRow("1", "Test Todo"))
+				mockDB.ExpectCommit()
+			},
+			expectedTodos: []models.Todo{{ID: "1", Title: "Test Todo"}},
+		},
+		{
+			name: "Todos matching an extra condition with a limit",
+			filter: models.TodoFilter{
+				AccessibleBy: "user",
+				Condition:    priorityCondition(constants.PriorityHigh),
+				Page:         models.Page{Sort: constants.SortCreatedAt, Limit: 5},
+			},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT (.+) FROM todos WHERE deleted_at IS NULL AND list_id IN \\(SELECT list_id FROM list_access WHERE user_id = \\$1 (.+)\\) "+
+					"AND \\(priority = \\$2\\) ORDER BY created_at ASC, id ASC LIMIT \\$3").
+					WithArgs("user", constants.PriorityHigh, 5).
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "title"}).AddRow("1", "Test Todo"))
+				mockDB.ExpectCommit()
+			},
+			expectedTodos: []models.Todo{{ID: "1", Title: "Test Todo"}},
+		},
+		{
+			name:   "Todos with a tag across accessible lists",
+			filter: models.TodoFilter{AccessibleBy: "user", TagID: "tag", Page: models.Page{Sort: constants.SortCreatedAt}},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT (.+) FROM todos WHERE deleted_at IS NULL AND list_id IN \\(SELECT list_id FROM list_access WHERE user_id = \\$1 (.+)\\) "+
+					"AND id IN \\(SELECT todo_id FROM todo_tags WHERE tag_id = \\$2\\) ORDER BY created_at ASC, id ASC").
+					WithArgs("user", "tag").
+					WillReturnRows(sqlxmock.NewRows([]string{"id", "title"}).AddRow("1", "Test Todo"))
+				mockDB.ExpectCommit()
+			},
+			expectedTodos: []models.Todo{{ID: "1", Title: "Test Todo"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.GetFiltered(ctx, tc.filter)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+			if tc.expectedTodos != nil {
+				assert.Equal(t, tc.expectedTodos, result)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+type priorityCondition constants.PriorityLevel
+
+func (c priorityCondition) SQL(arg func(value interface{}) string) string {
+	return "priority = " + arg(constants.PriorityLevel(c))
+}
@@ -2,9 +2,22 @@ package todos
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/activity"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/customfields"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/quickadd"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/converters"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/mergepatch"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
+	"strings"
 	"time"
 )
 
@@ -14,15 +27,46 @@ type TodoService interface {
 	GetTodo(ctx context.Context, id string) (models.Todo, error)
 	GetAllTodos(ctx context.Context) ([]models.Todo, error)
 	UpdateTodo(ctx context.Context, todo models.Todo) error
+	PatchTodo(ctx context.Context, id string, patch []byte) (models.Todo, error)
 	DeleteTodo(ctx context.Context, id string) error
 	ListTodosByListID(ctx context.Context, listID string) ([]models.Todo, error)
+	FilterTodos(ctx context.Context, filter models.TodoFilter) ([]models.Todo, string, error)
 	CompleteTodo(ctx context.Context, id string) (models.Todo, error)
+	ReopenTodo(ctx context.Context, id string) (models.Todo, error)
 	UpdateTodoTitle(ctx context.Context, id, name string) (models.Todo, error)
 	UpdateTodoDescription(ctx context.Context, id, description string) (models.Todo, error)
 	UpdateTodoPriority(ctx context.Context, id string, priority constants.PriorityLevel) (models.Todo, error)
 	UpdateAssignedTo(ctx context.Context, id, userID string) (models.Todo, error)
+	ListSubtasks(ctx context.Context, id string) ([]models.Todo, error)
+	CompleteTodoWithSubtasks(ctx context.Context, id string) (models.Todo, error)
+	AddDependency(ctx context.Context, todoID, blockedByID string) error
+	RemoveDependency(ctx context.Context, todoID, blockedByID string) error
+	ListBlockedBy(ctx context.Context, id string) ([]models.Todo, error)
+	ListBlocking(ctx context.Context, id string) ([]models.Todo, error)
+	ReorderTodo(ctx context.Context, id, beforeID, afterID string) (models.Todo, error)
+	MoveTodoToList(ctx context.Context, id, listID string) (models.Todo, error)
+	BulkUpdateTodos(ctx context.Context, operation models.BulkOperation) ([]models.Todo, error)
+	QuickAddTodo(ctx context.Context, listID, text string) (models.Todo, error)
+	TransitionTodo(ctx context.Context, id, statusID string) (models.Todo, error)
+	SmartView(ctx context.Context, userID string, view constants.SmartView, timeZone string, days int) (models.SmartView, error)
+	CheckVersion(ctx context.Context, id string, version int) error
 }
 
+var (
+	ErrDependencyCycle = errors.New("dependency would create a cycle")
+	ErrTodoBlocked     = errors.New("todo is blocked by open todos")
+	ErrInvalidReorder  = errors.New("todo must be moved before or after another todo in the same list")
+	ErrInvalidBulk     = errors.New("invalid bulk operation")
+	ErrUnknownAssignee = errors.New("assignee has no access to the list")
+	ErrInvalidView     = errors.New("invalid smart view")
+	ErrVersionMismatch = errors.New("todo was changed since it was read")
+)
+
+const (
+	defaultUpcomingDays = 7
+	maxUpcomingDays     = 90
+)
+
 //go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
 type UUIDService interface {
 	Generate() string
@@ -36,20 +80,68 @@ type TimeService interface {
 var _ TodoService = &service{}
 
 type service struct {
-	repo        TodoRepository
-	uuidService UUIDService
-	timeService TimeService
+	repo         TodoRepository
+	activity     activity.ActivityService
+	customFields customfields.CustomFieldService
+	tags         tags.TagService
+	statuses     statuses.StatusService
+	uuidService  UUIDService
+	timeService  TimeService
 }
 
-func NewService(repo TodoRepository, uuidService UUIDService, timeService TimeService) TodoService {
-	return &service{repo: repo, uuidService: uuidService, timeService: timeService}
+func NewService(repo TodoRepository, activityService activity.ActivityService, customFieldService customfields.CustomFieldService, tagService tags.TagService, statusService statuses.StatusService, uuidService UUIDService, timeService TimeService) TodoService {
+	return &service{repo: repo, activity: activityService, customFields: customFieldService, tags: tagService, statuses: statusService, uuidService: uuidService, timeService: timeService}
 }
 
+// CreateTodo adds the todo at the end of its list. A todo created in a status
+// is completed when the status is a done one; without a status it goes to the
+// first status of the list matching its completed flag.
 func (s *service) CreateTodo(ctx context.Context, todo models.Todo) (string, error) {
 	log.C(ctx).Info("creating todo service")
-	if err := validateTodo(todo); err != nil {
+	if todo.Priority == "" {
+		todo.Priority = constants.PriorityLow
+	}
+	if err := s.validateTodo(ctx, todo, todo.ListID); err != nil {
+		return "", err
+	}
+	customFields, err := s.customFields.ValidateValues(ctx, todo.ListID, todo.CustomFields)
+	if err != nil {
+		return "", err
+	}
+	todo.CustomFields = customFields
+	tagNames, err := tags.Names(todo.Tags)
+	if err != nil {
 		return "", err
 	}
+	if todo.ParentID != nil && *todo.ParentID == "" {
+		todo.ParentID = nil
+	}
+	if todo.StatusID != nil && *todo.StatusID == "" {
+		todo.StatusID = nil
+	}
+	if todo.StatusID != nil {
+		if todo.Completed, err = s.statusCompletes(ctx, *todo.StatusID, todo.ListID); err != nil {
+			return "", err
+		}
+	}
+	if todo.ParentID != nil {
+		parent, err := s.repo.Get(ctx, *todo.ParentID)
+		if err != nil {
+			log.C(ctx).Errorf("getting parent todo with id %s failed", *todo.ParentID)
+			return "", err
+		}
+		if parent.ListID != todo.ListID {
+			log.C(ctx).Errorf("parent todo %s belongs to list %s, not %s", parent.ID, parent.ListID, todo.ListID)
+			return "", errors.New("subtask must belong to the same list as its parent")
+		}
+	}
+
+	lastPosition, err := s.repo.GetLastPosition(ctx, todo.ListID)
+	if err != nil {
+		log.C(ctx).Errorf("getting last position in list with id %s failed", todo.ListID)
+		return "", err
+	}
+	todo.Position = rankBetween(lastPosition, "")
 
 	todo.ID = s.uuidService.Generate()
 	todo.CreatedAt = s.timeService.Now()
@@ -57,7 +149,18 @@ func (s *service) CreateTodo(ctx context.Context, todo models.Todo) (string, err
 
 	log.C(ctx).Debugf("creating todo with id %s", todo.ID)
 
-	return s.repo.Create(ctx, todo)
+	id, err := s.repo.Create(ctx, todo)
+	if err != nil {
+		return "", err
+	}
+	if err = s.tags.SetTodoTags(ctx, id, todo.ListID, tagNames); err != nil {
+		log.C(ctx).Errorf("setting tags of todo with id %s failed", id)
+		return "", err
+	}
+	if err = s.activity.Record(ctx, constants.ActivityTodoCreated, todo.ListID, &id, nil, todo); err != nil {
+		return "", err
+	}
+	return id, nil
 }
 
 func (s *service) GetTodo(ctx context.Context, id string) (models.Todo, error) {
@@ -65,24 +168,149 @@ func (s *service) GetTodo(ctx context.Context, id string) (models.Todo, error) {
 	return s.repo.Get(ctx, id)
 }
 
+// UpdateTodo replaces the todo. Custom field values are merged into the ones
+// the todo already has, and a nil value clears the field. Moving the todo to
+// another status sets its completed flag from the category of the status;
+// otherwise a change of the flag moves it to the first status of the list in
+// the matching category. Completing or reopening the todo this way goes
+// through CompleteTodo and ReopenTodo once the other fields are stored, so open
+// blockers still refuse it and recurring todos get their next occurrence.
 func (s *service) UpdateTodo(ctx context.Context, todo models.Todo) error {
 	log.C(ctx).Info("updating todo service")
-	if err := validateTodo(todo); err != nil {
-		return err
-	}
 	dbTodo, err := s.repo.Get(ctx, todo.ID)
 	log.C(ctx).Debugf("updating todo in the database with id %s", dbTodo.ID)
 	if err != nil {
 		log.C(ctx).Errorf("getting todo with id %s failed", todo.ID)
 		return err
 	}
+	if todo.Priority == "" {
+		todo.Priority = dbTodo.Priority
+	}
+	if err = s.validateTodo(ctx, todo, dbTodo.ListID); err != nil {
+		return err
+	}
+	tagNames, err := tags.Names(todo.Tags)
+	if err != nil {
+		return err
+	}
+	todo.CustomFields, err = s.customFields.ValidateValues(ctx, dbTodo.ListID, mergeCustomFields(dbTodo.CustomFields, todo.CustomFields))
+	if err != nil {
+		return err
+	}
+	if todo.StatusID != nil && *todo.StatusID == "" {
+		todo.StatusID = nil
+	}
+	statusChanged := todo.StatusID != nil && (dbTodo.StatusID == nil || *todo.StatusID != *dbTodo.StatusID)
+	if statusChanged {
+		if todo.Completed, err = s.statusCompletes(ctx, *todo.StatusID, dbTodo.ListID); err != nil {
+			return err
+		}
+	}
+	statusID, completed := todo.StatusID, todo.Completed
+	completionChanged := completed != dbTodo.Completed
+	if completionChanged {
+		todo.StatusID, todo.Completed = dbTodo.StatusID, dbTodo.Completed
+	}
 	todo.UpdatedAt = s.timeService.Now()
-	return s.repo.Update(ctx, todo)
+	if err = s.repo.Update(ctx, todo); err != nil {
+		return err
+	}
+	if err = s.tags.SetTodoTags(ctx, todo.ID, dbTodo.ListID, tagNames); err != nil {
+		log.C(ctx).Errorf("setting tags of todo with id %s failed", todo.ID)
+		return err
+	}
+	updated, err := s.repo.Get(ctx, todo.ID)
+	if err != nil {
+		log.C(ctx).Errorf("getting updated todo with id %s failed", todo.ID)
+		return err
+	}
+	if err = s.activity.Record(ctx, constants.ActivityTodoUpdated, updated.ListID, &updated.ID, dbTodo, updated); err != nil {
+		return err
+	}
+	if !completionChanged {
+		return nil
+	}
+	switch {
+	case statusChanged:
+		_, err = s.TransitionTodo(ctx, todo.ID, *statusID)
+	case completed:
+		_, err = s.CompleteTodo(ctx, todo.ID)
+	default:
+		_, err = s.ReopenTodo(ctx, todo.ID)
+	}
+	return err
+}
+
+// patchableTodoFields are the members of a todo PatchTodo changes, mapped to
+// whether null may clear them.
+var patchableTodoFields = map[string]bool{
+	"title":            false,
+	"description":      true,
+	"tags":             true,
+	"completed":        false,
+	"due_date":         true,
+	"start_date":       true,
+	"priority":         false,
+	"assigned_to":      true,
+	"recurrence":       true,
+	"estimate_minutes": true,
+	"custom_fields":    true,
+	"status_id":        false,
 }
 
+// PatchTodo applies a JSON merge patch to the todo and updates it like
+// UpdateTodo, so the fields missing from the patch keep their values.
+func (s *service) PatchTodo(ctx context.Context, id string, patch []byte) (models.Todo, error) {
+	log.C(ctx).Info("patching todo service")
+	if err := mergepatch.Check(patch, patchableTodoFields); err != nil {
+		return models.Todo{}, err
+	}
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("getting todo with id %s failed", id)
+		return models.Todo{}, err
+	}
+	document, err := json.Marshal(current)
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("failed to marshal todo: %w", err)
+	}
+	patched, err := mergepatch.Apply(document, patch)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	var todo models.Todo
+	if err = json.Unmarshal(patched, &todo); err != nil {
+		return models.Todo{}, fmt.Errorf("%w: %v", mergepatch.ErrInvalidPatch, err)
+	}
+	todo.ID = id
+	// UpdateTodo keeps the custom field values it is not given, so the ones
+	// removed by the patch are passed as nil to clear them.
+	for fieldID := range current.CustomFields {
+		if _, ok := todo.CustomFields[fieldID]; !ok {
+			if todo.CustomFields == nil {
+				todo.CustomFields = map[string]interface{}{}
+			}
+			todo.CustomFields[fieldID] = nil
+		}
+	}
+	if err = s.UpdateTodo(ctx, todo); err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, id)
+}
+
+// DeleteTodo moves the todo and its subtasks to the trash.
 func (s *service) DeleteTodo(ctx context.Context, id string) error {
 	log.C(ctx).Info("deleting todo service")
-	return s.repo.Delete(ctx, id)
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("getting todo with id %s failed", id)
+		return err
+	}
+	if err = s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.activity.Record(ctx, constants.ActivityTodoDeleted, todo.ListID, &todo.ID, todo, nil)
 }
 
 func (s *service) ListTodosByListID(ctx context.Context, listID string) ([]models.Todo, error) {
@@ -90,36 +318,773 @@ func (s *service) ListTodosByListID(ctx context.Context, listID string) ([]model
 	return s.repo.GetAllByListID(ctx, listID)
 }
 
+// FilterTodos returns the todos matching the filter and, when there are more
+// todos after the requested page, the cursor of the next page. Todos of a
+// single list are ordered by position unless another sort key is given.
+func (s *service) FilterTodos(ctx context.Context, filter models.TodoFilter) ([]models.Todo, string, error) {
+	log.C(ctx).Info("filtering todos service")
+	if filter.Sort == "" {
+		filter.Sort = constants.SortCreatedAt
+		if filter.ListID != "" {
+			filter.Sort = constants.SortPosition
+		}
+	}
+	limit := filter.Limit
+	if limit > 0 {
+		filter.Limit = limit + 1
+	}
+
+	todos, err := s.repo.GetFiltered(ctx, filter)
+	if err != nil {
+		log.C(ctx).Errorf("filtering todos failed: %v", err)
+		return nil, "", err
+	}
+	if limit <= 0 || len(todos) <= limit {
+		return todos, "", nil
+	}
+
+	todos = todos[:limit]
+	last := todos[limit-1]
+	return todos, converters.CursorToString(models.Cursor{Value: sortValue(last, filter.Sort), ID: last.ID}), nil
+}
+
+// SmartView returns the open todos of every list the user can access for the
+// view, grouped by the day they are due on in the time zone, UTC when empty:
+//   - today: due today
+//   - overdue: due before today
+//   - upcoming: due in the days after today, seven unless days is given
+//   - inbox: without a due date, in a single group
+func (s *service) SmartView(ctx context.Context, userID string, view constants.SmartView, timeZone string, days int) (models.SmartView, error) {
+	log.C(ctx).Info("getting smart view service")
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return models.SmartView{}, fmt.Errorf("%w: unknown time zone %q", ErrInvalidView, timeZone)
+	}
+	if days == 0 {
+		days = defaultUpcomingDays
+	}
+	if days < 0 || days > maxUpcomingDays {
+		return models.SmartView{}, fmt.Errorf("%w: days must be between 1 and %d", ErrInvalidView, maxUpcomingDays)
+	}
+
+	now := s.timeService.Now().In(location)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, location)
+	completed, hasDueDate := false, true
+	filter := models.TodoFilter{
+		AccessibleBy: userID,
+		Completed:    &completed,
+		HasDueDate:   &hasDueDate,
+		Page:         models.Page{Sort: constants.SortDueDate},
+	}
+	switch view {
+	case constants.SmartViewToday:
+		filter.DueFrom, filter.DueBefore = &today, &tomorrow
+	case constants.SmartViewOverdue:
+		filter.DueBefore = &today
+	case constants.SmartViewUpcoming:
+		end := time.Date(now.Year(), now.Month(), now.Day()+1+days, 0, 0, 0, 0, location)
+		filter.DueFrom, filter.DueBefore = &tomorrow, &end
+	case constants.SmartViewInbox:
+		hasDueDate = false
+		filter.Sort = constants.SortCreatedAt
+	default:
+		return models.SmartView{}, fmt.Errorf("%w: unknown view %q", ErrInvalidView, view)
+	}
+
+	todos, err := s.repo.GetFiltered(ctx, filter)
+	if err != nil {
+		log.C(ctx).Errorf("getting %s view of user %s failed: %v", view, userID, err)
+		return models.SmartView{}, err
+	}
+	return models.SmartView{View: view, TimeZone: location.String(), Groups: groupByDueDate(todos, location)}, nil
+}
+
+// groupByDueDate splits todos sorted by due date into groups of todos due on
+// the same day in the location. Todos without a due date form one group.
+func groupByDueDate(todos []models.Todo, location *time.Location) []models.TodoDateGroup {
+	groups := make([]models.TodoDateGroup, 0)
+	for _, todo := range todos {
+		var date *string
+		if todo.DueDate != nil {
+			day := todo.DueDate.In(location).Format(time.DateOnly)
+			date = &day
+		}
+		last := len(groups) - 1
+		if last >= 0 && equalDates(groups[last].Date, date) {
+			groups[last].Todos = append(groups[last].Todos, todo)
+			continue
+		}
+		groups = append(groups, models.TodoDateGroup{Date: date, Todos: []models.Todo{todo}})
+	}
+	return groups
+}
+
+func equalDates(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
 func (s *service) GetAllTodos(ctx context.Context) ([]models.Todo, error) {
 	log.C(ctx).Info("getting all todos service")
 	return s.repo.GetAll(ctx)
 }
 
+// CompleteTodo completes the todo. A todo that is already completed is
+// returned unchanged, so completing it again does not schedule another
+// occurrence of a recurring todo.
 func (s *service) CompleteTodo(ctx context.Context, id string) (models.Todo, error) {
 	log.C(ctx).Info("completing todo service")
-	return s.repo.CompleteTodo(ctx, id)
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("getting todo with id %s failed", id)
+		return models.Todo{}, err
+	}
+	if todo.Completed {
+		return todo, nil
+	}
+	return s.completeTodo(ctx, id, s.repo.CompleteTodo)
+}
+
+// completeTodo applies the completing mutation once no open todo blocks the
+// todo any more, and schedules the next occurrence of recurring todos.
+func (s *service) completeTodo(ctx context.Context, id string, complete func(ctx context.Context, id string) (models.Todo, error)) (models.Todo, error) {
+	blockers, err := s.repo.GetBlockedBy(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("getting blockers of todo with id %s failed", id)
+		return models.Todo{}, err
+	}
+	for _, blocker := range blockers {
+		if !blocker.Completed {
+			log.C(ctx).Errorf("todo with id %s is blocked by open todo %s", id, blocker.ID)
+			return models.Todo{}, fmt.Errorf("%w: %s", ErrTodoBlocked, blocker.Title)
+		}
+	}
+
+	todo, err := s.changeTodo(ctx, id, constants.ActivityTodoCompleted, complete)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if err = s.scheduleNextOccurrence(ctx, todo); err != nil {
+		return models.Todo{}, err
+	}
+	return todo, nil
+}
+
+func (s *service) ReopenTodo(ctx context.Context, id string) (models.Todo, error) {
+	log.C(ctx).Info("reopening todo service")
+	return s.changeTodo(ctx, id, constants.ActivityTodoReopened, s.repo.ReopenTodo)
+}
+
+// TransitionTodo moves the todo to another status of its list. Moving it into
+// a done status completes it and moving it out of one reopens it, so the
+// completed flag always matches the category of the status.
+func (s *service) TransitionTodo(ctx context.Context, id, statusID string) (models.Todo, error) {
+	log.C(ctx).Info("transitioning todo service")
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("getting todo with id %s failed", id)
+		return models.Todo{}, err
+	}
+	done, err := s.statusCompletes(ctx, statusID, todo.ListID)
+	if err != nil {
+		return models.Todo{}, err
+	}
+
+	transition := func(ctx context.Context, id string) (models.Todo, error) {
+		return s.repo.UpdateTodoStatus(ctx, id, statusID, done)
+	}
+	switch {
+	case done && !todo.Completed:
+		return s.completeTodo(ctx, id, transition)
+	case !done && todo.Completed:
+		return s.changeTodo(ctx, id, constants.ActivityTodoReopened, transition)
+	default:
+		return s.changeTodo(ctx, id, constants.ActivityTodoUpdated, transition)
+	}
+}
+
+// statusCompletes reports whether todos in the status of the list are
+// completed.
+func (s *service) statusCompletes(ctx context.Context, statusID, listID string) (bool, error) {
+	status, err := s.statuses.GetStatus(ctx, statusID, listID)
+	if err != nil {
+		log.C(ctx).Errorf("getting status with id %s of list %s failed", statusID, listID)
+		return false, err
+	}
+	return status.Category == constants.StatusCategoryDone, nil
 }
 
 func (s *service) UpdateTodoTitle(ctx context.Context, id, title string) (models.Todo, error) {
 	log.C(ctx).Info("updating todo title service")
-	return s.repo.UpdateTodoTitle(ctx, id, title)
+	v := validation.New()
+	v.Length("title", title, 1, validation.MaxTitleLength)
+	if err := v.Err(); err != nil {
+		return models.Todo{}, err
+	}
+	return s.changeTodo(ctx, id, constants.ActivityTodoUpdated, func(ctx context.Context, id string) (models.Todo, error) {
+		return s.repo.UpdateTodoTitle(ctx, id, title)
+	})
 }
 
 func (s *service) UpdateTodoDescription(ctx context.Context, id, description string) (models.Todo, error) {
 	log.C(ctx).Info("updating todo description service")
-	return s.repo.UpdateTodoDescription(ctx, id, description)
+	return s.changeTodo(ctx, id, constants.ActivityTodoUpdated, func(ctx context.Context, id string) (models.Todo, error) {
+		return s.repo.UpdateTodoDescription(ctx, id, description)
+	})
 }
 
 func (s *service) UpdateTodoPriority(ctx context.Context, id string, priority constants.PriorityLevel) (models.Todo, error) {
 	log.C(ctx).Info("updating todo priority service")
-	return s.repo.UpdateTodoPriority(ctx, id, priority)
+	v := validation.New()
+	v.OneOf("priority", string(priority), string(constants.PriorityLow), string(constants.PriorityMedium), string(constants.PriorityHigh))
+	if err := v.Err(); err != nil {
+		return models.Todo{}, err
+	}
+	return s.changeTodo(ctx, id, constants.ActivityTodoUpdated, func(ctx context.Context, id string) (models.Todo, error) {
+		return s.repo.UpdateTodoPriority(ctx, id, priority)
+	})
 }
 
 func (s *service) UpdateAssignedTo(ctx context.Context, id, userID string) (models.Todo, error) {
 	log.C(ctx).Info("updating todo assigned service")
-	return s.repo.UpdateAssignedTo(ctx, id, userID)
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("getting todo with id %s failed", id)
+		return models.Todo{}, err
+	}
+	v := validation.New()
+	if err = s.checkAssignee(ctx, v, todo.ListID, &userID); err != nil {
+		return models.Todo{}, err
+	}
+	if err = v.Err(); err != nil {
+		return models.Todo{}, err
+	}
+	return s.changeTodo(ctx, id, constants.ActivityTodoUpdated, func(ctx context.Context, id string) (models.Todo, error) {
+		return s.repo.UpdateAssignedTo(ctx, id, userID)
+	})
 }
 
-func validateTodo(todo models.Todo) error {
+// CheckVersion returns ErrVersionMismatch unless the todo is at the version.
+// The todo stays locked until the transaction ends, so a change made in the
+// same transaction cannot overwrite someone else's.
+func (s *service) CheckVersion(ctx context.Context, id string, version int) error {
+	log.C(ctx).Info("checking todo version service")
+	current, err := s.repo.LockVersion(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("locking todo with id %s failed", id)
+		return err
+	}
+	if current != version {
+		return fmt.Errorf("%w: expected version %d, found %d", ErrVersionMismatch, version, current)
+	}
+	return nil
+}
+
+// changeTodo applies a single-row mutation and records the fields it changed.
+func (s *service) changeTodo(ctx context.Context, id string, action constants.ActivityAction, change func(ctx context.Context, id string) (models.Todo, error)) (models.Todo, error) {
+	before, err := s.repo.Get(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("getting todo with id %s failed", id)
+		return models.Todo{}, err
+	}
+	after, err := change(ctx, id)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if err = s.activity.Record(ctx, action, after.ListID, &after.ID, before, after); err != nil {
+		return models.Todo{}, err
+	}
+	return after, nil
+}
+
+func (s *service) ListSubtasks(ctx context.Context, id string) ([]models.Todo, error) {
+	log.C(ctx).Info("listing subtasks service")
+	return s.repo.GetSubtasks(ctx, id)
+}
+
+// CompleteTodoWithSubtasks completes the todo and every subtask below it,
+// deepest first. Each of them goes through CompleteTodo, so blocked subtasks
+// refuse the whole completion with ErrTodoBlocked, recurring ones schedule
+// their next occurrence and every completion is recorded in the activity log.
+func (s *service) CompleteTodoWithSubtasks(ctx context.Context, id string) (models.Todo, error) {
+	log.C(ctx).Info("completing todo with subtasks service")
+	subtasks, err := s.repo.GetSubtasks(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("getting subtasks of todo with id %s failed", id)
+		return models.Todo{}, err
+	}
+	for _, subtask := range subtasks {
+		if _, err = s.CompleteTodoWithSubtasks(ctx, subtask.ID); err != nil {
+			log.C(ctx).Errorf("completing subtask with id %s of todo with id %s failed", subtask.ID, id)
+			return models.Todo{}, err
+		}
+	}
+	return s.CompleteTodo(ctx, id)
+}
+
+// scheduleNextOccurrence creates the follow-up of a completed recurring todo,
+// shifting its start and due dates to the next occurrence of the rule.
+func (s *service) scheduleNextOccurrence(ctx context.Context, todo models.Todo) error {
+	if todo.Recurrence == nil {
+		return nil
+	}
+	completedAt := s.timeService.Now()
+	hasDueDate := todo.DueDate != nil && !todo.DueDate.IsZero()
+	hasStartDate := todo.StartDate != nil && !todo.StartDate.IsZero()
+
+	anchor := completedAt
+	if hasDueDate {
+		anchor = *todo.DueDate
+	} else if hasStartDate {
+		anchor = *todo.StartDate
+	}
+	next := nextOccurrence(*todo.Recurrence, anchor, completedAt)
+
+	var dueDate, startDate time.Time
+	if hasStartDate {
+		startDate = todo.StartDate.Add(next.Sub(anchor))
+	}
+	if hasDueDate || !hasStartDate {
+		dueDate = next
+	}
+
+	assignedTo := ""
+	if todo.AssignedTo != nil {
+		assignedTo = *todo.AssignedTo
+	}
+
+	nextTodo := models.Todo{
+		ListID:       todo.ListID,
+		Title:        todo.Title,
+		Description:  todo.Description,
+		Tags:         todo.Tags,
+		Priority:     todo.Priority,
+		DueDate:      &dueDate,
+		StartDate:    &startDate,
+		AssignedTo:   &assignedTo,
+		ParentID:     todo.ParentID,
+		Recurrence:   todo.Recurrence,
+		CustomFields: todo.CustomFields,
+	}
+	id, err := s.CreateTodo(ctx, nextTodo)
+	if err != nil {
+		log.C(ctx).Errorf("creating next occurrence of todo with id %s failed: %v", todo.ID, err)
+		return err
+	}
+	log.C(ctx).Debugf("created next occurrence %s of todo %s", id, todo.ID)
+	return nil
+}
+
+func (s *service) AddDependency(ctx context.Context, todoID, blockedByID string) error {
+	log.C(ctx).Info("adding todo dependency service")
+	if todoID == blockedByID {
+		return ErrDependencyCycle
+	}
+	cycle, err := s.repo.DependsOn(ctx, blockedByID, todoID)
+	if err != nil {
+		log.C(ctx).Errorf("checking dependency path from %s to %s failed", blockedByID, todoID)
+		return err
+	}
+	if cycle {
+		log.C(ctx).Errorf("todo %s already depends on todo %s", blockedByID, todoID)
+		return ErrDependencyCycle
+	}
+	if err = s.repo.AddDependency(ctx, todoID, blockedByID); err != nil {
+		return err
+	}
+	return s.recordDependency(ctx, constants.ActivityDependencyAdded, todoID, nil, dependency{BlockedBy: blockedByID})
+}
+
+func (s *service) RemoveDependency(ctx context.Context, todoID, blockedByID string) error {
+	log.C(ctx).Info("removing todo dependency service")
+	if err := s.repo.RemoveDependency(ctx, todoID, blockedByID); err != nil {
+		return err
+	}
+	return s.recordDependency(ctx, constants.ActivityDependencyRemoved, todoID, dependency{BlockedBy: blockedByID}, nil)
+}
+
+// dependency is the activity representation of a blocked-by edge.
+type dependency struct {
+	BlockedBy string `json:"blocked_by"`
+}
+
+func (s *service) recordDependency(ctx context.Context, action constants.ActivityAction, todoID string, before, after any) error {
+	todo, err := s.repo.Get(ctx, todoID)
+	if err != nil {
+		log.C(ctx).Errorf("getting todo with id %s failed", todoID)
+		return err
+	}
+	return s.activity.Record(ctx, action, todo.ListID, &todo.ID, before, after)
+}
+
+func (s *service) ListBlockedBy(ctx context.Context, id string) ([]models.Todo, error) {
+	log.C(ctx).Info("listing todo blockers service")
+	return s.repo.GetBlockedBy(ctx, id)
+}
+
+func (s *service) ListBlocking(ctx context.Context, id string) ([]models.Todo, error) {
+	log.C(ctx).Info("listing todos blocked by todo service")
+	return s.repo.GetBlocking(ctx, id)
+}
+
+// ReorderTodo places the todo directly before beforeID or directly after afterID.
+// Exactly one of them must be set and refer to another todo in the same list.
+func (s *service) ReorderTodo(ctx context.Context, id, beforeID, afterID string) (models.Todo, error) {
+	log.C(ctx).Info("reordering todo service")
+	if (beforeID == "") == (afterID == "") {
+		return models.Todo{}, ErrInvalidReorder
+	}
+	targetID := beforeID
+	if targetID == "" {
+		targetID = afterID
+	}
+	if targetID == id {
+		return models.Todo{}, ErrInvalidReorder
+	}
+
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("getting todo with id %s failed", id)
+		return models.Todo{}, err
+	}
+	target, err := s.repo.Get(ctx, targetID)
+	if err != nil {
+		log.C(ctx).Errorf("getting todo with id %s failed", targetID)
+		return models.Todo{}, err
+	}
+	if target.ListID != todo.ListID {
+		log.C(ctx).Errorf("todo %s belongs to list %s, not %s", target.ID, target.ListID, todo.ListID)
+		return models.Todo{}, ErrInvalidReorder
+	}
+
+	var prev, next string
+	if beforeID != "" {
+		next = target.Position
+		prev, err = s.repo.GetPositionBefore(ctx, todo.ListID, target.Position)
+	} else {
+		prev = target.Position
+		next, err = s.repo.GetPositionAfter(ctx, todo.ListID, target.Position)
+	}
+	if err != nil {
+		log.C(ctx).Errorf("getting neighbour position of todo with id %s failed", targetID)
+		return models.Todo{}, err
+	}
+
+	position := rankBetween(prev, next)
+	if err = s.repo.UpdatePosition(ctx, id, position); err != nil {
+		log.C(ctx).Errorf("updating position of todo with id %s failed", id)
+		return models.Todo{}, err
+	}
+	before := todo
+	todo.Position = position
+	if err = s.activity.Record(ctx, constants.ActivityTodoReordered, todo.ListID, &todo.ID, before, todo); err != nil {
+		return models.Todo{}, err
+	}
+	return todo, nil
+}
+
+func (s *service) MoveTodoToList(ctx context.Context, id, listID string) (models.Todo, error) {
+	log.C(ctx).Info("moving todo to list service")
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("getting todo with id %s failed", id)
+		return models.Todo{}, err
+	}
+	if todo.ListID == listID {
+		return todo, nil
+	}
+
+	// The subtasks move along, so their tag names are read before the move.
+	subtree, err := s.withDescendants(ctx, todo)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	lastPosition, err := s.repo.GetLastPosition(ctx, listID)
+	if err != nil {
+		log.C(ctx).Errorf("getting last position in list with id %s failed", listID)
+		return models.Todo{}, err
+	}
+	if err = s.repo.MoveToList(ctx, id, listID, rankBetween(lastPosition, "")); err != nil {
+		log.C(ctx).Errorf("moving todo with id %s to list %s failed", id, listID)
+		return models.Todo{}, err
+	}
+	// Tags of the source list do not apply to the target list, so the names
+	// are resolved again for every moved todo.
+	for _, movedTodo := range subtree {
+		tagNames, err := tags.Names(movedTodo.Tags)
+		if err != nil {
+			return models.Todo{}, err
+		}
+		if err = s.tags.SetTodoTags(ctx, movedTodo.ID, listID, tagNames); err != nil {
+			log.C(ctx).Errorf("setting tags of moved todo with id %s failed", movedTodo.ID)
+			return models.Todo{}, err
+		}
+	}
+	moved, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	// The move shows up in the history of both lists.
+	for _, historyListID := range []string{todo.ListID, moved.ListID} {
+		if err = s.activity.Record(ctx, constants.ActivityTodoMoved, historyListID, &moved.ID, todo, moved); err != nil {
+			return models.Todo{}, err
+		}
+	}
+	return moved, nil
+}
+
+// withDescendants returns the todo followed by all of its subtasks, at any depth.
+func (s *service) withDescendants(ctx context.Context, todo models.Todo) ([]models.Todo, error) {
+	result := []models.Todo{todo}
+	subtasks, err := s.repo.GetSubtasks(ctx, todo.ID)
+	if err != nil {
+		log.C(ctx).Errorf("getting subtasks of todo with id %s failed", todo.ID)
+		return nil, err
+	}
+	for _, subtask := range subtasks {
+		descendants, err := s.withDescendants(ctx, subtask)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, descendants...)
+	}
+	return result, nil
+}
+
+// QuickAddTodo creates a todo from free text such as "Pay rent every month on
+// the 1st p1 #finance @alice". A mentioned assignee must be a collaborator on
+// the list.
+func (s *service) QuickAddTodo(ctx context.Context, listID, text string) (models.Todo, error) {
+	log.C(ctx).Info("quick adding todo service")
+	parsed, err := quickadd.Parse(text, s.timeService.Now())
+	if err != nil {
+		log.C(ctx).Errorf("parsing quick-add text failed: %v", err)
+		return models.Todo{}, err
+	}
+
+	tags, err := json.Marshal(append([]string{}, parsed.Tags...))
+	if err != nil {
+		return models.Todo{}, fmt.Errorf("failed to encode todo tags: %w", err)
+	}
+	todo := models.Todo{
+		ListID:     listID,
+		Title:      parsed.Title,
+		Priority:   constants.PriorityLow,
+		Tags:       tags,
+		DueDate:    &time.Time{},
+		StartDate:  &time.Time{},
+		Recurrence: parsed.Recurrence,
+	}
+	if parsed.Priority != "" {
+		todo.Priority = parsed.Priority
+	}
+	if parsed.DueDate != nil {
+		todo.DueDate = parsed.DueDate
+	}
+	if parsed.Assignee != "" {
+		userID, err := s.repo.FindCollaborator(ctx, listID, parsed.Assignee)
+		if errors.Is(err, sql.ErrNoRows) {
+			log.C(ctx).Errorf("no collaborator %s on list with id %s", parsed.Assignee, listID)
+			return models.Todo{}, fmt.Errorf("%w: %s", ErrUnknownAssignee, parsed.Assignee)
+		}
+		if err != nil {
+			log.C(ctx).Errorf("finding collaborator %s on list with id %s failed", parsed.Assignee, listID)
+			return models.Todo{}, err
+		}
+		todo.AssignedTo = &userID
+	}
+
+	id, err := s.CreateTodo(ctx, todo)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, id)
+}
+
+// BulkUpdateTodos applies the operation to every todo in turn. It relies on the
+// caller's transaction, so either all todos are updated or none is. Deleted
+// todos are not part of the result, and subtasks already trashed with their
+// parent count as deleted.
+func (s *service) BulkUpdateTodos(ctx context.Context, operation models.BulkOperation) ([]models.Todo, error) {
+	log.C(ctx).Info("bulk updating todos service")
+	if err := validateBulkOperation(operation); err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Todo, 0, len(operation.IDs))
+	seen := make(map[string]bool, len(operation.IDs))
+	for _, id := range operation.IDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if operation.Action == constants.BulkDelete {
+			// Deleting a todo moves its subtasks to the trash as well, so
+			// they are done when the batch reaches them.
+			subtree, err := s.withDescendants(ctx, models.Todo{ID: id})
+			if err != nil {
+				return nil, fmt.Errorf("bulk %s of todo %s failed: %w", operation.Action, id, err)
+			}
+			for _, subtask := range subtree[1:] {
+				seen[subtask.ID] = true
+			}
+		}
+
+		todo, err := s.applyBulkAction(ctx, id, operation)
+		if err != nil {
+			log.C(ctx).Errorf("bulk %s of todo with id %s failed", operation.Action, id)
+			return nil, fmt.Errorf("bulk %s of todo %s failed: %w", operation.Action, id, err)
+		}
+		if operation.Action != constants.BulkDelete {
+			result = append(result, todo)
+		}
+	}
+	return result, nil
+}
+
+func (s *service) applyBulkAction(ctx context.Context, id string, operation models.BulkOperation) (models.Todo, error) {
+	switch operation.Action {
+	case constants.BulkComplete:
+		return s.CompleteTodo(ctx, id)
+	case constants.BulkReopen:
+		return s.ReopenTodo(ctx, id)
+	case constants.BulkDelete:
+		return models.Todo{}, s.DeleteTodo(ctx, id)
+	case constants.BulkSetPriority:
+		return s.UpdateTodoPriority(ctx, id, operation.Priority)
+	case constants.BulkAssign:
+		return s.UpdateAssignedTo(ctx, id, operation.AssignedTo)
+	case constants.BulkMove:
+		return s.MoveTodoToList(ctx, id, operation.ListID)
+	default:
+		todo, err := s.repo.Get(ctx, id)
+		if err != nil {
+			return models.Todo{}, err
+		}
+		tagNames, err := tags.Names(todo.Tags)
+		if err != nil {
+			return models.Todo{}, err
+		}
+		tagNames = updateTags(tagNames, operation.Tags, operation.Action == constants.BulkAddTags)
+		if err = s.tags.SetTodoTags(ctx, id, todo.ListID, tagNames); err != nil {
+			return models.Todo{}, err
+		}
+		updated, err := s.repo.Get(ctx, id)
+		if err != nil {
+			return models.Todo{}, err
+		}
+		if err = s.activity.Record(ctx, constants.ActivityTodoUpdated, updated.ListID, &updated.ID, todo, updated); err != nil {
+			return models.Todo{}, err
+		}
+		return updated, nil
+	}
+}
+
+func validateBulkOperation(operation models.BulkOperation) error {
+	if len(operation.IDs) == 0 {
+		return fmt.Errorf("%w: no todo ids given", ErrInvalidBulk)
+	}
+	switch operation.Action {
+	case constants.BulkComplete, constants.BulkReopen, constants.BulkDelete:
+		return nil
+	case constants.BulkSetPriority:
+		switch operation.Priority {
+		case constants.PriorityLow, constants.PriorityMedium, constants.PriorityHigh:
+			return nil
+		}
+		return fmt.Errorf("%w: invalid priority level %q", ErrInvalidBulk, operation.Priority)
+	case constants.BulkAddTags, constants.BulkRemoveTags:
+		if len(operation.Tags) == 0 {
+			return fmt.Errorf("%w: no tags given", ErrInvalidBulk)
+		}
+		return nil
+	case constants.BulkAssign:
+		if operation.AssignedTo == "" {
+			return fmt.Errorf("%w: no assignee given", ErrInvalidBulk)
+		}
+		return nil
+	case constants.BulkMove:
+		if operation.ListID == "" {
+			return fmt.Errorf("%w: no target list given", ErrInvalidBulk)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown action %q", ErrInvalidBulk, operation.Action)
+	}
+}
+
+// updateTags adds or removes the changed tags from the tag names of a todo,
+// keeping the order of the existing tags. Names are compared
+// case-insensitively.
+func updateTags(current []string, changes []string, add bool) []string {
+	changed := make(map[string]bool, len(changes))
+	for _, tag := range changes {
+		changed[strings.ToLower(tag)] = true
+	}
+
+	updated := make([]string, 0, len(current)+len(changes))
+	for _, tag := range current {
+		key := strings.ToLower(tag)
+		if add {
+			delete(changed, key)
+		} else if changed[key] {
+			continue
+		}
+		updated = append(updated, tag)
+	}
+	if add {
+		for _, tag := range changes {
+			if key := strings.ToLower(tag); changed[key] {
+				updated = append(updated, tag)
+				delete(changed, key)
+			}
+		}
+	}
+	return updated
+}
+
+func mergeCustomFields(current, changes map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(current)+len(changes))
+	for id, value := range current {
+		merged[id] = value
+	}
+	for id, value := range changes {
+		merged[id] = value
+	}
+	return merged
+}
+
+// validateTodo checks the todo as it would be stored in the list and returns
+// a *validation.Errors listing every invalid field.
+func (s *service) validateTodo(ctx context.Context, todo models.Todo, listID string) error {
+	v := validation.Todo(todo)
+	if err := validateRecurrence(todo.Recurrence); err != nil {
+		v.Add("recurrence", "%v", err)
+	}
+	if err := s.checkAssignee(ctx, v, listID, todo.AssignedTo); err != nil {
+		return err
+	}
+	return v.Err()
+}
+
+// checkAssignee records a field error unless the assignee, when there is one,
+// is the owner of the list or has accepted it.
+func (s *service) checkAssignee(ctx context.Context, v *validation.Validator, listID string, assignee *string) error {
+	if assignee == nil || *assignee == "" {
+		return nil
+	}
+	ok, err := s.repo.IsCollaborator(ctx, listID, *assignee)
+	if err != nil {
+		log.C(ctx).Errorf("checking assignee %s of list %s failed", *assignee, listID)
+		return err
+	}
+	v.Check(ok, "assigned_to", "must be a collaborator of the list")
 	return nil
 }
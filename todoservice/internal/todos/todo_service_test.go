@@ -2,11 +2,24 @@ package todos_test
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	activityautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/activity/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/customfields"
+	customfieldautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/customfields/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/quickadd"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	statusautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses/automock"
+	tagautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/tags/automock"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos/automock"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/mergepatch"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -35,6 +48,7 @@ func TestServiceCreateTodo(t *testing.T) {
 		Priority:    constants.PriorityLow,
 		CreatedAt:   mockTime,
 		UpdatedAt:   mockTime,
+		Position:    "1",
 	}
 
 	tests := []struct {
@@ -54,6 +68,7 @@ func TestServiceCreateTodo(t *testing.T) {
 			},
 			repo: func() *automock.TodoRepository {
 				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetLastPosition(ctx, "1").Return("", nil).Once()
 				repo.EXPECT().Create(ctx, model).Return(id, nil).Once()
 				return repo
 			},
@@ -74,6 +89,7 @@ func TestServiceCreateTodo(t *testing.T) {
 			},
 			repo: func() *automock.TodoRepository {
 				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetLastPosition(ctx, "1").Return("", nil).Once()
 				repo.EXPECT().Create(ctx, model).Return("", err).Once()
 				return repo
 			},
@@ -93,7 +109,7 @@ func TestServiceCreateTodo(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
 
-			svc := todos.NewService(repo, uuidService, timeService)
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
 			_, err := svc.CreateTodo(ctx, tt.input)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -166,7 +182,7 @@ func TestServiceGetTodo(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
 
-			svc := todos.NewService(repo, uuidService, timeService)
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
 			_, err := svc.GetTodo(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -219,7 +235,7 @@ func TestServiceUpdateTodo(t *testing.T) {
 			repo: func() *automock.TodoRepository {
 				repo := &automock.TodoRepository{}
 				repo.EXPECT().Update(ctx, model).Return(nil).Once()
-				repo.EXPECT().Get(ctx, id).Return(model, nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(model, nil).Twice()
 				return repo
 			},
 			timeService: func() *automock.TimeService {
@@ -256,7 +272,7 @@ func TestServiceUpdateTodo(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
 
-			svc := todos.NewService(repo, uuidService, timeService)
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
 			err := svc.UpdateTodo(ctx, modelInput)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -268,6 +284,290 @@ func TestServiceUpdateTodo(t *testing.T) {
 	}
 }
 
+func TestServiceUpdateTodoCustomFields(t *testing.T) {
+	ctx := context.Background()
+	id := "1"
+	mockTime := time.Time{}
+	stored := models.Todo{ID: id, ListID: "list", Title: "Ship it", Priority: constants.PriorityLow, CustomFields: map[string]interface{}{"points": float64(3), "stage": "todo"}}
+
+	tests := []struct {
+		name          string
+		input         map[string]interface{}
+		validated     map[string]interface{}
+		validateError error
+		expectedError error
+	}{
+		{
+			name:      "Changes are merged into the stored values",
+			input:     map[string]interface{}{"stage": "done", "points": nil},
+			validated: map[string]interface{}{"stage": "done"},
+		},
+		{
+			name:          "Error when a value is invalid",
+			input:         map[string]interface{}{"stage": "doing"},
+			validateError: customfields.ErrInvalidFieldValue,
+			expectedError: customfields.ErrInvalidFieldValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := map[string]interface{}{"points": float64(3), "stage": "todo"}
+			for key, value := range tt.input {
+				merged[key] = value
+			}
+			customFieldService := &customfieldautomock.CustomFieldService{}
+			customFieldService.EXPECT().ValidateValues(ctx, "list", merged).Return(tt.validated, tt.validateError).Once()
+
+			repo := &automock.TodoRepository{}
+			repo.EXPECT().Get(ctx, id).Return(stored, nil)
+			if tt.expectedError == nil {
+				repo.EXPECT().Update(ctx, models.Todo{ID: id, ListID: "list", Title: "Ship it", Priority: constants.PriorityLow, CustomFields: tt.validated}).Return(nil).Once()
+			}
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(mockTime).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo, customFieldService)
+
+			svc := todos.NewService(repo, noopActivity(), customFieldService, noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, timeService)
+			err := svc.UpdateTodo(ctx, models.Todo{ID: id, ListID: "list", Title: "Ship it", CustomFields: tt.input})
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServicePatchTodo(t *testing.T) {
+	ctx := context.Background()
+	id := "1"
+	mockTime := time.Time{}
+	due := time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC)
+	stored := models.Todo{ID: id, ListID: "list", Title: "Ship it", Description: "Before the release", Tags: json.RawMessage(`["work"]`), Priority: constants.PriorityHigh, DueDate: &due, CustomFields: map[string]interface{}{"points": float64(3)}, Version: 2}
+
+	tests := []struct {
+		name           string
+		patch          string
+		repo           func() *automock.TodoRepository
+		expectedError  error
+		expectedFields []validation.FieldError
+	}{
+		{
+			name:  "Only the supplied fields change and null clears",
+			patch: `{"title": "Ship it today", "due_date": null, "custom_fields": {"points": null}}`,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(stored, nil)
+				repo.EXPECT().Update(ctx, models.Todo{ID: id, ListID: "list", Title: "Ship it today", Description: "Before the release", Tags: json.RawMessage(`["work"]`), Priority: constants.PriorityHigh, Version: 2}).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:  "Error when the patch changes read-only or required fields",
+			patch: `{"title": null, "list_id": "other", "description": null}`,
+			repo:  func() *automock.TodoRepository { return &automock.TodoRepository{} },
+			expectedFields: []validation.FieldError{
+				{Field: "list_id", Message: "cannot be changed"},
+				{Field: "title", Message: "cannot be null"},
+			},
+		},
+		{
+			name:          "Error when the patch is not an object",
+			patch:         `["title"]`,
+			repo:          func() *automock.TodoRepository { return &automock.TodoRepository{} },
+			expectedError: mergepatch.ErrInvalidPatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			customFieldService := &customfieldautomock.CustomFieldService{}
+			customFieldService.EXPECT().ValidateValues(ctx, "list", map[string]interface{}{"points": nil}).Return(nil, nil).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(mockTime).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo, customFieldService)
+
+			svc := todos.NewService(repo, noopActivity(), customFieldService, noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, timeService)
+			todo, err := svc.PatchTodo(ctx, id, []byte(tt.patch))
+			switch {
+			case tt.expectedFields != nil:
+				var validationErr *validation.Errors
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.expectedFields, validationErr.Fields)
+			case tt.expectedError != nil:
+				require.ErrorIs(t, err, tt.expectedError)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, stored, todo)
+			}
+		})
+	}
+}
+
+func TestServicePatchTodoCompletion(t *testing.T) {
+	ctx := context.Background()
+	id := "1"
+	now := time.Date(2024, time.March, 20, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2024, time.March, 18, 9, 0, 0, 0, time.UTC)
+	open := models.Todo{ID: id, ListID: "list", Title: "Water the plants", Priority: constants.PriorityLow}
+	recurring := models.Todo{ID: id, ListID: "list", Title: "Water the plants", Priority: constants.PriorityLow, DueDate: &due, Recurrence: &models.Recurrence{Frequency: constants.RecurrenceDaily, Interval: 1}}
+	done := models.Todo{ID: id, ListID: "list", Title: "Water the plants", Priority: constants.PriorityLow, Completed: true}
+	keepsCompletion := func(completed bool) interface{} {
+		return mock.MatchedBy(func(todo models.Todo) bool { return todo.Completed == completed })
+	}
+
+	tests := []struct {
+		name          string
+		patch         string
+		repo          func() *automock.TodoRepository
+		uuidService   func() *automock.UUIDService
+		expectedError error
+	}{
+		{
+			name:  "Completing refuses a todo with open blockers",
+			patch: `{"completed": true}`,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(open, nil)
+				repo.EXPECT().Update(ctx, keepsCompletion(false)).Return(nil).Once()
+				repo.EXPECT().GetBlockedBy(ctx, id).Return([]models.Todo{{ID: "2", Title: "Still open"}}, nil).Once()
+				return repo
+			},
+			uuidService:   func() *automock.UUIDService { return &automock.UUIDService{} },
+			expectedError: todos.ErrTodoBlocked,
+		},
+		{
+			name:  "Completing a recurring todo schedules its next occurrence",
+			patch: `{"completed": true, "title": "Water the plants"}`,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(recurring, nil)
+				repo.EXPECT().Update(ctx, keepsCompletion(false)).Return(nil).Once()
+				repo.EXPECT().GetBlockedBy(ctx, id).Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().CompleteTodo(ctx, id).Return(recurring, nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, "list").Return("5", nil).Once()
+				repo.EXPECT().Create(ctx, mock.MatchedBy(func(next models.Todo) bool { return next.ID == "2" && !next.Completed })).Return("2", nil).Once()
+				return repo
+			},
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return("2").Once()
+				return uuidService
+			},
+		},
+		{
+			name:  "Reopening goes through ReopenTodo",
+			patch: `{"completed": false}`,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(done, nil)
+				repo.EXPECT().Update(ctx, keepsCompletion(true)).Return(nil).Once()
+				repo.EXPECT().ReopenTodo(ctx, id).Return(open, nil).Once()
+				return repo
+			},
+			uuidService: func() *automock.UUIDService { return &automock.UUIDService{} },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := tt.uuidService()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo, uuidService)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
+			_, err := svc.PatchTodo(ctx, id, []byte(tt.patch))
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServiceCreateTodoValidation(t *testing.T) {
+	ctx := context.Background()
+	due := time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC)
+	start := due.AddDate(0, 0, 1)
+	assignee := "user-1"
+
+	tests := []struct {
+		name           string
+		input          models.Todo
+		repo           func() *automock.TodoRepository
+		expectedFields []validation.FieldError
+	}{
+		{
+			name:  "Every invalid field is reported",
+			input: models.Todo{ListID: "list", Title: "  ", Priority: "urgent", DueDate: &due, StartDate: &start, Tags: json.RawMessage(`"work"`)},
+			repo:  func() *automock.TodoRepository { return &automock.TodoRepository{} },
+			expectedFields: []validation.FieldError{
+				{Field: "title", Message: "is required"},
+				{Field: "priority", Message: "must be one of low, medium, high"},
+				{Field: "start_date", Message: "must not be after due_date"},
+				{Field: "tags", Message: "must be an array of names"},
+			},
+		},
+		{
+			name:  "The assignee must be a collaborator",
+			input: models.Todo{ListID: "list", Title: "Pay rent", AssignedTo: &assignee},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().IsCollaborator(ctx, "list", assignee).Return(false, nil).Once()
+				return repo
+			},
+			expectedFields: []validation.FieldError{
+				{Field: "assigned_to", Message: "must be a collaborator of the list"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, &automock.TimeService{})
+			_, err := svc.CreateTodo(ctx, tt.input)
+			require.ErrorIs(t, err, validation.ErrValidation)
+			var validationErr *validation.Errors
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedFields, validationErr.Fields)
+		})
+	}
+}
+
+func TestServiceCheckVersion(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		current       int
+		lockError     error
+		expectedError error
+	}{
+		{name: "The version matches", current: 3},
+		{name: "Error when the todo was changed", current: 4, expectedError: todos.ErrVersionMismatch},
+		{name: "Error when the todo does not exist", lockError: fmt.Errorf("todo not found: %w", sql.ErrNoRows), expectedError: sql.ErrNoRows},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &automock.TodoRepository{}
+			repo.EXPECT().LockVersion(ctx, "1").Return(tt.current, tt.lockError).Once()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, &automock.TimeService{})
+			err := svc.CheckVersion(ctx, "1", 3)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestServiceDeleteTodo(t *testing.T) {
 	id := "1"
 	err := errors.New("error")
@@ -288,6 +588,7 @@ func TestServiceDeleteTodo(t *testing.T) {
 			},
 			repo: func() *automock.TodoRepository {
 				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(models.Todo{ID: id, ListID: "list"}, nil).Once()
 				repo.EXPECT().Delete(ctx, id).Return(nil).Once()
 				return repo
 			},
@@ -305,6 +606,7 @@ func TestServiceDeleteTodo(t *testing.T) {
 			},
 			repo: func() *automock.TodoRepository {
 				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(models.Todo{ID: id, ListID: "list"}, nil).Once()
 				repo.EXPECT().Delete(ctx, id).Return(err).Once()
 				return repo
 			},
@@ -322,7 +624,7 @@ func TestServiceDeleteTodo(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
 
-			svc := todos.NewService(repo, uuidService, timeService)
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
 			err := svc.DeleteTodo(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -396,7 +698,7 @@ func TestServiceListTodosByListID(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
 
-			svc := todos.NewService(repo, uuidService, timeService)
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
 			_, err := svc.ListTodosByListID(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -407,3 +709,1312 @@ func TestServiceListTodosByListID(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceCreateSubtask(t *testing.T) {
+	id := "1"
+	parentID := "parent"
+	mockTime := time.Time{}
+	ctx := context.Background()
+
+	modelInput := models.Todo{
+		Title:    "Test Subtask",
+		ListID:   "1",
+		Priority: constants.PriorityLow,
+		ParentID: &parentID,
+	}
+
+	model := models.Todo{
+		ID:        id,
+		Title:     "Test Subtask",
+		ListID:    "1",
+		Priority:  constants.PriorityLow,
+		ParentID:  &parentID,
+		CreatedAt: mockTime,
+		UpdatedAt: mockTime,
+		Position:  "1",
+	}
+
+	tests := []struct {
+		name          string
+		uuidService   func() *automock.UUIDService
+		repo          func() *automock.TodoRepository
+		timeService   func() *automock.TimeService
+		expectedError error
+	}{
+		{
+			name: "Create subtask in the parent's list",
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(id).Once()
+				return uuidService
+			},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, parentID).Return(models.Todo{ID: parentID, ListID: "1"}, nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, "1").Return("", nil).Once()
+				repo.EXPECT().Create(ctx, model).Return(id, nil).Once()
+				return repo
+			},
+			timeService: func() *automock.TimeService {
+				timeService := &automock.TimeService{}
+				timeService.EXPECT().Now().Return(mockTime).Twice()
+				return timeService
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Error when parent belongs to another list",
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, parentID).Return(models.Todo{ID: parentID, ListID: "2"}, nil).Once()
+				return repo
+			},
+			timeService: func() *automock.TimeService {
+				return &automock.TimeService{}
+			},
+			expectedError: errors.New("subtask must belong to the same list as its parent"),
+		},
+		{
+			name: "Error when parent does not exist",
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, parentID).Return(models.Todo{}, errors.New("todo not found")).Once()
+				return repo
+			},
+			timeService: func() *automock.TimeService {
+				return &automock.TimeService{}
+			},
+			expectedError: errors.New("todo not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuidService := tt.uuidService()
+			repo := tt.repo()
+			timeService := tt.timeService()
+			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
+			_, err := svc.CreateTodo(ctx, modelInput)
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServiceCompleteTodoWithSubtasks(t *testing.T) {
+	id := "1"
+	childID := "2"
+	doneChildID := "3"
+	grandchildID := "4"
+	err := errors.New("error")
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 20, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2024, time.March, 18, 9, 0, 0, 0, time.UTC)
+
+	parent := models.Todo{ID: id, ListID: "list", Title: "Move house"}
+	child := models.Todo{ID: childID, ListID: "list", Title: "Pack", ParentID: &id}
+	doneChild := models.Todo{ID: doneChildID, ListID: "list", Title: "Book van", ParentID: &id, Completed: true}
+	grandchild := models.Todo{ID: grandchildID, ListID: "list", Title: "Buy boxes", ParentID: &childID, DueDate: &due, Recurrence: &models.Recurrence{Frequency: constants.RecurrenceDaily, Interval: 1}}
+	completed := func(todo models.Todo) models.Todo {
+		todo.Completed = true
+		return todo
+	}
+	expectCompletion := func(repo *automock.TodoRepository, todo models.Todo) {
+		repo.EXPECT().Get(ctx, todo.ID).Return(todo, nil).Twice()
+		repo.EXPECT().GetBlockedBy(ctx, todo.ID).Return([]models.Todo{}, nil).Once()
+		repo.EXPECT().CompleteTodo(ctx, todo.ID).Return(completed(todo), nil).Once()
+	}
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.TodoRepository
+		activity      func() *activityautomock.ActivityService
+		expectedTodo  models.Todo
+		expectedError error
+	}{
+		{
+			name: "Complete every open descendant and record each completion",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetSubtasks(ctx, id).Return([]models.Todo{child, doneChild}, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, childID).Return([]models.Todo{grandchild}, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, grandchildID).Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, doneChildID).Return([]models.Todo{}, nil).Once()
+				expectCompletion(repo, grandchild)
+				repo.EXPECT().Get(ctx, childID).Return(child, nil).Once()
+				expectCompletion(repo, child)
+				repo.EXPECT().Get(ctx, doneChildID).Return(doneChild, nil).Once()
+				expectCompletion(repo, parent)
+				repo.EXPECT().GetLastPosition(ctx, "list").Return("5", nil).Once()
+				repo.EXPECT().Create(ctx, mock.MatchedBy(func(next models.Todo) bool {
+					return next.ID == "5" && *next.ParentID == childID && !next.Completed
+				})).Return("5", nil).Once()
+				return repo
+			},
+			activity: func() *activityautomock.ActivityService {
+				activity := &activityautomock.ActivityService{}
+				activity.EXPECT().Record(ctx, constants.ActivityTodoCompleted, "list", mock.Anything, grandchild, completed(grandchild)).Return(nil).Once()
+				activity.EXPECT().Record(ctx, constants.ActivityTodoCreated, "list", mock.Anything, nil, mock.Anything).Return(nil).Once()
+				activity.EXPECT().Record(ctx, constants.ActivityTodoCompleted, "list", mock.Anything, child, completed(child)).Return(nil).Once()
+				activity.EXPECT().Record(ctx, constants.ActivityTodoCompleted, "list", mock.Anything, parent, completed(parent)).Return(nil).Once()
+				return activity
+			},
+			expectedTodo:  completed(parent),
+			expectedError: nil,
+		},
+		{
+			name: "Error when a subtask is blocked by an open todo",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetSubtasks(ctx, id).Return([]models.Todo{child}, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, childID).Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().Get(ctx, childID).Return(child, nil).Once()
+				repo.EXPECT().GetBlockedBy(ctx, childID).Return([]models.Todo{{ID: "9", Title: "Sign lease"}}, nil).Once()
+				return repo
+			},
+			activity:      noopActivity,
+			expectedTodo:  models.Todo{},
+			expectedError: todos.ErrTodoBlocked,
+		},
+		{
+			name: "Error when getting subtasks fails",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetSubtasks(ctx, id).Return(nil, err).Once()
+				return repo
+			},
+			activity:      noopActivity,
+			expectedTodo:  models.Todo{},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			activity := tt.activity()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return("5").Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo, activity)
+
+			svc := todos.NewService(repo, activity, noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
+			todo, err := svc.CompleteTodoWithSubtasks(ctx, id)
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedTodo, todo)
+		})
+	}
+}
+
+func TestServiceCompleteRecurringTodo(t *testing.T) {
+	id := "1"
+	nextID := "2"
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 20, 9, 0, 0, 0, time.UTC)
+
+	date := func(year int, month time.Month, day int) *time.Time {
+		d := time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+		return &d
+	}
+
+	tests := []struct {
+		name          string
+		todo          models.Todo
+		expectedDue   time.Time
+		expectedStart time.Time
+	}{
+		{
+			name: "Daily rule shifts both dates",
+			todo: models.Todo{
+				ID:         id,
+				ListID:     "list",
+				Title:      "Water the plants",
+				DueDate:    date(2024, time.March, 18),
+				StartDate:  date(2024, time.March, 17),
+				Recurrence: &models.Recurrence{Frequency: constants.RecurrenceDaily, Interval: 2},
+			},
+			expectedDue:   *date(2024, time.March, 20),
+			expectedStart: *date(2024, time.March, 19),
+		},
+		{
+			name: "Weekly rule picks the next listed weekday",
+			todo: models.Todo{
+				ID:      id,
+				ListID:  "list",
+				Title:   "Water the plants",
+				DueDate: date(2024, time.March, 18),
+				Recurrence: &models.Recurrence{
+					Frequency: constants.RecurrenceWeekly,
+					Interval:  1,
+					Weekdays:  []time.Weekday{time.Monday, time.Thursday},
+				},
+			},
+			expectedDue: *date(2024, time.March, 21),
+		},
+		{
+			name: "Biweekly rule skips the off week",
+			todo: models.Todo{
+				ID:      id,
+				ListID:  "list",
+				Title:   "Water the plants",
+				DueDate: date(2024, time.March, 21),
+				Recurrence: &models.Recurrence{
+					Frequency: constants.RecurrenceWeekly,
+					Interval:  2,
+					Weekdays:  []time.Weekday{time.Monday, time.Thursday},
+				},
+			},
+			expectedDue: *date(2024, time.April, 1),
+		},
+		{
+			name: "Monthly rule clamps to the end of shorter months",
+			todo: models.Todo{
+				ID:         id,
+				ListID:     "list",
+				Title:      "Water the plants",
+				DueDate:    date(2024, time.January, 31),
+				Recurrence: &models.Recurrence{Frequency: constants.RecurrenceMonthly, Interval: 1, DayOfMonth: 31},
+			},
+			expectedDue: *date(2024, time.February, 29),
+		},
+		{
+			name: "After completion rule counts from the completion date",
+			todo: models.Todo{
+				ID:         id,
+				ListID:     "list",
+				Title:      "Water the plants",
+				DueDate:    date(2024, time.March, 1),
+				Recurrence: &models.Recurrence{Frequency: constants.RecurrenceAfterCompletion, Interval: 3},
+			},
+			expectedDue: now.AddDate(0, 0, 3),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &automock.TodoRepository{}
+			uuidService := &automock.UUIDService{}
+			timeService := &automock.TimeService{}
+			defer mock.AssertExpectationsForObjects(t, repo, uuidService)
+
+			repo.EXPECT().GetBlockedBy(ctx, id).Return([]models.Todo{}, nil).Once()
+			repo.EXPECT().Get(ctx, id).Return(tt.todo, nil).Twice()
+			repo.EXPECT().CompleteTodo(ctx, id).Return(tt.todo, nil).Once()
+			repo.EXPECT().GetLastPosition(ctx, tt.todo.ListID).Return("5", nil).Once()
+			repo.EXPECT().Create(ctx, mock.MatchedBy(func(next models.Todo) bool {
+				return next.ID == nextID &&
+					next.ListID == tt.todo.ListID &&
+					!next.Completed &&
+					next.Recurrence == tt.todo.Recurrence &&
+					next.Position == "6" &&
+					next.DueDate.Equal(tt.expectedDue) &&
+					next.StartDate.Equal(tt.expectedStart)
+			})).Return(nextID, nil).Once()
+			uuidService.EXPECT().Generate().Return(nextID).Once()
+			timeService.EXPECT().Now().Return(now)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
+			todo, err := svc.CompleteTodo(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, tt.todo, todo)
+		})
+	}
+}
+
+func TestServiceCompleteRecurringTodoTwice(t *testing.T) {
+	id := "1"
+	ctx := context.Background()
+	now := time.Date(2024, time.March, 20, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2024, time.March, 18, 9, 0, 0, 0, time.UTC)
+	open := models.Todo{ID: id, ListID: "list", Title: "Water the plants", DueDate: &due, Recurrence: &models.Recurrence{Frequency: constants.RecurrenceDaily, Interval: 1}}
+	completed := open
+	completed.Completed = true
+
+	repo := &automock.TodoRepository{}
+	uuidService := &automock.UUIDService{}
+	timeService := &automock.TimeService{}
+	defer mock.AssertExpectationsForObjects(t, repo, uuidService)
+
+	repo.EXPECT().Get(ctx, id).Return(open, nil).Twice()
+	repo.EXPECT().Get(ctx, id).Return(completed, nil).Once()
+	repo.EXPECT().GetBlockedBy(ctx, id).Return([]models.Todo{}, nil).Once()
+	repo.EXPECT().CompleteTodo(ctx, id).Return(completed, nil).Once()
+	repo.EXPECT().GetLastPosition(ctx, "list").Return("5", nil).Once()
+	repo.EXPECT().Create(ctx, mock.Anything).Return("2", nil).Once()
+	uuidService.EXPECT().Generate().Return("2").Once()
+	timeService.EXPECT().Now().Return(now)
+
+	svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
+	first, err := svc.CompleteTodo(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, completed, first)
+	second, err := svc.CompleteTodo(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, completed, second)
+}
+
+func TestServiceTransitionTodo(t *testing.T) {
+	id := "1"
+	listID := "list"
+	err := errors.New("error")
+	ctx := context.Background()
+
+	open := models.Todo{ID: id, ListID: listID, Title: "Test Todo"}
+	completed := models.Todo{ID: id, ListID: listID, Title: "Test Todo", Completed: true}
+	doing := models.WorkflowStatus{ID: "doing", ListID: listID, Category: constants.StatusCategoryOpen}
+	done := models.WorkflowStatus{ID: "done", ListID: listID, Category: constants.StatusCategoryDone}
+
+	tests := []struct {
+		name          string
+		statusID      string
+		repo          func() *automock.TodoRepository
+		statuses      func() *statusautomock.StatusService
+		expectedTodo  models.Todo
+		expectedError error
+	}{
+		{
+			name:     "Move an open todo to another open status",
+			statusID: doing.ID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(open, nil).Twice()
+				repo.EXPECT().UpdateTodoStatus(ctx, id, doing.ID, false).Return(open, nil).Once()
+				return repo
+			},
+			statuses: func() *statusautomock.StatusService {
+				statusService := &statusautomock.StatusService{}
+				statusService.EXPECT().GetStatus(ctx, doing.ID, listID).Return(doing, nil).Once()
+				return statusService
+			},
+			expectedTodo: open,
+		},
+		{
+			name:     "Moving an open todo to a done status completes it",
+			statusID: done.ID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(open, nil).Twice()
+				repo.EXPECT().GetBlockedBy(ctx, id).Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().UpdateTodoStatus(ctx, id, done.ID, true).Return(completed, nil).Once()
+				return repo
+			},
+			statuses: func() *statusautomock.StatusService {
+				statusService := &statusautomock.StatusService{}
+				statusService.EXPECT().GetStatus(ctx, done.ID, listID).Return(done, nil).Once()
+				return statusService
+			},
+			expectedTodo: completed,
+		},
+		{
+			name:     "Moving a completed todo to an open status reopens it",
+			statusID: doing.ID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(completed, nil).Twice()
+				repo.EXPECT().UpdateTodoStatus(ctx, id, doing.ID, false).Return(open, nil).Once()
+				return repo
+			},
+			statuses: func() *statusautomock.StatusService {
+				statusService := &statusautomock.StatusService{}
+				statusService.EXPECT().GetStatus(ctx, doing.ID, listID).Return(doing, nil).Once()
+				return statusService
+			},
+			expectedTodo: open,
+		},
+		{
+			name:     "Error when the todo is blocked",
+			statusID: done.ID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(open, nil).Once()
+				repo.EXPECT().GetBlockedBy(ctx, id).Return([]models.Todo{{ID: "2", Title: "Blocker"}}, nil).Once()
+				return repo
+			},
+			statuses: func() *statusautomock.StatusService {
+				statusService := &statusautomock.StatusService{}
+				statusService.EXPECT().GetStatus(ctx, done.ID, listID).Return(done, nil).Once()
+				return statusService
+			},
+			expectedError: todos.ErrTodoBlocked,
+		},
+		{
+			name:     "Error when the status is not in the list of the todo",
+			statusID: "other",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(open, nil).Once()
+				return repo
+			},
+			statuses: func() *statusautomock.StatusService {
+				statusService := &statusautomock.StatusService{}
+				statusService.EXPECT().GetStatus(ctx, "other", listID).Return(models.WorkflowStatus{}, statuses.ErrStatusNotFound).Once()
+				return statusService
+			},
+			expectedError: statuses.ErrStatusNotFound,
+		},
+		{
+			name:     "Error when getting the todo fails",
+			statusID: doing.ID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(models.Todo{}, err).Once()
+				return repo
+			},
+			statuses:      func() *statusautomock.StatusService { return &statusautomock.StatusService{} },
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			statusService := tt.statuses()
+			defer mock.AssertExpectationsForObjects(t, repo, statusService)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), statusService, &automock.UUIDService{}, &automock.TimeService{})
+			todo, err := svc.TransitionTodo(ctx, id, tt.statusID)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTodo, todo)
+			}
+		})
+	}
+}
+
+func TestServiceAddDependency(t *testing.T) {
+	todoID := "1"
+	blockerID := "2"
+	err := errors.New("error")
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		todoID        string
+		blockedByID   string
+		repo          func() *automock.TodoRepository
+		expectedError error
+	}{
+		{
+			name:        "Add dependency",
+			todoID:      todoID,
+			blockedByID: blockerID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().DependsOn(ctx, blockerID, todoID).Return(false, nil).Once()
+				repo.EXPECT().AddDependency(ctx, todoID, blockerID).Return(nil).Once()
+				repo.EXPECT().Get(ctx, todoID).Return(models.Todo{ID: todoID, ListID: "list"}, nil).Once()
+				return repo
+			},
+			expectedError: nil,
+		},
+		{
+			name:        "Reject todo blocking itself",
+			todoID:      todoID,
+			blockedByID: todoID,
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			expectedError: todos.ErrDependencyCycle,
+		},
+		{
+			name:        "Reject dependency that closes a cycle",
+			todoID:      todoID,
+			blockedByID: blockerID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().DependsOn(ctx, blockerID, todoID).Return(true, nil).Once()
+				return repo
+			},
+			expectedError: todos.ErrDependencyCycle,
+		},
+		{
+			name:        "Error when checking the dependency path fails",
+			todoID:      todoID,
+			blockedByID: blockerID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().DependsOn(ctx, blockerID, todoID).Return(false, err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, &automock.TimeService{})
+			err := svc.AddDependency(ctx, tt.todoID, tt.blockedByID)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServiceCompleteBlockedTodo(t *testing.T) {
+	id := "1"
+	ctx := context.Background()
+
+	repo := &automock.TodoRepository{}
+	defer mock.AssertExpectationsForObjects(t, repo)
+	repo.EXPECT().Get(ctx, id).Return(models.Todo{ID: id, Title: "Blocked"}, nil).Once()
+	repo.EXPECT().GetBlockedBy(ctx, id).Return([]models.Todo{
+		{ID: "2", Title: "Done first", Completed: true},
+		{ID: "3", Title: "Still open", Completed: false},
+	}, nil).Once()
+
+	svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, &automock.TimeService{})
+	todo, err := svc.CompleteTodo(ctx, id)
+	require.ErrorIs(t, err, todos.ErrTodoBlocked)
+	assert.Contains(t, err.Error(), "Still open")
+	assert.Equal(t, models.Todo{}, todo)
+}
+
+func TestServiceReorderTodo(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+
+	moved := models.Todo{ID: "1", ListID: "list", Position: "1"}
+	target := models.Todo{ID: "2", ListID: "list", Position: "5"}
+
+	tests := []struct {
+		name             string
+		beforeID         string
+		afterID          string
+		repo             func() *automock.TodoRepository
+		expectedPosition string
+		expectedError    error
+	}{
+		{
+			name:     "Move todo before another todo",
+			beforeID: target.ID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, moved.ID).Return(moved, nil).Once()
+				repo.EXPECT().Get(ctx, target.ID).Return(target, nil).Once()
+				repo.EXPECT().GetPositionBefore(ctx, "list", "5").Return("3", nil).Once()
+				repo.EXPECT().UpdatePosition(ctx, moved.ID, "4").Return(nil).Once()
+				return repo
+			},
+			expectedPosition: "4",
+		},
+		{
+			name:    "Move todo after the last todo",
+			afterID: target.ID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, moved.ID).Return(moved, nil).Once()
+				repo.EXPECT().Get(ctx, target.ID).Return(target, nil).Once()
+				repo.EXPECT().GetPositionAfter(ctx, "list", "5").Return("", nil).Once()
+				repo.EXPECT().UpdatePosition(ctx, moved.ID, "6").Return(nil).Once()
+				return repo
+			},
+			expectedPosition: "6",
+		},
+		{
+			name:     "Error when both before and after are given",
+			beforeID: target.ID,
+			afterID:  target.ID,
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			expectedError: todos.ErrInvalidReorder,
+		},
+		{
+			name:     "Error when target is in another list",
+			beforeID: target.ID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, moved.ID).Return(moved, nil).Once()
+				repo.EXPECT().Get(ctx, target.ID).Return(models.Todo{ID: target.ID, ListID: "other"}, nil).Once()
+				return repo
+			},
+			expectedError: todos.ErrInvalidReorder,
+		},
+		{
+			name:     "Error when updating the position fails",
+			beforeID: target.ID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, moved.ID).Return(moved, nil).Once()
+				repo.EXPECT().Get(ctx, target.ID).Return(target, nil).Once()
+				repo.EXPECT().GetPositionBefore(ctx, "list", "5").Return("3", nil).Once()
+				repo.EXPECT().UpdatePosition(ctx, moved.ID, "4").Return(err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, &automock.TimeService{})
+			todo, err := svc.ReorderTodo(ctx, moved.ID, tt.beforeID, tt.afterID)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPosition, todo.Position)
+			}
+		})
+	}
+}
+
+func TestServiceMoveTodoToList(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+
+	todo := models.Todo{ID: "1", ListID: "source", Position: "3", Tags: json.RawMessage(`["home"]`)}
+	moved := models.Todo{ID: "1", ListID: "target", Position: "6", Tags: json.RawMessage(`["home"]`)}
+	subtask := models.Todo{ID: "2", ListID: "source", ParentID: &todo.ID, Tags: json.RawMessage(`["urgent"]`)}
+
+	tests := []struct {
+		name          string
+		listID        string
+		repo          func() *automock.TodoRepository
+		tags          func() *tagautomock.TagService
+		expectedTodo  models.Todo
+		expectedError error
+	}{
+		{
+			name:   "Move todo to the end of another list",
+			listID: "target",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, todo.ID).Return(todo, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, todo.ID).Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, "target").Return("5", nil).Once()
+				repo.EXPECT().MoveToList(ctx, todo.ID, "target", "6").Return(nil).Once()
+				repo.EXPECT().Get(ctx, todo.ID).Return(moved, nil).Once()
+				return repo
+			},
+			tags: func() *tagautomock.TagService {
+				tagService := &tagautomock.TagService{}
+				tagService.EXPECT().SetTodoTags(ctx, todo.ID, "target", []string{"home"}).Return(nil).Once()
+				return tagService
+			},
+			expectedTodo: moved,
+		},
+		{
+			name:   "Move todo keeps the tags of its subtasks",
+			listID: "target",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, todo.ID).Return(todo, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, todo.ID).Return([]models.Todo{subtask}, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, subtask.ID).Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, "target").Return("5", nil).Once()
+				repo.EXPECT().MoveToList(ctx, todo.ID, "target", "6").Return(nil).Once()
+				repo.EXPECT().Get(ctx, todo.ID).Return(moved, nil).Once()
+				return repo
+			},
+			tags: func() *tagautomock.TagService {
+				tagService := &tagautomock.TagService{}
+				tagService.EXPECT().SetTodoTags(ctx, todo.ID, "target", []string{"home"}).Return(nil).Once()
+				tagService.EXPECT().SetTodoTags(ctx, subtask.ID, "target", []string{"urgent"}).Return(nil).Once()
+				return tagService
+			},
+			expectedTodo: moved,
+		},
+		{
+			name:   "Moving to the same list is a no-op",
+			listID: "source",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, todo.ID).Return(todo, nil).Once()
+				return repo
+			},
+			tags:         noopTags,
+			expectedTodo: todo,
+		},
+		{
+			name:   "Error when moving fails",
+			listID: "target",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, todo.ID).Return(todo, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, todo.ID).Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, "target").Return("", nil).Once()
+				repo.EXPECT().MoveToList(ctx, todo.ID, "target", "1").Return(err).Once()
+				return repo
+			},
+			tags:          noopTags,
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			tagService := tt.tags()
+			defer mock.AssertExpectationsForObjects(t, repo, tagService)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), tagService, &statusautomock.StatusService{}, &automock.UUIDService{}, &automock.TimeService{})
+			result, err := svc.MoveTodoToList(ctx, todo.ID, tt.listID)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTodo, result)
+			}
+		})
+	}
+}
+
+func TestServiceQuickAddTodo(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.October, 14, 9, 30, 0, 0, time.UTC)
+	due := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	assignee := "user-1"
+
+	expected := models.Todo{
+		ID:         "1",
+		ListID:     "list",
+		Title:      "Pay rent",
+		Priority:   constants.PriorityHigh,
+		Tags:       json.RawMessage(`["finance"]`),
+		DueDate:    &due,
+		StartDate:  &time.Time{},
+		AssignedTo: &assignee,
+		Recurrence: &models.Recurrence{Frequency: constants.RecurrenceMonthly, Interval: 1, DayOfMonth: 1},
+		Position:   "1",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	tests := []struct {
+		name          string
+		text          string
+		repo          func() *automock.TodoRepository
+		expectedTodo  models.Todo
+		expectedError error
+	}{
+		{
+			name: "Create todo from parsed text",
+			text: "Pay rent every month on the 1st p1 #finance @alice",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().FindCollaborator(ctx, "list", "alice").Return(assignee, nil).Once()
+				repo.EXPECT().IsCollaborator(ctx, "list", assignee).Return(true, nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, "list").Return("", nil).Once()
+				repo.EXPECT().Create(ctx, expected).Return(expected.ID, nil).Once()
+				repo.EXPECT().Get(ctx, expected.ID).Return(expected, nil).Once()
+				return repo
+			},
+			expectedTodo: expected,
+		},
+		{
+			name: "Error when the assignee is not a collaborator",
+			text: "Pay rent @bob",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().FindCollaborator(ctx, "list", "bob").Return("", fmt.Errorf("failed to find list collaborator: %w", sql.ErrNoRows)).Once()
+				return repo
+			},
+			expectedError: todos.ErrUnknownAssignee,
+		},
+		{
+			name: "Error when the text has no title",
+			text: "tomorrow p1",
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			expectedError: quickadd.ErrEmptyTitle,
+		},
+		{
+			name: "Error when creating fails",
+			text: "Pay rent",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetLastPosition(ctx, "list").Return("", err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(expected.ID).Maybe()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now)
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, uuidService, timeService)
+			result, err := svc.QuickAddTodo(ctx, "list", tt.text)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTodo, result)
+			}
+		})
+	}
+}
+
+func TestServiceBulkUpdateTodos(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+
+	tests := []struct {
+		name          string
+		operation     models.BulkOperation
+		repo          func() *automock.TodoRepository
+		expectedTodos []models.Todo
+		expectedError error
+	}{
+		{
+			name:      "Set priority of every todo once",
+			operation: models.BulkOperation{IDs: []string{"1", "2", "1"}, Action: constants.BulkSetPriority, Priority: constants.PriorityHigh},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(models.Todo{ID: "1", Priority: constants.PriorityLow}, nil).Once()
+				repo.EXPECT().Get(ctx, "2").Return(models.Todo{ID: "2", Priority: constants.PriorityLow}, nil).Once()
+				repo.EXPECT().UpdateTodoPriority(ctx, "1", constants.PriorityHigh).Return(models.Todo{ID: "1", Priority: constants.PriorityHigh}, nil).Once()
+				repo.EXPECT().UpdateTodoPriority(ctx, "2", constants.PriorityHigh).Return(models.Todo{ID: "2", Priority: constants.PriorityHigh}, nil).Once()
+				return repo
+			},
+			expectedTodos: []models.Todo{
+				{ID: "1", Priority: constants.PriorityHigh},
+				{ID: "2", Priority: constants.PriorityHigh},
+			},
+		},
+		{
+			name:      "Deleted todos are not returned",
+			operation: models.BulkOperation{IDs: []string{"1", "2"}, Action: constants.BulkDelete},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetSubtasks(ctx, "1").Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, "2").Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(models.Todo{ID: "1"}, nil).Once()
+				repo.EXPECT().Get(ctx, "2").Return(models.Todo{ID: "2"}, nil).Once()
+				repo.EXPECT().Delete(ctx, "1").Return(nil).Once()
+				repo.EXPECT().Delete(ctx, "2").Return(nil).Once()
+				return repo
+			},
+			expectedTodos: []models.Todo{},
+		},
+		{
+			name:      "Subtasks trashed with their parent are not deleted again",
+			operation: models.BulkOperation{IDs: []string{"1", "2", "3"}, Action: constants.BulkDelete},
+			repo: func() *automock.TodoRepository {
+				parentID := "1"
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetSubtasks(ctx, "1").Return([]models.Todo{{ID: "2", ParentID: &parentID}}, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, "2").Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().GetSubtasks(ctx, "3").Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(models.Todo{ID: "1"}, nil).Once()
+				repo.EXPECT().Get(ctx, "3").Return(models.Todo{ID: "3"}, nil).Once()
+				repo.EXPECT().Delete(ctx, "1").Return(nil).Once()
+				repo.EXPECT().Delete(ctx, "3").Return(nil).Once()
+				return repo
+			},
+			expectedTodos: []models.Todo{},
+		},
+		{
+			name:      "Stop at the first failing todo",
+			operation: models.BulkOperation{IDs: []string{"1", "2"}, Action: constants.BulkDelete},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetSubtasks(ctx, "1").Return([]models.Todo{}, nil).Once()
+				repo.EXPECT().Get(ctx, "1").Return(models.Todo{ID: "1"}, nil).Once()
+				repo.EXPECT().Delete(ctx, "1").Return(err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+		{
+			name:      "Error for unknown action",
+			operation: models.BulkOperation{IDs: []string{"1"}, Action: "archive"},
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			expectedError: todos.ErrInvalidBulk,
+		},
+		{
+			name:      "Error for move without target list",
+			operation: models.BulkOperation{IDs: []string{"1"}, Action: constants.BulkMove},
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			expectedError: todos.ErrInvalidBulk,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, &automock.TimeService{})
+			result, err := svc.BulkUpdateTodos(ctx, tt.operation)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTodos, result)
+			}
+		})
+	}
+}
+
+func TestServiceFilterTodos(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	completed := false
+
+	first := models.Todo{ID: "1", ListID: "list", Position: "1"}
+	second := models.Todo{ID: "2", ListID: "list", Position: "2"}
+	third := models.Todo{ID: "3", ListID: "list", Position: "3"}
+
+	tests := []struct {
+		name               string
+		filter             models.TodoFilter
+		repo               func() *automock.TodoRepository
+		expectedTodos      []models.Todo
+		expectedNextCursor string
+		expectedError      error
+	}{
+		{
+			name:   "Todos of a list are ordered by position",
+			filter: models.TodoFilter{ListID: "list", Completed: &completed},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetFiltered(ctx, models.TodoFilter{ListID: "list", Completed: &completed, Page: models.Page{Sort: constants.SortPosition}}).
+					Return([]models.Todo{first, second}, nil).Once()
+				return repo
+			},
+			expectedTodos: []models.Todo{first, second},
+		},
+		{
+			name:   "Cursor of the next page is returned when more todos follow",
+			filter: models.TodoFilter{ListID: "list", Page: models.Page{Limit: 2}},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetFiltered(ctx, models.TodoFilter{ListID: "list", Page: models.Page{Sort: constants.SortPosition, Limit: 3}}).
+					Return([]models.Todo{first, second, third}, nil).Once()
+				return repo
+			},
+			expectedTodos:      []models.Todo{first, second},
+			expectedNextCursor: converters.CursorToString(models.Cursor{Value: "2", ID: "2"}),
+		},
+		{
+			name:   "No cursor on the last page",
+			filter: models.TodoFilter{Page: models.Page{Sort: constants.SortPriority, Limit: 2}},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetFiltered(ctx, models.TodoFilter{Page: models.Page{Sort: constants.SortPriority, Limit: 3}}).
+					Return([]models.Todo{third}, nil).Once()
+				return repo
+			},
+			expectedTodos: []models.Todo{third},
+		},
+		{
+			name:   "Error from the repository",
+			filter: models.TodoFilter{},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetFiltered(ctx, models.TodoFilter{Page: models.Page{Sort: constants.SortCreatedAt}}).
+					Return(nil, err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, &automock.TimeService{})
+			result, nextCursor, err := svc.FilterTodos(ctx, tt.filter)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedTodos, result)
+				assert.Equal(t, tt.expectedNextCursor, nextCursor)
+			}
+		})
+	}
+}
+
+func TestServiceSmartView(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	userID := "user"
+	sofia, loadErr := time.LoadLocation("Europe/Sofia")
+	require.NoError(t, loadErr)
+	// 22:30 UTC is already the next day in Sofia.
+	now := time.Date(2026, time.October, 18, 22, 30, 0, 0, time.UTC)
+	completed := false
+	hasDueDate := true
+	noDueDate := false
+
+	at := func(year int, month time.Month, day, hour int, location *time.Location) *time.Time {
+		t := time.Date(year, month, day, hour, 0, 0, 0, location)
+		return &t
+	}
+	date := func(value string) *string { return &value }
+
+	morning := models.Todo{ID: "1", DueDate: at(2026, time.October, 19, 6, time.UTC)}
+	evening := models.Todo{ID: "2", DueDate: at(2026, time.October, 19, 20, time.UTC)}
+	later := models.Todo{ID: "3", DueDate: at(2026, time.October, 22, 10, time.UTC)}
+	undated := models.Todo{ID: "4"}
+
+	tests := []struct {
+		name          string
+		view          constants.SmartView
+		timeZone      string
+		days          int
+		repo          func() *automock.TodoRepository
+		expected      models.SmartView
+		expectedError error
+	}{
+		{
+			name:     "Today in the time zone of the user",
+			view:     constants.SmartViewToday,
+			timeZone: "Europe/Sofia",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetFiltered(ctx, models.TodoFilter{
+					AccessibleBy: userID,
+					Completed:    &completed,
+					HasDueDate:   &hasDueDate,
+					DueFrom:      at(2026, time.October, 19, 0, sofia),
+					DueBefore:    at(2026, time.October, 20, 0, sofia),
+					Page:         models.Page{Sort: constants.SortDueDate},
+				}).Return([]models.Todo{morning, evening}, nil).Once()
+				return repo
+			},
+			expected: models.SmartView{View: constants.SmartViewToday, TimeZone: "Europe/Sofia", Groups: []models.TodoDateGroup{
+				{Date: date("2026-10-19"), Todos: []models.Todo{morning, evening}},
+			}},
+		},
+		{
+			name: "Upcoming days are grouped by date",
+			view: constants.SmartViewUpcoming,
+			days: 3,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetFiltered(ctx, models.TodoFilter{
+					AccessibleBy: userID,
+					Completed:    &completed,
+					HasDueDate:   &hasDueDate,
+					DueFrom:      at(2026, time.October, 19, 0, time.UTC),
+					DueBefore:    at(2026, time.October, 22, 0, time.UTC),
+					Page:         models.Page{Sort: constants.SortDueDate},
+				}).Return([]models.Todo{morning, evening, later}, nil).Once()
+				return repo
+			},
+			expected: models.SmartView{View: constants.SmartViewUpcoming, TimeZone: "UTC", Groups: []models.TodoDateGroup{
+				{Date: date("2026-10-19"), Todos: []models.Todo{morning, evening}},
+				{Date: date("2026-10-22"), Todos: []models.Todo{later}},
+			}},
+		},
+		{
+			name:     "Overdue todos are due before today",
+			view:     constants.SmartViewOverdue,
+			timeZone: "Europe/Sofia",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetFiltered(ctx, models.TodoFilter{
+					AccessibleBy: userID,
+					Completed:    &completed,
+					HasDueDate:   &hasDueDate,
+					DueBefore:    at(2026, time.October, 19, 0, sofia),
+					Page:         models.Page{Sort: constants.SortDueDate},
+				}).Return([]models.Todo{}, nil).Once()
+				return repo
+			},
+			expected: models.SmartView{View: constants.SmartViewOverdue, TimeZone: "Europe/Sofia", Groups: []models.TodoDateGroup{}},
+		},
+		{
+			name: "Inbox holds the todos without a due date",
+			view: constants.SmartViewInbox,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetFiltered(ctx, models.TodoFilter{
+					AccessibleBy: userID,
+					Completed:    &completed,
+					HasDueDate:   &noDueDate,
+					Page:         models.Page{Sort: constants.SortCreatedAt},
+				}).Return([]models.Todo{undated}, nil).Once()
+				return repo
+			},
+			expected: models.SmartView{View: constants.SmartViewInbox, TimeZone: "UTC", Groups: []models.TodoDateGroup{
+				{Todos: []models.Todo{undated}},
+			}},
+		},
+		{
+			name:          "Error when the time zone is unknown",
+			view:          constants.SmartViewToday,
+			timeZone:      "Mars/Olympus",
+			repo:          func() *automock.TodoRepository { return &automock.TodoRepository{} },
+			expectedError: todos.ErrInvalidView,
+		},
+		{
+			name:          "Error when the upcoming view is too long",
+			view:          constants.SmartViewUpcoming,
+			days:          365,
+			repo:          func() *automock.TodoRepository { return &automock.TodoRepository{} },
+			expectedError: todos.ErrInvalidView,
+		},
+		{
+			name:          "Error when the view is unknown",
+			view:          "someday",
+			repo:          func() *automock.TodoRepository { return &automock.TodoRepository{} },
+			expectedError: todos.ErrInvalidView,
+		},
+		{
+			name: "Error from the repository",
+			view: constants.SmartViewOverdue,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().GetFiltered(ctx, mock.Anything).Return(nil, err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, timeService)
+			result, err := svc.SmartView(ctx, userID, tt.view, tt.timeZone, tt.days)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateTodoTitleRecordsActivity(t *testing.T) {
+	ctx := context.Background()
+	id := "1"
+	before := models.Todo{ID: id, ListID: "list", Title: "Old"}
+	after := models.Todo{ID: id, ListID: "list", Title: "New"}
+
+	repo := &automock.TodoRepository{}
+	repo.EXPECT().Get(ctx, id).Return(before, nil).Once()
+	repo.EXPECT().UpdateTodoTitle(ctx, id, "New").Return(after, nil).Once()
+	activityService := &activityautomock.ActivityService{}
+	activityService.EXPECT().Record(ctx, constants.ActivityTodoUpdated, "list", &id, before, after).Return(nil).Once()
+	defer mock.AssertExpectationsForObjects(t, repo, activityService)
+
+	svc := todos.NewService(repo, activityService, noopCustomFields(), noopTags(), &statusautomock.StatusService{}, &automock.UUIDService{}, &automock.TimeService{})
+	todo, err := svc.UpdateTodoTitle(ctx, id, "New")
+	require.NoError(t, err)
+	assert.Equal(t, after, todo)
+}
+
+func TestServiceBulkUpdateTodoTags(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+
+	tests := []struct {
+		name          string
+		operation     models.BulkOperation
+		current       json.RawMessage
+		expectedTags  []string
+		tagsErr       error
+		expectedError error
+	}{
+		{
+			name:         "Add tags without duplicating existing ones",
+			operation:    models.BulkOperation{IDs: []string{"1"}, Action: constants.BulkAddTags, Tags: []string{"work", "urgent"}},
+			current:      json.RawMessage(`["Work"]`),
+			expectedTags: []string{"Work", "urgent"},
+		},
+		{
+			name:         "Remove tags",
+			operation:    models.BulkOperation{IDs: []string{"1"}, Action: constants.BulkRemoveTags, Tags: []string{"WORK"}},
+			current:      json.RawMessage(`["home","work"]`),
+			expectedTags: []string{"home"},
+		},
+		{
+			name:          "Error when setting the tags fails",
+			operation:     models.BulkOperation{IDs: []string{"1"}, Action: constants.BulkAddTags, Tags: []string{"work"}},
+			expectedTags:  []string{"work"},
+			tagsErr:       err,
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &automock.TodoRepository{}
+			repo.EXPECT().Get(ctx, "1").Return(models.Todo{ID: "1", ListID: "list", Tags: tt.current}, nil).Once()
+			tagService := &tagautomock.TagService{}
+			tagService.EXPECT().SetTodoTags(ctx, "1", "list", tt.expectedTags).Return(tt.tagsErr).Once()
+			if tt.tagsErr == nil {
+				repo.EXPECT().Get(ctx, "1").Return(models.Todo{ID: "1", ListID: "list"}, nil).Once()
+			}
+			defer mock.AssertExpectationsForObjects(t, repo, tagService)
+
+			svc := todos.NewService(repo, noopActivity(), noopCustomFields(), tagService, &statusautomock.StatusService{}, &automock.UUIDService{}, &automock.TimeService{})
+			result, err := svc.BulkUpdateTodos(ctx, tt.operation)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, []models.Todo{{ID: "1", ListID: "list"}}, result)
+			}
+		})
+	}
+}
+
+// noopActivity accepts any activity, for tests that are not about recording it.
+func noopActivity() *activityautomock.ActivityService {
+	activityService := &activityautomock.ActivityService{}
+	activityService.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return activityService
+}
+
+// noopCustomFields accepts any custom field values as they are.
+func noopCustomFields() *customfieldautomock.CustomFieldService {
+	customFieldService := &customfieldautomock.CustomFieldService{}
+	customFieldService.EXPECT().ValidateValues(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, values map[string]interface{}) (map[string]interface{}, error) {
+			if len(values) == 0 {
+				return nil, nil
+			}
+			return values, nil
+		}).Maybe()
+	return customFieldService
+}
+
+// noopTags accepts any tags of todos.
+func noopTags() *tagautomock.TagService {
+	tagService := &tagautomock.TagService{}
+	tagService.EXPECT().SetTodoTags(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return tagService
+}
@@ -0,0 +1,261 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// TrashRepository is an autogenerated mock type for the TrashRepository type
+type TrashRepository struct {
+	mock.Mock
+}
+
+type TrashRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TrashRepository) EXPECT() *TrashRepository_Expecter {
+	return &TrashRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userID
+func (_m *TrashRepository) GetByUserID(ctx context.Context, userID string) ([]models.TrashItem, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []models.TrashItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.TrashItem, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.TrashItem); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TrashItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrashRepository_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type TrashRepository_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *TrashRepository_Expecter) GetByUserID(ctx interface{}, userID interface{}) *TrashRepository_GetByUserID_Call {
+	return &TrashRepository_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userID)}
+}
+
+func (_c *TrashRepository_GetByUserID_Call) Run(run func(ctx context.Context, userID string)) *TrashRepository_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TrashRepository_GetByUserID_Call) Return(_a0 []models.TrashItem, _a1 error) *TrashRepository_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TrashRepository_GetByUserID_Call) RunAndReturn(run func(context.Context, string) ([]models.TrashItem, error)) *TrashRepository_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, before
+func (_m *TrashRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrashRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type TrashRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *TrashRepository_Expecter) Purge(ctx interface{}, before interface{}) *TrashRepository_Purge_Call {
+	return &TrashRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, before)}
+}
+
+func (_c *TrashRepository_Purge_Call) Run(run func(ctx context.Context, before time.Time)) *TrashRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TrashRepository_Purge_Call) Return(_a0 int64, _a1 error) *TrashRepository_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TrashRepository_Purge_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *TrashRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreList provides a mock function with given fields: ctx, id, userID
+func (_m *TrashRepository) RestoreList(ctx context.Context, id string, userID string) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TrashRepository_RestoreList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreList'
+type TrashRepository_RestoreList_Call struct {
+	*mock.Call
+}
+
+// RestoreList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *TrashRepository_Expecter) RestoreList(ctx interface{}, id interface{}, userID interface{}) *TrashRepository_RestoreList_Call {
+	return &TrashRepository_RestoreList_Call{Call: _e.mock.On("RestoreList", ctx, id, userID)}
+}
+
+func (_c *TrashRepository_RestoreList_Call) Run(run func(ctx context.Context, id string, userID string)) *TrashRepository_RestoreList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TrashRepository_RestoreList_Call) Return(_a0 error) *TrashRepository_RestoreList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TrashRepository_RestoreList_Call) RunAndReturn(run func(context.Context, string, string) error) *TrashRepository_RestoreList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreTodo provides a mock function with given fields: ctx, id, userID
+func (_m *TrashRepository) RestoreTodo(ctx context.Context, id string, userID string) (string, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreTodo")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrashRepository_RestoreTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreTodo'
+type TrashRepository_RestoreTodo_Call struct {
+	*mock.Call
+}
+
+// RestoreTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *TrashRepository_Expecter) RestoreTodo(ctx interface{}, id interface{}, userID interface{}) *TrashRepository_RestoreTodo_Call {
+	return &TrashRepository_RestoreTodo_Call{Call: _e.mock.On("RestoreTodo", ctx, id, userID)}
+}
+
+func (_c *TrashRepository_RestoreTodo_Call) Run(run func(ctx context.Context, id string, userID string)) *TrashRepository_RestoreTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TrashRepository_RestoreTodo_Call) Return(_a0 string, _a1 error) *TrashRepository_RestoreTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TrashRepository_RestoreTodo_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *TrashRepository_RestoreTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTrashRepository creates a new instance of TrashRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTrashRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TrashRepository {
+	mock := &TrashRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,192 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TrashService is an autogenerated mock type for the TrashService type
+type TrashService struct {
+	mock.Mock
+}
+
+type TrashService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TrashService) EXPECT() *TrashService_Expecter {
+	return &TrashService_Expecter{mock: &_m.Mock}
+}
+
+// ListTrash provides a mock function with given fields: ctx, userID
+func (_m *TrashService) ListTrash(ctx context.Context, userID string) ([]models.TrashItem, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 []models.TrashItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.TrashItem, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.TrashItem); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TrashItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrashService_ListTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrash'
+type TrashService_ListTrash_Call struct {
+	*mock.Call
+}
+
+// ListTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *TrashService_Expecter) ListTrash(ctx interface{}, userID interface{}) *TrashService_ListTrash_Call {
+	return &TrashService_ListTrash_Call{Call: _e.mock.On("ListTrash", ctx, userID)}
+}
+
+func (_c *TrashService_ListTrash_Call) Run(run func(ctx context.Context, userID string)) *TrashService_ListTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TrashService_ListTrash_Call) Return(_a0 []models.TrashItem, _a1 error) *TrashService_ListTrash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TrashService_ListTrash_Call) RunAndReturn(run func(context.Context, string) ([]models.TrashItem, error)) *TrashService_ListTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreList provides a mock function with given fields: ctx, id, userID
+func (_m *TrashService) RestoreList(ctx context.Context, id string, userID string) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TrashService_RestoreList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreList'
+type TrashService_RestoreList_Call struct {
+	*mock.Call
+}
+
+// RestoreList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *TrashService_Expecter) RestoreList(ctx interface{}, id interface{}, userID interface{}) *TrashService_RestoreList_Call {
+	return &TrashService_RestoreList_Call{Call: _e.mock.On("RestoreList", ctx, id, userID)}
+}
+
+func (_c *TrashService_RestoreList_Call) Run(run func(ctx context.Context, id string, userID string)) *TrashService_RestoreList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TrashService_RestoreList_Call) Return(_a0 error) *TrashService_RestoreList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TrashService_RestoreList_Call) RunAndReturn(run func(context.Context, string, string) error) *TrashService_RestoreList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreTodo provides a mock function with given fields: ctx, id, userID
+func (_m *TrashService) RestoreTodo(ctx context.Context, id string, userID string) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TrashService_RestoreTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreTodo'
+type TrashService_RestoreTodo_Call struct {
+	*mock.Call
+}
+
+// RestoreTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *TrashService_Expecter) RestoreTodo(ctx interface{}, id interface{}, userID interface{}) *TrashService_RestoreTodo_Call {
+	return &TrashService_RestoreTodo_Call{Call: _e.mock.On("RestoreTodo", ctx, id, userID)}
+}
+
+func (_c *TrashService_RestoreTodo_Call) Run(run func(ctx context.Context, id string, userID string)) *TrashService_RestoreTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TrashService_RestoreTodo_Call) Return(_a0 error) *TrashService_RestoreTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TrashService_RestoreTodo_Call) RunAndReturn(run func(context.Context, string, string) error) *TrashService_RestoreTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTrashService creates a new instance of TrashService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTrashService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TrashService {
+	mock := &TrashService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,19 @@
+package trash
+
+import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertItemToModel(entity ItemEntity) models.TrashItem {
+	return models.TrashItem{
+		Type:      entity.Type,
+		ID:        entity.ID,
+		ListID:    entity.ListID,
+		Title:     entity.Title,
+		DeletedAt: entity.DeletedAt,
+	}
+}
@@ -0,0 +1,14 @@
+package trash
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+type ItemEntity struct {
+	Type      constants.TrashItemType `db:"type"`
+	ID        string                  `db:"id"`
+	ListID    string                  `db:"list_id"`
+	Title     string                  `db:"title"`
+	DeletedAt time.Time               `db:"deleted_at"`
+}
@@ -0,0 +1,66 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+type PurgerConfig struct {
+	Retention time.Duration `envconfig:"APP_TRASH_RETENTION" default:"720h"`
+	Interval  time.Duration `envconfig:"APP_TRASH_PURGE_INTERVAL" default:"1h"`
+}
+
+// Purger permanently deletes todos and lists that have been in the trash for
+// longer than the retention period.
+type Purger struct {
+	database    *sqlx.DB
+	repo        TrashRepository
+	timeService TimeService
+	config      PurgerConfig
+}
+
+func NewPurger(database *sqlx.DB, repo TrashRepository, timeService TimeService, config PurgerConfig) *Purger {
+	return &Purger{database: database, repo: repo, timeService: timeService, config: config}
+}
+
+// Run purges the trash every interval until ctx is cancelled.
+func (p *Purger) Run(ctx context.Context) {
+	log.C(ctx).Infof("starting trash purger with interval %s and retention %s", p.config.Interval, p.config.Retention)
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := p.Purge(ctx); err != nil {
+			log.C(ctx).Errorf("purging trash failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("stopping trash purger")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge deletes everything trashed before the retention period and returns
+// how many rows were removed.
+func (p *Purger) Purge(ctx context.Context) (int64, error) {
+	tx, err := p.database.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+	ctx = db.SaveToContext(ctx, tx)
+
+	purged, err := p.repo.Purge(ctx, p.timeService.Now().Add(-p.config.Retention))
+	if err != nil {
+		return 0, err
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return purged, nil
+}
@@ -0,0 +1,70 @@
+package trash_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/trash"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/trash/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestPurgerPurge(t *testing.T) {
+	config := trash.PurgerConfig{Retention: 30 * 24 * time.Hour}
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	before := time.Date(2026, time.September, 18, 9, 0, 0, 0, time.UTC)
+	err := errors.New("error")
+
+	tests := []struct {
+		name           string
+		setup          func(mockDB sqlxmock.Sqlmock, repo *automock.TrashRepository)
+		expectedPurged int64
+		expectedError  error
+	}{
+		{
+			name: "Purge everything trashed before the retention period",
+			setup: func(mockDB sqlxmock.Sqlmock, repo *automock.TrashRepository) {
+				mockDB.ExpectBegin()
+				repo.EXPECT().Purge(mock.Anything, before).Return(3, nil).Once()
+				mockDB.ExpectCommit()
+			},
+			expectedPurged: 3,
+		},
+		{
+			name: "Error when purging fails",
+			setup: func(mockDB sqlxmock.Sqlmock, repo *automock.TrashRepository) {
+				mockDB.ExpectBegin()
+				repo.EXPECT().Purge(mock.Anything, before).Return(0, err).Once()
+				mockDB.ExpectRollback()
+			},
+			expectedError: err,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, mockDB, dbErr := sqlxmock.Newx()
+			require.NoError(t, dbErr)
+			repo := &automock.TrashRepository{}
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Once()
+			tt.setup(mockDB, repo)
+			purger := trash.NewPurger(database, repo, timeService, config)
+
+			purged, purgeErr := purger.Purge(context.Background())
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, purgeErr, tt.expectedError)
+			} else {
+				require.NoError(t, purgeErr)
+				assert.Equal(t, tt.expectedPurged, purged)
+			}
+			require.NoError(t, mockDB.ExpectationsWereMet())
+			mock.AssertExpectationsForObjects(t, repo, timeService)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package trash
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"time"
+)
+
+var ErrNotInTrash = errors.New("item not found in trash")
+
+//go:generate mockery --name=TrashRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TrashRepository interface {
+	GetByUserID(ctx context.Context, userID string) ([]models.TrashItem, error)
+	RestoreList(ctx context.Context, id string, userID string) error
+	RestoreTodo(ctx context.Context, id string, userID string) (string, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
+}
+
+type SQLXTrashRepository struct {
+	converter *Converter
+}
+
+var _ TrashRepository = &SQLXTrashRepository{}
+
+func NewSQLXTrashRepository() TrashRepository {
+	return &SQLXTrashRepository{converter: NewConverter()}
+}
+
+// GetByUserID returns the trashed lists the user owns or has accepted access
+// to, and the trashed todos of the user's lists that are not in the trash
+// themselves, newest first.
+func (r *SQLXTrashRepository) GetByUserID(ctx context.Context, userID string) ([]models.TrashItem, error) {
+	log.C(ctx).Info("getting trash of user repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		WITH accessible AS (
+			SELECT list_id FROM list_access
+			WHERE user_id = $1 AND status IN ('owner', 'accepted')
+		)
+		SELECT 'list' AS type, l.id, l.id AS list_id, l.name AS title, l.deleted_at
+		FROM lists l
+		WHERE l.id IN (SELECT list_id FROM accessible) AND l.deleted_at IS NOT NULL
+		UNION ALL
+		SELECT 'todo' AS type, t.id, t.list_id, t.title, t.deleted_at
+		FROM todos t
+		JOIN lists l ON l.id = t.list_id AND l.deleted_at IS NULL
+		WHERE t.list_id IN (SELECT list_id FROM accessible) AND t.deleted_at IS NOT NULL
+		  AND NOT EXISTS (SELECT 1 FROM todos p WHERE p.id = t.parent_id AND p.deleted_at IS NOT NULL)
+		ORDER BY deleted_at DESC, id
+	`
+
+	var entities []ItemEntity
+	err = tx.SelectContext(ctx, &entities, query, userID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get trash: %v", err)
+		return nil, fmt.Errorf("failed to get trash: %w", err)
+	}
+
+	result := make([]models.TrashItem, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertItemToModel(entity))
+	}
+	return result, nil
+}
+
+// RestoreList takes the list out of the trash together with the todos that
+// were trashed with it. Todos deleted before the list stay in the trash. Only
+// the owner, who alone may delete the list, can restore it.
+func (r *SQLXTrashRepository) RestoreList(ctx context.Context, id string, userID string) error {
+	log.C(ctx).Info("restoring list repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `
+		WITH trashed AS (
+			SELECT l.id, l.deleted_at FROM lists l
+			JOIN list_access a ON a.list_id = l.id AND a.user_id = $2 AND a.status = 'owner'
+			WHERE l.id = $1 AND l.deleted_at IS NOT NULL
+		), restored_todos AS (
+			UPDATE todos
+			SET deleted_at = NULL
+			FROM trashed
+			WHERE todos.list_id = trashed.id AND todos.deleted_at = trashed.deleted_at
+		)
+		UPDATE lists
+		SET deleted_at = NULL
+		FROM trashed
+		WHERE lists.id = trashed.id
+	`
+
+	result, err := tx.ExecContext(ctx, query, id, userID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to restore list: %v", err)
+		return fmt.Errorf("failed to restore list: %w", err)
+	}
+	restored, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to restore list: %w", err)
+	}
+	if restored == 0 {
+		return ErrNotInTrash
+	}
+	log.C(ctx).Debugf("restored list: %v", id)
+	return nil
+}
+
+// RestoreTodo takes the todo out of the trash together with the subtasks that
+// were trashed with it and returns the ID of its list. A todo whose list or
+// parent is still in the trash cannot be restored on its own, and the user
+// needs writer access to the list.
+func (r *SQLXTrashRepository) RestoreTodo(ctx context.Context, id string, userID string) (string, error) {
+	log.C(ctx).Info("restoring todo repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	findQuery := `
+		SELECT t.list_id FROM todos t
+		JOIN lists l ON l.id = t.list_id AND l.deleted_at IS NULL
+		JOIN list_access a ON a.list_id = t.list_id AND a.user_id = $2 AND a.status IN ('owner', 'accepted')
+		  AND a.access_level IN ('writer', 'admin')
+		WHERE t.id = $1 AND t.deleted_at IS NOT NULL
+		  AND NOT EXISTS (SELECT 1 FROM todos p WHERE p.id = t.parent_id AND p.deleted_at IS NOT NULL)
+	`
+	var listID string
+	err = tx.GetContext(ctx, &listID, findQuery, id, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNotInTrash
+	}
+	if err != nil {
+		log.C(ctx).Errorf("failed to find trashed todo: %v", err)
+		return "", fmt.Errorf("failed to restore todo: %w", err)
+	}
+
+	restoreQuery := `
+		WITH RECURSIVE restored AS (
+			SELECT id, deleted_at FROM todos WHERE id = $1
+			UNION ALL
+			SELECT t.id, t.deleted_at FROM todos t JOIN restored r ON t.parent_id = r.id AND t.deleted_at = r.deleted_at
+		)
+		UPDATE todos
+		SET deleted_at = NULL
+		WHERE id IN (SELECT id FROM restored)
+	`
+	if _, err = tx.ExecContext(ctx, restoreQuery, id); err != nil {
+		log.C(ctx).Errorf("failed to restore todo: %v", err)
+		return "", fmt.Errorf("failed to restore todo: %w", err)
+	}
+	log.C(ctx).Debugf("restored todo: %v", id)
+	return listID, nil
+}
+
+// Purge permanently deletes the todos and lists trashed before the given time
+// and returns how many rows were removed. Their subtasks, comments,
+// attachments and the rest go with them through the foreign key cascades.
+func (r *SQLXTrashRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	log.C(ctx).Info("purging trash repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return 0, err
+	}
+
+	var purged int64
+	for _, query := range []string{
+		`DELETE FROM todos WHERE deleted_at < $1`,
+		`DELETE FROM lists WHERE deleted_at < $1`,
+	} {
+		result, err := tx.ExecContext(ctx, query, before)
+		if err != nil {
+			log.C(ctx).Errorf("failed to purge trash: %v", err)
+			return 0, fmt.Errorf("failed to purge trash: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to purge trash: %w", err)
+		}
+		purged += rows
+	}
+	log.C(ctx).Debugf("purged %d trashed rows", purged)
+	return purged, nil
+}
@@ -0,0 +1,262 @@
+package trash_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/trash"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXTrashRepositoryGetByUserID(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := trash.NewSQLXTrashRepository()
+	deletedAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedItems []models.TrashItem
+		expectedError error
+	}{
+		{
+			name: "Successful listing of the trash",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("WITH accessible AS (.+) FROM lists l (.+) UNION ALL (.+) FROM todos t (.+) ORDER BY deleted_at DESC, id").
+					WithArgs("user").
+					WillReturnRows(sqlxmock.NewRows([]string{"type", "id", "list_id", "title", "deleted_at"}).
+						AddRow("todo", "1", "2", "Buy milk", deletedAt).
+						AddRow("list", "3", "3", "Groceries", deletedAt.Add(-time.Hour)))
+				mockDB.ExpectCommit()
+			},
+			expectedItems: []models.TrashItem{
+				{Type: constants.TrashItemTodo, ID: "1", ListID: "2", Title: "Buy milk", DeletedAt: deletedAt},
+				{Type: constants.TrashItemList, ID: "3", ListID: "3", Title: "Groceries", DeletedAt: deletedAt.Add(-time.Hour)},
+			},
+		},
+		{
+			name: "Failed listing of the trash due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("WITH accessible AS (.+)").
+					WithArgs("user").
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get trash: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			items, err := repo.GetByUserID(ctx, "user")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedItems, items)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTrashRepositoryRestoreList(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := trash.NewSQLXTrashRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful restore of a list and its todos",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("WITH trashed AS (.+) UPDATE todos SET deleted_at = NULL (.+) UPDATE lists SET deleted_at = NULL").
+					WithArgs("list", "user").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed restore of a list that is not in the trash",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("WITH trashed AS (.+)").
+					WithArgs("list", "user").
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: trash.ErrNotInTrash,
+		},
+		{
+			name: "Failed restore of a list by a collaborator who is not the owner",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec("WITH trashed AS (.+) JOIN list_access a ON a.list_id = l.id AND a.user_id = \\$2 AND a.status = 'owner'").
+					WithArgs("list", "user").
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: trash.ErrNotInTrash,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.RestoreList(ctx, "list", "user")
+
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTrashRepositoryRestoreTodo(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := trash.NewSQLXTrashRepository()
+
+	testCases := []struct {
+		name           string
+		setupMocks     func()
+		expectedListID string
+		expectedError  error
+	}{
+		{
+			name: "Successful restore of a todo and its subtasks",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT t.list_id FROM todos t (.+) WHERE t.id = \\$1 AND t.deleted_at IS NOT NULL").
+					WithArgs("todo", "user").
+					WillReturnRows(sqlxmock.NewRows([]string{"list_id"}).AddRow("list"))
+				mockDB.ExpectExec("WITH RECURSIVE restored AS (.+) UPDATE todos SET deleted_at = NULL").
+					WithArgs("todo").
+					WillReturnResult(sqlxmock.NewResult(0, 2))
+				mockDB.ExpectCommit()
+			},
+			expectedListID: "list",
+		},
+		{
+			name: "Failed restore of a todo that is not in the trash",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT t.list_id FROM todos t").
+					WithArgs("todo", "user").
+					WillReturnError(sql.ErrNoRows)
+				mockDB.ExpectRollback()
+			},
+			expectedError: trash.ErrNotInTrash,
+		},
+		{
+			name: "Failed restore of a todo by a reader of the list",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT t.list_id FROM todos t (.+) AND a.access_level IN \\('writer', 'admin'\\)").
+					WithArgs("todo", "user").
+					WillReturnError(sql.ErrNoRows)
+				mockDB.ExpectRollback()
+			},
+			expectedError: trash.ErrNotInTrash,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			listID, err := repo.RestoreTodo(ctx, "todo", "user")
+
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedListID, listID)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXTrashRepositoryPurge(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := trash.NewSQLXTrashRepository()
+	before := time.Date(2026, time.September, 18, 9, 0, 0, 0, time.UTC)
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectExec("DELETE FROM todos WHERE deleted_at < \\$1").
+		WithArgs(before).
+		WillReturnResult(sqlxmock.NewResult(0, 4))
+	mockDB.ExpectExec("DELETE FROM lists WHERE deleted_at < \\$1").
+		WithArgs(before).
+		WillReturnResult(sqlxmock.NewResult(0, 1))
+	mockDB.ExpectCommit()
+
+	ctx := context.Background()
+	tx, err := database.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+	ctx = db.SaveToContext(ctx, tx)
+
+	purged, err := repo.Purge(ctx, before)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), purged)
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
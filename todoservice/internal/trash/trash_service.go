@@ -0,0 +1,55 @@
+package trash
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/activity"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"time"
+)
+
+//go:generate mockery --name=TrashService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TrashService interface {
+	ListTrash(ctx context.Context, userID string) ([]models.TrashItem, error)
+	RestoreList(ctx context.Context, id string, userID string) error
+	RestoreTodo(ctx context.Context, id string, userID string) error
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ TrashService = &service{}
+
+type service struct {
+	repo     TrashRepository
+	activity activity.ActivityService
+}
+
+func NewService(repo TrashRepository, activityService activity.ActivityService) TrashService {
+	return &service{repo: repo, activity: activityService}
+}
+
+func (s *service) ListTrash(ctx context.Context, userID string) ([]models.TrashItem, error) {
+	log.C(ctx).Info("listing trash service")
+	return s.repo.GetByUserID(ctx, userID)
+}
+
+func (s *service) RestoreList(ctx context.Context, id string, userID string) error {
+	log.C(ctx).Info("restoring list service")
+	if err := s.repo.RestoreList(ctx, id, userID); err != nil {
+		return err
+	}
+	return s.activity.Record(ctx, constants.ActivityListRestored, id, nil, nil, nil)
+}
+
+func (s *service) RestoreTodo(ctx context.Context, id string, userID string) error {
+	log.C(ctx).Info("restoring todo service")
+	listID, err := s.repo.RestoreTodo(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	return s.activity.Record(ctx, constants.ActivityTodoRestored, listID, &id, nil, nil)
+}
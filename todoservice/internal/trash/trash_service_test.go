@@ -0,0 +1,133 @@
+package trash_test
+
+import (
+	"context"
+	"errors"
+	activityautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/activity/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/trash"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/trash/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestServiceRestoreList(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.TrashRepository
+		activity      func() *activityautomock.ActivityService
+		expectedError error
+	}{
+		{
+			name: "Restore list and record it",
+			repo: func() *automock.TrashRepository {
+				repo := &automock.TrashRepository{}
+				repo.EXPECT().RestoreList(ctx, "list", "user").Return(nil).Once()
+				return repo
+			},
+			activity: func() *activityautomock.ActivityService {
+				activityService := &activityautomock.ActivityService{}
+				activityService.EXPECT().Record(ctx, constants.ActivityListRestored, "list", (*string)(nil), nil, nil).Return(nil).Once()
+				return activityService
+			},
+		},
+		{
+			name: "Error when the list is not in the trash",
+			repo: func() *automock.TrashRepository {
+				repo := &automock.TrashRepository{}
+				repo.EXPECT().RestoreList(ctx, "list", "user").Return(trash.ErrNotInTrash).Once()
+				return repo
+			},
+			activity: func() *activityautomock.ActivityService {
+				return &activityautomock.ActivityService{}
+			},
+			expectedError: trash.ErrNotInTrash,
+		},
+		{
+			name: "Error when recording fails",
+			repo: func() *automock.TrashRepository {
+				repo := &automock.TrashRepository{}
+				repo.EXPECT().RestoreList(ctx, "list", "user").Return(nil).Once()
+				return repo
+			},
+			activity: func() *activityautomock.ActivityService {
+				activityService := &activityautomock.ActivityService{}
+				activityService.EXPECT().Record(ctx, constants.ActivityListRestored, "list", (*string)(nil), nil, nil).Return(err).Once()
+				return activityService
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			activityService := tt.activity()
+			defer mock.AssertExpectationsForObjects(t, repo, activityService)
+
+			svc := trash.NewService(repo, activityService)
+			restoreErr := svc.RestoreList(ctx, "list", "user")
+			if tt.expectedError != nil {
+				require.ErrorIs(t, restoreErr, tt.expectedError)
+			} else {
+				require.NoError(t, restoreErr)
+			}
+		})
+	}
+}
+
+func TestServiceRestoreTodo(t *testing.T) {
+	ctx := context.Background()
+	todoID := "todo"
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.TrashRepository
+		activity      func() *activityautomock.ActivityService
+		expectedError error
+	}{
+		{
+			name: "Restore todo and record it on its list",
+			repo: func() *automock.TrashRepository {
+				repo := &automock.TrashRepository{}
+				repo.EXPECT().RestoreTodo(ctx, todoID, "user").Return("list", nil).Once()
+				return repo
+			},
+			activity: func() *activityautomock.ActivityService {
+				activityService := &activityautomock.ActivityService{}
+				activityService.EXPECT().Record(ctx, constants.ActivityTodoRestored, "list", &todoID, nil, nil).Return(nil).Once()
+				return activityService
+			},
+		},
+		{
+			name: "Error when the todo is not in the trash",
+			repo: func() *automock.TrashRepository {
+				repo := &automock.TrashRepository{}
+				repo.EXPECT().RestoreTodo(ctx, todoID, "user").Return("", trash.ErrNotInTrash).Once()
+				return repo
+			},
+			activity: func() *activityautomock.ActivityService {
+				return &activityautomock.ActivityService{}
+			},
+			expectedError: trash.ErrNotInTrash,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			activityService := tt.activity()
+			defer mock.AssertExpectationsForObjects(t, repo, activityService)
+
+			svc := trash.NewService(repo, activityService)
+			restoreErr := svc.RestoreTodo(ctx, todoID, "user")
+			if tt.expectedError != nil {
+				require.ErrorIs(t, restoreErr, tt.expectedError)
+			} else {
+				require.NoError(t, restoreErr)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
 	"time"
 )
 
@@ -94,7 +95,7 @@ func (s *service) SaveRefreshToken(ctx context.Context, email string, refreshTok
 }
 
 func validateUser(user models.User) error {
-	return nil
+	return validation.User(user).Err()
 }
 
 func (s *service) GetUserByEmail(ctx context.Context, email string) (models.User, error) {
@@ -6,6 +6,7 @@ import (
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/users"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/users/automock"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -20,16 +21,16 @@ func TestServiceCreateUser(t *testing.T) {
 	err := errors.New("error")
 
 	modelInput := models.User{
-		Email:    "test",
+		Email:    "test@example.com",
 		GithubID: "github1",
-		Role:     "user",
+		Role:     "reader",
 	}
 
 	model := models.User{
 		ID:        id,
-		Email:     "test",
+		Email:     "test@example.com",
 		GithubID:  "github1",
-		Role:      "user",
+		Role:      "reader",
 		CreatedAt: mockTime,
 		UpdatedAt: mockTime,
 	}
@@ -82,6 +83,14 @@ func TestServiceCreateUser(t *testing.T) {
 			input:         modelInput,
 			expectedError: err,
 		},
+		{
+			name:          "Error when the input is invalid",
+			uuidService:   func() *automock.UUIDService { return &automock.UUIDService{} },
+			repo:          func() *automock.UserRepository { return &automock.UserRepository{} },
+			timeService:   func() *automock.TimeService { return &automock.TimeService{} },
+			input:         models.User{Email: "not an email", Role: "owner"},
+			expectedError: validation.ErrValidation,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -110,9 +119,9 @@ func TestServiceGetUser(t *testing.T) {
 
 	model := models.User{
 		ID:        id,
-		Email:     "test",
+		Email:     "test@example.com",
 		GithubID:  "github1",
-		Role:      "user",
+		Role:      "reader",
 		CreatedAt: mockTime,
 		UpdatedAt: mockTime,
 	}
@@ -165,16 +174,16 @@ func TestServiceUpdateUser(t *testing.T) {
 
 	modelInput := models.User{
 		ID:       id,
-		Email:    "test",
+		Email:    "test@example.com",
 		GithubID: "github1",
-		Role:     "user",
+		Role:     "reader",
 	}
 
 	model := models.User{
 		ID:        id,
-		Email:     "test",
+		Email:     "test@example.com",
 		GithubID:  "github1",
-		Role:      "user",
+		Role:      "reader",
 		CreatedAt: mockTime,
 		UpdatedAt: mockTime,
 	}
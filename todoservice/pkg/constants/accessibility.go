@@ -6,5 +6,7 @@ const (
 	IsOwner       Accessibility = "owner"
 	HasAccessList Accessibility = "has_access_list"
 	HasAccessTodo Accessibility = "has_access_todo"
+	CanMoveTodo   Accessibility = "can_move_todo"
+	CanBulkTodos  Accessibility = "can_bulk_todos"
 	NoRestriction Accessibility = "no_restriction"
 )
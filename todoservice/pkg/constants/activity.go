@@ -0,0 +1,23 @@
+package constants
+
+type ActivityAction string
+
+const (
+	ActivityListCreated       ActivityAction = "list.created"
+	ActivityListUpdated       ActivityAction = "list.updated"
+	ActivityListShared        ActivityAction = "list.shared"
+	ActivityListAccepted      ActivityAction = "list.accepted"
+	ActivityListUnshared      ActivityAction = "list.unshared"
+	ActivityListDeleted       ActivityAction = "list.deleted"
+	ActivityListRestored      ActivityAction = "list.restored"
+	ActivityTodoCreated       ActivityAction = "todo.created"
+	ActivityTodoUpdated       ActivityAction = "todo.updated"
+	ActivityTodoDeleted       ActivityAction = "todo.deleted"
+	ActivityTodoRestored      ActivityAction = "todo.restored"
+	ActivityTodoCompleted     ActivityAction = "todo.completed"
+	ActivityTodoReopened      ActivityAction = "todo.reopened"
+	ActivityTodoMoved         ActivityAction = "todo.moved"
+	ActivityTodoReordered     ActivityAction = "todo.reordered"
+	ActivityDependencyAdded   ActivityAction = "todo.dependency_added"
+	ActivityDependencyRemoved ActivityAction = "todo.dependency_removed"
+)
@@ -0,0 +1,14 @@
+package constants
+
+type BulkAction string
+
+const (
+	BulkComplete    BulkAction = "complete"
+	BulkReopen      BulkAction = "reopen"
+	BulkDelete      BulkAction = "delete"
+	BulkSetPriority BulkAction = "set_priority"
+	BulkAddTags     BulkAction = "add_tags"
+	BulkRemoveTags  BulkAction = "remove_tags"
+	BulkAssign      BulkAction = "assign"
+	BulkMove        BulkAction = "move"
+)
@@ -0,0 +1,10 @@
+package constants
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily           RecurrenceFrequency = "daily"
+	RecurrenceWeekly          RecurrenceFrequency = "weekly"
+	RecurrenceMonthly         RecurrenceFrequency = "monthly"
+	RecurrenceAfterCompletion RecurrenceFrequency = "after_completion"
+)
@@ -0,0 +1,17 @@
+package constants
+
+type ReminderChannel string
+
+const (
+	ReminderChannelEmail   ReminderChannel = "email"
+	ReminderChannelWebhook ReminderChannel = "webhook"
+)
+
+type ReminderStatus string
+
+const (
+	ReminderPending ReminderStatus = "pending"
+	ReminderSending ReminderStatus = "sending"
+	ReminderSent    ReminderStatus = "sent"
+	ReminderFailed  ReminderStatus = "failed"
+)
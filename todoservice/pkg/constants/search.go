@@ -0,0 +1,8 @@
+package constants
+
+type SearchResultType string
+
+const (
+	SearchResultTodo SearchResultType = "todo"
+	SearchResultList SearchResultType = "list"
+)
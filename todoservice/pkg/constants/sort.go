@@ -0,0 +1,17 @@
+package constants
+
+type SortKey string
+
+const (
+	SortPosition  SortKey = "position"
+	SortCreatedAt SortKey = "created_at"
+	SortDueDate   SortKey = "due_date"
+	SortPriority  SortKey = "priority"
+	SortTitle     SortKey = "title"
+	SortName      SortKey = "name"
+)
+
+const (
+	NextCursorHeader = "X-Next-Cursor"
+	MaxPageSize      = 500
+)
@@ -0,0 +1,10 @@
+package constants
+
+// StatusCategory tells whether todos in a workflow status are still open or
+// done.
+type StatusCategory string
+
+const (
+	StatusCategoryOpen StatusCategory = "open"
+	StatusCategoryDone StatusCategory = "done"
+)
@@ -0,0 +1,8 @@
+package constants
+
+type TrashItemType string
+
+const (
+	TrashItemTodo TrashItemType = "todo"
+	TrashItemList TrashItemType = "list"
+)
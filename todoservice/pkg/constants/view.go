@@ -0,0 +1,11 @@
+package constants
+
+// SmartView names a listing of the open todos of every list a user can access.
+type SmartView string
+
+const (
+	SmartViewToday    SmartView = "today"
+	SmartViewUpcoming SmartView = "upcoming"
+	SmartViewOverdue  SmartView = "overdue"
+	SmartViewInbox    SmartView = "inbox"
+)
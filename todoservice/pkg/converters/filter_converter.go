@@ -0,0 +1,176 @@
+package converters
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var todoSortKeyMap = map[string]constants.SortKey{
+	"position":   constants.SortPosition,
+	"created_at": constants.SortCreatedAt,
+	"due_date":   constants.SortDueDate,
+	"priority":   constants.SortPriority,
+	"title":      constants.SortTitle,
+}
+
+var listSortKeyMap = map[string]constants.SortKey{
+	"created_at": constants.SortCreatedAt,
+	"name":       constants.SortName,
+}
+
+// ToTodoFilter reads the todo filter from the query parameters completed,
+// priority, tag, tag_id, status_id, assigned_to, due_before, due_after and
+// field[<field id>] together with the paging parameters sort, order, limit and
+// cursor.
+func ToTodoFilter(values url.Values) (models.TodoFilter, error) {
+	var filter models.TodoFilter
+	if completed := values.Get("completed"); completed != "" {
+		value, err := strconv.ParseBool(completed)
+		if err != nil {
+			return models.TodoFilter{}, fmt.Errorf("invalid completed value %s", completed)
+		}
+		filter.Completed = &value
+	}
+	if priority := values.Get("priority"); priority != "" {
+		value, err := ToPriorityLevel(priority)
+		if err != nil {
+			return models.TodoFilter{}, err
+		}
+		filter.Priority = value
+	}
+	filter.Tag = values.Get("tag")
+	filter.TagID = values.Get("tag_id")
+	filter.StatusID = values.Get("status_id")
+	filter.AssignedTo = values.Get("assigned_to")
+
+	var err error
+	if filter.DueBefore, err = toOptionalTime(values, "due_before"); err != nil {
+		return models.TodoFilter{}, err
+	}
+	if filter.DueAfter, err = toOptionalTime(values, "due_after"); err != nil {
+		return models.TodoFilter{}, err
+	}
+	for key := range values {
+		if !strings.HasPrefix(key, "field[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		id := strings.TrimSuffix(strings.TrimPrefix(key, "field["), "]")
+		if id == "" {
+			return models.TodoFilter{}, fmt.Errorf("invalid custom field filter %s", key)
+		}
+		if filter.CustomFields == nil {
+			filter.CustomFields = make(map[string]string)
+		}
+		filter.CustomFields[id] = values.Get(key)
+	}
+
+	filter.Page, err = toPage(values, todoSortKeyMap)
+	if err != nil {
+		return models.TodoFilter{}, err
+	}
+	return filter, nil
+}
+
+// ToListFilter reads the list filter from the query parameters visibility and
+// tag together with the paging parameters sort, order, limit and cursor.
+func ToListFilter(values url.Values) (models.ListFilter, error) {
+	var filter models.ListFilter
+	if visibility := values.Get("visibility"); visibility != "" {
+		value, err := ToVisibility(visibility)
+		if err != nil {
+			return models.ListFilter{}, err
+		}
+		filter.Visibility = value
+	}
+	filter.Tag = values.Get("tag")
+
+	page, err := toPage(values, listSortKeyMap)
+	if err != nil {
+		return models.ListFilter{}, err
+	}
+	filter.Page = page
+	return filter, nil
+}
+
+func toPage(values url.Values, sortKeys map[string]constants.SortKey) (models.Page, error) {
+	var page models.Page
+	if sort := values.Get("sort"); sort != "" {
+		key, ok := sortKeys[sort]
+		if !ok {
+			return models.Page{}, fmt.Errorf("invalid sort key %s", sort)
+		}
+		page.Sort = key
+	}
+	switch order := values.Get("order"); order {
+	case "", "asc":
+	case "desc":
+		page.Descending = true
+	default:
+		return models.Page{}, fmt.Errorf("invalid order %s", order)
+	}
+	if limit := values.Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil || value <= 0 || value > constants.MaxPageSize {
+			return models.Page{}, fmt.Errorf("invalid limit %s", limit)
+		}
+		page.Limit = value
+	}
+	if cursor := values.Get("cursor"); cursor != "" {
+		value, err := ToCursor(cursor)
+		if err != nil {
+			return models.Page{}, err
+		}
+		page.After = value
+	}
+	return page, nil
+}
+
+// ToTimeEntryFilter reads the time range of a time total from the query
+// parameters from and to.
+func ToTimeEntryFilter(values url.Values) (models.TimeEntryFilter, error) {
+	var filter models.TimeEntryFilter
+	var err error
+	if filter.From, err = toOptionalTime(values, "from"); err != nil {
+		return models.TimeEntryFilter{}, err
+	}
+	if filter.To, err = toOptionalTime(values, "to"); err != nil {
+		return models.TimeEntryFilter{}, err
+	}
+	return filter, nil
+}
+
+func toOptionalTime(values url.Values, name string) (*time.Time, error) {
+	raw := values.Get(name)
+	if raw == "" {
+		return nil, nil
+	}
+	value, err := time.Parse(constants.DateFormat, raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s value %s", name, raw)
+	}
+	return &value, nil
+}
+
+func ToCursor(cursorStr string) (*models.Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %s", cursorStr)
+	}
+	var cursor models.Cursor
+	if err = json.Unmarshal(raw, &cursor); err != nil || cursor.ID == "" {
+		return nil, fmt.Errorf("invalid cursor %s", cursorStr)
+	}
+	return &cursor, nil
+}
+
+func CursorToString(cursor models.Cursor) string {
+	raw, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
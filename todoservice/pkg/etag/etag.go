@@ -0,0 +1,51 @@
+// Package etag exposes the versions of todos and lists as entity tags and
+// reads the version a client expects from the If-Match header.
+package etag
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Format returns the strong entity tag of a version, such as "3".
+func Format(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// Set sets the ETag header of the response to the version.
+func Set(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", Format(version))
+}
+
+// IfMatch returns the versions listed in the If-Match header of the request
+// and whether the request requires one of them. A missing header and "*"
+// require nothing. The header may list several entity tags separated by
+// commas. If-Match compares strongly, so weak tags such as W/"3" and anything
+// not written by Format are left out; a header without any usable tag yields
+// no versions, which nothing matches.
+func IfMatch(r *http.Request) ([]int, bool) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return nil, false
+	}
+	versions := make([]int, 0)
+	for _, tag := range strings.Split(value, ",") {
+		if version, ok := parse(strings.TrimSpace(tag)); ok {
+			versions = append(versions, version)
+		}
+	}
+	return versions, true
+}
+
+// parse returns the version of a strong entity tag written by Format.
+func parse(tag string) (int, bool) {
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil || version < 1 {
+		return 0, false
+	}
+	return version, true
+}
@@ -0,0 +1,71 @@
+package etag_test
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/etag"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIfMatch(t *testing.T) {
+	tests := []struct {
+		name             string
+		header           string
+		expectedVersions []int
+		expectedRequired bool
+	}{
+		{
+			name:             "Missing header requires nothing",
+			header:           "",
+			expectedVersions: nil,
+			expectedRequired: false,
+		},
+		{
+			name:             "Wildcard requires nothing",
+			header:           "*",
+			expectedVersions: nil,
+			expectedRequired: false,
+		},
+		{
+			name:             "Strong tag requires its version",
+			header:           `"3"`,
+			expectedVersions: []int{3},
+			expectedRequired: true,
+		},
+		{
+			name:             "Weak tag never matches",
+			header:           `W/"3"`,
+			expectedVersions: []int{},
+			expectedRequired: true,
+		},
+		{
+			name:             "List requires one of its strong versions",
+			header:           `"3", W/"4" ,"5"`,
+			expectedVersions: []int{3, 5},
+			expectedRequired: true,
+		},
+		{
+			name:             "Garbage never matches",
+			header:           `"abc", 3, "0", "`,
+			expectedVersions: []int{},
+			expectedRequired: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/todos/1", nil)
+			if tt.header != "" {
+				req.Header.Set("If-Match", tt.header)
+			}
+
+			versions, required := etag.IfMatch(req)
+			assert.Equal(t, tt.expectedVersions, versions)
+			assert.Equal(t, tt.expectedRequired, required)
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, `"3"`, etag.Format(3))
+}
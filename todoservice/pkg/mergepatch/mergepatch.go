@@ -0,0 +1,81 @@
+// Package mergepatch applies JSON merge patches (RFC 7386) to todos and lists,
+// so clients can change a few fields without sending the whole object.
+package mergepatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
+	"sort"
+)
+
+// ContentType is the media type of merge patch documents.
+const ContentType = "application/merge-patch+json"
+
+// ErrInvalidPatch is returned when the patch is not a JSON object. A patch of
+// any other kind would replace the whole todo or list.
+var ErrInvalidPatch = errors.New("merge patch must be a JSON object")
+
+// Apply returns the target document with the patch applied. Members of the
+// patch replace the members of the target, objects are merged recursively and
+// null removes the member.
+func Apply(target []byte, patch []byte) ([]byte, error) {
+	var targetValue, patchValue interface{}
+	if err := json.Unmarshal(target, &targetValue); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	if _, ok := patchValue.(map[string]interface{}); !ok {
+		return nil, ErrInvalidPatch
+	}
+	return json.Marshal(merge(targetValue, patchValue))
+}
+
+func merge(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+		targetObject[name] = merge(targetObject[name], value)
+	}
+	return targetObject
+}
+
+// Check makes sure the patch only changes the given members. fields maps the
+// name of every member that may be patched to whether it may be cleared with
+// null. The other members are reported as validation errors.
+func Check(patch []byte, fields map[string]bool) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &members); err != nil || members == nil {
+		return ErrInvalidPatch
+	}
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	v := validation.New()
+	for _, name := range names {
+		nullable, ok := fields[name]
+		switch {
+		case !ok:
+			v.Add(name, "cannot be changed")
+		case !nullable && string(members[name]) == "null":
+			v.Add(name, "cannot be null")
+		}
+	}
+	return v.Err()
+}
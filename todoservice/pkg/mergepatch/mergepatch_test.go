@@ -0,0 +1,70 @@
+package mergepatch_test
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/mergepatch"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		patch         string
+		expected      string
+		expectedError error
+	}{
+		{
+			name:     "Members are replaced, added and removed",
+			target:   `{"title": "Ship it", "description": "Soon", "due_date": "2026-10-20T00:00:00Z"}`,
+			patch:    `{"title": "Ship it today", "due_date": null, "priority": "high"}`,
+			expected: `{"title": "Ship it today", "description": "Soon", "priority": "high"}`,
+		},
+		{
+			name:     "Objects are merged and arrays replaced",
+			target:   `{"custom_fields": {"points": 3, "stage": "todo"}, "tags": ["work", "home"]}`,
+			patch:    `{"custom_fields": {"points": null, "stage": "done"}, "tags": ["work"]}`,
+			expected: `{"custom_fields": {"stage": "done"}, "tags": ["work"]}`,
+		},
+		{
+			name:     "A patched object replaces a scalar",
+			target:   `{"recurrence": null}`,
+			patch:    `{"recurrence": {"frequency": "daily", "interval": null}}`,
+			expected: `{"recurrence": {"frequency": "daily"}}`,
+		},
+		{
+			name:          "Error when the patch is not an object",
+			target:        `{"title": "Ship it"}`,
+			patch:         `["title"]`,
+			expectedError: mergepatch.ErrInvalidPatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := mergepatch.Apply([]byte(tt.target), []byte(tt.patch))
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(result))
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	fields := map[string]bool{"name": false, "description": true}
+
+	require.NoError(t, mergepatch.Check([]byte(`{"name": "Groceries", "description": null}`), fields))
+	require.ErrorIs(t, mergepatch.Check([]byte(`null`), fields), mergepatch.ErrInvalidPatch)
+
+	var validationErr *validation.Errors
+	require.ErrorAs(t, mergepatch.Check([]byte(`{"owner_id": "user", "name": null}`), fields), &validationErr)
+	assert.Equal(t, []validation.FieldError{
+		{Field: "name", Message: "cannot be null"},
+		{Field: "owner_id", Message: "cannot be changed"},
+	}, validationErr.Fields)
+}
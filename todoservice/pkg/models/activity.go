@@ -0,0 +1,27 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+// Activity is one recorded mutation of a list or of a todo in it. ActorID is
+// nil when the change was not made on behalf of a user.
+type Activity struct {
+	ID        string                   `json:"id"`
+	ListID    string                   `json:"list_id"`
+	TodoID    *string                  `json:"todo_id"`
+	ActorID   *string                  `json:"actor_id"`
+	Action    constants.ActivityAction `json:"action"`
+	Changes   []FieldChange            `json:"changes"`
+	CreatedAt time.Time                `json:"created_at"`
+}
+
+// FieldChange holds the JSON encoded value of a field before and after a
+// mutation. Before is null for new values and After is null for removed ones.
+type FieldChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
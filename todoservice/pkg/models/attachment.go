@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Attachment is the metadata of a file attached to a todo. The content lives
+// in the blob store under StorageKey.
+type Attachment struct {
+	ID          string    `json:"id"`
+	TodoID      string    `json:"todo_id"`
+	UploadedBy  *string   `json:"uploaded_by"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	StorageKey  string    `json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
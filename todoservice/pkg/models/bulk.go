@@ -0,0 +1,15 @@
+package models
+
+import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+
+// BulkOperation applies a single action to every todo in IDs. Only the field
+// belonging to Action is used: Priority for set_priority, Tags for add_tags and
+// remove_tags, AssignedTo for assign and ListID for move.
+type BulkOperation struct {
+	IDs        []string                `json:"ids"`
+	Action     constants.BulkAction    `json:"action"`
+	Priority   constants.PriorityLevel `json:"priority,omitempty"`
+	Tags       []string                `json:"tags,omitempty"`
+	AssignedTo string                  `json:"assigned_to,omitempty"`
+	ListID     string                  `json:"list_id,omitempty"`
+}
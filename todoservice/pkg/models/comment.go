@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// Comment is a message on a todo. Mentions holds the IDs of the users
+// mentioned in the body as @email.
+type Comment struct {
+	ID        string    `json:"id"`
+	TodoID    string    `json:"todo_id"`
+	AuthorID  string    `json:"author_id"`
+	Body      string    `json:"body"`
+	Mentions  []string  `json:"mentions"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+// CustomField is a typed field defined on a list. Options lists the allowed
+// values of single select fields.
+type CustomField struct {
+	ID        string                    `json:"id"`
+	ListID    string                    `json:"list_id"`
+	Name      string                    `json:"name"`
+	Type      constants.CustomFieldType `json:"type"`
+	Options   []string                  `json:"options"`
+	CreatedAt time.Time                 `json:"created_at"`
+}
+
+// CustomFieldValue is the value a todo has for a field of its list. Value is
+// nil when the todo has no value for the field.
+type CustomFieldValue struct {
+	FieldID string                    `json:"field_id"`
+	Name    string                    `json:"name"`
+	Type    constants.CustomFieldType `json:"type"`
+	Value   interface{}               `json:"value"`
+}
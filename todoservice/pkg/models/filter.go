@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+// Cursor points at the last item of a page: Value is the sort key of that
+// item and ID breaks ties between items with equal sort keys.
+type Cursor struct {
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+// Page describes the order of a listing and, when Limit is set, which part of
+// it to return. Items come after After in the given order.
+type Page struct {
+	Sort       constants.SortKey
+	Descending bool
+	Limit      int
+	After      *Cursor
+}
+
+// TodoFilter narrows down todo listings. ListID and AccessibleBy are set by
+// the handlers to scope the listing to a list or to the lists a user owns or
+// has accepted; DueFrom and HasDueDate are only set by the smart views and
+// Condition by saved filters. The other fields come from the request. Tag
+// matches tag names case-insensitively while TagID matches a single tag.
+// CustomFields maps custom field IDs to the value the todos must have for
+// them.
+type TodoFilter struct {
+	ListID       string
+	AccessibleBy string
+	Completed    *bool
+	Priority     constants.PriorityLevel
+	Tag          string
+	TagID        string
+	StatusID     string
+	AssignedTo   string
+	DueBefore    *time.Time
+	DueAfter     *time.Time
+	DueFrom      *time.Time
+	HasDueDate   *bool
+	CustomFields map[string]string
+	Condition    Condition
+	Page
+}
+
+// Condition is an extra SQL condition on todos. SQL returns the condition,
+// calling arg for every value to add it as a query parameter and get its
+// placeholder.
+type Condition interface {
+	SQL(arg func(value interface{}) string) string
+}
+
+type ListFilter struct {
+	Visibility constants.Visibility
+	Tag        string
+	Page
+}
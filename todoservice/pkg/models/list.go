@@ -16,4 +16,5 @@ type List struct {
 	CreatedAt   time.Time            `json:"creation_date"`
 	UpdatedAt   time.Time            `json:"last_update_date"`
 	Visibility  constants.Visibility `json:"visibility"`
+	Version     int                  `json:"version"`
 }
@@ -0,0 +1,8 @@
+package models
+
+// QuickAdd is a todo written as free text, e.g. "Pay rent every month on the
+// 1st p1 #finance @alice", to be created in the list with ListID.
+type QuickAdd struct {
+	ListID string `json:"list_id"`
+	Text   string `json:"text"`
+}
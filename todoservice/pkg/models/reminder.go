@@ -0,0 +1,39 @@
+package models
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+// Reminder notifies a user about a todo, either at RemindAt or OffsetMinutes
+// before the todo is due. Exactly one of the two is set. Target is the URL of
+// a webhook reminder; email reminders go to the user's address.
+type Reminder struct {
+	ID            string                    `json:"id"`
+	TodoID        string                    `json:"todo_id"`
+	UserID        string                    `json:"user_id"`
+	RemindAt      *time.Time                `json:"remind_at,omitempty"`
+	OffsetMinutes *int                      `json:"offset_minutes,omitempty"`
+	Channel       constants.ReminderChannel `json:"channel"`
+	Target        string                    `json:"target,omitempty"`
+	Status        constants.ReminderStatus  `json:"status"`
+	Attempts      int                       `json:"attempts"`
+	LastError     string                    `json:"last_error,omitempty"`
+	SentAt        *time.Time                `json:"sent_at,omitempty"`
+	CreatedAt     time.Time                 `json:"created_at"`
+}
+
+// ReminderDelivery is a reminder claimed for delivery together with the todo
+// and recipient details a notifier needs.
+type ReminderDelivery struct {
+	ReminderID string                    `json:"reminder_id"`
+	Channel    constants.ReminderChannel `json:"channel"`
+	Target     string                    `json:"-"`
+	Email      string                    `json:"email"`
+	TodoID     string                    `json:"todo_id"`
+	ListID     string                    `json:"list_id"`
+	TodoTitle  string                    `json:"todo_title"`
+	DueDate    *time.Time                `json:"due_date,omitempty"`
+	FireAt     time.Time                 `json:"fire_at"`
+	Attempts   int                       `json:"attempts"`
+}
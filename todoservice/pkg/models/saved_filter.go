@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// SavedFilter is a filter expression a user keeps under a name to use as a
+// smart list. Saved filters are private to their user.
+type SavedFilter struct {
+	ID         string    `json:"id"`
+	UserID     string    `json:"user_id"`
+	Name       string    `json:"name"`
+	Expression string    `json:"expression"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
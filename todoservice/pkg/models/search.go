@@ -0,0 +1,15 @@
+package models
+
+import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+
+// SearchResult is a todo or list matching a full-text search. Snippet holds
+// the matching part of the text, HTML-escaped, with the matched words wrapped
+// in <b> tags.
+type SearchResult struct {
+	Type    constants.SearchResultType `json:"type"`
+	ID      string                     `json:"id"`
+	ListID  string                     `json:"list_id"`
+	Title   string                     `json:"title"`
+	Snippet string                     `json:"snippet"`
+	Rank    float64                    `json:"rank"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+// WorkflowStatus is a column of the board of a list. Statuses are ordered by
+// Position, and todos in a status of the done category are completed.
+type WorkflowStatus struct {
+	ID        string                   `json:"id"`
+	ListID    string                   `json:"list_id"`
+	Name      string                   `json:"name"`
+	Category  constants.StatusCategory `json:"category"`
+	Position  int                      `json:"position"`
+	CreatedAt time.Time                `json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Tag labels todos and lists. A tag belongs either to a user, who can put it
+// on their lists and on the todos in them, or to a single list whose todos it
+// can be put on; exactly one of UserID and ListID is set. UsageCount is the
+// number of todos and lists carrying the tag, trashed ones excluded.
+type Tag struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Color       string    `json:"color"`
+	Description string    `json:"description"`
+	UserID      *string   `json:"user_id"`
+	ListID      *string   `json:"list_id"`
+	UsageCount  int       `json:"usage_count"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+// ListTemplate is a list kept to be created again, such as an onboarding or a
+// release checklist. The dates of its todos are stored as offsets in days from
+// an anchor date and resolved against a new anchor when the template is
+// instantiated. Templates are private to their owner.
+type ListTemplate struct {
+	ID          string          `json:"id"`
+	OwnerID     string          `json:"owner_id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Tags        json.RawMessage `json:"tags"`
+	Todos       []TemplateTodo  `json:"todos"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// TemplateTodo is a todo of a template. StartOffsetDays and DueOffsetDays are
+// the number of days from the anchor to the start and due date, and nil when
+// the todo has no such date.
+type TemplateTodo struct {
+	Title           string                  `json:"title"`
+	Description     string                  `json:"description"`
+	Priority        constants.PriorityLevel `json:"priority"`
+	Tags            json.RawMessage         `json:"tags"`
+	StartOffsetDays *int                    `json:"start_offset_days"`
+	DueOffsetDays   *int                    `json:"due_offset_days"`
+	Subtasks        []TemplateTodo          `json:"subtasks,omitempty"`
+}
+
+// TemplateAnchor names a template or a list made from one and sets the anchor
+// date its offsets are counted from. An empty name and a nil anchor leave the
+// choice to the service.
+type TemplateAnchor struct {
+	Name   string     `json:"name"`
+	Anchor *time.Time `json:"anchor"`
+}
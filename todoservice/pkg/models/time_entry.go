@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// TimeEntry is time a user spent on a todo. An entry without EndedAt is a
+// running timer.
+type TimeEntry struct {
+	ID        string     `json:"id"`
+	TodoID    string     `json:"todo_id"`
+	UserID    string     `json:"user_id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+	Note      string     `json:"note"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TimeEntryFilter scopes a time total to a todo, a list or a user. Entries
+// overlapping the From/To range only count the overlapping part.
+type TimeEntryFilter struct {
+	TodoID string
+	ListID string
+	UserID string
+	From   *time.Time
+	To     *time.Time
+}
+
+// TimeTotal is the logged time of the entries matching a TimeEntryFilter.
+type TimeTotal struct {
+	Seconds int64 `json:"seconds"`
+	Entries int   `json:"entries"`
+}
@@ -7,16 +7,41 @@ import (
 )
 
 type Todo struct {
-	ID          string                  `json:"id"`
-	ListID      string                  `json:"list_id"`
-	Title       string                  `json:"title"`
-	Description string                  `json:"description"`
-	Tags        json.RawMessage         `json:"tags"`
-	Completed   bool                    `json:"completed"`
-	DueDate     *time.Time              `json:"due_date"`
-	StartDate   *time.Time              `json:"start_date"`
-	Priority    constants.PriorityLevel `json:"priority"`
-	CreatedAt   time.Time               `json:"creation_date"`
-	UpdatedAt   time.Time               `json:"last_update_date"`
-	AssignedTo  *string                 `json:"assigned_to"`
+	ID              string                  `json:"id"`
+	ListID          string                  `json:"list_id"`
+	Title           string                  `json:"title"`
+	Description     string                  `json:"description"`
+	Tags            json.RawMessage         `json:"tags"`
+	Completed       bool                    `json:"completed"`
+	CompletedAt     *time.Time              `json:"completed_at"`
+	DueDate         *time.Time              `json:"due_date"`
+	StartDate       *time.Time              `json:"start_date"`
+	Priority        constants.PriorityLevel `json:"priority"`
+	CreatedAt       time.Time               `json:"creation_date"`
+	UpdatedAt       time.Time               `json:"last_update_date"`
+	AssignedTo      *string                 `json:"assigned_to"`
+	ParentID        *string                 `json:"parent_id"`
+	Subtasks        SubtaskProgress         `json:"subtasks"`
+	Recurrence      *Recurrence             `json:"recurrence"`
+	Position        string                  `json:"position"`
+	EstimateMinutes *int                    `json:"estimate_minutes"`
+	CustomFields    map[string]interface{}  `json:"custom_fields"`
+	StatusID        *string                 `json:"status_id"`
+	Version         int                     `json:"version"`
+}
+
+type SubtaskProgress struct {
+	Total     int `json:"total"`
+	Completed int `json:"completed"`
+}
+
+// Recurrence describes when the next occurrence of a recurring todo is due.
+// Interval is the number of days, weeks or months between occurrences depending
+// on Frequency. Weekdays is only used by weekly rules and DayOfMonth only by
+// monthly rules.
+type Recurrence struct {
+	Frequency  constants.RecurrenceFrequency `json:"frequency"`
+	Interval   int                           `json:"interval"`
+	Weekdays   []time.Weekday                `json:"weekdays,omitempty"`
+	DayOfMonth int                           `json:"day_of_month,omitempty"`
 }
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+// TrashItem is a deleted todo or list that can still be restored. Subtasks
+// and the todos of a deleted list are restored together with it and are not
+// listed on their own.
+type TrashItem struct {
+	Type      constants.TrashItemType `json:"type"`
+	ID        string                  `json:"id"`
+	ListID    string                  `json:"list_id"`
+	Title     string                  `json:"title"`
+	DeletedAt time.Time               `json:"deleted_at"`
+}
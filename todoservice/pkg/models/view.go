@@ -0,0 +1,18 @@
+package models
+
+import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+
+// SmartView holds the todos of a smart view grouped by the day they are due
+// on in TimeZone, earliest day first.
+type SmartView struct {
+	View     constants.SmartView `json:"view"`
+	TimeZone string              `json:"time_zone"`
+	Groups   []TodoDateGroup     `json:"groups"`
+}
+
+// TodoDateGroup holds the todos due on Date, formatted as 2006-01-02. Date is
+// nil for the group of todos without a due date.
+type TodoDateGroup struct {
+	Date  *string `json:"date"`
+	Todos []Todo  `json:"todos"`
+}
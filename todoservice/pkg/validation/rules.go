@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// The limits follow the sizes of the database columns.
+const (
+	MaxTitleLength = 255
+	MaxNameLength  = 255
+	MaxEmailLength = 255
+	MaxTagLength   = 64
+)
+
+// Todo checks the fields of a todo that need no lookups. The caller adds the
+// checks that do, such as whether the assignee can see the list.
+func Todo(todo models.Todo) *Validator {
+	v := New()
+	v.Length("title", todo.Title, 1, MaxTitleLength)
+	v.OneOf("priority", string(todo.Priority), string(constants.PriorityLow), string(constants.PriorityMedium), string(constants.PriorityHigh))
+	v.Order("start_date", todo.StartDate, "due_date", todo.DueDate)
+	v.Tags("tags", todo.Tags, MaxTagLength)
+	v.Check(todo.EstimateMinutes == nil || *todo.EstimateMinutes >= 0, "estimate_minutes", "must not be negative")
+	return v
+}
+
+// List checks the fields of a list.
+func List(list models.List) *Validator {
+	v := New()
+	v.Length("name", list.Name, 1, MaxNameLength)
+	v.OneOf("visibility", string(list.Visibility), string(constants.VisibilityPublic), string(constants.VisibilityPrivate), string(constants.VisibilityShared))
+	v.Check(len(list.SharedWith) == 0 || list.Visibility != constants.VisibilityPrivate, "shared_with", "a private list cannot be shared")
+	v.Tags("tags", list.Tags, MaxTagLength)
+	return v
+}
+
+// User checks the fields of a user.
+func User(user models.User) *Validator {
+	v := New()
+	v.Length("email", user.Email, 1, MaxEmailLength)
+	v.Check(user.Email == "" || pkg.IsValidEmail(user.Email), "email", "must be a valid email address")
+	v.Length("github_id", user.GithubID, 0, MaxNameLength)
+	v.OneOf("role", string(user.Role), string(constants.Reader), string(constants.Writer), string(constants.Admin))
+	return v
+}
+
+// Template checks the fields of a list template and of each of its todos,
+// reporting the todos as todos[i] and their subtasks as todos[i].subtasks[j].
+func Template(template models.ListTemplate) *Validator {
+	v := New()
+	v.Length("name", template.Name, 1, MaxNameLength)
+	v.Tags("tags", template.Tags, MaxTagLength)
+	templateTodos(v, "todos", template.Todos)
+	return v
+}
+
+func templateTodos(v *Validator, field string, todos []models.TemplateTodo) {
+	for i, todo := range todos {
+		prefix := fmt.Sprintf("%s[%d].", field, i)
+		v.Length(prefix+"title", todo.Title, 1, MaxTitleLength)
+		v.OneOf(prefix+"priority", string(todo.Priority), string(constants.PriorityLow), string(constants.PriorityMedium), string(constants.PriorityHigh))
+		v.Tags(prefix+"tags", todo.Tags, MaxTagLength)
+		if todo.StartOffsetDays != nil && todo.DueOffsetDays != nil && *todo.StartOffsetDays > *todo.DueOffsetDays {
+			v.Add(prefix+"start_offset_days", "must not be after %sdue_offset_days", prefix)
+		}
+		templateTodos(v, prefix+"subtasks", todo.Subtasks)
+	}
+}
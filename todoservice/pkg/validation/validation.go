@@ -0,0 +1,147 @@
+// Package validation checks todos, lists and users before they are stored and
+// reports every problem it finds against the field it belongs to, so clients
+// can show the messages next to their inputs.
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrValidation matches every *Errors with errors.Is.
+var ErrValidation = errors.New("validation failed")
+
+// FieldError says why the value of a field was rejected. Field is the JSON
+// name of the field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Errors is the error returned when validation fails. It is sent to clients
+// as {"error": "validation failed", "fields": [...]}.
+type Errors struct {
+	Fields []FieldError `json:"fields"`
+}
+
+func (e *Errors) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		messages = append(messages, field.Field+" "+field.Message)
+	}
+	return ErrValidation.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *Errors) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// Validator collects the field errors of a single value.
+type Validator struct {
+	fields []FieldError
+}
+
+func New() *Validator {
+	return &Validator{}
+}
+
+// Add records a problem with the field.
+func (v *Validator) Add(field string, format string, args ...interface{}) {
+	v.fields = append(v.fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Check records the message against the field unless ok holds.
+func (v *Validator) Check(ok bool, field string, message string) {
+	if !ok {
+		v.Add(field, "%s", message)
+	}
+}
+
+// Length checks that the value, ignoring surrounding white space, has at
+// least min and at most max characters. A min of zero allows an empty value.
+func (v *Validator) Length(field string, value string, min int, max int) {
+	length := utf8.RuneCountInString(strings.TrimSpace(value))
+	switch {
+	case length == 0 && min > 0:
+		v.Add(field, "is required")
+	case length < min:
+		v.Add(field, "must be at least %d characters", min)
+	case length > max:
+		v.Add(field, "must be at most %d characters", max)
+	}
+}
+
+// OneOf checks that the value is one of the allowed ones.
+func (v *Validator) OneOf(field string, value string, allowed ...string) {
+	for _, candidate := range allowed {
+		if value == candidate {
+			return
+		}
+	}
+	v.Add(field, "must be one of %s", strings.Join(allowed, ", "))
+}
+
+// Order checks that start is not after end when both are set.
+func (v *Validator) Order(startField string, start *time.Time, endField string, end *time.Time) {
+	if start != nil && end != nil && start.After(*end) {
+		v.Add(startField, "must not be after %s", endField)
+	}
+}
+
+// Tags checks that raw is a JSON array of tag names of at most maxLength
+// characters. A missing or null array means no tags.
+func (v *Validator) Tags(field string, raw json.RawMessage, maxLength int) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return
+	}
+	var names []string
+	if err := json.Unmarshal(raw, &names); err != nil {
+		v.Add(field, "must be an array of names")
+		return
+	}
+	for _, name := range names {
+		if utf8.RuneCountInString(strings.TrimSpace(name)) > maxLength {
+			v.Add(field, "%q is longer than %d characters", strings.TrimSpace(name), maxLength)
+		}
+	}
+}
+
+// Err returns the collected field errors as an *Errors, or nil when there are
+// none.
+func (v *Validator) Err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &Errors{Fields: v.fields}
+}
+
+// WriteError responds with 400 Bad Request and the field errors as JSON when
+// err is a validation error, and reports whether it did.
+func WriteError(w http.ResponseWriter, err error) bool {
+	var validationErr *Errors
+	if !errors.As(err, &validationErr) {
+		return false
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(struct {
+		Error  string       `json:"error"`
+		Fields []FieldError `json:"fields"`
+	}{Error: ErrValidation.Error(), Fields: validationErr.Fields})
+	return true
+}
+
+// Decode reads the field errors from a response body written by WriteError.
+// It returns nil when the body holds none.
+func Decode(body []byte) *Errors {
+	var validationErr Errors
+	if err := json.Unmarshal(body, &validationErr); err != nil || len(validationErr.Fields) == 0 {
+		return nil
+	}
+	return &validationErr
+}
@@ -0,0 +1,67 @@
+package validation_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          models.List
+		expectedFields []validation.FieldError
+	}{
+		{
+			name:  "Valid list",
+			input: models.List{Name: "Groceries", Visibility: "shared", SharedWith: []string{"user"}, Tags: json.RawMessage(`["home"]`)},
+		},
+		{
+			name:  "Every invalid field is reported",
+			input: models.List{Name: strings.Repeat("a", 256), Visibility: "private", SharedWith: []string{"user"}, Tags: json.RawMessage(`["` + strings.Repeat("b", 65) + `"]`)},
+			expectedFields: []validation.FieldError{
+				{Field: "name", Message: "must be at most 255 characters"},
+				{Field: "shared_with", Message: "a private list cannot be shared"},
+				{Field: "tags", Message: fmt.Sprintf("%q is longer than 64 characters", strings.Repeat("b", 65))},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validation.List(tt.input).Err()
+			if tt.expectedFields == nil {
+				require.NoError(t, err)
+				return
+			}
+			var validationErr *validation.Errors
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedFields, validationErr.Fields)
+		})
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	err := fmt.Errorf("creating user: %w", validation.User(models.User{Email: "alice", Role: "reader"}).Err())
+	require.ErrorIs(t, err, validation.ErrValidation)
+
+	w := httptest.NewRecorder()
+	require.True(t, validation.WriteError(w, err))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"error": "validation failed", "fields": [{"field": "email", "message": "must be a valid email address"}]}`, w.Body.String())
+
+	decoded := validation.Decode(w.Body.Bytes())
+	require.NotNil(t, decoded)
+	assert.Equal(t, []validation.FieldError{{Field: "email", Message: "must be a valid email address"}}, decoded.Fields)
+
+	assert.False(t, validation.WriteError(httptest.NewRecorder(), errors.New("error")))
+	assert.Nil(t, validation.Decode([]byte("list not found")))
+}